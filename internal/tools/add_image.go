@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -15,21 +16,22 @@ import (
 
 // Sentinel errors for add_image tool.
 var (
-	ErrAddImageFailed       = errors.New("failed to add image")
-	ErrInvalidImageData     = errors.New("invalid image data: base64 decoding failed")
-	ErrImageUploadFailed    = errors.New("failed to upload image to Drive")
-	ErrInvalidImageSize     = errors.New("size must have positive width and/or height")
-	ErrInvalidImagePosition = errors.New("position coordinates must be non-negative")
+	ErrAddImageFailed        = errors.New("failed to add image")
+	ErrInvalidImageData      = errors.New("invalid image data: base64 decoding failed")
+	ErrImageUploadFailed     = errors.New("failed to upload image to Drive")
+	ErrInvalidImageSize      = errors.New("size must have positive width and/or height")
+	ErrInvalidImagePosition  = errors.New("position coordinates must be non-negative")
+	ErrDisallowedImageFormat = errors.New("image format not allowed")
 )
 
 // AddImageInput represents the input for the add_image tool.
 type AddImageInput struct {
-	PresentationID string           `json:"presentation_id"`
-	SlideIndex     int              `json:"slide_index,omitempty"` // 1-based index
-	SlideID        string           `json:"slide_id,omitempty"`    // Alternative to slide_index
-	ImageBase64    string           `json:"image_base64"`          // Base64 encoded image data
-	Position       *PositionInput   `json:"position,omitempty"`    // Position in points (default: 0, 0)
-	Size           *ImageSizeInput  `json:"size,omitempty"`        // Size in points (optional)
+	PresentationID string          `json:"presentation_id"`
+	SlideIndex     int             `json:"slide_index,omitempty"` // 1-based index
+	SlideID        string          `json:"slide_id,omitempty"`    // Alternative to slide_index
+	ImageBase64    string          `json:"image_base64"`          // Base64 encoded image data
+	Position       *PositionInput  `json:"position,omitempty"`    // Position in points (default: 0, 0)
+	Size           *ImageSizeInput `json:"size,omitempty"`        // Size in points (optional)
 }
 
 // ImageSizeInput represents width and height for image sizing.
@@ -92,6 +94,9 @@ func (t *Tools) AddImage(ctx context.Context, tokenSource oauth2.TokenSource, in
 	if mimeType == "" {
 		return nil, fmt.Errorf("%w: unable to detect image format", ErrInvalidImageData)
 	}
+	if err := t.checkImageFormatAllowed(mimeType); err != nil {
+		return nil, err
+	}
 
 	// Create services
 	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
@@ -104,49 +109,29 @@ func (t *Tools) AddImage(ctx context.Context, tokenSource oauth2.TokenSource, in
 		return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
 	}
 
-	// Get the presentation to find the target slide
-	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
-	if err != nil {
-		if isNotFoundError(err) {
-			return nil, ErrPresentationNotFound
-		}
-		if isForbiddenError(err) {
-			return nil, ErrAccessDenied
-		}
-		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
-	}
-
 	// Find the target slide
-	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	slideID, _, err := t.resolveSlideID(ctx, slidesService, input.PresentationID, input.SlideIndex, input.SlideID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Upload image to Drive
-	fileName := generateImageFileName()
-	uploadedFile, err := driveService.UploadFile(ctx, fileName, mimeType, bytes.NewReader(imageData))
-	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrImageUploadFailed, err)
-	}
-
-	// Make the file publicly accessible so Slides can read it
-	err = driveService.MakeFilePublic(ctx, uploadedFile.Id)
+	driveFileID, err := t.uploadSlideImage(ctx, driveService, generateImageFileName(), mimeType, imageData)
 	if err != nil {
-		t.config.Logger.Warn("failed to make image public, image may not display",
-			slog.String("file_id", uploadedFile.Id),
-			slog.String("error", err.Error()),
-		)
+		return nil, err
 	}
 
 	// Generate a unique object ID for the image
 	objectID := generateImageObjectID()
 
 	// Build the request to create the image
-	requests := buildImageRequests(objectID, slideID, uploadedFile.Id, input)
+	requests := buildImageRequests(objectID, slideID, driveFileID, input)
 
 	// Execute batch update
 	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
 	if err != nil {
+		// The image never made it onto the slide, so don't leave the upload behind
+		t.deleteOrphanedUploads(ctx, driveService, []string{driveFileID})
 		if isNotFoundError(err) {
 			return nil, ErrPresentationNotFound
 		}
@@ -163,12 +148,58 @@ func (t *Tools) AddImage(ctx context.Context, tokenSource oauth2.TokenSource, in
 	t.config.Logger.Info("image added successfully",
 		slog.String("presentation_id", input.PresentationID),
 		slog.String("object_id", output.ObjectID),
-		slog.String("drive_file_id", uploadedFile.Id),
+		slog.String("drive_file_id", driveFileID),
 	)
 
 	return output, nil
 }
 
+// uploadSlideImage uploads image data to Drive and shares it via link so Slides can read it.
+// Sharing failures are logged rather than returned, as the image may still be readable.
+func (t *Tools) uploadSlideImage(ctx context.Context, driveService DriveService, fileName, mimeType string, imageData []byte) (string, error) {
+	uploadedFile, err := driveService.UploadFile(ctx, fileName, mimeType, bytes.NewReader(imageData))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrImageUploadFailed, err)
+	}
+
+	// Make the file publicly accessible so Slides can read it
+	err = driveService.MakeFilePublic(ctx, uploadedFile.Id)
+	if err != nil {
+		t.config.Logger.Warn("failed to make image public, image may not display",
+			slog.String("file_id", uploadedFile.Id),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return uploadedFile.Id, nil
+}
+
+// orphanCleanupTimeout bounds the best-effort deletion of uploads left behind by a failed request.
+const orphanCleanupTimeout = 30 * time.Second
+
+// deleteOrphanedUploads deletes Drive files uploaded for a request that then failed. It runs
+// even when ctx is cancelled, since cancellation is often why the request failed, and only
+// logs deletion errors so the caller can return the original failure.
+func (t *Tools) deleteOrphanedUploads(ctx context.Context, driveService DriveService, fileIDs []string) {
+	if len(fileIDs) == 0 {
+		return
+	}
+
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), orphanCleanupTimeout)
+	defer cancel()
+
+	for _, fileID := range fileIDs {
+		if err := driveService.DeleteFile(cleanupCtx, fileID); err != nil {
+			t.config.Logger.Warn("failed to delete orphaned upload",
+				slog.String("file_id", fileID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		t.config.Logger.Info("deleted orphaned upload", slog.String("file_id", fileID))
+	}
+}
+
 // detectImageMimeType detects the MIME type from image magic bytes.
 func detectImageMimeType(data []byte) string {
 	if len(data) < 4 {
@@ -204,17 +235,42 @@ func detectImageMimeType(data []byte) string {
 	return ""
 }
 
-// imageTimeNowFunc allows overriding the time function for tests.
-var imageTimeNowFunc = time.Now
+// checkImageFormatAllowed rejects MIME types missing from ToolsConfig.AllowedImageFormats.
+// Entries may be MIME types ("image/png") or short names ("png", "jpg"). An empty list allows all.
+func (t *Tools) checkImageFormatAllowed(mimeType string) error {
+	if len(t.config.AllowedImageFormats) == 0 {
+		return nil
+	}
+
+	for _, format := range t.config.AllowedImageFormats {
+		if normalizeImageFormat(format) == mimeType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s is not in the allowed formats (%s)", ErrDisallowedImageFormat, mimeType, strings.Join(t.config.AllowedImageFormats, ", "))
+}
+
+// normalizeImageFormat turns a short format name into its MIME type.
+func normalizeImageFormat(format string) string {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if strings.Contains(format, "/") {
+		return format
+	}
+	if format == "jpg" {
+		format = "jpeg"
+	}
+	return "image/" + format
+}
 
 // generateImageFileName generates a unique file name for the uploaded image.
 func generateImageFileName() string {
-	return fmt.Sprintf("slides_image_%d", imageTimeNowFunc().UnixNano())
+	return newObjectID("slides_image")
 }
 
 // generateImageObjectID generates a unique object ID for a new image element.
 func generateImageObjectID() string {
-	return fmt.Sprintf("image_%d", imageTimeNowFunc().UnixNano())
+	return newObjectID("image")
 }
 
 // buildImageRequests creates the batch update requests to add an image.
@@ -273,4 +329,3 @@ func buildImageRequests(objectID, slideID, driveFileID string, input AddImageInp
 		},
 	}
 }
-
@@ -0,0 +1,232 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for add_image_grid tool.
+var (
+	ErrAddImageGridFailed = errors.New("failed to add image grid")
+	ErrInvalidImageGrid   = errors.New("invalid image grid")
+)
+
+// Default slide size in points, used when the presentation does not report a page size.
+const (
+	defaultPageWidthPoints  = 720.0
+	defaultPageHeightPoints = 405.0
+)
+
+// AddImageGridInput represents the input for the add_image_grid tool.
+type AddImageGridInput struct {
+	PresentationID string   `json:"presentation_id"`
+	SlideIndex     int      `json:"slide_index,omitempty"` // 1-based index
+	SlideID        string   `json:"slide_id,omitempty"`    // Alternative to slide_index
+	Images         []string `json:"images"`                // Base64 encoded images, placed row by row
+	Rows           int      `json:"rows"`
+	Columns        int      `json:"columns"`
+	Spacing        float64  `json:"spacing,omitempty"` // Gap between cells in points
+	Margin         float64  `json:"margin,omitempty"`  // Distance from slide edges in points
+}
+
+// AddImageGridOutput represents the output of the add_image_grid tool.
+type AddImageGridOutput struct {
+	ObjectIDs []string        `json:"object_ids"`
+	Cells     []ImageGridCell `json:"cells"`
+}
+
+// ImageGridCell describes where one image of the grid was placed.
+type ImageGridCell struct {
+	Row      int     `json:"row"`    // 0-based
+	Column   int     `json:"column"` // 0-based
+	ObjectID string  `json:"object_id"`
+	X        float64 `json:"x"` // Points
+	Y        float64 `json:"y"` // Points
+	Width    float64 `json:"width"`
+	Height   float64 `json:"height"`
+}
+
+// AddImageGrid uploads several images and places them on a slide in a rows x columns grid.
+func (t *Tools) AddImageGrid(ctx context.Context, tokenSource oauth2.TokenSource, input AddImageGridInput) (*AddImageGridOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	if input.SlideIndex == 0 && input.SlideID == "" {
+		return nil, ErrInvalidSlideReference
+	}
+
+	if len(input.Images) == 0 {
+		return nil, fmt.Errorf("%w: at least one image is required", ErrInvalidImageData)
+	}
+
+	if input.Rows < 1 || input.Columns < 1 {
+		return nil, fmt.Errorf("%w: rows and columns must be at least 1", ErrInvalidImageGrid)
+	}
+
+	if len(input.Images) > input.Rows*input.Columns {
+		return nil, fmt.Errorf("%w: %d images do not fit a %dx%d grid", ErrInvalidImageGrid, len(input.Images), input.Rows, input.Columns)
+	}
+
+	if input.Spacing < 0 || input.Margin < 0 {
+		return nil, fmt.Errorf("%w: spacing and margin must be non-negative", ErrInvalidImageGrid)
+	}
+
+	// Decode all images before touching Drive
+	imageData := make([][]byte, len(input.Images))
+	mimeTypes := make([]string, len(input.Images))
+	for i, encoded := range input.Images {
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w: image %d: %v", ErrInvalidImageData, i, err)
+		}
+		mimeType := detectImageMimeType(data)
+		if mimeType == "" {
+			return nil, fmt.Errorf("%w: image %d: unable to detect image format", ErrInvalidImageData, i)
+		}
+		if err := t.checkImageFormatAllowed(mimeType); err != nil {
+			return nil, fmt.Errorf("image %d: %w", i, err)
+		}
+		imageData[i] = data
+		mimeTypes[i] = mimeType
+	}
+
+	t.config.Logger.Info("adding image grid to slide",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+		slog.Int("image_count", len(input.Images)),
+		slog.Int("rows", input.Rows),
+		slog.Int("columns", input.Columns),
+	)
+
+	// Create services
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	driveService, err := t.driveServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+	}
+
+	// Get the presentation to find the target slide and page size
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	if err != nil {
+		return nil, err
+	}
+
+	pageWidth, pageHeight := pageSizeInPoints(presentation.PageSize)
+	cells, err := computeImageGridCells(len(input.Images), input.Rows, input.Columns, input.Spacing, input.Margin, pageWidth, pageHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Upload each image and place it in its cell: one step per upload plus the final placement
+	progress := ProgressFromContext(ctx)
+	totalSteps := len(cells) + 1
+	baseObjectID := generateImageObjectID()
+	baseFileName := generateImageFileName()
+	var requests []*slides.Request
+	objectIDs := make([]string, 0, len(cells))
+	uploadedFileIDs := make([]string, 0, len(cells))
+
+	for i := range cells {
+		driveFileID, err := t.uploadSlideImage(ctx, driveService, fmt.Sprintf("%s_%d", baseFileName, i), mimeTypes[i], imageData[i])
+		if err != nil {
+			t.deleteOrphanedUploads(ctx, driveService, uploadedFileIDs)
+			return nil, err
+		}
+		uploadedFileIDs = append(uploadedFileIDs, driveFileID)
+
+		cells[i].ObjectID = fmt.Sprintf("%s_%d", baseObjectID, i)
+		objectIDs = append(objectIDs, cells[i].ObjectID)
+
+		width := cells[i].Width
+		height := cells[i].Height
+		requests = append(requests, buildImageRequests(cells[i].ObjectID, slideID, driveFileID, AddImageInput{
+			Position: &PositionInput{X: cells[i].X, Y: cells[i].Y},
+			Size:     &ImageSizeInput{Width: &width, Height: &height},
+		})...)
+
+		progress.Report(i+1, totalSteps, fmt.Sprintf("Uploaded image %d of %d", i+1, len(cells)))
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		t.deleteOrphanedUploads(ctx, driveService, uploadedFileIDs)
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrAddImageGridFailed, err)
+	}
+	progress.Report(totalSteps, totalSteps, "Placed images on slide")
+
+	output := &AddImageGridOutput{
+		ObjectIDs: objectIDs,
+		Cells:     cells,
+	}
+
+	t.config.Logger.Info("image grid added successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("slide_id", slideID),
+		slog.Int("image_count", len(objectIDs)),
+	)
+
+	return output, nil
+}
+
+// pageSizeInPoints returns the page dimensions in points, falling back to the default slide size.
+func pageSizeInPoints(pageSize *slides.Size) (float64, float64) {
+	if pageSize == nil || pageSize.Width == nil || pageSize.Height == nil {
+		return defaultPageWidthPoints, defaultPageHeightPoints
+	}
+	return convertToPoints(pageSize.Width), convertToPoints(pageSize.Height)
+}
+
+// computeImageGridCells lays out count cells row by row inside the page minus margins.
+func computeImageGridCells(count, rows, columns int, spacing, margin, pageWidth, pageHeight float64) ([]ImageGridCell, error) {
+	cellWidth := (pageWidth - 2*margin - float64(columns-1)*spacing) / float64(columns)
+	cellHeight := (pageHeight - 2*margin - float64(rows-1)*spacing) / float64(rows)
+	if cellWidth <= 0 || cellHeight <= 0 {
+		return nil, fmt.Errorf("%w: margin and spacing leave no room for cells", ErrInvalidImageGrid)
+	}
+
+	cells := make([]ImageGridCell, 0, count)
+	for i := 0; i < count; i++ {
+		row := i / columns
+		column := i % columns
+		cells = append(cells, ImageGridCell{
+			Row:    row,
+			Column: column,
+			X:      margin + float64(column)*(cellWidth+spacing),
+			Y:      margin + float64(row)*(cellHeight+spacing),
+			Width:  cellWidth,
+			Height: cellHeight,
+		})
+	}
+	return cells, nil
+}
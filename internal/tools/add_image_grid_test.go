@@ -0,0 +1,302 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+func newImageGridTestTools(mockSlides *mockSlidesService, mockDrive *mockDriveService) *Tools {
+	return NewToolsWithDrive(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	}, func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return mockDrive, nil
+	})
+}
+
+func TestAddImageGrid_TwoByTwo(t *testing.T) {
+	var capturedRequests []*slides.Request
+	uploads := 0
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "test-presentation",
+				PageSize: &slides.Size{
+					Width:  &slides.Dimension{Magnitude: 720, Unit: "PT"},
+					Height: &slides.Dimension{Magnitude: 400, Unit: "PT"},
+				},
+				Slides: []*slides.Page{{ObjectId: "slide-1"}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			uploads++
+			return &drive.File{Id: "file-" + string(rune('a'+uploads-1))}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+	}
+
+	image := base64.StdEncoding.EncodeToString(testPNGBytes)
+	tools := newImageGridTestTools(mockSlides, mockDrive)
+
+	output, err := tools.AddImageGrid(context.Background(), &mockTokenSource{}, AddImageGridInput{
+		PresentationID: "test-presentation",
+		SlideIndex:     1,
+		Images:         []string{image, image, image, image},
+		Rows:           2,
+		Columns:        2,
+		Spacing:        20,
+		Margin:         40,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if uploads != 4 {
+		t.Errorf("expected 4 uploads, got %d", uploads)
+	}
+	if len(output.ObjectIDs) != 4 || len(capturedRequests) != 4 {
+		t.Fatalf("expected 4 objects and requests, got %d and %d", len(output.ObjectIDs), len(capturedRequests))
+	}
+
+	// Usable area is 640x320, so each cell is (640-20)/2 x (320-20)/2 = 310x150
+	wantCells := []ImageGridCell{
+		{Row: 0, Column: 0, X: 40, Y: 40, Width: 310, Height: 150},
+		{Row: 0, Column: 1, X: 370, Y: 40, Width: 310, Height: 150},
+		{Row: 1, Column: 0, X: 40, Y: 210, Width: 310, Height: 150},
+		{Row: 1, Column: 1, X: 370, Y: 210, Width: 310, Height: 150},
+	}
+
+	for i, want := range wantCells {
+		got := output.Cells[i]
+		if got.Row != want.Row || got.Column != want.Column || got.X != want.X || got.Y != want.Y ||
+			got.Width != want.Width || got.Height != want.Height {
+			t.Errorf("cell %d = %+v, want %+v", i, got, want)
+		}
+
+		req := capturedRequests[i].CreateImage
+		if req == nil {
+			t.Fatalf("request %d: expected CreateImage", i)
+		}
+		if req.ObjectId != got.ObjectID {
+			t.Errorf("request %d: ObjectId = %q, want %q", i, req.ObjectId, got.ObjectID)
+		}
+		if req.ElementProperties.PageObjectId != "slide-1" {
+			t.Errorf("request %d: PageObjectId = %q, want slide-1", i, req.ElementProperties.PageObjectId)
+		}
+		if math.Abs(req.ElementProperties.Transform.TranslateX-pointsToEMU(want.X)) > 0.01 ||
+			math.Abs(req.ElementProperties.Transform.TranslateY-pointsToEMU(want.Y)) > 0.01 {
+			t.Errorf("request %d: translate = (%.0f, %.0f), want (%.0f, %.0f)", i,
+				req.ElementProperties.Transform.TranslateX, req.ElementProperties.Transform.TranslateY,
+				pointsToEMU(want.X), pointsToEMU(want.Y))
+		}
+		if req.ElementProperties.Size.Width.Magnitude != pointsToEMU(want.Width) {
+			t.Errorf("request %d: width = %.0f, want %.0f", i, req.ElementProperties.Size.Width.Magnitude, pointsToEMU(want.Width))
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, id := range output.ObjectIDs {
+		if seen[id] {
+			t.Errorf("duplicate object ID %q", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestAddImageGrid_ValidationErrors(t *testing.T) {
+	image := base64.StdEncoding.EncodeToString(testPNGBytes)
+
+	tests := []struct {
+		name    string
+		input   AddImageGridInput
+		wantErr error
+	}{
+		{
+			name:    "missing presentation ID",
+			input:   AddImageGridInput{SlideIndex: 1, Images: []string{image}, Rows: 1, Columns: 1},
+			wantErr: ErrInvalidPresentationID,
+		},
+		{
+			name:    "missing slide reference",
+			input:   AddImageGridInput{PresentationID: "p", Images: []string{image}, Rows: 1, Columns: 1},
+			wantErr: ErrInvalidSlideReference,
+		},
+		{
+			name:    "no images",
+			input:   AddImageGridInput{PresentationID: "p", SlideIndex: 1, Rows: 1, Columns: 1},
+			wantErr: ErrInvalidImageData,
+		},
+		{
+			name:    "zero rows",
+			input:   AddImageGridInput{PresentationID: "p", SlideIndex: 1, Images: []string{image}, Columns: 1},
+			wantErr: ErrInvalidImageGrid,
+		},
+		{
+			name:    "too many images",
+			input:   AddImageGridInput{PresentationID: "p", SlideIndex: 1, Images: []string{image, image, image}, Rows: 1, Columns: 2},
+			wantErr: ErrInvalidImageGrid,
+		},
+		{
+			name:    "negative spacing",
+			input:   AddImageGridInput{PresentationID: "p", SlideIndex: 1, Images: []string{image}, Rows: 1, Columns: 1, Spacing: -1},
+			wantErr: ErrInvalidImageGrid,
+		},
+		{
+			name:    "invalid base64",
+			input:   AddImageGridInput{PresentationID: "p", SlideIndex: 1, Images: []string{"not-base64!"}, Rows: 1, Columns: 1},
+			wantErr: ErrInvalidImageData,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newImageGridTestTools(&mockSlidesService{}, &mockDriveService{})
+			_, err := tools.AddImageGrid(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAddImageGrid_MarginTooLarge(t *testing.T) {
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+	}
+	tools := newImageGridTestTools(mockSlides, &mockDriveService{})
+
+	_, err := tools.AddImageGrid(context.Background(), &mockTokenSource{}, AddImageGridInput{
+		PresentationID: "p",
+		SlideIndex:     1,
+		Images:         []string{base64.StdEncoding.EncodeToString(testPNGBytes)},
+		Rows:           1,
+		Columns:        1,
+		Margin:         400,
+	})
+	if !errors.Is(err, ErrInvalidImageGrid) {
+		t.Errorf("expected ErrInvalidImageGrid, got %v", err)
+	}
+}
+
+func TestAddImageGrid_APIErrors(t *testing.T) {
+	image := base64.StdEncoding.EncodeToString(testPNGBytes)
+
+	tests := []struct {
+		name      string
+		getErr    error
+		uploadErr error
+		batchErr  error
+		wantErr   error
+	}{
+		{name: "presentation not found", getErr: errors.New("googleapi: Error 404: not found"), wantErr: ErrPresentationNotFound},
+		{name: "access denied", getErr: errors.New("googleapi: Error 403: forbidden"), wantErr: ErrAccessDenied},
+		{name: "upload failed", uploadErr: errors.New("quota exceeded"), wantErr: ErrImageUploadFailed},
+		{name: "batch update failed", batchErr: errors.New("server error"), wantErr: ErrAddImageGridFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSlides := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					if tt.batchErr != nil {
+						return nil, tt.batchErr
+					}
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			mockDrive := &mockDriveService{
+				UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+					if tt.uploadErr != nil {
+						return nil, tt.uploadErr
+					}
+					return &drive.File{Id: "file-1"}, nil
+				},
+				MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+					return nil
+				},
+			}
+
+			tools := newImageGridTestTools(mockSlides, mockDrive)
+			_, err := tools.AddImageGrid(context.Background(), &mockTokenSource{}, AddImageGridInput{
+				PresentationID: "p",
+				SlideIndex:     1,
+				Images:         []string{image},
+				Rows:           1,
+				Columns:        1,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAddImageGrid_ReportsProgress(t *testing.T) {
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			return &drive.File{Id: "file-1"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+	}
+
+	recorder := &progressRecorder{}
+	ctx := WithProgress(context.Background(), recorder)
+	image := base64.StdEncoding.EncodeToString(testPNGBytes)
+
+	tools := newImageGridTestTools(mockSlides, mockDrive)
+	_, err := tools.AddImageGrid(ctx, &mockTokenSource{}, AddImageGridInput{
+		PresentationID: "p",
+		SlideIndex:     1,
+		Images:         []string{image, image, image},
+		Rows:           2,
+		Columns:        2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Three uploads plus the final placement
+	wantCompleted := []int{1, 2, 3, 4}
+	if len(recorder.completed) != len(wantCompleted) {
+		t.Fatalf("expected %d progress reports, got %d", len(wantCompleted), len(recorder.completed))
+	}
+	for i, want := range wantCompleted {
+		if recorder.completed[i] != want || recorder.totals[i] != 4 {
+			t.Errorf("report %d = %d/%d, want %d/4", i, recorder.completed[i], recorder.totals[i], want)
+		}
+	}
+}
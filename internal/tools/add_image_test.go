@@ -6,7 +6,6 @@ import (
 	"errors"
 	"io"
 	"testing"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/drive/v3"
@@ -96,12 +95,8 @@ func TestAddImage_Success(t *testing.T) {
 		return mockDrive, nil
 	}
 
-	// Set fixed time for deterministic object IDs
-	originalTimeFunc := imageTimeNowFunc
-	imageTimeNowFunc = func() time.Time {
-		return time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
-	}
-	defer func() { imageTimeNowFunc = originalTimeFunc }()
+	// Fixed ID suffix for deterministic object IDs
+	stubObjectIDSuffix(t, "1705312800000000000")
 
 	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, driveFactory)
 	tokenSource := &mockTokenSource{}
@@ -851,6 +846,66 @@ func TestAddImage_BatchUpdateFailed(t *testing.T) {
 	}
 }
 
+func TestAddImage_BatchUpdateFailed_DeletesUpload(t *testing.T) {
+	// The caller's context is cancelled by the time cleanup runs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "test-presentation",
+				Slides:         []*slides.Page{{ObjectId: "slide-1"}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			cancel()
+			return nil, context.Canceled
+		},
+	}
+
+	var deletedIDs []string
+	var deleteCtxErr error
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			return &drive.File{Id: "uploaded-file-123"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			deletedIDs = append(deletedIDs, fileID)
+			deleteCtxErr = ctx.Err()
+			return nil
+		},
+	}
+
+	slidesFactory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	}
+	driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return mockDrive, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, driveFactory)
+
+	_, err := tools.AddImage(ctx, &mockTokenSource{}, AddImageInput{
+		PresentationID: "test-presentation",
+		SlideIndex:     1,
+		ImageBase64:    base64.StdEncoding.EncodeToString(testPNGBytes),
+	})
+	if !errors.Is(err, ErrAddImageFailed) {
+		t.Fatalf("expected ErrAddImageFailed, got %v", err)
+	}
+
+	if len(deletedIDs) != 1 || deletedIDs[0] != "uploaded-file-123" {
+		t.Errorf("expected uploaded file to be deleted, got %v", deletedIDs)
+	}
+	if deleteCtxErr != nil {
+		t.Errorf("expected cleanup context to survive cancellation, got %v", deleteCtxErr)
+	}
+}
+
 // Helper function to create a pointer to float64
 func ptrFloat64(f float64) *float64 {
 	return &f
@@ -917,11 +972,7 @@ func TestDetectImageMimeType(t *testing.T) {
 
 // Tests for generateImageFileName and generateImageObjectID
 func TestGenerateImageFileName(t *testing.T) {
-	originalTimeFunc := imageTimeNowFunc
-	imageTimeNowFunc = func() time.Time {
-		return time.Date(2024, 1, 15, 10, 30, 0, 123456789, time.UTC)
-	}
-	defer func() { imageTimeNowFunc = originalTimeFunc }()
+	stubObjectIDSuffix(t, "1705314600123456789")
 
 	fileName := generateImageFileName()
 	if fileName == "" {
@@ -935,11 +986,7 @@ func TestGenerateImageFileName(t *testing.T) {
 }
 
 func TestGenerateImageObjectID(t *testing.T) {
-	originalTimeFunc := imageTimeNowFunc
-	imageTimeNowFunc = func() time.Time {
-		return time.Date(2024, 1, 15, 10, 30, 0, 123456789, time.UTC)
-	}
-	defer func() { imageTimeNowFunc = originalTimeFunc }()
+	stubObjectIDSuffix(t, "1705314600123456789")
 
 	objectID := generateImageObjectID()
 	if objectID == "" {
@@ -1085,3 +1132,83 @@ func TestBuildImageRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestAddImage_AllowedImageFormats(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		image   []byte
+		wantErr error
+	}{
+		{name: "gif rejected when only png and jpeg allowed", allowed: []string{"png", "jpeg"}, image: testGIFBytes, wantErr: ErrDisallowedImageFormat},
+		{name: "png accepted by short name", allowed: []string{"png", "jpeg"}, image: testPNGBytes},
+		{name: "png accepted by MIME type", allowed: []string{"image/png"}, image: testPNGBytes},
+		{name: "gif accepted when no allowlist", image: testGIFBytes},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uploaded := false
+			mockSlides := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			mockDrive := &mockDriveService{
+				UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+					uploaded = true
+					return &drive.File{Id: "file-1"}, nil
+				},
+				MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+					return nil
+				},
+			}
+
+			config := DefaultToolsConfig()
+			config.AllowedImageFormats = tt.allowed
+			tools := NewToolsWithDrive(config, func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockSlides, nil
+			}, func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+				return mockDrive, nil
+			})
+
+			_, err := tools.AddImage(context.Background(), &mockTokenSource{}, AddImageInput{
+				PresentationID: "test-presentation",
+				SlideIndex:     1,
+				ImageBase64:    base64.StdEncoding.EncodeToString(tt.image),
+			})
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				if uploaded {
+					t.Error("disallowed image should not be uploaded")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNormalizeImageFormat(t *testing.T) {
+	tests := map[string]string{
+		"png":        "image/png",
+		"JPG":        "image/jpeg",
+		"jpeg":       "image/jpeg",
+		" image/GIF": "image/gif",
+		"webp":       "image/webp",
+	}
+
+	for input, want := range tests {
+		if got := normalizeImageFormat(input); got != want {
+			t.Errorf("normalizeImageFormat(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -36,14 +36,21 @@ var validLayoutTypes = map[string]bool{
 // AddSlideInput represents the input for the add_slide tool.
 type AddSlideInput struct {
 	PresentationID string `json:"presentation_id"`
-	Position       int    `json:"position,omitempty"` // 1-based position (0 or omitted = end)
-	Layout         string `json:"layout"`             // Layout type (BLANK, TITLE, TITLE_AND_BODY, etc.)
+	Position       int    `json:"position,omitempty"`         // 1-based position (0 or omitted = end)
+	Layout         string `json:"layout,omitempty"`           // Layout type (BLANK, TITLE, TITLE_AND_BODY, etc.)
+	LayoutObjectID string `json:"layout_object_id,omitempty"` // Specific layout in the deck, e.g. a custom one (use this OR Layout)
+
+	ReturnPlaceholders bool `json:"return_placeholders,omitempty"` // Re-fetch the new slide and return its placeholder IDs
 }
 
 // AddSlideOutput represents the output of the add_slide tool.
 type AddSlideOutput struct {
 	SlideIndex int    `json:"slide_index"` // 1-based index of the new slide
 	SlideID    string `json:"slide_id"`    // Object ID of the new slide
+
+	// Placeholder type (TITLE, BODY, ...) to object ID, only with return_placeholders.
+	// Repeated types get a numbered key in page order: BODY, BODY_2, ...
+	Placeholders map[string]string `json:"placeholders,omitempty"`
 }
 
 // AddSlide adds a new slide to a presentation.
@@ -53,19 +60,15 @@ func (t *Tools) AddSlide(ctx context.Context, tokenSource oauth2.TokenSource, in
 		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
 	}
 
-	if input.Layout == "" {
-		return nil, fmt.Errorf("%w: layout is required", ErrInvalidLayout)
-	}
-
-	// Validate layout type
-	if !validLayoutTypes[input.Layout] {
-		return nil, fmt.Errorf("%w: unsupported layout '%s'", ErrInvalidLayout, input.Layout)
+	if err := validateAddSlideLayout(input); err != nil {
+		return nil, err
 	}
 
 	t.config.Logger.Info("adding slide to presentation",
 		slog.String("presentation_id", input.PresentationID),
 		slog.Int("position", input.Position),
 		slog.String("layout", input.Layout),
+		slog.String("layout_object_id", input.LayoutObjectID),
 	)
 
 	// Create Slides service
@@ -98,35 +101,15 @@ func (t *Tools) AddSlide(ctx context.Context, tokenSource oauth2.TokenSource, in
 		insertionIndex = input.Position - 1
 	}
 
-	// Find the layout object ID that matches the requested layout type
-	layoutObjectID := findLayoutByType(presentation.Layouts, input.Layout)
-	if layoutObjectID == "" {
-		// If no matching layout found, use the first layout as fallback
-		// This can happen if the presentation has custom layouts
-		if len(presentation.Layouts) > 0 {
-			layoutObjectID = presentation.Layouts[0].ObjectId
-			t.config.Logger.Warn("requested layout not found, using first available layout",
-				slog.String("requested_layout", input.Layout),
-				slog.String("fallback_layout_id", layoutObjectID),
-			)
-		}
+	layoutReference, err := t.resolveSlideLayout(presentation, input.Layout, input.LayoutObjectID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build the CreateSlideRequest
 	createSlideRequest := &slides.CreateSlideRequest{
-		InsertionIndex: int64(insertionIndex),
-	}
-
-	// Only set LayoutReference if we found a layout
-	if layoutObjectID != "" {
-		createSlideRequest.SlideLayoutReference = &slides.LayoutReference{
-			LayoutId: layoutObjectID,
-		}
-	} else {
-		// Use predefined layout type
-		createSlideRequest.SlideLayoutReference = &slides.LayoutReference{
-			PredefinedLayout: input.Layout,
-		}
+		InsertionIndex:       int64(insertionIndex),
+		SlideLayoutReference: layoutReference,
 	}
 
 	// Execute batch update
@@ -161,6 +144,10 @@ func (t *Tools) AddSlide(ctx context.Context, tokenSource oauth2.TokenSource, in
 		SlideID:    newSlideID,
 	}
 
+	if input.ReturnPlaceholders && newSlideID != "" {
+		output.Placeholders = t.fetchSlidePlaceholders(ctx, slidesService, input.PresentationID, newSlideID)
+	}
+
 	t.config.Logger.Info("slide added successfully",
 		slog.String("presentation_id", input.PresentationID),
 		slog.Int("slide_index", output.SlideIndex),
@@ -170,6 +157,121 @@ func (t *Tools) AddSlide(ctx context.Context, tokenSource oauth2.TokenSource, in
 	return output, nil
 }
 
+// fetchSlidePlaceholders re-fetches the presentation and maps the new slide's placeholders. The
+// slide already exists at this point, so a failed fetch is logged rather than returned.
+func (t *Tools) fetchSlidePlaceholders(ctx context.Context, slidesService SlidesService, presentationID, slideID string) map[string]string {
+	presentation, err := slidesService.GetPresentation(ctx, presentationID)
+	if err != nil {
+		t.config.Logger.Warn("failed to fetch placeholders of new slide",
+			slog.String("slide_id", slideID),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+
+	for _, slide := range presentation.Slides {
+		if slide.ObjectId == slideID {
+			return collectPlaceholderIDs(slide.PageElements)
+		}
+	}
+
+	t.config.Logger.Warn("new slide not found when fetching placeholders",
+		slog.String("slide_id", slideID),
+	)
+	return nil
+}
+
+// collectPlaceholderIDs maps placeholder types to object IDs in page order, looking inside groups.
+// The first placeholder of a type uses the type as key; later ones are numbered (BODY_2, ...).
+func collectPlaceholderIDs(elements []*slides.PageElement) map[string]string {
+	placeholders := make(map[string]string)
+	counts := make(map[string]int)
+
+	var walk func(elements []*slides.PageElement)
+	walk = func(elements []*slides.PageElement) {
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+			if element.ElementGroup != nil {
+				walk(element.ElementGroup.Children)
+				continue
+			}
+			if element.Shape == nil || element.Shape.Placeholder == nil {
+				continue
+			}
+			placeholderType := element.Shape.Placeholder.Type
+			counts[placeholderType]++
+			key := placeholderType
+			if counts[placeholderType] > 1 {
+				key = fmt.Sprintf("%s_%d", placeholderType, counts[placeholderType])
+			}
+			placeholders[key] = element.ObjectId
+		}
+	}
+	walk(elements)
+
+	return placeholders
+}
+
+// resolveSlideLayout turns a layout type or layout object ID into a layout reference. A layout
+// object ID must exist in the deck; a layout type is matched by layout name, falling back to the
+// deck's first layout and, for decks without layouts, to the predefined layout.
+func (t *Tools) resolveSlideLayout(presentation *slides.Presentation, layout, layoutObjectID string) (*slides.LayoutReference, error) {
+	// A specific layout must exist in the deck
+	if layoutObjectID != "" {
+		if !hasLayoutID(presentation.Layouts, layoutObjectID) {
+			return nil, fmt.Errorf("%w: layout '%s' not found in presentation", ErrInvalidLayout, layoutObjectID)
+		}
+		return &slides.LayoutReference{LayoutId: layoutObjectID}, nil
+	}
+
+	// Otherwise find the layout object ID that matches the requested layout type
+	layoutObjectID = findLayoutByType(presentation.Layouts, layout)
+	if layoutObjectID == "" && len(presentation.Layouts) > 0 {
+		// If no matching layout found, use the first layout as fallback
+		// This can happen if the presentation has custom layouts
+		layoutObjectID = presentation.Layouts[0].ObjectId
+		t.config.Logger.Warn("requested layout not found, using first available layout",
+			slog.String("requested_layout", layout),
+			slog.String("fallback_layout_id", layoutObjectID),
+		)
+	}
+
+	if layoutObjectID != "" {
+		return &slides.LayoutReference{LayoutId: layoutObjectID}, nil
+	}
+	// Use predefined layout type
+	return &slides.LayoutReference{PredefinedLayout: layout}, nil
+}
+
+// validateAddSlideLayout checks that exactly one of Layout and LayoutObjectID is set, and that
+// Layout is a supported predefined type.
+func validateAddSlideLayout(input AddSlideInput) error {
+	if input.Layout == "" && input.LayoutObjectID == "" {
+		return fmt.Errorf("%w: layout or layout_object_id is required", ErrInvalidLayout)
+	}
+	if input.Layout != "" && input.LayoutObjectID != "" {
+		return fmt.Errorf("%w: provide layout or layout_object_id, not both", ErrInvalidLayout)
+	}
+
+	// Validate layout type
+	if input.Layout != "" && !validLayoutTypes[input.Layout] {
+		return fmt.Errorf("%w: unsupported layout '%s'", ErrInvalidLayout, input.Layout)
+	}
+	return nil
+}
+
+// hasLayoutID reports whether a layout with the given object ID exists.
+func hasLayoutID(layouts []*slides.Page, layoutID string) bool {
+	for _, layout := range layouts {
+		if layout.ObjectId == layoutID {
+			return true
+		}
+	}
+	return false
+}
+
 // findLayoutByType finds a layout object ID by its type name.
 func findLayoutByType(layouts []*slides.Page, layoutType string) string {
 	for _, layout := range layouts {
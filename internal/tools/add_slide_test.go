@@ -592,3 +592,192 @@ func TestFindLayoutByType(t *testing.T) {
 		})
 	}
 }
+
+func TestAddSlide_LayoutObjectID(t *testing.T) {
+	var capturedLayoutRef *slides.LayoutReference
+
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				Slides:         []*slides.Page{{ObjectId: "slide-1"}},
+				Layouts: []*slides.Page{
+					{ObjectId: "layout-blank", LayoutProperties: &slides.LayoutProperties{Name: "BLANK"}},
+					{ObjectId: "layout-custom", LayoutProperties: &slides.LayoutProperties{Name: "CUSTOM_1", DisplayName: "Quote"}},
+				},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedLayoutRef = requests[0].CreateSlide.SlideLayoutReference
+			return &slides.BatchUpdatePresentationResponse{
+				Replies: []*slides.Response{
+					{CreateSlide: &slides.CreateSlideResponse{ObjectId: "new-slide"}},
+				},
+			}, nil
+		},
+	}
+
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.AddSlide(context.Background(), &mockTokenSource{}, AddSlideInput{
+		PresentationID: "test-pres-id",
+		LayoutObjectID: "layout-custom",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.SlideIndex != 2 || output.SlideID != "new-slide" {
+		t.Errorf("unexpected output %+v", output)
+	}
+	if capturedLayoutRef == nil || capturedLayoutRef.LayoutId != "layout-custom" || capturedLayoutRef.PredefinedLayout != "" {
+		t.Errorf("expected LayoutId 'layout-custom', got %+v", capturedLayoutRef)
+	}
+}
+
+func TestAddSlide_LayoutObjectIDErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input AddSlideInput
+	}{
+		{"unknown layout ID", AddSlideInput{PresentationID: "test-pres-id", LayoutObjectID: "layout-missing"}},
+		{"layout and layout ID", AddSlideInput{PresentationID: "test-pres-id", Layout: "BLANK", LayoutObjectID: "layout-blank"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batchCalled := false
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return &slides.Presentation{
+						PresentationId: presentationID,
+						Layouts:        []*slides.Page{{ObjectId: "layout-blank"}},
+					}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					batchCalled = true
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			_, err := tools.AddSlide(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, ErrInvalidLayout) {
+				t.Errorf("expected ErrInvalidLayout, got %v", err)
+			}
+			if batchCalled {
+				t.Error("expected no batch update")
+			}
+		})
+	}
+}
+
+func TestAddSlide_ReturnPlaceholders(t *testing.T) {
+	created := false
+	placeholder := func(id, placeholderType string) *slides.PageElement {
+		return &slides.PageElement{ObjectId: id, Shape: &slides.Shape{
+			ShapeType:   "TEXT_BOX",
+			Placeholder: &slides.Placeholder{Type: placeholderType},
+		}}
+	}
+
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			presentation := &slides.Presentation{
+				PresentationId: presentationID,
+				Slides:         []*slides.Page{{ObjectId: "slide-1"}},
+				Layouts: []*slides.Page{
+					{ObjectId: "layout-two-columns", LayoutProperties: &slides.LayoutProperties{Name: "TITLE_AND_TWO_COLUMNS"}},
+				},
+			}
+			if created {
+				presentation.Slides = append(presentation.Slides, &slides.Page{
+					ObjectId: "new-slide",
+					PageElements: []*slides.PageElement{
+						placeholder("new-title", "TITLE"),
+						placeholder("new-body-left", "BODY"),
+						placeholder("new-body-right", "BODY"),
+						{ObjectId: "logo", Image: &slides.Image{}},
+					},
+				})
+			}
+			return presentation, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			created = true
+			return &slides.BatchUpdatePresentationResponse{
+				Replies: []*slides.Response{
+					{CreateSlide: &slides.CreateSlideResponse{ObjectId: "new-slide"}},
+				},
+			}, nil
+		},
+	}
+
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.AddSlide(context.Background(), &mockTokenSource{}, AddSlideInput{
+		PresentationID:     "test-pres-id",
+		Layout:             "TITLE_AND_TWO_COLUMNS",
+		ReturnPlaceholders: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"TITLE":  "new-title",
+		"BODY":   "new-body-left",
+		"BODY_2": "new-body-right",
+	}
+	if len(output.Placeholders) != len(want) {
+		t.Fatalf("Placeholders = %v, want %v", output.Placeholders, want)
+	}
+	for key, id := range want {
+		if output.Placeholders[key] != id {
+			t.Errorf("Placeholders[%s] = %q, want %q", key, output.Placeholders[key], id)
+		}
+	}
+}
+
+func TestAddSlide_ReturnPlaceholdersFetchFails(t *testing.T) {
+	calls := 0
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			calls++
+			if calls > 1 {
+				return nil, errors.New("transient failure")
+			}
+			return &slides.Presentation{PresentationId: presentationID}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{
+				Replies: []*slides.Response{
+					{CreateSlide: &slides.CreateSlideResponse{ObjectId: "new-slide"}},
+				},
+			}, nil
+		},
+	}
+
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	// The slide was created, so the call still succeeds without placeholders
+	output, err := tools.AddSlide(context.Background(), &mockTokenSource{}, AddSlideInput{
+		PresentationID:     "test-pres-id",
+		Layout:             "BLANK",
+		ReturnPlaceholders: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.SlideID != "new-slide" || output.Placeholders != nil {
+		t.Errorf("unexpected output %+v", output)
+	}
+}
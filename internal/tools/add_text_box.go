@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -17,6 +16,7 @@ var (
 	ErrAddTextBoxFailed = errors.New("failed to add text box")
 	ErrInvalidText      = errors.New("text content is required")
 	ErrInvalidSize      = errors.New("size (width and height) is required")
+	ErrInvalidOutline   = errors.New("invalid outline options")
 )
 
 // AddTextBoxInput represents the input for the add_text_box tool.
@@ -28,6 +28,15 @@ type AddTextBoxInput struct {
 	Position       *PositionInput  `json:"position"` // Position in points
 	Size           *SizeInput      `json:"size"`     // Size in points
 	Style          *TextStyleInput `json:"style,omitempty"`
+	SoftBreaks     bool            `json:"soft_breaks,omitempty"`     // Insert newlines as line breaks within a paragraph
+	DefaultStyle   *TextStyleInput `json:"default_style,omitempty"`   // Style for text typed later; allows empty text
+	AutoTextColor  bool            `json:"auto_text_color,omitempty"` // Pick black or white text for contrast with the background
+
+	// Outline turns leading indentation into bullet nesting: a tab or every OutlineIndent
+	// spaces (default 2) is one level.
+	Outline       bool   `json:"outline,omitempty"`
+	OutlineIndent int    `json:"outline_indent,omitempty"`
+	BulletStyle   string `json:"bullet_style,omitempty"` // Outline bullet style, as in create_bullet_list (default DISC)
 }
 
 // PositionInput represents x, y coordinates in points.
@@ -53,9 +62,16 @@ type TextStyleInput struct {
 
 // AddTextBoxOutput represents the output of the add_text_box tool.
 type AddTextBoxOutput struct {
-	ObjectID string `json:"object_id"`
+	ObjectID        string `json:"object_id"`
+	PlaceholderText bool   `json:"placeholder_text,omitempty"` // True when a space was inserted to carry default_style
+	AutoTextColor   string `json:"auto_text_color,omitempty"`  // Text color chosen by auto_text_color
+	NestingLevels   []int  `json:"nesting_levels,omitempty"`   // Per-paragraph bullet nesting when outline is set
 }
 
+// defaultStylePlaceholder is inserted into otherwise empty text boxes so default_style has a
+// range to apply to. The Slides API cannot style an empty range.
+const defaultStylePlaceholder = " "
+
 // AddTextBox adds a new text box to a slide.
 func (t *Tools) AddTextBox(ctx context.Context, tokenSource oauth2.TokenSource, input AddTextBoxInput) (*AddTextBoxOutput, error) {
 	// Validate input
@@ -67,9 +83,39 @@ func (t *Tools) AddTextBox(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, ErrInvalidSlideReference
 	}
 
+	placeholder, err := seedDefaultStylePlaceholder(&input)
+	if err != nil {
+		return nil, err
+	}
+
 	if input.Text == "" {
 		return nil, ErrInvalidText
 	}
+	input.Text = normalizeLineBreaks(input.Text, input.SoftBreaks)
+
+	var nestingLevels []int
+	if input.Outline {
+		if input.SoftBreaks {
+			return nil, fmt.Errorf("%w: outline needs paragraph breaks, so soft_breaks must be false", ErrInvalidOutline)
+		}
+		if input.OutlineIndent < 0 {
+			return nil, fmt.Errorf("%w: outline_indent cannot be negative", ErrInvalidOutline)
+		}
+		bulletStyle := input.BulletStyle
+		if bulletStyle == "" {
+			bulletStyle = "DISC"
+		}
+		bulletPreset, ok := validBulletStyles[strings.ToUpper(bulletStyle)]
+		if !ok {
+			return nil, fmt.Errorf("%w: '%s' is not a valid bullet style", ErrInvalidBulletStyle, input.BulletStyle)
+		}
+		input.BulletStyle = bulletPreset
+
+		input.Text, nestingLevels = outlineToNestedParagraphs(input.Text, input.OutlineIndent)
+		if strings.TrimSpace(input.Text) == "" {
+			return nil, ErrInvalidText
+		}
+	}
 
 	if input.Position == nil {
 		input.Position = &PositionInput{X: 0, Y: 0}
@@ -92,22 +138,42 @@ func (t *Tools) AddTextBox(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
 	}
 
-	// Get the presentation to find the target slide
-	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
-	if err != nil {
-		if isNotFoundError(err) {
-			return nil, ErrPresentationNotFound
+	// Find the target slide. Auto text color needs the slide contents, so bypass the ID cache.
+	var slideID, autoColor string
+	if input.AutoTextColor && (input.Style == nil || input.Style.Color == "") {
+		presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, ErrPresentationNotFound
+			}
+			if isForbiddenError(err) {
+				return nil, ErrAccessDenied
+			}
+			return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
 		}
-		if isForbiddenError(err) {
-			return nil, ErrAccessDenied
+
+		var slideIndex int
+		slideID, slideIndex, err = findSlide(presentation, input.SlideIndex, input.SlideID)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
-	}
 
-	// Find the target slide
-	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
-	if err != nil {
-		return nil, err
+		// Sample the background at the center of the text box
+		centerX := pointsToEMU(input.Position.X + input.Size.Width/2)
+		centerY := pointsToEMU(input.Position.Y + input.Size.Height/2)
+		autoColor = contrastingTextColor(resolveBackgroundAt(presentation.Slides[slideIndex-1], centerX, centerY))
+
+		style := TextStyleInput{}
+		if input.Style != nil {
+			style = *input.Style
+		}
+		style.Color = autoColor
+		input.Style = &style
+	} else {
+		slideID, _, err = t.resolveSlideID(ctx, slidesService, input.PresentationID, input.SlideIndex, input.SlideID)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Generate a unique object ID for the text box
@@ -129,7 +195,10 @@ func (t *Tools) AddTextBox(ctx context.Context, tokenSource oauth2.TokenSource,
 	}
 
 	output := &AddTextBoxOutput{
-		ObjectID: objectID,
+		ObjectID:        objectID,
+		PlaceholderText: placeholder,
+		AutoTextColor:   autoColor,
+		NestingLevels:   nestingLevels,
 	}
 
 	t.config.Logger.Info("text box added successfully",
@@ -159,22 +228,10 @@ func findSlide(presentation *slides.Presentation, slideIndex int, slideID string
 	return presentation.Slides[slideIndex-1].ObjectId, slideIndex, nil
 }
 
-// timeNowFunc allows overriding the time function for tests.
-var timeNowFunc = time.Now
-
 // generateObjectID generates a unique object ID for a new element.
 // Google Slides accepts client-generated IDs that start with a letter.
 func generateObjectID() string {
-	// Use a simple timestamp-based format with a prefix
-	return fmt.Sprintf("textbox_%d", timeNowFunc().UnixNano())
-}
-
-// pointsToEMU converts points to EMU (English Metric Units).
-// 1 point = 12700 EMU
-const pointsPerEMU = 12700.0
-
-func pointsToEMU(points float64) float64 {
-	return points * pointsPerEMU
+	return newObjectID("textbox")
 }
 
 // buildTextBoxRequests creates the batch update requests to add a text box.
@@ -220,6 +277,14 @@ func buildTextBoxRequests(objectID, slideID string, input AddTextBoxInput) []*sl
 	}
 	requests = append(requests, insertTextRequest)
 
+	// Seed the default style first so an explicit style can override it
+	if input.DefaultStyle != nil {
+		styleRequest := buildTextStyleRequest(objectID, input.DefaultStyle)
+		if styleRequest != nil {
+			requests = append(requests, styleRequest)
+		}
+	}
+
 	// Apply text style if provided
 	if input.Style != nil {
 		styleRequest := buildTextStyleRequest(objectID, input.Style)
@@ -228,9 +293,37 @@ func buildTextBoxRequests(objectID, slideID string, input AddTextBoxInput) []*sl
 		}
 	}
 
+	// Bullets go last: they strip the leading tabs that set each paragraph's nesting level,
+	// which would shift the indices of any later request
+	if input.Outline {
+		requests = append(requests, &slides.Request{
+			CreateParagraphBullets: &slides.CreateParagraphBulletsRequest{
+				ObjectId:     objectID,
+				TextRange:    &slides.Range{Type: "ALL"},
+				BulletPreset: input.BulletStyle,
+			},
+		})
+	}
+
 	return requests
 }
 
+// seedDefaultStylePlaceholder fills empty text with a placeholder when a default style is given.
+// It reports whether the placeholder was used.
+func seedDefaultStylePlaceholder(input *AddTextBoxInput) (bool, error) {
+	if input.DefaultStyle == nil {
+		return false, nil
+	}
+	if buildTextStyleRequest("", input.DefaultStyle) == nil {
+		return false, fmt.Errorf("%w: default_style must set at least one property", ErrNoStyleProvided)
+	}
+	if input.Text != "" {
+		return false, nil
+	}
+	input.Text = defaultStylePlaceholder
+	return true, nil
+}
+
 // buildTextStyleRequest creates a request to update text style.
 func buildTextStyleRequest(objectID string, style *TextStyleInput) *slides.Request {
 	if style == nil {
@@ -310,3 +403,20 @@ func parseHexColor(hex string) *slides.RgbColor {
 		Blue:  float64(b) / 255.0,
 	}
 }
+
+// parseColor parses a hex color (e.g., "#FF0000") or a theme color name (e.g., "ACCENT1",
+// case-insensitive, optionally prefixed with "theme:" as get_object reports it). It returns
+// nil for anything else, including unknown theme names.
+func parseColor(color string) *slides.OpaqueColor {
+	if rgb := parseHexColor(color); rgb != nil {
+		return &slides.OpaqueColor{RgbColor: rgb}
+	}
+
+	name := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(color)), "THEME:")
+	for _, themeColor := range themeColorTypes {
+		if name == themeColor {
+			return &slides.OpaqueColor{ThemeColor: themeColor}
+		}
+	}
+	return nil
+}
@@ -3,8 +3,8 @@ package tools
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -19,6 +19,35 @@ func TestAddTextBox(t *testing.T) {
 		wantErrContain string
 		wantObjectID   bool
 	}{
+		{
+			name: "soft breaks insert vertical tabs",
+			input: AddTextBoxInput{
+				PresentationID: "test-presentation",
+				SlideIndex:     1,
+				Text:           "Line 1\nLine 2",
+				Size:           &SizeInput{Width: 300, Height: 100},
+				SoftBreaks:     true,
+			},
+			mockService: func() *mockSlidesService {
+				return &mockSlidesService{
+					GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+						return &slides.Presentation{
+							PresentationId: "test-presentation",
+							Slides: []*slides.Page{
+								{ObjectId: "slide-1"},
+							},
+						}, nil
+					},
+					BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+						if requests[1].InsertText.Text != "Line 1\vLine 2" {
+							t.Errorf("expected soft break, got %q", requests[1].InsertText.Text)
+						}
+						return &slides.BatchUpdatePresentationResponse{}, nil
+					},
+				}
+			},
+			wantObjectID: true,
+		},
 		{
 			name: "creates text box at specified position with slide_index",
 			input: AddTextBoxInput{
@@ -169,11 +198,10 @@ func TestAddTextBox(t *testing.T) {
 					},
 					BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
 						shape := requests[0].CreateShape
-						// 1 point = 12700 EMU
-						expectedWidth := 200 * 12700.0  // 2540000
-						expectedHeight := 100 * 12700.0 // 1270000
-						expectedX := 100 * 12700.0      // 1270000
-						expectedY := 50 * 12700.0       // 635000
+						expectedWidth := 200 * emuPerPoint  // 2540000
+						expectedHeight := 100 * emuPerPoint // 1270000
+						expectedX := 100 * emuPerPoint      // 1270000
+						expectedY := 50 * emuPerPoint       // 635000
 
 						if shape.ElementProperties.Size.Width.Magnitude != expectedWidth {
 							t.Errorf("expected width %f EMU, got %f", expectedWidth, shape.ElementProperties.Size.Width.Magnitude)
@@ -409,12 +437,8 @@ func TestAddTextBox(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Override time function for consistent object IDs
-			origTimeNowFunc := timeNowFunc
-			timeNowFunc = func() time.Time {
-				return time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.UTC)
-			}
-			defer func() { timeNowFunc = origTimeNowFunc }()
+			// Fixed ID suffix for deterministic object IDs
+			stubObjectIDSuffix(t, "1705312800123456789")
 
 			mockSvc := tt.mockService()
 			factory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
@@ -552,6 +576,41 @@ func TestParseHexColor(t *testing.T) {
 	}
 }
 
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		name      string
+		color     string
+		wantTheme string
+		wantRGB   bool
+		wantNil   bool
+	}{
+		{name: "hex", color: "#FF0000", wantRGB: true},
+		{name: "theme name", color: "ACCENT1", wantTheme: "ACCENT1"},
+		{name: "lowercase theme name", color: "dark2", wantTheme: "DARK2"},
+		{name: "get_object theme reference", color: "theme:LIGHT1", wantTheme: "LIGHT1"},
+		{name: "unknown theme name", color: "ACCENT9", wantNil: true},
+		{name: "empty", color: "", wantNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseColor(tt.color)
+			if tt.wantNil {
+				if got != nil {
+					t.Errorf("parseColor(%q) = %+v, want nil", tt.color, got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("parseColor(%q) = nil", tt.color)
+			}
+			if got.ThemeColor != tt.wantTheme || (got.RgbColor != nil) != tt.wantRGB {
+				t.Errorf("parseColor(%q) = %+v, want theme %q, rgb %v", tt.color, got, tt.wantTheme, tt.wantRGB)
+			}
+		})
+	}
+}
+
 func TestPointsToEMU(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -782,3 +841,185 @@ func TestBuildTextStyleRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestAddTextBox_DefaultStyle(t *testing.T) {
+	tests := []struct {
+		name            string
+		text            string
+		style           *TextStyleInput
+		wantText        string
+		wantPlaceholder bool
+		wantStyleFields []string
+	}{
+		{
+			name:            "empty text seeds placeholder",
+			wantText:        " ",
+			wantPlaceholder: true,
+			wantStyleFields: []string{"fontFamily,fontSize,bold"},
+		},
+		{
+			name:            "existing text keeps content",
+			text:            "Title",
+			wantText:        "Title",
+			wantStyleFields: []string{"fontFamily,fontSize,bold"},
+		},
+		{
+			name:            "explicit style applied after default",
+			text:            "Title",
+			style:           &TextStyleInput{Color: "#FF0000"},
+			wantText:        "Title",
+			wantStyleFields: []string{"fontFamily,fontSize,bold", "foregroundColor"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedRequests []*slides.Request
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					capturedRequests = requests
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			output, err := tools.AddTextBox(context.Background(), nil, AddTextBoxInput{
+				PresentationID: "test-presentation",
+				SlideIndex:     1,
+				Text:           tt.text,
+				Size:           &SizeInput{Width: 300, Height: 100},
+				Style:          tt.style,
+				DefaultStyle:   &TextStyleInput{FontFamily: "Roboto", FontSize: 24, Bold: true},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.PlaceholderText != tt.wantPlaceholder {
+				t.Errorf("PlaceholderText = %v, want %v", output.PlaceholderText, tt.wantPlaceholder)
+			}
+			if capturedRequests[1].InsertText == nil || capturedRequests[1].InsertText.Text != tt.wantText {
+				t.Fatalf("expected InsertText %q as second request", tt.wantText)
+			}
+
+			styleRequests := capturedRequests[2:]
+			if len(styleRequests) != len(tt.wantStyleFields) {
+				t.Fatalf("expected %d style requests, got %d", len(tt.wantStyleFields), len(styleRequests))
+			}
+			for i, req := range styleRequests {
+				if req.UpdateTextStyle == nil {
+					t.Fatalf("request %d: expected UpdateTextStyle", i)
+				}
+				if req.UpdateTextStyle.ObjectId != output.ObjectID {
+					t.Errorf("request %d: ObjectId = %q, want %q", i, req.UpdateTextStyle.ObjectId, output.ObjectID)
+				}
+				if req.UpdateTextStyle.TextRange.Type != "ALL" {
+					t.Errorf("request %d: range type = %q, want ALL", i, req.UpdateTextStyle.TextRange.Type)
+				}
+				if req.UpdateTextStyle.Fields != tt.wantStyleFields[i] {
+					t.Errorf("request %d: fields = %q, want %q", i, req.UpdateTextStyle.Fields, tt.wantStyleFields[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAddTextBox_EmptyDefaultStyle(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return &mockSlidesService{}, nil
+	})
+
+	_, err := tools.AddTextBox(context.Background(), nil, AddTextBoxInput{
+		PresentationID: "test-presentation",
+		SlideIndex:     1,
+		Size:           &SizeInput{Width: 300, Height: 100},
+		DefaultStyle:   &TextStyleInput{},
+	})
+	if !errors.Is(err, ErrNoStyleProvided) {
+		t.Errorf("expected ErrNoStyleProvided, got %v", err)
+	}
+}
+
+func TestAddTextBox_Outline(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.AddTextBox(context.Background(), nil, AddTextBoxInput{
+		PresentationID: "test-presentation",
+		SlideIndex:     1,
+		Text:           "Agenda\r\n  Intro\r\n    Team\r\n  Roadmap\r\nQ&A",
+		Size:           &SizeInput{Width: 300, Height: 200},
+		Style:          &TextStyleInput{Bold: true},
+		Outline:        true,
+		BulletStyle:    "arrow",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantLevels := []int{0, 1, 2, 1, 0}
+	if !reflect.DeepEqual(output.NestingLevels, wantLevels) {
+		t.Errorf("NestingLevels = %v, want %v", output.NestingLevels, wantLevels)
+	}
+
+	if got := capturedRequests[1].InsertText.Text; got != "Agenda\n\tIntro\n\t\tTeam\n\tRoadmap\nQ&A" {
+		t.Errorf("inserted text = %q", got)
+	}
+
+	last := capturedRequests[len(capturedRequests)-1]
+	if last.CreateParagraphBullets == nil {
+		t.Fatal("expected CreateParagraphBullets as the last request")
+	}
+	if last.CreateParagraphBullets.BulletPreset != "BULLET_ARROW_DIAMOND_DISC" {
+		t.Errorf("BulletPreset = %q, want BULLET_ARROW_DIAMOND_DISC", last.CreateParagraphBullets.BulletPreset)
+	}
+	if last.CreateParagraphBullets.TextRange.Type != "ALL" {
+		t.Errorf("range type = %q, want ALL", last.CreateParagraphBullets.TextRange.Type)
+	}
+}
+
+func TestAddTextBox_OutlineValidation(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return &mockSlidesService{}, nil
+	})
+
+	tests := []struct {
+		name    string
+		input   AddTextBoxInput
+		wantErr error
+	}{
+		{"soft breaks", AddTextBoxInput{SoftBreaks: true}, ErrInvalidOutline},
+		{"negative indent", AddTextBoxInput{OutlineIndent: -1}, ErrInvalidOutline},
+		{"unknown bullet style", AddTextBoxInput{BulletStyle: "HEART"}, ErrInvalidBulletStyle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.input.PresentationID = "test-presentation"
+			tt.input.SlideIndex = 1
+			tt.input.Text = "A\n  B"
+			tt.input.Size = &SizeInput{Width: 100, Height: 100}
+			tt.input.Outline = true
+			_, err := tools.AddTextBox(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for add_text_outline tool.
+var (
+	ErrTextStrokeNotSupported  = errors.New("text stroke is not supported by Google Slides")
+	ErrInvalidTextOutlineColor = errors.New("invalid text outline color")
+	ErrInvalidTextOutlineWidth = errors.New("invalid text outline width")
+	ErrAddTextOutlineFailed    = errors.New("failed to add text outline")
+)
+
+// Text outline defaults and limits, in points.
+const (
+	defaultTextOutlineColor = "#000000"
+	defaultTextOutlineWidth = 1.0
+	maxTextOutlineWidth     = 10.0
+)
+
+// textOutlineOffsets are the unit directions of the outline copies: left, right, up, down.
+var textOutlineOffsets = [][2]float64{{-1, 0}, {1, 0}, {0, -1}, {0, 1}}
+
+// AddTextOutlineInput represents the input for the add_text_outline tool.
+type AddTextOutlineInput struct {
+	PresentationID string  `json:"presentation_id"`
+	ObjectID       string  `json:"object_id"`             // Top-level shape holding the text
+	Color          string  `json:"color,omitempty"`       // Hex outline color, default "#000000"
+	Width          float64 `json:"width,omitempty"`       // Outline thickness in points, default 1, max 10
+	Approximate    bool    `json:"approximate,omitempty"` // Required to accept the layered-copies approximation
+}
+
+// AddTextOutlineOutput represents the output of the add_text_outline tool.
+type AddTextOutlineOutput struct {
+	ObjectID         string   `json:"object_id"`
+	OutlineObjectIDs []string `json:"outline_object_ids"` // Copies placed behind the text, one per direction
+	Color            string   `json:"color"`
+	Width            float64  `json:"width"`
+}
+
+// AddTextOutline approximates a text stroke, which Slides cannot render, by layering copies of
+// the text shape behind it. Each copy has its text recolored to the outline color, its fill and
+// border hidden, and is shifted by the outline width in one of four directions, so the copies
+// peek out around the glyph edges. The copies are independent shapes: later edits to the text
+// are not mirrored. Without Approximate the tool returns ErrTextStrokeNotSupported.
+func (t *Tools) AddTextOutline(ctx context.Context, tokenSource oauth2.TokenSource, input AddTextOutlineInput) (*AddTextOutlineOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.ObjectID == "" {
+		return nil, fmt.Errorf("%w: object_id is required", ErrInvalidObjectID)
+	}
+	if !input.Approximate {
+		return nil, fmt.Errorf("%w: set approximate to true to layer outline-colored copies of the text behind it", ErrTextStrokeNotSupported)
+	}
+	color := input.Color
+	if color == "" {
+		color = defaultTextOutlineColor
+	}
+	rgb := parseHexColor(color)
+	if rgb == nil {
+		return nil, fmt.Errorf("%w: '%s' is not a #RRGGBB color", ErrInvalidTextOutlineColor, input.Color)
+	}
+	width := input.Width
+	if width == 0 {
+		width = defaultTextOutlineWidth
+	}
+	if !(width > 0) || width > maxTextOutlineWidth {
+		return nil, fmt.Errorf("%w: width must be greater than 0 and at most %g points", ErrInvalidTextOutlineWidth, maxTextOutlineWidth)
+	}
+
+	t.config.Logger.Info("adding text outline",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("object_id", input.ObjectID),
+		slog.String("color", color),
+		slog.Float64("width", width),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to verify the object holds text
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	var element *slides.PageElement
+	var inGroup bool
+	for _, slide := range presentation.Slides {
+		if element, inGroup = findElementAndCheckGroup(slide.PageElements, input.ObjectID); element != nil {
+			break
+		}
+	}
+	if element == nil {
+		return nil, fmt.Errorf("%w: object '%s' not found in presentation", ErrObjectNotFound, input.ObjectID)
+	}
+	if inGroup {
+		// Copies must be layered behind the text, which z-order changes cannot do inside a group
+		return nil, fmt.Errorf("%w: object '%s' is inside a group", ErrObjectInGroup, input.ObjectID)
+	}
+	if element.Shape == nil || extractTextFromTextContent(element.Shape.Text) == "" {
+		if element.Table != nil {
+			return nil, fmt.Errorf("%w: tables cannot be outlined", ErrNotTextObject)
+		}
+		return nil, fmt.Errorf("%w: object '%s' does not contain text", ErrNotTextObject, input.ObjectID)
+	}
+
+	baseID := newObjectID("text_outline")
+	outlineIDs := make([]string, 0, len(textOutlineOffsets))
+	var requests []*slides.Request
+	for i, direction := range textOutlineOffsets {
+		outlineID := fmt.Sprintf("%s_%d", baseID, i+1)
+		outlineIDs = append(outlineIDs, outlineID)
+		requests = append(requests, buildTextOutlineCopyRequests(input.ObjectID, outlineID, rgb,
+			pointsToEMU(direction[0]*width), pointsToEMU(direction[1]*width))...)
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrAddTextOutlineFailed, err)
+	}
+
+	output := &AddTextOutlineOutput{
+		ObjectID:         input.ObjectID,
+		OutlineObjectIDs: outlineIDs,
+		Color:            color,
+		Width:            width,
+	}
+
+	t.config.Logger.Info("text outline added successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("object_id", input.ObjectID),
+		slog.Int("copy_count", len(outlineIDs)),
+	)
+
+	return output, nil
+}
+
+// buildTextOutlineCopyRequests duplicates sourceID as outlineID, recolors its text, hides its
+// fill and border, shifts it by (dx, dy) EMU and sends it one layer back. The API places a
+// duplicate directly above its source, so one step back puts it just behind the text.
+func buildTextOutlineCopyRequests(sourceID, outlineID string, rgb *slides.RgbColor, dx, dy float64) []*slides.Request {
+	return []*slides.Request{
+		{
+			DuplicateObject: &slides.DuplicateObjectRequest{
+				ObjectId:  sourceID,
+				ObjectIds: map[string]string{sourceID: outlineID},
+			},
+		},
+		{
+			UpdateTextStyle: &slides.UpdateTextStyleRequest{
+				ObjectId:  outlineID,
+				TextRange: &slides.Range{Type: "ALL"},
+				Style: &slides.TextStyle{
+					ForegroundColor: &slides.OptionalColor{OpaqueColor: &slides.OpaqueColor{RgbColor: rgb}},
+				},
+				Fields: "foregroundColor",
+			},
+		},
+		{
+			UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
+				ObjectId: outlineID,
+				ShapeProperties: &slides.ShapeProperties{
+					ShapeBackgroundFill: &slides.ShapeBackgroundFill{PropertyState: "NOT_RENDERED"},
+					Outline:             &slides.Outline{PropertyState: "NOT_RENDERED"},
+				},
+				Fields: "shapeBackgroundFill.propertyState,outline.propertyState",
+			},
+		},
+		{
+			UpdatePageElementTransform: &slides.UpdatePageElementTransformRequest{
+				ObjectId: outlineID,
+				Transform: &slides.AffineTransform{
+					ScaleX:     1,
+					ScaleY:     1,
+					TranslateX: dx,
+					TranslateY: dy,
+					Unit:       "EMU",
+				},
+				ApplyMode: "RELATIVE",
+			},
+		},
+		buildZOrderRequest(outlineID, "SEND_BACKWARD"),
+	}
+}
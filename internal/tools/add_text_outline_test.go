@@ -0,0 +1,174 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newAddTextOutlineTestTools(getErr, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	title := treeTestElement("title", 20, 20, 400, 60)
+	title.Shape.Text = &slides.TextContent{TextElements: []*slides.TextElement{
+		{TextRun: &slides.TextRun{Content: "Headline\n"}},
+	}}
+	grouped := treeTestElement("grouped-text", 0, 0, 100, 20)
+	grouped.Shape.Text = &slides.TextContent{TextElements: []*slides.TextElement{
+		{TextRun: &slides.TextRun{Content: "Caption\n"}},
+	}}
+	empty := treeTestElement("empty-text", 0, 100, 100, 20)
+	empty.Shape.Text = &slides.TextContent{TextElements: []*slides.TextElement{
+		{TextRun: &slides.TextRun{Content: "\n"}},
+	}}
+
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{{
+			ObjectId: "slide-1",
+			PageElements: []*slides.PageElement{
+				title,
+				treeTestElement("plain-rect", 0, 200, 50, 50),
+				empty,
+				{ObjectId: "table-1", Table: &slides.Table{}},
+				{ObjectId: "group-1", ElementGroup: &slides.Group{Children: []*slides.PageElement{grouped}}},
+			},
+		}},
+	}
+
+	return newBatchCaptureTestTools(presentation, getErr, batchErr, batchCalls, captured)
+}
+
+func TestAddTextOutline_CreatesCopiesBehindText(t *testing.T) {
+	stubObjectIDSuffix(t, "abc")
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newAddTextOutlineTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.AddTextOutline(context.Background(), nil, AddTextOutlineInput{
+		PresentationID: "pres-1",
+		ObjectID:       "title",
+		Color:          "#FF0000",
+		Width:          2,
+		Approximate:    true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 || len(captured) != 20 {
+		t.Fatalf("expected 5 requests per copy in one batch, got %d calls and %d requests", batchCalls, len(captured))
+	}
+	wantIDs := []string{"text_outline_abc_1", "text_outline_abc_2", "text_outline_abc_3", "text_outline_abc_4"}
+	if len(output.OutlineObjectIDs) != len(wantIDs) {
+		t.Fatalf("expected %v, got %v", wantIDs, output.OutlineObjectIDs)
+	}
+	for i, id := range wantIDs {
+		if output.OutlineObjectIDs[i] != id {
+			t.Errorf("copy %d: expected ID %s, got %s", i, id, output.OutlineObjectIDs[i])
+		}
+	}
+
+	// The first copy: duplicate, recolor, hide shape, shift left, send behind the text
+	duplicate := captured[0].DuplicateObject
+	if duplicate == nil || duplicate.ObjectId != "title" || duplicate.ObjectIds["title"] != wantIDs[0] {
+		t.Fatalf("expected the title to be duplicated as %s, got %+v", wantIDs[0], duplicate)
+	}
+	style := captured[1].UpdateTextStyle
+	if style == nil || style.ObjectId != wantIDs[0] || style.Fields != "foregroundColor" || style.TextRange.Type != "ALL" {
+		t.Fatalf("unexpected text style request: %+v", style)
+	}
+	if rgb := style.Style.ForegroundColor.OpaqueColor.RgbColor; rgb.Red != 1 || rgb.Green != 0 || rgb.Blue != 0 {
+		t.Errorf("expected a red outline, got %+v", rgb)
+	}
+	shape := captured[2].UpdateShapeProperties
+	if shape == nil || shape.ShapeProperties.ShapeBackgroundFill.PropertyState != "NOT_RENDERED" || shape.ShapeProperties.Outline.PropertyState != "NOT_RENDERED" {
+		t.Errorf("expected the copy's fill and border to be hidden, got %+v", shape)
+	}
+	transform := captured[3].UpdatePageElementTransform
+	if transform == nil || transform.ApplyMode != "RELATIVE" || transform.Transform.TranslateX != pointsToEMU(-2) || transform.Transform.TranslateY != 0 {
+		t.Errorf("expected a 2pt shift to the left, got %+v", transform)
+	}
+	zOrder := captured[4].UpdatePageElementsZOrder
+	if zOrder == nil || zOrder.Operation != "SEND_BACKWARD" || zOrder.PageElementObjectIds[0] != wantIDs[0] {
+		t.Errorf("expected the copy to be sent behind the text, got %+v", zOrder)
+	}
+
+	// The last copy shifts down
+	if last := captured[18].UpdatePageElementTransform; last.Transform.TranslateX != 0 || last.Transform.TranslateY != pointsToEMU(2) {
+		t.Errorf("expected the last copy to shift 2pt down, got %+v", last.Transform)
+	}
+	if output.Color != "#FF0000" || output.Width != 2 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+}
+
+func TestAddTextOutline_Defaults(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newAddTextOutlineTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.AddTextOutline(context.Background(), nil, AddTextOutlineInput{
+		PresentationID: "pres-1",
+		ObjectID:       "title",
+		Approximate:    true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Color != defaultTextOutlineColor || output.Width != defaultTextOutlineWidth {
+		t.Errorf("expected default color and width, got %+v", output)
+	}
+	if got := captured[3].UpdatePageElementTransform.Transform.TranslateX; got != pointsToEMU(-1) {
+		t.Errorf("expected a 1pt default shift, got %v EMU", got)
+	}
+}
+
+func TestAddTextOutline_Errors(t *testing.T) {
+	valid := AddTextOutlineInput{PresentationID: "pres-1", ObjectID: "title", Approximate: true}
+	with := func(modify func(*AddTextOutlineInput)) AddTextOutlineInput {
+		input := valid
+		modify(&input)
+		return input
+	}
+
+	tests := []struct {
+		name     string
+		input    AddTextOutlineInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", with(func(i *AddTextOutlineInput) { i.PresentationID = "" }), nil, nil, ErrInvalidPresentationID},
+		{"missing object", with(func(i *AddTextOutlineInput) { i.ObjectID = "" }), nil, nil, ErrInvalidObjectID},
+		{"approximation not requested", with(func(i *AddTextOutlineInput) { i.Approximate = false }), nil, nil, ErrTextStrokeNotSupported},
+		{"invalid color", with(func(i *AddTextOutlineInput) { i.Color = "red" }), nil, nil, ErrInvalidTextOutlineColor},
+		{"negative width", with(func(i *AddTextOutlineInput) { i.Width = -1 }), nil, nil, ErrInvalidTextOutlineWidth},
+		{"width too large", with(func(i *AddTextOutlineInput) { i.Width = 11 }), nil, nil, ErrInvalidTextOutlineWidth},
+		{"unknown object", with(func(i *AddTextOutlineInput) { i.ObjectID = "nope" }), nil, nil, ErrObjectNotFound},
+		{"shape without text", with(func(i *AddTextOutlineInput) { i.ObjectID = "plain-rect" }), nil, nil, ErrNotTextObject},
+		{"empty text", with(func(i *AddTextOutlineInput) { i.ObjectID = "empty-text" }), nil, nil, ErrNotTextObject},
+		{"table", with(func(i *AddTextOutlineInput) { i.ObjectID = "table-1" }), nil, nil, ErrNotTextObject},
+		{"grouped text", with(func(i *AddTextOutlineInput) { i.ObjectID = "grouped-text" }), nil, nil, ErrObjectInGroup},
+		{"presentation not found", valid, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", valid, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", valid, nil, errors.New("boom"), ErrAddTextOutlineFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newAddTextOutlineTestTools(tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.AddTextOutline(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", batchCalls)
+			}
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -43,12 +42,9 @@ type AddVideoOutput struct {
 	ObjectID string `json:"object_id"`
 }
 
-// videoTimeNowFunc allows overriding the time function for tests.
-var videoTimeNowFunc = time.Now
-
 // generateVideoObjectID generates a unique object ID for a new video element.
 func generateVideoObjectID() string {
-	return fmt.Sprintf("video_%d", videoTimeNowFunc().UnixNano())
+	return newObjectID("video")
 }
 
 // AddVideo adds a video to a slide.
@@ -111,20 +107,8 @@ func (t *Tools) AddVideo(ctx context.Context, tokenSource oauth2.TokenSource, in
 		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
 	}
 
-	// Get the presentation to find the target slide
-	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
-	if err != nil {
-		if isNotFoundError(err) {
-			return nil, ErrPresentationNotFound
-		}
-		if isForbiddenError(err) {
-			return nil, ErrAccessDenied
-		}
-		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
-	}
-
 	// Find the target slide
-	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	slideID, _, err := t.resolveSlideID(ctx, slidesService, input.PresentationID, input.SlideIndex, input.SlideID)
 	if err != nil {
 		return nil, err
 	}
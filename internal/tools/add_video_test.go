@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"testing"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -32,12 +31,8 @@ func TestAddVideo_YouTube_Success(t *testing.T) {
 		return mockSlides, nil
 	}
 
-	// Set fixed time for deterministic object IDs
-	originalTimeFunc := videoTimeNowFunc
-	videoTimeNowFunc = func() time.Time {
-		return time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
-	}
-	defer func() { videoTimeNowFunc = originalTimeFunc }()
+	// Fixed ID suffix for deterministic object IDs
+	stubObjectIDSuffix(t, "1705312800000000000")
 
 	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, nil)
 	tokenSource := &mockTokenSource{}
@@ -124,12 +119,8 @@ func TestAddVideo_Drive_Success(t *testing.T) {
 		return mockSlides, nil
 	}
 
-	// Set fixed time for deterministic object IDs
-	originalTimeFunc := videoTimeNowFunc
-	videoTimeNowFunc = func() time.Time {
-		return time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
-	}
-	defer func() { videoTimeNowFunc = originalTimeFunc }()
+	// Fixed ID suffix for deterministic object IDs
+	stubObjectIDSuffix(t, "1705312800000000000")
 
 	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, nil)
 	tokenSource := &mockTokenSource{}
@@ -811,11 +802,7 @@ func TestAddVideo_VideoSourceCaseInsensitive(t *testing.T) {
 
 // Tests for helper functions
 func TestGenerateVideoObjectID(t *testing.T) {
-	originalTimeFunc := videoTimeNowFunc
-	videoTimeNowFunc = func() time.Time {
-		return time.Date(2024, 1, 15, 10, 30, 0, 123456789, time.UTC)
-	}
-	defer func() { videoTimeNowFunc = originalTimeFunc }()
+	stubObjectIDSuffix(t, "1705314600123456789")
 
 	objectID := generateVideoObjectID()
 	if objectID == "" {
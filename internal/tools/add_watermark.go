@@ -0,0 +1,457 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for add_watermark and remove_watermark tools.
+var (
+	ErrAddWatermarkFailed    = errors.New("failed to add watermark")
+	ErrRemoveWatermarkFailed = errors.New("failed to remove watermark")
+	ErrInvalidWatermark      = errors.New("invalid watermark")
+)
+
+// watermarkIDPrefix marks watermark elements so remove_watermark can find them again
+// without any state kept between calls.
+const watermarkIDPrefix = "watermark_"
+
+// Watermark defaults, in points unless noted.
+const (
+	defaultWatermarkFontSize   = 72
+	defaultWatermarkColor      = "#BFBFBF"
+	defaultWatermarkOpacity    = 0.3
+	defaultWatermarkPlacement  = "center"
+	watermarkMargin            = 20.0
+	watermarkTextWidthRatio    = 0.6  // Of the page width
+	watermarkImageWidthRatio   = 0.25 // Of the page width
+	watermarkLineHeightPerFont = 1.6  // Text box height per point of font size
+)
+
+// validWatermarkPlacements lists the placement presets for add_watermark.
+var validWatermarkPlacements = map[string]bool{
+	"center":       true,
+	"top_left":     true,
+	"top_right":    true,
+	"bottom_left":  true,
+	"bottom_right": true,
+}
+
+// AddWatermarkInput represents the input for the add_watermark tool.
+// Exactly one of Text and ImageBase64 is required.
+type AddWatermarkInput struct {
+	PresentationID string `json:"presentation_id"`
+	Text           string `json:"text,omitempty"`         // Text watermark, e.g. "DRAFT"
+	ImageBase64    string `json:"image_base64,omitempty"` // Image watermark, e.g. a logo
+
+	StartSlide int `json:"start_slide,omitempty"` // 1-based, inclusive; default first slide
+	EndSlide   int `json:"end_slide,omitempty"`   // 1-based, inclusive; default last slide
+
+	Placement string         `json:"placement,omitempty"` // center (default), top_left, top_right, bottom_left, bottom_right
+	Position  *PositionInput `json:"position,omitempty"`  // Explicit top-left corner in points; overrides placement
+	Size      *SizeInput     `json:"size,omitempty"`      // Size in points; defaults depend on the watermark kind
+
+	FontSize int      `json:"font_size,omitempty"` // Text only, default 72
+	Color    string   `json:"color,omitempty"`     // Text only, hex color, default #BFBFBF
+	Opacity  *float64 `json:"opacity,omitempty"`   // Image only, 0-1, default 0.3
+}
+
+// AddWatermarkOutput represents the output of the add_watermark tool.
+type AddWatermarkOutput struct {
+	Kind      string   `json:"kind"`       // "text" or "image"
+	ObjectIDs []string `json:"object_ids"` // One watermark per slide, in slide order
+	SlideIDs  []string `json:"slide_ids"`
+	Position  Position `json:"position"` // Top-left corner in points
+	Size      Size     `json:"size"`     // In points
+}
+
+// AddWatermark places the same text or image watermark on a range of slides in one batch update.
+// Watermark object IDs start with "watermark_" so remove_watermark can find them later.
+func (t *Tools) AddWatermark(ctx context.Context, tokenSource oauth2.TokenSource, input AddWatermarkInput) (*AddWatermarkOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	kind, imageData, mimeType, err := t.validateWatermarkInput(&input)
+	if err != nil {
+		return nil, err
+	}
+
+	t.config.Logger.Info("adding watermark",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("kind", kind),
+		slog.Int("start_slide", input.StartSlide),
+		slog.Int("end_slide", input.EndSlide),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation for its slides and page size
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	targetSlides, err := watermarkSlideRange(presentation, input.StartSlide, input.EndSlide)
+	if err != nil {
+		return nil, err
+	}
+
+	pageWidth, pageHeight := pageSizeInPoints(presentation.PageSize)
+	size := watermarkSize(kind, input, imageData, pageWidth)
+	position := watermarkPosition(input, size, pageWidth, pageHeight)
+
+	output := &AddWatermarkOutput{
+		Kind:      kind,
+		ObjectIDs: make([]string, 0, len(targetSlides)),
+		SlideIDs:  make([]string, 0, len(targetSlides)),
+		Position:  Position{X: position.X, Y: position.Y},
+		Size:      Size{Width: size.Width, Height: size.Height},
+	}
+
+	// Image watermarks share one Drive upload
+	var driveFileID string
+	var driveService DriveService
+	if kind == "image" {
+		driveService, err = t.driveServiceFactory(ctx, tokenSource)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+		}
+		driveFileID, err = t.uploadSlideImage(ctx, driveService, generateImageFileName(), mimeType, imageData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	baseID := newObjectID("watermark")
+	var requests []*slides.Request
+	for _, slide := range targetSlides {
+		objectID := fmt.Sprintf("%s_%d", baseID, len(output.ObjectIDs)+1)
+		if kind == "text" {
+			requests = append(requests, buildTextWatermarkRequests(objectID, slide.ObjectId, input, position, size)...)
+		} else {
+			requests = append(requests, buildImageWatermarkRequests(objectID, slide.ObjectId, driveFileID, input, position, size)...)
+		}
+		output.ObjectIDs = append(output.ObjectIDs, objectID)
+		output.SlideIDs = append(output.SlideIDs, slide.ObjectId)
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if driveFileID != "" {
+			t.deleteOrphanedUploads(ctx, driveService, []string{driveFileID})
+		}
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrAddWatermarkFailed, err)
+	}
+
+	t.config.Logger.Info("watermark added successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("kind", kind),
+		slog.Int("slides", len(output.ObjectIDs)),
+	)
+
+	return output, nil
+}
+
+// validateWatermarkInput checks the watermark content and options, fills in defaults, and
+// returns the watermark kind. For images it also returns the decoded data and MIME type.
+func (t *Tools) validateWatermarkInput(input *AddWatermarkInput) (string, []byte, string, error) {
+	hasText := strings.TrimSpace(input.Text) != ""
+	if hasText == (input.ImageBase64 != "") {
+		return "", nil, "", fmt.Errorf("%w: provide exactly one of text or image_base64", ErrInvalidWatermark)
+	}
+
+	if input.StartSlide < 0 || input.EndSlide < 0 {
+		return "", nil, "", fmt.Errorf("%w: start_slide and end_slide must be positive", ErrInvalidSlideReference)
+	}
+	if input.EndSlide > 0 && input.StartSlide > input.EndSlide {
+		return "", nil, "", fmt.Errorf("%w: start_slide %d is after end_slide %d", ErrInvalidSlideReference, input.StartSlide, input.EndSlide)
+	}
+
+	if input.Placement == "" {
+		input.Placement = defaultWatermarkPlacement
+	}
+	input.Placement = strings.ToLower(input.Placement)
+	if !validWatermarkPlacements[input.Placement] {
+		return "", nil, "", fmt.Errorf("%w: placement must be center, top_left, top_right, bottom_left or bottom_right, got '%s'", ErrInvalidWatermark, input.Placement)
+	}
+	if input.Position != nil && (input.Position.X < 0 || input.Position.Y < 0) {
+		return "", nil, "", fmt.Errorf("%w: position coordinates must be non-negative", ErrInvalidWatermark)
+	}
+	if input.Size != nil && (input.Size.Width <= 0 || input.Size.Height <= 0) {
+		return "", nil, "", fmt.Errorf("%w: size must have positive width and height", ErrInvalidWatermark)
+	}
+
+	if hasText {
+		if input.Opacity != nil {
+			return "", nil, "", fmt.Errorf("%w: opacity only applies to image watermarks; use a light color for text", ErrInvalidWatermark)
+		}
+		if input.FontSize < 0 {
+			return "", nil, "", fmt.Errorf("%w: font_size must be positive", ErrInvalidWatermark)
+		}
+		if input.FontSize == 0 {
+			input.FontSize = defaultWatermarkFontSize
+		}
+		if input.Color == "" {
+			input.Color = defaultWatermarkColor
+		}
+		if parseHexColor(input.Color) == nil {
+			return "", nil, "", fmt.Errorf("%w: color must be a hex color like #BFBFBF, got '%s'", ErrInvalidWatermark, input.Color)
+		}
+		return "text", nil, "", nil
+	}
+
+	if input.FontSize != 0 || input.Color != "" {
+		return "", nil, "", fmt.Errorf("%w: font_size and color only apply to text watermarks", ErrInvalidWatermark)
+	}
+	if input.Opacity == nil {
+		opacity := defaultWatermarkOpacity
+		input.Opacity = &opacity
+	}
+	if *input.Opacity <= 0 || *input.Opacity > 1 {
+		return "", nil, "", fmt.Errorf("%w: opacity must be greater than 0 and at most 1", ErrInvalidWatermark)
+	}
+
+	imageData, err := base64.StdEncoding.DecodeString(input.ImageBase64)
+	if err != nil {
+		return "", nil, "", fmt.Errorf("%w: %v", ErrInvalidImageData, err)
+	}
+	mimeType := detectImageMimeType(imageData)
+	if mimeType == "" {
+		return "", nil, "", fmt.Errorf("%w: unable to detect image format", ErrInvalidImageData)
+	}
+	if err := t.checkImageFormatAllowed(mimeType); err != nil {
+		return "", nil, "", err
+	}
+	return "image", imageData, mimeType, nil
+}
+
+// watermarkSlideRange returns the slides from start to end (1-based, inclusive, 0 = open end).
+func watermarkSlideRange(presentation *slides.Presentation, start, end int) ([]*slides.Page, error) {
+	count := len(presentation.Slides)
+	if count == 0 {
+		return nil, fmt.Errorf("%w: presentation has no slides", ErrSlideNotFound)
+	}
+	if start == 0 {
+		start = 1
+	}
+	if end == 0 {
+		end = count
+	}
+	if start > count || end > count {
+		return nil, fmt.Errorf("%w: slide range %d-%d out of range (1-%d)", ErrSlideNotFound, start, end, count)
+	}
+	return presentation.Slides[start-1 : end], nil
+}
+
+// watermarkSize returns the explicit size, or a default: a wide box for text and a quarter of the
+// page width for images, keeping the image's aspect ratio when it can be read.
+func watermarkSize(kind string, input AddWatermarkInput, imageData []byte, pageWidth float64) SizeInput {
+	if input.Size != nil {
+		return *input.Size
+	}
+	if kind == "text" {
+		return SizeInput{
+			Width:  pageWidth * watermarkTextWidthRatio,
+			Height: float64(input.FontSize) * watermarkLineHeightPerFont,
+		}
+	}
+
+	width := pageWidth * watermarkImageWidthRatio
+	height := width
+	if config, _, err := image.DecodeConfig(bytes.NewReader(imageData)); err == nil && config.Width > 0 {
+		height = width * float64(config.Height) / float64(config.Width)
+	}
+	return SizeInput{Width: width, Height: height}
+}
+
+// watermarkPosition returns the explicit position or the top-left corner for the placement preset.
+func watermarkPosition(input AddWatermarkInput, size SizeInput, pageWidth, pageHeight float64) PositionInput {
+	if input.Position != nil {
+		return *input.Position
+	}
+
+	left := watermarkMargin
+	right := pageWidth - size.Width - watermarkMargin
+	top := watermarkMargin
+	bottom := pageHeight - size.Height - watermarkMargin
+
+	switch input.Placement {
+	case "top_left":
+		return PositionInput{X: left, Y: top}
+	case "top_right":
+		return PositionInput{X: right, Y: top}
+	case "bottom_left":
+		return PositionInput{X: left, Y: bottom}
+	case "bottom_right":
+		return PositionInput{X: right, Y: bottom}
+	default:
+		return PositionInput{X: (pageWidth - size.Width) / 2, Y: (pageHeight - size.Height) / 2}
+	}
+}
+
+// buildTextWatermarkRequests adds a bold, centered text box. The Slides API has no text opacity,
+// so the watermark's faintness comes from its color.
+func buildTextWatermarkRequests(objectID, slideID string, input AddWatermarkInput, position PositionInput, size SizeInput) []*slides.Request {
+	requests := buildTextBoxRequests(objectID, slideID, AddTextBoxInput{
+		Text:     input.Text,
+		Position: &position,
+		Size:     &size,
+		Style: &TextStyleInput{
+			FontSize: input.FontSize,
+			Bold:     true,
+			Color:    input.Color,
+		},
+	})
+	return append(requests, &slides.Request{
+		UpdateParagraphStyle: &slides.UpdateParagraphStyleRequest{
+			ObjectId:  objectID,
+			TextRange: &slides.Range{Type: "ALL"},
+			Style:     &slides.ParagraphStyle{Alignment: "CENTER"},
+			Fields:    "alignment",
+		},
+	})
+}
+
+// buildImageWatermarkRequests adds the uploaded image and makes it semi-transparent.
+func buildImageWatermarkRequests(objectID, slideID, driveFileID string, input AddWatermarkInput, position PositionInput, size SizeInput) []*slides.Request {
+	requests := buildImageRequests(objectID, slideID, driveFileID, AddImageInput{
+		Position: &position,
+		Size:     &ImageSizeInput{Width: &size.Width, Height: &size.Height},
+	})
+	return append(requests, &slides.Request{
+		UpdateImageProperties: &slides.UpdateImagePropertiesRequest{
+			ObjectId:        objectID,
+			ImageProperties: &slides.ImageProperties{Transparency: 1 - *input.Opacity},
+			Fields:          "transparency",
+		},
+	})
+}
+
+// RemoveWatermarkInput represents the input for the remove_watermark tool.
+type RemoveWatermarkInput struct {
+	PresentationID string   `json:"presentation_id"`
+	ObjectIDs      []string `json:"object_ids,omitempty"` // IDs returned by add_watermark; default every watermark
+}
+
+// RemoveWatermarkOutput represents the output of the remove_watermark tool.
+type RemoveWatermarkOutput struct {
+	DeletedIDs []string `json:"deleted_ids"`
+	Count      int      `json:"count"`
+}
+
+// RemoveWatermark deletes watermarks created by add_watermark: the listed ones, or every element
+// whose object ID carries the watermark prefix (see remove_objects_by_prefix).
+func (t *Tools) RemoveWatermark(ctx context.Context, tokenSource oauth2.TokenSource, input RemoveWatermarkInput) (*RemoveWatermarkOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	for _, objectID := range input.ObjectIDs {
+		if !strings.HasPrefix(objectID, watermarkIDPrefix) {
+			return nil, fmt.Errorf("%w: '%s' was not created by add_watermark", ErrInvalidWatermark, objectID)
+		}
+	}
+
+	t.config.Logger.Info("removing watermark",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("object_ids", len(input.ObjectIDs)),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to find the watermarks
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	found := findSlideElementIDsByPrefix(presentation, watermarkIDPrefix)
+	present := make(map[string]bool, len(found))
+	for _, objectID := range found {
+		present[objectID] = true
+	}
+
+	deleteIDs := found
+	if len(input.ObjectIDs) > 0 {
+		deleteIDs = nil
+		seen := make(map[string]bool, len(input.ObjectIDs))
+		for _, objectID := range input.ObjectIDs {
+			if seen[objectID] {
+				continue
+			}
+			seen[objectID] = true
+			if !present[objectID] {
+				return nil, fmt.Errorf("%w: watermark '%s' not found in presentation", ErrObjectNotFound, objectID)
+			}
+			deleteIDs = append(deleteIDs, objectID)
+		}
+	}
+
+	output := &RemoveWatermarkOutput{DeletedIDs: []string{}}
+	if len(deleteIDs) == 0 {
+		t.config.Logger.Info("no watermarks to remove",
+			slog.String("presentation_id", input.PresentationID),
+		)
+		return output, nil
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, buildDeleteObjectRequests(deleteIDs))
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrRemoveWatermarkFailed, err)
+	}
+
+	output.DeletedIDs = deleteIDs
+	output.Count = len(deleteIDs)
+
+	t.config.Logger.Info("watermark removed successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("count", output.Count),
+	)
+
+	return output, nil
+}
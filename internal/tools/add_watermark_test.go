@@ -0,0 +1,253 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+func newWatermarkTestPresentation() *slides.Presentation {
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1"},
+			{ObjectId: "slide-2", PageElements: []*slides.PageElement{{ObjectId: "watermark_old_1"}, {ObjectId: "title"}}},
+			{ObjectId: "slide-3", PageElements: []*slides.PageElement{{ObjectId: "watermark_old_2"}}},
+			{ObjectId: "slide-4"},
+		},
+	}
+}
+
+func newWatermarkTestTools(captured *[]*slides.Request, batchErr error, deleted *[]string) *Tools {
+	presentation := newWatermarkTestPresentation()
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*captured = requests
+			return &slides.BatchUpdatePresentationResponse{}, batchErr
+		},
+	}
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			return &drive.File{Id: "uploaded-file-123"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			*deleted = append(*deleted, fileID)
+			return nil
+		},
+	}
+
+	return NewToolsWithDrive(DefaultToolsConfig(),
+		func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+			return mockSlides, nil
+		},
+		func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+			return mockDrive, nil
+		},
+	)
+}
+
+func TestAddWatermark_TextOnEachSlideInRange(t *testing.T) {
+	stubObjectIDSuffix(t, "abc123")
+
+	var captured []*slides.Request
+	var deleted []string
+	tools := newWatermarkTestTools(&captured, nil, &deleted)
+
+	output, err := tools.AddWatermark(context.Background(), nil, AddWatermarkInput{
+		PresentationID: "pres-1",
+		Text:           "DRAFT",
+		StartSlide:     2,
+		EndSlide:       3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantIDs := []string{"watermark_abc123_1", "watermark_abc123_2"}
+	if len(output.ObjectIDs) != 2 || output.ObjectIDs[0] != wantIDs[0] || output.ObjectIDs[1] != wantIDs[1] {
+		t.Fatalf("ObjectIDs = %v, want %v", output.ObjectIDs, wantIDs)
+	}
+	if output.SlideIDs[0] != "slide-2" || output.SlideIDs[1] != "slide-3" || output.Kind != "text" {
+		t.Errorf("unexpected output %+v", output)
+	}
+
+	// Default text box: 60% of the 720pt page width, centered
+	if output.Size.Width != 432 || output.Size.Height != 72*watermarkLineHeightPerFont {
+		t.Errorf("unexpected size %+v", output.Size)
+	}
+	if output.Position.X != 144 || output.Position.Y != (defaultPageHeightPoints-output.Size.Height)/2 {
+		t.Errorf("expected centered position, got %+v", output.Position)
+	}
+
+	shapes := map[string]string{}
+	centered := map[string]bool{}
+	for _, req := range captured {
+		if req.CreateShape != nil {
+			shapes[req.CreateShape.ObjectId] = req.CreateShape.ElementProperties.PageObjectId
+		}
+		if req.UpdateTextStyle != nil {
+			style := req.UpdateTextStyle.Style
+			if style.FontSize == nil || style.FontSize.Magnitude != 72 || !style.Bold {
+				t.Errorf("expected bold 72pt text, got %+v", style)
+			}
+		}
+		if req.UpdateParagraphStyle != nil && req.UpdateParagraphStyle.Style.Alignment == "CENTER" {
+			centered[req.UpdateParagraphStyle.ObjectId] = true
+		}
+	}
+	if len(shapes) != 2 || shapes[wantIDs[0]] != "slide-2" || shapes[wantIDs[1]] != "slide-3" {
+		t.Errorf("expected one text box per slide in range, got %v", shapes)
+	}
+	if !centered[wantIDs[0]] || !centered[wantIDs[1]] {
+		t.Errorf("expected centered paragraphs, got %v", centered)
+	}
+}
+
+func TestAddWatermark_ImageUploadedOnce(t *testing.T) {
+	stubObjectIDSuffix(t, "abc123")
+
+	var captured []*slides.Request
+	var deleted []string
+	tools := newWatermarkTestTools(&captured, nil, &deleted)
+
+	output, err := tools.AddWatermark(context.Background(), nil, AddWatermarkInput{
+		PresentationID: "pres-1",
+		ImageBase64:    base64.StdEncoding.EncodeToString(testPNGBytes),
+		Placement:      "bottom_right",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(output.ObjectIDs) != 4 {
+		t.Fatalf("expected a watermark on all 4 slides, got %v", output.ObjectIDs)
+	}
+	if output.Position.X != 720-output.Size.Width-watermarkMargin {
+		t.Errorf("expected bottom-right position, got %+v", output.Position)
+	}
+
+	images := 0
+	for _, req := range captured {
+		if req.CreateImage != nil {
+			images++
+			if req.CreateImage.Url != "https://drive.google.com/uc?id=uploaded-file-123&export=download" {
+				t.Errorf("unexpected image URL %q", req.CreateImage.Url)
+			}
+		}
+		if req.UpdateImageProperties != nil {
+			if got := req.UpdateImageProperties.ImageProperties.Transparency; got < 0.69 || got > 0.71 {
+				t.Errorf("expected transparency 0.7 for default opacity, got %v", got)
+			}
+		}
+	}
+	if images != 4 {
+		t.Errorf("expected 4 CreateImage requests, got %d", images)
+	}
+}
+
+func TestAddWatermark_ImageBatchFailureDeletesUpload(t *testing.T) {
+	var captured []*slides.Request
+	var deleted []string
+	tools := newWatermarkTestTools(&captured, errors.New("boom"), &deleted)
+
+	_, err := tools.AddWatermark(context.Background(), nil, AddWatermarkInput{
+		PresentationID: "pres-1",
+		ImageBase64:    base64.StdEncoding.EncodeToString(testPNGBytes),
+	})
+	if !errors.Is(err, ErrAddWatermarkFailed) {
+		t.Fatalf("expected ErrAddWatermarkFailed, got %v", err)
+	}
+	if len(deleted) != 1 || deleted[0] != "uploaded-file-123" {
+		t.Errorf("expected the orphaned upload to be deleted, got %v", deleted)
+	}
+}
+
+func TestAddWatermark_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   AddWatermarkInput
+		wantErr error
+	}{
+		{"missing presentation", AddWatermarkInput{Text: "DRAFT"}, ErrInvalidPresentationID},
+		{"no content", AddWatermarkInput{PresentationID: "pres-1"}, ErrInvalidWatermark},
+		{"text and image", AddWatermarkInput{PresentationID: "pres-1", Text: "DRAFT", ImageBase64: "aGk="}, ErrInvalidWatermark},
+		{"invalid placement", AddWatermarkInput{PresentationID: "pres-1", Text: "DRAFT", Placement: "middle"}, ErrInvalidWatermark},
+		{"opacity on text", AddWatermarkInput{PresentationID: "pres-1", Text: "DRAFT", Opacity: float64Ptr(0.5)}, ErrInvalidWatermark},
+		{"invalid color", AddWatermarkInput{PresentationID: "pres-1", Text: "DRAFT", Color: "gray"}, ErrInvalidWatermark},
+		{"opacity out of range", AddWatermarkInput{PresentationID: "pres-1", ImageBase64: "aGk=", Opacity: float64Ptr(1.5)}, ErrInvalidWatermark},
+		{"invalid image data", AddWatermarkInput{PresentationID: "pres-1", ImageBase64: "!!!"}, ErrInvalidImageData},
+		{"start after end", AddWatermarkInput{PresentationID: "pres-1", Text: "DRAFT", StartSlide: 3, EndSlide: 2}, ErrInvalidSlideReference},
+		{"end out of range", AddWatermarkInput{PresentationID: "pres-1", Text: "DRAFT", EndSlide: 5}, ErrSlideNotFound},
+		{"batch update failure", AddWatermarkInput{PresentationID: "pres-1", Text: "DRAFT"}, ErrAddWatermarkFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []*slides.Request
+			var deleted []string
+			tools := newWatermarkTestTools(&captured, errors.New("boom"), &deleted)
+
+			_, err := tools.AddWatermark(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRemoveWatermark(t *testing.T) {
+	tests := []struct {
+		name      string
+		objectIDs []string
+		wantIDs   []string
+		wantErr   error
+	}{
+		{"all watermarks", nil, []string{"watermark_old_1", "watermark_old_2"}, nil},
+		{"listed watermark", []string{"watermark_old_2"}, []string{"watermark_old_2"}, nil},
+		{"not a watermark", []string{"title"}, nil, ErrInvalidWatermark},
+		{"missing watermark", []string{"watermark_gone"}, nil, ErrObjectNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []*slides.Request
+			var deleted []string
+			tools := newWatermarkTestTools(&captured, nil, &deleted)
+
+			output, err := tools.RemoveWatermark(context.Background(), nil, RemoveWatermarkInput{
+				PresentationID: "pres-1",
+				ObjectIDs:      tt.objectIDs,
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.Count != len(tt.wantIDs) || len(captured) != len(tt.wantIDs) {
+				t.Fatalf("expected %d deletions, got output %+v and %d requests", len(tt.wantIDs), output, len(captured))
+			}
+			for i, id := range tt.wantIDs {
+				if captured[i].DeleteObject == nil || captured[i].DeleteObject.ObjectId != id {
+					t.Errorf("request %d: expected delete of %s, got %+v", i, id, captured[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2"
+)
+
+// AppendSlideInput represents the input for the append_slide tool.
+type AppendSlideInput struct {
+	PresentationID string `json:"presentation_id"`
+	Layout         string `json:"layout"` // Layout type (BLANK, TITLE, TITLE_AND_BODY, etc.)
+}
+
+// AppendSlideOutput represents the output of the append_slide tool.
+type AppendSlideOutput struct {
+	SlideIndex int    `json:"slide_index"` // 1-based index of the new slide, always the last
+	SlideID    string `json:"slide_id"`    // Object ID of the new slide
+	SlideCount int    `json:"slide_count"` // Number of slides after the append
+}
+
+// AppendSlide adds a new slide at the end of a presentation, so callers do not have to
+// work out the slide count for add_slide's position.
+func (t *Tools) AppendSlide(ctx context.Context, tokenSource oauth2.TokenSource, input AppendSlideInput) (*AppendSlideOutput, error) {
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	// Position 0 makes add_slide insert after the last slide it read from the presentation
+	added, err := t.AddSlide(ctx, tokenSource, AddSlideInput{
+		PresentationID: input.PresentationID,
+		Layout:         input.Layout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppendSlideOutput{
+		SlideIndex: added.SlideIndex,
+		SlideID:    added.SlideID,
+		SlideCount: added.SlideIndex,
+	}, nil
+}
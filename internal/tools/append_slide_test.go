@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestAppendSlide(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				Slides:         []*slides.Page{{ObjectId: "slide-1"}, {ObjectId: "slide-2"}, {ObjectId: "slide-3"}},
+				Layouts: []*slides.Page{
+					{ObjectId: "layout-blank", LayoutProperties: &slides.LayoutProperties{Name: "BLANK"}},
+				},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{
+				Replies: []*slides.Response{{CreateSlide: &slides.CreateSlideResponse{ObjectId: "new-slide"}}},
+			}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.AppendSlide(context.Background(), &mockTokenSource{}, AppendSlideInput{
+		PresentationID: "pres-1",
+		Layout:         "BLANK",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.SlideID != "new-slide" || output.SlideIndex != 4 || output.SlideCount != 4 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+	if len(capturedRequests) != 1 || capturedRequests[0].CreateSlide == nil {
+		t.Fatalf("expected a single CreateSlide request, got %+v", capturedRequests)
+	}
+	if got := capturedRequests[0].CreateSlide.InsertionIndex; got != 3 {
+		t.Errorf("InsertionIndex = %d, want 3 (after the last of 3 slides)", got)
+	}
+}
+
+func TestAppendSlide_Errors(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	_, err := tools.AppendSlide(context.Background(), nil, AppendSlideInput{Layout: "BLANK"})
+	if !errors.Is(err, ErrInvalidPresentationID) {
+		t.Errorf("expected ErrInvalidPresentationID, got %v", err)
+	}
+
+	_, err = tools.AppendSlide(context.Background(), nil, AppendSlideInput{PresentationID: "pres-1", Layout: "NOT_A_LAYOUT"})
+	if !errors.Is(err, ErrInvalidLayout) {
+		t.Errorf("expected ErrInvalidLayout, got %v", err)
+	}
+}
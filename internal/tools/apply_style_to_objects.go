@@ -0,0 +1,230 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for apply_style_to_objects tool.
+var (
+	ErrApplyStyleFailed = errors.New("failed to apply style to objects")
+	ErrNoStyleTargets   = errors.New("no style targets")
+)
+
+// ApplyStyleToObjectsInput represents the input for the apply_style_to_objects tool.
+// Targets are either explicit ObjectIDs or the text shapes matching the filters.
+type ApplyStyleToObjectsInput struct {
+	PresentationID   string              `json:"presentation_id"`
+	ObjectIDs        []string            `json:"object_ids,omitempty"`        // Explicit targets; cannot be combined with filters
+	ObjectTypes      []string            `json:"object_types,omitempty"`      // Filter: TEXT_BOX, RECTANGLE, etc.
+	PlaceholderTypes []string            `json:"placeholder_types,omitempty"` // Filter: TITLE, CENTERED_TITLE, BODY, etc.
+	SlideIndices     []int               `json:"slide_indices,omitempty"`     // Filter scope, 1-based; default all slides
+	Style            *StyleTextStyleSpec `json:"style"`
+}
+
+// ApplyStyleToObjectsOutput represents the output of the apply_style_to_objects tool.
+type ApplyStyleToObjectsOutput struct {
+	PresentationID string   `json:"presentation_id"`
+	AppliedStyles  []string `json:"applied_styles"`
+	ObjectIDs      []string `json:"object_ids"` // Styled objects, in slide order for filters
+	Count          int      `json:"count"`
+}
+
+// ApplyStyleToObjects applies one text style to the whole text of several objects in a single batch.
+func (t *Tools) ApplyStyleToObjects(ctx context.Context, tokenSource oauth2.TokenSource, input ApplyStyleToObjectsInput) (*ApplyStyleToObjectsOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.Style == nil {
+		return nil, fmt.Errorf("%w: style is required", ErrNoStyleProvided)
+	}
+
+	hasFilters := len(input.ObjectTypes) > 0 || len(input.PlaceholderTypes) > 0 || len(input.SlideIndices) > 0
+	if len(input.ObjectIDs) == 0 && !hasFilters {
+		return nil, fmt.Errorf("%w: provide object_ids or at least one of object_types, placeholder_types, slide_indices", ErrNoStyleTargets)
+	}
+	if len(input.ObjectIDs) > 0 && hasFilters {
+		return nil, fmt.Errorf("%w: object_ids cannot be combined with filters", ErrNoStyleTargets)
+	}
+	for _, objectID := range input.ObjectIDs {
+		if objectID == "" {
+			return nil, fmt.Errorf("%w: object_ids cannot contain empty IDs", ErrInvalidObjectID)
+		}
+	}
+
+	// Check the style once before resolving any target
+	if request, _ := buildStyleTextRequest(StyleTextInput{Style: input.Style}); request == nil {
+		return nil, ErrNoStyleProvided
+	}
+
+	t.config.Logger.Info("applying style to objects",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("object_ids", len(input.ObjectIDs)),
+		slog.Any("object_types", input.ObjectTypes),
+		slog.Any("placeholder_types", input.PlaceholderTypes),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to resolve targets
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	var objectIDs []string
+	if len(input.ObjectIDs) > 0 {
+		objectIDs, err = resolveExplicitStyleTargets(presentation, input.ObjectIDs)
+	} else {
+		objectIDs, err = resolveFilteredStyleTargets(presentation, input)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ApplyStyleToObjectsOutput{
+		PresentationID: input.PresentationID,
+		ObjectIDs:      objectIDs,
+		Count:          len(objectIDs),
+	}
+
+	var requests []*slides.Request
+	for _, objectID := range objectIDs {
+		request, appliedStyles := buildStyleTextRequest(StyleTextInput{ObjectID: objectID, Style: input.Style})
+		requests = append(requests, request)
+		output.AppliedStyles = appliedStyles
+	}
+
+	// Filters matching nothing are not an error; there is simply nothing to style
+	if len(requests) == 0 {
+		output.ObjectIDs = []string{}
+		t.config.Logger.Info("no objects matched style filters",
+			slog.String("presentation_id", input.PresentationID),
+		)
+		return output, nil
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrApplyStyleFailed, err)
+	}
+
+	t.config.Logger.Info("style applied to objects successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("count", output.Count),
+	)
+
+	return output, nil
+}
+
+// resolveExplicitStyleTargets checks every listed object exists and holds text.
+func resolveExplicitStyleTargets(presentation *slides.Presentation, objectIDs []string) ([]string, error) {
+	seen := make(map[string]bool, len(objectIDs))
+	var targets []string
+	for _, objectID := range objectIDs {
+		if seen[objectID] {
+			continue
+		}
+		seen[objectID] = true
+
+		var element *slides.PageElement
+		for _, slide := range presentation.Slides {
+			if element = findElementByID(slide.PageElements, objectID); element != nil {
+				break
+			}
+		}
+		if element == nil {
+			return nil, fmt.Errorf("%w: object '%s' not found in presentation", ErrObjectNotFound, objectID)
+		}
+		if element.Shape == nil || element.Shape.Text == nil {
+			if element.Table != nil {
+				return nil, fmt.Errorf("%w: tables must be styled cell by cell", ErrNotTextObject)
+			}
+			return nil, fmt.Errorf("%w: object '%s' does not contain text", ErrNotTextObject, objectID)
+		}
+		targets = append(targets, objectID)
+	}
+	return targets, nil
+}
+
+// resolveFilteredStyleTargets collects text shapes matching the type and placeholder filters
+// on the selected slides, including shapes inside groups. Shapes without text are skipped.
+func resolveFilteredStyleTargets(presentation *slides.Presentation, input ApplyStyleToObjectsInput) ([]string, error) {
+	allowedSlides := make(map[int]bool, len(input.SlideIndices))
+	for _, idx := range input.SlideIndices {
+		if idx < 1 || idx > len(presentation.Slides) {
+			return nil, fmt.Errorf("%w: slide index %d out of range (1-%d)", ErrSlideNotFound, idx, len(presentation.Slides))
+		}
+		allowedSlides[idx] = true
+	}
+
+	objectTypes := upperSet(input.ObjectTypes)
+	placeholderTypes := upperSet(input.PlaceholderTypes)
+
+	var targets []string
+	var visit func(elements []*slides.PageElement)
+	visit = func(elements []*slides.PageElement) {
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+			if element.ElementGroup != nil {
+				visit(element.ElementGroup.Children)
+				continue
+			}
+			if element.Shape == nil || element.Shape.Text == nil {
+				continue
+			}
+			if len(objectTypes) > 0 && !objectTypes[determineObjectType(element)] {
+				continue
+			}
+			if len(placeholderTypes) > 0 {
+				if element.Shape.Placeholder == nil || !placeholderTypes[element.Shape.Placeholder.Type] {
+					continue
+				}
+			}
+			targets = append(targets, element.ObjectId)
+		}
+	}
+
+	for i, slide := range presentation.Slides {
+		if len(allowedSlides) > 0 && !allowedSlides[i+1] {
+			continue
+		}
+		visit(slide.PageElements)
+	}
+	return targets, nil
+}
+
+// upperSet builds a case-insensitive lookup set from a list of names.
+func upperSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[strings.ToUpper(strings.TrimSpace(value))] = true
+	}
+	return set
+}
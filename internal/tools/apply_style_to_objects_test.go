@@ -0,0 +1,201 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func newApplyStyleTestPresentation() *slides.Presentation {
+	textShape := func(id, shapeType, placeholder string) *slides.PageElement {
+		shape := &slides.Shape{
+			ShapeType: shapeType,
+			Text:      &slides.TextContent{TextElements: createTextElementsNoLink("text")},
+		}
+		if placeholder != "" {
+			shape.Placeholder = &slides.Placeholder{Type: placeholder}
+		}
+		return &slides.PageElement{ObjectId: id, Shape: shape}
+	}
+
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					textShape("title-1", "TEXT_BOX", "CENTERED_TITLE"),
+					textShape("body-1", "TEXT_BOX", "BODY"),
+					{ObjectId: "empty-rect", Shape: &slides.Shape{ShapeType: "RECTANGLE"}},
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					textShape("title-2", "TEXT_BOX", "TITLE"),
+					{
+						ObjectId: "group-1",
+						ElementGroup: &slides.Group{Children: []*slides.PageElement{
+							textShape("grouped-rect", "RECTANGLE", ""),
+						}},
+					},
+					{ObjectId: "table-1", Table: &slides.Table{}},
+				},
+			},
+		},
+	}
+}
+
+func TestApplyStyleToObjects(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       ApplyStyleToObjectsInput
+		wantObjects []string
+	}{
+		{
+			name:        "title placeholders",
+			input:       ApplyStyleToObjectsInput{PlaceholderTypes: []string{"title", "CENTERED_TITLE"}},
+			wantObjects: []string{"title-1", "title-2"},
+		},
+		{
+			name:        "object type includes grouped shapes and skips shapes without text",
+			input:       ApplyStyleToObjectsInput{ObjectTypes: []string{"RECTANGLE"}},
+			wantObjects: []string{"grouped-rect"},
+		},
+		{
+			name:        "slide scope",
+			input:       ApplyStyleToObjectsInput{SlideIndices: []int{1}},
+			wantObjects: []string{"title-1", "body-1"},
+		},
+		{
+			name:        "explicit IDs keep order and drop duplicates",
+			input:       ApplyStyleToObjectsInput{ObjectIDs: []string{"body-1", "title-2", "body-1"}},
+			wantObjects: []string{"body-1", "title-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedRequests []*slides.Request
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return newApplyStyleTestPresentation(), nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					capturedRequests = requests
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			tt.input.PresentationID = "pres-1"
+			tt.input.Style = &StyleTextStyleSpec{FontFamily: "Montserrat", Bold: boolPtr(true)}
+			output, err := tools.ApplyStyleToObjects(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(output.ObjectIDs, tt.wantObjects) {
+				t.Errorf("ObjectIDs = %v, want %v", output.ObjectIDs, tt.wantObjects)
+			}
+			if output.Count != len(tt.wantObjects) {
+				t.Errorf("Count = %d, want %d", output.Count, len(tt.wantObjects))
+			}
+			if !reflect.DeepEqual(output.AppliedStyles, []string{"font_family=Montserrat", "bold=true"}) {
+				t.Errorf("unexpected applied styles: %v", output.AppliedStyles)
+			}
+
+			if len(capturedRequests) != len(tt.wantObjects) {
+				t.Fatalf("expected %d requests, got %d", len(tt.wantObjects), len(capturedRequests))
+			}
+			for i, req := range capturedRequests {
+				style := req.UpdateTextStyle
+				if style == nil {
+					t.Fatalf("request %d: expected UpdateTextStyle", i)
+				}
+				if style.ObjectId != tt.wantObjects[i] {
+					t.Errorf("request %d: ObjectId = %q, want %q", i, style.ObjectId, tt.wantObjects[i])
+				}
+				if style.TextRange.Type != "ALL" {
+					t.Errorf("request %d: range type = %q, want ALL", i, style.TextRange.Type)
+				}
+				if style.Fields != "fontFamily,bold" {
+					t.Errorf("request %d: fields = %q", i, style.Fields)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyStyleToObjects_NoMatches(t *testing.T) {
+	batchCalled := false
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return newApplyStyleTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			batchCalled = true
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.ApplyStyleToObjects(context.Background(), nil, ApplyStyleToObjectsInput{
+		PresentationID:   "pres-1",
+		PlaceholderTypes: []string{"SUBTITLE"},
+		Style:            &StyleTextStyleSpec{FontSize: 18},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Count != 0 || len(output.ObjectIDs) != 0 {
+		t.Errorf("expected no styled objects, got %+v", output)
+	}
+	if batchCalled {
+		t.Error("expected no batch update when nothing matches")
+	}
+}
+
+func TestApplyStyleToObjects_Errors(t *testing.T) {
+	style := &StyleTextStyleSpec{FontSize: 18}
+	tests := []struct {
+		name    string
+		input   ApplyStyleToObjectsInput
+		wantErr error
+	}{
+		{"missing presentation", ApplyStyleToObjectsInput{ObjectIDs: []string{"body-1"}, Style: style}, ErrInvalidPresentationID},
+		{"missing style", ApplyStyleToObjectsInput{PresentationID: "pres-1", ObjectIDs: []string{"body-1"}}, ErrNoStyleProvided},
+		{"empty style", ApplyStyleToObjectsInput{PresentationID: "pres-1", ObjectIDs: []string{"body-1"}, Style: &StyleTextStyleSpec{}}, ErrNoStyleProvided},
+		{"no targets", ApplyStyleToObjectsInput{PresentationID: "pres-1", Style: style}, ErrNoStyleTargets},
+		{"ids and filters", ApplyStyleToObjectsInput{PresentationID: "pres-1", ObjectIDs: []string{"body-1"}, ObjectTypes: []string{"TEXT_BOX"}, Style: style}, ErrNoStyleTargets},
+		{"unknown object", ApplyStyleToObjectsInput{PresentationID: "pres-1", ObjectIDs: []string{"missing"}, Style: style}, ErrObjectNotFound},
+		{"table", ApplyStyleToObjectsInput{PresentationID: "pres-1", ObjectIDs: []string{"table-1"}, Style: style}, ErrNotTextObject},
+		{"slide out of range", ApplyStyleToObjectsInput{PresentationID: "pres-1", SlideIndices: []int{3}, Style: style}, ErrSlideNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return newApplyStyleTestPresentation(), nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			_, err := tools.ApplyStyleToObjects(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
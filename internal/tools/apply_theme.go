@@ -13,21 +13,22 @@ import (
 
 // Sentinel errors for apply_theme tool.
 var (
-	ErrApplyThemeFailed     = errors.New("failed to apply theme")
-	ErrInvalidThemeSource   = errors.New("invalid theme source")
-	ErrGalleryNotSupported  = errors.New("gallery themes are not supported by the API")
-	ErrNoMasterInSource     = errors.New("no master slides found in source presentation")
-	ErrNoMasterInTarget     = errors.New("no master slides found in target presentation")
-	ErrNoColorScheme        = errors.New("no color scheme found in source presentation")
-	ErrInvalidSourcePresID  = errors.New("source presentation ID is required")
+	ErrApplyThemeFailed    = errors.New("failed to apply theme")
+	ErrInvalidThemeSource  = errors.New("invalid theme source")
+	ErrGalleryNotSupported = errors.New("gallery themes are not supported by the API")
+	ErrNoMasterInSource    = errors.New("no master slides found in source presentation")
+	ErrNoMasterInTarget    = errors.New("no master slides found in target presentation")
+	ErrNoColorScheme       = errors.New("no color scheme found in source presentation")
+	ErrInvalidSourcePresID = errors.New("source presentation ID is required")
 )
 
 // ApplyThemeInput represents the input for the apply_theme tool.
 type ApplyThemeInput struct {
-	PresentationID         string `json:"presentation_id"`          // Target presentation
-	ThemeSource            string `json:"theme_source"`             // "gallery" or "presentation"
-	ThemeID                string `json:"theme_id,omitempty"`       // Gallery theme ID (not supported)
-	SourcePresentationID   string `json:"source_presentation_id,omitempty"` // Source presentation for copying theme
+	PresentationID       string `json:"presentation_id"`                  // Target presentation
+	ThemeSource          string `json:"theme_source"`                     // "gallery" or "presentation"
+	ThemeID              string `json:"theme_id,omitempty"`               // Gallery theme ID (not supported)
+	SourcePresentationID string `json:"source_presentation_id,omitempty"` // Source presentation for copying theme
+	RemapObjects         bool   `json:"remap_objects,omitempty"`          // Also rewrite explicit colors and default fonts on slide objects
 }
 
 // ApplyThemeOutput represents the output of the apply_theme tool.
@@ -37,6 +38,11 @@ type ApplyThemeOutput struct {
 	UpdatedProperties []string `json:"updated_properties,omitempty"`
 	SourceMasterID    string   `json:"source_master_id,omitempty"`
 	TargetMasterID    string   `json:"target_master_id,omitempty"`
+
+	// Only with remap_objects
+	RemappedColors  int      `json:"remapped_colors,omitempty"`  // Text runs and shape fills/outlines recolored
+	RemappedFonts   int      `json:"remapped_fonts,omitempty"`   // Text runs switched to the source's default font
+	RemappedObjects []string `json:"remapped_objects,omitempty"` // Objects touched by the remap
 }
 
 // themeColorTypes are the first 12 ThemeColorTypes that can be edited.
@@ -59,6 +65,7 @@ var themeColorTypes = []string{
 // ApplyTheme applies a theme to a presentation.
 // For "presentation" source: copies theme colors from another presentation.
 // For "gallery" source: not supported by the API (returns error with guidance).
+// With RemapObjects, explicit colors and default fonts copied from the old theme are rewritten on slide objects.
 func (t *Tools) ApplyTheme(ctx context.Context, tokenSource oauth2.TokenSource, input ApplyThemeInput) (*ApplyThemeOutput, error) {
 	// Validate input
 	if input.PresentationID == "" {
@@ -159,6 +166,16 @@ func (t *Tools) ApplyTheme(ctx context.Context, tokenSource oauth2.TokenSource,
 		},
 	}
 
+	// Objects with theme color references follow the new scheme on their own; explicit
+	// RGB values and fonts copied from the old theme have to be rewritten one by one
+	var remapStats themeRemapStats
+	if input.RemapObjects {
+		remap := buildThemeRemap(sourceMaster, targetMaster)
+		var remapRequests []*slides.Request
+		remapRequests, remapStats = buildThemeRemapRequests(targetPresentation.Slides, remap)
+		requests = append(requests, remapRequests...)
+	}
+
 	// Execute batch update
 	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
 	if err != nil {
@@ -185,6 +202,9 @@ func (t *Tools) ApplyTheme(ctx context.Context, tokenSource oauth2.TokenSource,
 		UpdatedProperties: updatedProps,
 		SourceMasterID:    sourceMaster.ObjectId,
 		TargetMasterID:    targetMaster.ObjectId,
+		RemappedColors:    remapStats.colors,
+		RemappedFonts:     remapStats.fonts,
+		RemappedObjects:   remapStats.objectIDs,
 	}
 
 	t.config.Logger.Info("theme applied successfully",
@@ -242,3 +262,238 @@ func cloneRgbColor(color *slides.RgbColor) *slides.RgbColor {
 		Blue:  color.Blue,
 	}
 }
+
+// themeRemap maps the target's old theme values to the source's, for objects that hold
+// explicit copies of them rather than theme references.
+type themeRemap struct {
+	colors    map[string]*slides.RgbColor // Target theme color hex -> source color of the same type
+	titleFont map[string]string           // Target title font -> source title font
+	bodyFont  map[string]string           // Target body font -> source body font
+}
+
+// themeRemapStats counts what buildThemeRemapRequests rewrote.
+type themeRemapStats struct {
+	colors    int
+	fonts     int
+	objectIDs []string
+}
+
+// buildThemeRemap pairs each target theme color with the source color of the same type, and
+// the target master's title and body fonts with the source's. Pairs that do not change are
+// dropped. When two target types share a color, the first in themeColorTypes order wins.
+func buildThemeRemap(sourceMaster, targetMaster *slides.Page) *themeRemap {
+	remap := &themeRemap{
+		colors:    make(map[string]*slides.RgbColor),
+		titleFont: make(map[string]string),
+		bodyFont:  make(map[string]string),
+	}
+
+	sourceColors := themeColorsByType(sourceMaster)
+	targetColors := themeColorsByType(targetMaster)
+	for _, colorType := range themeColorTypes {
+		from, to := targetColors[colorType], sourceColors[colorType]
+		if from == nil || to == nil {
+			continue
+		}
+		fromHex := rgbHex(from)
+		if _, seen := remap.colors[fromHex]; seen || fromHex == rgbHex(to) {
+			continue
+		}
+		remap.colors[fromHex] = cloneRgbColor(to)
+	}
+
+	sourceTitle, sourceBody := masterDefaultFonts(sourceMaster)
+	targetTitle, targetBody := masterDefaultFonts(targetMaster)
+	if targetTitle != "" && sourceTitle != "" && targetTitle != sourceTitle {
+		remap.titleFont[targetTitle] = sourceTitle
+	}
+	if targetBody != "" && sourceBody != "" && targetBody != sourceBody {
+		remap.bodyFont[targetBody] = sourceBody
+	}
+
+	return remap
+}
+
+// themeColorsByType returns a master's color scheme keyed by theme color type.
+func themeColorsByType(master *slides.Page) map[string]*slides.RgbColor {
+	colors := make(map[string]*slides.RgbColor)
+	if master == nil || master.PageProperties == nil || master.PageProperties.ColorScheme == nil {
+		return colors
+	}
+	for _, pair := range master.PageProperties.ColorScheme.Colors {
+		if pair != nil && pair.Type != "" && pair.Color != nil {
+			colors[pair.Type] = pair.Color
+		}
+	}
+	return colors
+}
+
+// masterDefaultFonts reads the font family of the first styled run in the master's title
+// and body placeholders.
+func masterDefaultFonts(master *slides.Page) (string, string) {
+	var titleFont, bodyFont string
+	for _, element := range master.PageElements {
+		if element.Shape == nil || element.Shape.Placeholder == nil {
+			continue
+		}
+		font := firstRunFontFamily(element.Shape.Text)
+		switch element.Shape.Placeholder.Type {
+		case "TITLE", "CENTERED_TITLE":
+			if titleFont == "" {
+				titleFont = font
+			}
+		case "BODY":
+			if bodyFont == "" {
+				bodyFont = font
+			}
+		}
+	}
+	return titleFont, bodyFont
+}
+
+// firstRunFontFamily returns the font family of the first run that sets one.
+func firstRunFontFamily(text *slides.TextContent) string {
+	if text == nil {
+		return ""
+	}
+	for _, element := range text.TextElements {
+		if element.TextRun != nil && element.TextRun.Style != nil && element.TextRun.Style.FontFamily != "" {
+			return element.TextRun.Style.FontFamily
+		}
+	}
+	return ""
+}
+
+// rgbHex formats an RGB color the way extractColor does, so colors compare by their hex value.
+func rgbHex(color *slides.RgbColor) string {
+	return extractColor(&slides.OpaqueColor{RgbColor: color})
+}
+
+// buildThemeRemapRequests rewrites explicit theme copies on slide shapes, including shapes in
+// groups: text runs get an UpdateTextStyle for a remapped foreground color or default font
+// (title placeholders use the title font pair, everything else the body pair), and shapes get
+// an UpdateShapeProperties for a remapped solid fill or outline color. Tables, layouts and
+// masters are left alone.
+func buildThemeRemapRequests(pages []*slides.Page, remap *themeRemap) ([]*slides.Request, themeRemapStats) {
+	var requests []*slides.Request
+	var stats themeRemapStats
+	if remap == nil || (len(remap.colors) == 0 && len(remap.titleFont) == 0 && len(remap.bodyFont) == 0) {
+		return nil, stats
+	}
+
+	remappedColor := func(color *slides.RgbColor) *slides.RgbColor {
+		if color == nil {
+			return nil
+		}
+		return remap.colors[rgbHex(color)]
+	}
+
+	var visit func(elements []*slides.PageElement)
+	visit = func(elements []*slides.PageElement) {
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+			if element.ElementGroup != nil {
+				visit(element.ElementGroup.Children)
+				continue
+			}
+			if element.Shape == nil {
+				continue
+			}
+
+			touched := false
+			fontPairs := remap.bodyFont
+			if placeholder := element.Shape.Placeholder; placeholder != nil && (placeholder.Type == "TITLE" || placeholder.Type == "CENTERED_TITLE") {
+				fontPairs = remap.titleFont
+			}
+
+			if element.Shape.Text != nil {
+				for _, textElement := range element.Shape.Text.TextElements {
+					run := textElement.TextRun
+					if run == nil || run.Style == nil || textElement.EndIndex <= textElement.StartIndex {
+						continue
+					}
+
+					style := &slides.TextStyle{}
+					var fields []string
+					if run.Style.ForegroundColor != nil && run.Style.ForegroundColor.OpaqueColor != nil {
+						if to := remappedColor(run.Style.ForegroundColor.OpaqueColor.RgbColor); to != nil {
+							style.ForegroundColor = &slides.OptionalColor{OpaqueColor: &slides.OpaqueColor{RgbColor: to}}
+							fields = append(fields, "foregroundColor")
+							stats.colors++
+						}
+					}
+					if to, ok := fontPairs[run.Style.FontFamily]; ok {
+						style.FontFamily = to
+						fields = append(fields, "fontFamily")
+						stats.fonts++
+					}
+					if len(fields) == 0 {
+						continue
+					}
+
+					start := textElement.StartIndex
+					end := textElement.EndIndex
+					requests = append(requests, &slides.Request{
+						UpdateTextStyle: &slides.UpdateTextStyleRequest{
+							ObjectId: element.ObjectId,
+							TextRange: &slides.Range{
+								Type:       "FIXED_RANGE",
+								StartIndex: &start,
+								EndIndex:   &end,
+							},
+							Style:  style,
+							Fields: strings.Join(fields, ","),
+						},
+					})
+					touched = true
+				}
+			}
+
+			if props := element.Shape.ShapeProperties; props != nil {
+				shapeProps := &slides.ShapeProperties{}
+				var fields []string
+				if fill := props.ShapeBackgroundFill; fill != nil && fill.SolidFill != nil && fill.SolidFill.Color != nil {
+					if to := remappedColor(fill.SolidFill.Color.RgbColor); to != nil {
+						shapeProps.ShapeBackgroundFill = &slides.ShapeBackgroundFill{
+							SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{RgbColor: to}},
+						}
+						fields = append(fields, "shapeBackgroundFill.solidFill.color")
+					}
+				}
+				if outline := props.Outline; outline != nil && outline.OutlineFill != nil && outline.OutlineFill.SolidFill != nil && outline.OutlineFill.SolidFill.Color != nil {
+					if to := remappedColor(outline.OutlineFill.SolidFill.Color.RgbColor); to != nil {
+						shapeProps.Outline = &slides.Outline{
+							OutlineFill: &slides.OutlineFill{
+								SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{RgbColor: to}},
+							},
+						}
+						fields = append(fields, "outline.outlineFill.solidFill.color")
+					}
+				}
+				if len(fields) > 0 {
+					requests = append(requests, &slides.Request{
+						UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
+							ObjectId:        element.ObjectId,
+							ShapeProperties: shapeProps,
+							Fields:          strings.Join(fields, ","),
+						},
+					})
+					stats.colors += len(fields)
+					touched = true
+				}
+			}
+
+			if touched {
+				stats.objectIDs = append(stats.objectIDs, element.ObjectId)
+			}
+		}
+	}
+
+	for _, page := range pages {
+		visit(page.PageElements)
+	}
+
+	return requests, stats
+}
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 
 	"golang.org/x/oauth2"
@@ -577,3 +578,198 @@ func createPresentationWithColorScheme(presentationID, masterID string) *slides.
 		},
 	}
 }
+
+func newThemeRemapTestDecks() (*slides.Presentation, *slides.Presentation) {
+	fontPlaceholder := func(id, placeholderType, font string) *slides.PageElement {
+		return &slides.PageElement{
+			ObjectId: id,
+			Shape: &slides.Shape{
+				Placeholder: &slides.Placeholder{Type: placeholderType},
+				Text: &slides.TextContent{TextElements: []*slides.TextElement{
+					{StartIndex: 0, EndIndex: 5, TextRun: &slides.TextRun{Content: "Text\n", Style: &slides.TextStyle{FontFamily: font}}},
+				}},
+			},
+		}
+	}
+	solid := func(color *slides.RgbColor) *slides.SolidFill {
+		return &slides.SolidFill{Color: &slides.OpaqueColor{RgbColor: color}}
+	}
+	targetAccent1 := &slides.RgbColor{Red: 0.26, Green: 0.52, Blue: 0.96}
+	unrelated := &slides.RgbColor{Red: 0.5, Green: 0.5, Blue: 0.5}
+
+	source := createPresentationWithColorScheme("source-pres", "source-master")
+	source.Masters[0].PageProperties.ColorScheme.Colors[4].Color = &slides.RgbColor{Red: 1, Green: 0, Blue: 0}
+	source.Masters[0].PageElements = []*slides.PageElement{
+		fontPlaceholder("source-title", "TITLE", "Lato"),
+		fontPlaceholder("source-body", "BODY", "Roboto"),
+	}
+
+	target := createPresentationWithColorScheme("target-pres", "target-master")
+	target.Masters[0].PageElements = []*slides.PageElement{
+		fontPlaceholder("target-title", "TITLE", "Arial"),
+		fontPlaceholder("target-body", "BODY", "Georgia"),
+	}
+	target.Slides = []*slides.Page{
+		{
+			ObjectId: "slide-1",
+			PageElements: []*slides.PageElement{
+				{
+					ObjectId: "title-1",
+					Shape: &slides.Shape{
+						Placeholder: &slides.Placeholder{Type: "CENTERED_TITLE"},
+						Text: &slides.TextContent{TextElements: []*slides.TextElement{
+							{StartIndex: 0, EndIndex: 6, TextRun: &slides.TextRun{Content: "Hello ", Style: &slides.TextStyle{
+								FontFamily:      "Arial",
+								ForegroundColor: &slides.OptionalColor{OpaqueColor: &slides.OpaqueColor{RgbColor: targetAccent1}},
+							}}},
+							{StartIndex: 6, EndIndex: 12, TextRun: &slides.TextRun{Content: "world\n", Style: &slides.TextStyle{
+								FontFamily:      "Courier New",
+								ForegroundColor: &slides.OptionalColor{OpaqueColor: &slides.OpaqueColor{ThemeColor: "ACCENT1"}},
+							}}},
+						}},
+					},
+				},
+				{
+					ObjectId: "body-1",
+					Shape: &slides.Shape{
+						Placeholder: &slides.Placeholder{Type: "BODY"},
+						Text: &slides.TextContent{TextElements: []*slides.TextElement{
+							{StartIndex: 0, EndIndex: 5, TextRun: &slides.TextRun{Content: "Body\n", Style: &slides.TextStyle{FontFamily: "Georgia"}}},
+						}},
+					},
+				},
+				{
+					ObjectId: "rect-1",
+					Shape: &slides.Shape{
+						ShapeType: "RECTANGLE",
+						ShapeProperties: &slides.ShapeProperties{
+							ShapeBackgroundFill: &slides.ShapeBackgroundFill{SolidFill: solid(targetAccent1)},
+							Outline:             &slides.Outline{OutlineFill: &slides.OutlineFill{SolidFill: solid(unrelated)}},
+						},
+					},
+				},
+				{
+					ObjectId: "group-1",
+					ElementGroup: &slides.Group{Children: []*slides.PageElement{
+						{
+							ObjectId: "grouped-ellipse",
+							Shape: &slides.Shape{
+								ShapeType: "ELLIPSE",
+								ShapeProperties: &slides.ShapeProperties{
+									Outline: &slides.Outline{OutlineFill: &slides.OutlineFill{SolidFill: solid(targetAccent1)}},
+								},
+							},
+						},
+					}},
+				},
+				{ObjectId: "table-1", Table: &slides.Table{}},
+			},
+		},
+	}
+	return source, target
+}
+
+func TestApplyTheme_RemapObjects(t *testing.T) {
+	source, target := newThemeRemapTestDecks()
+
+	var captured []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			if presentationID == "source-pres" {
+				return source, nil
+			}
+			return target, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			captured = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.ApplyTheme(context.Background(), nil, ApplyThemeInput{
+		PresentationID:       "target-pres",
+		ThemeSource:          "presentation",
+		SourcePresentationID: "source-pres",
+		RemapObjects:         true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.RemappedColors != 3 || output.RemappedFonts != 2 {
+		t.Errorf("expected 3 colors and 2 fonts remapped, got %d and %d", output.RemappedColors, output.RemappedFonts)
+	}
+	wantObjects := []string{"title-1", "body-1", "rect-1", "grouped-ellipse"}
+	if !reflect.DeepEqual(output.RemappedObjects, wantObjects) {
+		t.Errorf("RemappedObjects = %v, want %v", output.RemappedObjects, wantObjects)
+	}
+
+	// Color scheme update first, then one request per remapped run or shape
+	if len(captured) != 5 {
+		t.Fatalf("expected 5 requests, got %d", len(captured))
+	}
+	if captured[0].UpdatePageProperties == nil {
+		t.Fatal("request 0: expected the color scheme update")
+	}
+
+	titleRun := captured[1].UpdateTextStyle
+	if titleRun == nil || titleRun.ObjectId != "title-1" {
+		t.Fatalf("request 1: expected UpdateTextStyle on title-1, got %+v", captured[1])
+	}
+	if titleRun.Fields != "foregroundColor,fontFamily" {
+		t.Errorf("request 1: fields = %q", titleRun.Fields)
+	}
+	if titleRun.TextRange.Type != "FIXED_RANGE" || *titleRun.TextRange.StartIndex != 0 || *titleRun.TextRange.EndIndex != 6 {
+		t.Errorf("request 1: unexpected range %+v", titleRun.TextRange)
+	}
+	if got := titleRun.Style.ForegroundColor.OpaqueColor.RgbColor; got.Red != 1 || got.Green != 0 || got.Blue != 0 {
+		t.Errorf("request 1: foreground = %+v, want source ACCENT1", got)
+	}
+	if titleRun.Style.FontFamily != "Lato" {
+		t.Errorf("request 1: font = %q, want Lato", titleRun.Style.FontFamily)
+	}
+
+	bodyRun := captured[2].UpdateTextStyle
+	if bodyRun == nil || bodyRun.ObjectId != "body-1" || bodyRun.Fields != "fontFamily" || bodyRun.Style.FontFamily != "Roboto" {
+		t.Errorf("request 2: expected body font remap to Roboto, got %+v", captured[2])
+	}
+
+	rect := captured[3].UpdateShapeProperties
+	if rect == nil || rect.ObjectId != "rect-1" || rect.Fields != "shapeBackgroundFill.solidFill.color" {
+		t.Errorf("request 3: expected fill remap on rect-1, got %+v", captured[3])
+	}
+
+	ellipse := captured[4].UpdateShapeProperties
+	if ellipse == nil || ellipse.ObjectId != "grouped-ellipse" || ellipse.Fields != "outline.outlineFill.solidFill.color" {
+		t.Errorf("request 4: expected outline remap on grouped-ellipse, got %+v", captured[4])
+	}
+}
+
+func TestBuildThemeRemap(t *testing.T) {
+	source, target := newThemeRemapTestDecks()
+
+	remap := buildThemeRemap(source.Masters[0], target.Masters[0])
+
+	// Only ACCENT1 differs between the two schemes
+	if len(remap.colors) != 1 {
+		t.Fatalf("expected 1 color pair, got %d: %v", len(remap.colors), remap.colors)
+	}
+	if to := remap.colors["#4284F4"]; to == nil || to.Red != 1 {
+		t.Errorf("expected #4284F4 to map to the source ACCENT1, got %+v", to)
+	}
+	if !reflect.DeepEqual(remap.titleFont, map[string]string{"Arial": "Lato"}) {
+		t.Errorf("titleFont = %v", remap.titleFont)
+	}
+	if !reflect.DeepEqual(remap.bodyFont, map[string]string{"Georgia": "Roboto"}) {
+		t.Errorf("bodyFont = %v", remap.bodyFont)
+	}
+
+	// Identical themes produce nothing to rewrite
+	requests, stats := buildThemeRemapRequests(target.Slides, buildThemeRemap(target.Masters[0], target.Masters[0]))
+	if len(requests) != 0 || len(stats.objectIDs) != 0 {
+		t.Errorf("expected no requests for identical themes, got %d", len(requests))
+	}
+}
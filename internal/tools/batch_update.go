@@ -17,6 +17,7 @@ var (
 	ErrBatchUpdateFailed   = errors.New("batch update failed")
 	ErrInvalidOnError      = errors.New("invalid on_error value")
 	ErrNoOperations        = errors.New("no operations provided")
+	ErrTooManyOperations   = errors.New("too many operations")
 	ErrInvalidOperation    = errors.New("invalid operation")
 	ErrUnsupportedToolName = errors.New("unsupported tool name")
 )
@@ -40,7 +41,17 @@ type BatchOperation struct {
 type BatchUpdateInput struct {
 	PresentationID string           `json:"presentation_id"`
 	Operations     []BatchOperation `json:"operations"`
-	OnError        OnErrorMode      `json:"on_error,omitempty"` // Default: "stop"
+	OnError        OnErrorMode      `json:"on_error,omitempty"`      // Default: "stop"
+	EstimateOnly   bool             `json:"estimate_only,omitempty"` // Return the API call estimate without executing
+}
+
+// BatchCostEstimate describes how many API calls a batch would take, counted the same way
+// as BatchUpdateOutput.APICallCount.
+type BatchCostEstimate struct {
+	APICallCount         int   `json:"api_call_count"`
+	BatchedOperations    []int `json:"batched_operations"`           // Indices sharing the single batch request
+	IndividualOperations []int `json:"individual_operations"`        // Indices that each take their own call
+	InvalidOperations    []int `json:"invalid_operations,omitempty"` // Indices that fail to parse and cost nothing
 }
 
 // OperationResult represents the result of a single operation.
@@ -55,24 +66,25 @@ type OperationResult struct {
 
 // BatchUpdateOutput represents the output of the batch_update tool.
 type BatchUpdateOutput struct {
-	PresentationID   string            `json:"presentation_id"`
-	TotalOperations  int               `json:"total_operations"`
-	SuccessCount     int               `json:"success_count"`
-	FailureCount     int               `json:"failure_count"`
-	Results          []OperationResult `json:"results"`
-	RolledBack       bool              `json:"rolled_back,omitempty"`
-	RollbackError    string            `json:"rollback_error,omitempty"`
-	StoppedAtIndex   *int              `json:"stopped_at_index,omitempty"`
-	BatchOptimized   bool              `json:"batch_optimized"`
-	APICallCount     int               `json:"api_call_count"`
+	PresentationID  string             `json:"presentation_id"`
+	TotalOperations int                `json:"total_operations"`
+	SuccessCount    int                `json:"success_count"`
+	FailureCount    int                `json:"failure_count"`
+	Results         []OperationResult  `json:"results"`
+	RolledBack      bool               `json:"rolled_back,omitempty"`
+	RollbackError   string             `json:"rollback_error,omitempty"`
+	StoppedAtIndex  *int               `json:"stopped_at_index,omitempty"`
+	BatchOptimized  bool               `json:"batch_optimized"`
+	APICallCount    int                `json:"api_call_count"`
+	Estimate        *BatchCostEstimate `json:"estimate,omitempty"` // Only set with estimate_only
 }
 
 // BatchableOperation contains info about whether an operation can be batched.
 type batchableOperation struct {
-	index     int
-	toolName  string
-	requests  []*slides.Request
-	postFunc  func(response *slides.BatchUpdatePresentationResponse, startReplyIdx int) (json.RawMessage, error)
+	index    int
+	toolName string
+	requests []*slides.Request
+	postFunc func(response *slides.BatchUpdatePresentationResponse, startReplyIdx int) (json.RawMessage, error)
 }
 
 // BatchUpdate executes multiple operations in a batch for efficiency.
@@ -86,6 +98,18 @@ func (t *Tools) BatchUpdate(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, ErrNoOperations
 	}
 
+	if len(input.Operations) > t.config.MaxBatchOperations {
+		return nil, fmt.Errorf("%w: %d operations exceed the limit of %d per batch_update call; split the work into smaller batches",
+			ErrTooManyOperations, len(input.Operations), t.config.MaxBatchOperations)
+	}
+
+	// A tool switched off by the operator must not run by being wrapped in a batch
+	for idx, op := range input.Operations {
+		if err := t.CheckToolEnabled(strings.ToLower(op.ToolName)); err != nil {
+			return nil, fmt.Errorf("operation %d: %w", idx, err)
+		}
+	}
+
 	// Default on_error mode
 	if input.OnError == "" {
 		input.OnError = OnErrorStop
@@ -96,6 +120,24 @@ func (t *Tools) BatchUpdate(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, fmt.Errorf("%w: must be 'stop', 'continue', or 'rollback'", ErrInvalidOnError)
 	}
 
+	// An estimate only classifies operations, so it needs neither the service nor the presentation
+	if input.EstimateOnly {
+		estimate := t.estimateBatchCost(input.Operations, input.PresentationID)
+		t.config.Logger.Info("batch update estimated",
+			slog.String("presentation_id", input.PresentationID),
+			slog.Int("operation_count", len(input.Operations)),
+			slog.Int("api_call_count", estimate.APICallCount),
+		)
+		return &BatchUpdateOutput{
+			PresentationID:  input.PresentationID,
+			TotalOperations: len(input.Operations),
+			Results:         []OperationResult{},
+			BatchOptimized:  len(estimate.BatchedOperations) > 1 && estimate.APICallCount < len(input.Operations),
+			APICallCount:    estimate.APICallCount,
+			Estimate:        estimate,
+		}, nil
+	}
+
 	t.config.Logger.Info("executing batch update",
 		slog.String("presentation_id", input.PresentationID),
 		slog.Int("operation_count", len(input.Operations)),
@@ -130,6 +172,16 @@ func (t *Tools) BatchUpdate(ctx context.Context, tokenSource oauth2.TokenSource,
 	// Try to batch all operations that support Slides API batch requests
 	batchableOps, nonBatchableIndices, parseErrors := t.classifyOperations(input.Operations, input.PresentationID)
 
+	// One step for the combined batch call plus one per operation run on its own
+	progress := ProgressFromContext(ctx)
+	totalSteps := len(nonBatchableIndices)
+	if len(batchableOps) > 0 {
+		totalSteps++
+	}
+	completedSteps := 0
+	// Wrapped tools that report progress themselves would mix their steps into the batch's count
+	operationCtx := WithProgress(ctx, noopProgress{})
+
 	// Handle parse errors based on on_error mode
 	for idx, parseErr := range parseErrors {
 		if parseErr != nil {
@@ -199,6 +251,8 @@ func (t *Tools) BatchUpdate(ctx context.Context, tokenSource oauth2.TokenSource,
 			}
 		}
 		output.APICallCount++
+		completedSteps++
+		progress.Report(completedSteps, totalSteps, fmt.Sprintf("Applied %d batched operations", len(batchableOps)))
 	}
 
 	// Execute non-batchable operations individually
@@ -207,8 +261,10 @@ func (t *Tools) BatchUpdate(ctx context.Context, tokenSource oauth2.TokenSource,
 			continue // Already has an error
 		}
 
-		result, err := t.executeNonBatchableOperation(ctx, tokenSource, input.PresentationID, input.Operations[idx])
+		result, err := t.executeNonBatchableOperation(operationCtx, tokenSource, input.PresentationID, input.Operations[idx])
 		output.APICallCount++
+		completedSteps++
+		progress.Report(completedSteps, totalSteps, fmt.Sprintf("Ran operation %d (%s)", idx, input.Operations[idx].ToolName))
 
 		if err != nil {
 			output.Results[idx] = OperationResult{
@@ -299,6 +355,33 @@ func (t *Tools) classifyOperations(operations []BatchOperation, presentationID s
 	return batchable, nonBatchable, parseErrors
 }
 
+// estimateBatchCost counts the API calls BatchUpdate would make: one for all batchable
+// operations together plus one per non-batchable operation. Operations that fail to parse
+// are never sent and cost nothing.
+func (t *Tools) estimateBatchCost(operations []BatchOperation, presentationID string) *BatchCostEstimate {
+	batchableOps, nonBatchableIndices, parseErrors := t.classifyOperations(operations, presentationID)
+
+	estimate := &BatchCostEstimate{
+		BatchedOperations:    make([]int, 0, len(batchableOps)),
+		IndividualOperations: make([]int, 0, len(nonBatchableIndices)),
+	}
+	for _, op := range batchableOps {
+		estimate.BatchedOperations = append(estimate.BatchedOperations, op.index)
+	}
+	estimate.IndividualOperations = append(estimate.IndividualOperations, nonBatchableIndices...)
+	for idx := range operations {
+		if parseErrors[idx] != nil {
+			estimate.InvalidOperations = append(estimate.InvalidOperations, idx)
+		}
+	}
+
+	if len(estimate.BatchedOperations) > 0 {
+		estimate.APICallCount++
+	}
+	estimate.APICallCount += len(estimate.IndividualOperations)
+	return estimate
+}
+
 // operationToRequests converts an operation to Slides API requests.
 // Returns ErrUnsupportedToolName if the operation doesn't support batching.
 func (t *Tools) operationToRequests(op BatchOperation, presentationID string) ([]*slides.Request, func(*slides.BatchUpdatePresentationResponse, int) (json.RawMessage, error), error) {
@@ -323,6 +406,12 @@ func (t *Tools) operationToRequests(op BatchOperation, presentationID string) ([
 		return t.createBulletListToRequests(op.Parameters, presentationID)
 	case "create_numbered_list":
 		return t.createNumberedListToRequests(op.Parameters, presentationID)
+	case "change_z_order":
+		return t.changeZOrderToRequests(op.Parameters, presentationID)
+	case "convert_list":
+		return t.convertListToRequests(op.Parameters, presentationID)
+	case "set_background":
+		return t.setBackgroundSolidToRequests(op.Parameters, presentationID)
 	default:
 		// Not all tools support batching
 		return nil, nil, ErrUnsupportedToolName
@@ -427,6 +516,18 @@ func (t *Tools) executeNonBatchableOperation(ctx context.Context, tokenSource oa
 		}
 		return json.Marshal(result)
 
+	case "modify_text":
+		var input ModifyTextInput
+		if err := json.Unmarshal(op.Parameters, &input); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidOperation, err)
+		}
+		input.PresentationID = presentationID
+		result, err := t.ModifyText(ctx, tokenSource, input)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(result)
+
 	case "set_background":
 		var input SetBackgroundInput
 		if err := json.Unmarshal(op.Parameters, &input); err != nil {
@@ -464,19 +565,21 @@ func (t *Tools) addSlideToRequests(params json.RawMessage, presentationID string
 		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidOperation, err)
 	}
 
-	if input.Layout == "" {
-		return nil, nil, fmt.Errorf("%w: layout is required", ErrInvalidLayout)
-	}
-
-	if !validLayoutTypes[input.Layout] {
-		return nil, nil, fmt.Errorf("%w: unsupported layout '%s'", ErrInvalidLayout, input.Layout)
+	if err := validateAddSlideLayout(input); err != nil {
+		return nil, nil, err
 	}
 
 	createSlideRequest := &slides.CreateSlideRequest{}
 
-	// Use predefined layout type
-	createSlideRequest.SlideLayoutReference = &slides.LayoutReference{
-		PredefinedLayout: input.Layout,
+	// Use the specific layout if given (the API rejects unknown IDs), else the predefined type
+	if input.LayoutObjectID != "" {
+		createSlideRequest.SlideLayoutReference = &slides.LayoutReference{
+			LayoutId: input.LayoutObjectID,
+		}
+	} else {
+		createSlideRequest.SlideLayoutReference = &slides.LayoutReference{
+			PredefinedLayout: input.Layout,
+		}
 	}
 
 	if input.Position > 0 {
@@ -538,9 +641,15 @@ func (t *Tools) addTextBoxToRequests(params json.RawMessage, presentationID stri
 		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidOperation, err)
 	}
 
+	placeholder, err := seedDefaultStylePlaceholder(&input)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if input.Text == "" {
 		return nil, nil, fmt.Errorf("%w: text is required", ErrInvalidText)
 	}
+	input.Text = normalizeLineBreaks(input.Text, input.SoftBreaks)
 
 	if input.Size == nil || input.Size.Width <= 0 || input.Size.Height <= 0 {
 		return nil, nil, fmt.Errorf("%w: size with positive width and height is required", ErrInvalidSize)
@@ -575,13 +684,13 @@ func (t *Tools) addTextBoxToRequests(params json.RawMessage, presentationID stri
 					Transform: &slides.AffineTransform{
 						ScaleX:     1,
 						ScaleY:     1,
-						TranslateX: x * pointsPerEMU,
-						TranslateY: y * pointsPerEMU,
+						TranslateX: pointsToEMU(x),
+						TranslateY: pointsToEMU(y),
 						Unit:       "EMU",
 					},
 					Size: &slides.Size{
-						Width:  &slides.Dimension{Magnitude: input.Size.Width * pointsPerEMU, Unit: "EMU"},
-						Height: &slides.Dimension{Magnitude: input.Size.Height * pointsPerEMU, Unit: "EMU"},
+						Width:  &slides.Dimension{Magnitude: pointsToEMU(input.Size.Width), Unit: "EMU"},
+						Height: &slides.Dimension{Magnitude: pointsToEMU(input.Size.Height), Unit: "EMU"},
 					},
 				},
 			},
@@ -594,16 +703,19 @@ func (t *Tools) addTextBoxToRequests(params json.RawMessage, presentationID stri
 		},
 	}
 
-	// Add styling if provided
-	if input.Style != nil {
-		styleRequest := batchBuildTextStyleRequest(objectID, input.Style, nil, nil)
+	// Seed the default style, then add styling if provided
+	for _, style := range []*TextStyleInput{input.DefaultStyle, input.Style} {
+		if style == nil {
+			continue
+		}
+		styleRequest := batchBuildTextStyleRequest(objectID, style, nil, nil)
 		if styleRequest != nil {
 			requests = append(requests, styleRequest)
 		}
 	}
 
 	postFunc := func(response *slides.BatchUpdatePresentationResponse, startIdx int) (json.RawMessage, error) {
-		result := AddTextBoxOutput{ObjectID: objectID}
+		result := AddTextBoxOutput{ObjectID: objectID, PlaceholderText: placeholder}
 		return json.Marshal(result)
 	}
 
@@ -628,6 +740,11 @@ func (t *Tools) modifyTextToRequests(params json.RawMessage, presentationID stri
 	if action != "delete" && input.Text == "" {
 		return nil, nil, fmt.Errorf("%w: text is required for %s action", ErrTextRequired, action)
 	}
+	// Preserving style needs the current text runs, so it cannot be batched blind
+	if input.PreserveStyle {
+		return nil, nil, ErrUnsupportedToolName
+	}
+	input.Text = normalizeLineBreaks(input.Text, input.SoftBreaks)
 
 	var requests []*slides.Request
 
@@ -668,6 +785,15 @@ func (t *Tools) modifyTextToRequests(params json.RawMessage, presentationID stri
 			},
 		})
 	case "delete":
+		if input.StartIndex != nil || input.EndIndex != nil {
+			// Delete only the given range; the batch path has no current text to clamp against
+			if err := validateDeleteTextRange(input.StartIndex, input.EndIndex); err != nil {
+				return nil, nil, err
+			}
+			requests = append(requests, buildDeleteTextRangeRequest(input.ObjectID, *input.StartIndex, *input.EndIndex))
+			break
+		}
+
 		// Delete all text
 		requests = append(requests, &slides.Request{
 			DeleteText: &slides.DeleteTextRequest{
@@ -754,6 +880,19 @@ func (t *Tools) createShapeToRequests(params json.RawMessage, presentationID str
 		return nil, nil, fmt.Errorf("%w: either slide_index or slide_id is required", ErrInvalidSlideReference)
 	}
 
+	if len(input.Adjustments) > 0 {
+		return nil, nil, fmt.Errorf("%w: omit adjustments from create_shape", ErrAdjustmentsUnsupported)
+	}
+
+	if err := validateOpacity(input.Opacity); err != nil {
+		return nil, nil, err
+	}
+
+	outlineState, err := normalizeOutlineState(input.OutlineState, input.OutlineColor)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// For batch, we need slide_id
 	if input.SlideID == "" {
 		return nil, nil, ErrUnsupportedToolName
@@ -777,13 +916,13 @@ func (t *Tools) createShapeToRequests(params json.RawMessage, presentationID str
 					Transform: &slides.AffineTransform{
 						ScaleX:     1,
 						ScaleY:     1,
-						TranslateX: x * pointsPerEMU,
-						TranslateY: y * pointsPerEMU,
+						TranslateX: pointsToEMU(x),
+						TranslateY: pointsToEMU(y),
 						Unit:       "EMU",
 					},
 					Size: &slides.Size{
-						Width:  &slides.Dimension{Magnitude: input.Size.Width * pointsPerEMU, Unit: "EMU"},
-						Height: &slides.Dimension{Magnitude: input.Size.Height * pointsPerEMU, Unit: "EMU"},
+						Width:  &slides.Dimension{Magnitude: pointsToEMU(input.Size.Width), Unit: "EMU"},
+						Height: &slides.Dimension{Magnitude: pointsToEMU(input.Size.Height), Unit: "EMU"},
 					},
 				},
 			},
@@ -791,9 +930,9 @@ func (t *Tools) createShapeToRequests(params json.RawMessage, presentationID str
 	}
 
 	// Add fill and outline styling if provided
-	if input.FillColor != "" || input.OutlineColor != "" || input.OutlineWeight != nil {
-		styleReq := batchBuildShapeStyleRequest(objectID, input.FillColor, input.OutlineColor, input.OutlineWeight)
-		if styleReq != nil {
+	if input.FillColor != "" || input.OutlineColor != "" || input.OutlineWeight != nil || input.Opacity != nil || outlineState != "" {
+		styleReq := batchBuildShapeStyleRequest(objectID, input.FillColor, input.OutlineColor, input.OutlineWeight, outlineState)
+		if styleReq = withShapeOpacity(styleReq, objectID, input.Opacity); styleReq != nil {
 			requests = append(requests, styleReq)
 		}
 	}
@@ -820,6 +959,36 @@ func (t *Tools) transformObjectToRequests(params json.RawMessage, presentationID
 	return nil, nil, ErrUnsupportedToolName
 }
 
+func (t *Tools) changeZOrderToRequests(params json.RawMessage, presentationID string) ([]*slides.Request, func(*slides.BatchUpdatePresentationResponse, int) (json.RawMessage, error), error) {
+	var input ChangeZOrderInput
+	if err := json.Unmarshal(params, &input); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidOperation, err)
+	}
+
+	if input.ObjectID == "" {
+		return nil, nil, fmt.Errorf("%w: object_id is required", ErrObjectNotFound)
+	}
+
+	apiOperation, err := normalizeZOrderAction(input.Action)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Object existence is checked by the API when the batch is applied
+	requests := []*slides.Request{buildZOrderRequest(input.ObjectID, apiOperation)}
+
+	postFunc := func(response *slides.BatchUpdatePresentationResponse, startIdx int) (json.RawMessage, error) {
+		result := ChangeZOrderOutput{
+			ObjectID:  input.ObjectID,
+			Action:    strings.ToLower(apiOperation),
+			NewZOrder: -1, // Unknown without re-reading the slide
+		}
+		return json.Marshal(result)
+	}
+
+	return requests, postFunc, nil
+}
+
 func (t *Tools) styleTextToRequests(params json.RawMessage, presentationID string) ([]*slides.Request, func(*slides.BatchUpdatePresentationResponse, int) (json.RawMessage, error), error) {
 	var input StyleTextInput
 	if err := json.Unmarshal(params, &input); err != nil {
@@ -933,22 +1102,32 @@ func (t *Tools) createBulletListToRequests(params json.RawMessage, presentationI
 		return nil, nil, fmt.Errorf("%w: bullet_style is required", ErrInvalidBulletStyle)
 	}
 
+	if err := validateBulletGlyphStyle(input.BulletColor, input.BulletSize); err != nil {
+		return nil, nil, err
+	}
+
 	preset := lookupBulletPreset(bulletStyle)
+	textRange := &slides.Range{Type: "ALL"}
 
 	requests := []*slides.Request{
 		{
 			CreateParagraphBullets: &slides.CreateParagraphBulletsRequest{
 				ObjectId:     input.ObjectID,
 				BulletPreset: preset,
-				TextRange:    &slides.Range{Type: "ALL"},
+				TextRange:    textRange,
 			},
 		},
 	}
+	if glyphRequest := buildBulletGlyphStyleRequest(input.ObjectID, textRange, input.BulletColor, input.BulletSize); glyphRequest != nil {
+		requests = append(requests, glyphRequest)
+	}
 
 	postFunc := func(response *slides.BatchUpdatePresentationResponse, startIdx int) (json.RawMessage, error) {
 		result := CreateBulletListOutput{
 			ObjectID:     input.ObjectID,
 			BulletPreset: preset,
+			BulletColor:  input.BulletColor,
+			BulletSize:   input.BulletSize,
 		}
 		return json.Marshal(result)
 	}
@@ -971,6 +1150,11 @@ func (t *Tools) createNumberedListToRequests(params json.RawMessage, presentatio
 		return nil, nil, fmt.Errorf("%w: number_style is required", ErrInvalidNumberStyle)
 	}
 
+	startNumber, err := normalizeStartNumber(input.StartNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	preset := lookupNumberPreset(numberStyle)
 
 	requests := []*slides.Request{
@@ -985,8 +1169,72 @@ func (t *Tools) createNumberedListToRequests(params json.RawMessage, presentatio
 
 	postFunc := func(response *slides.BatchUpdatePresentationResponse, startIdx int) (json.RawMessage, error) {
 		result := CreateNumberedListOutput{
-			ObjectID:     input.ObjectID,
-			NumberPreset: preset,
+			ObjectID:           input.ObjectID,
+			NumberPreset:       preset,
+			StartNumber:        startNumber,
+			StartNumberIgnored: startNumber != 1,
+		}
+		return json.Marshal(result)
+	}
+
+	return requests, postFunc, nil
+}
+
+func (t *Tools) convertListToRequests(params json.RawMessage, presentationID string) ([]*slides.Request, func(*slides.BatchUpdatePresentationResponse, int) (json.RawMessage, error), error) {
+	var input ConvertListInput
+	if err := json.Unmarshal(params, &input); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidOperation, err)
+	}
+
+	requests, output, err := buildConvertListRequests(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	postFunc := func(response *slides.BatchUpdatePresentationResponse, startIdx int) (json.RawMessage, error) {
+		return json.Marshal(output)
+	}
+
+	return requests, postFunc, nil
+}
+
+// setBackgroundSolidToRequests batches a solid background on a slide given by ID. Image and
+// gradient backgrounds need a Drive upload, and other scopes or slide indices need the
+// presentation, so those still run individually.
+func (t *Tools) setBackgroundSolidToRequests(params json.RawMessage, presentationID string) ([]*slides.Request, func(*slides.BatchUpdatePresentationResponse, int) (json.RawMessage, error), error) {
+	var input SetBackgroundInput
+	if err := json.Unmarshal(params, &input); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidOperation, err)
+	}
+
+	bgType := strings.ToLower(strings.TrimSpace(input.BackgroundType))
+	scope := strings.ToLower(strings.TrimSpace(input.Scope))
+	if bgType != "solid" || scope != "slide" || input.SlideID == "" {
+		return nil, nil, ErrUnsupportedToolName
+	}
+
+	if err := t.validateBackgroundSpec(bgType, input); err != nil {
+		return nil, nil, err
+	}
+
+	requests := []*slides.Request{
+		{
+			UpdatePageProperties: &slides.UpdatePagePropertiesRequest{
+				ObjectId: input.SlideID,
+				PageProperties: &slides.PageProperties{
+					PageBackgroundFill: buildSolidBackgroundFill(input.Color),
+				},
+				Fields: "pageBackgroundFill",
+			},
+		},
+	}
+
+	postFunc := func(response *slides.BatchUpdatePresentationResponse, startIdx int) (json.RawMessage, error) {
+		result := SetBackgroundOutput{
+			Success:        true,
+			Message:        fmt.Sprintf("Solid background (%s) applied successfully to slide", input.Color),
+			AffectedSlides: []string{input.SlideID},
+			Summary:        buildBackgroundSummary(bgType, input, 1),
 		}
 		return json.Marshal(result)
 	}
@@ -1018,7 +1266,7 @@ func getErrorCode(err error) string {
 
 // batchGenerateObjectID generates a unique object ID for batch operations.
 func batchGenerateObjectID(prefix string) string {
-	return fmt.Sprintf("%s_%d", prefix, timeNowFunc().UnixNano())
+	return newObjectID(prefix)
 }
 
 // batchBuildTextStyleRequest creates a request to update text style for batch operations.
@@ -1098,7 +1346,7 @@ func lookupNumberPreset(style string) string {
 }
 
 // batchBuildShapeStyleRequest creates a request to update shape style for batch operations.
-func batchBuildShapeStyleRequest(objectID, fillColor, outlineColor string, outlineWeight *float64) *slides.Request {
+func batchBuildShapeStyleRequest(objectID, fillColor, outlineColor string, outlineWeight *float64, outlineState string) *slides.Request {
 	shapeProps := &slides.ShapeProperties{}
 	var fields []string
 
@@ -1145,6 +1393,13 @@ func batchBuildShapeStyleRequest(objectID, fillColor, outlineColor string, outli
 		fields = append(fields, "outline")
 	}
 
+	if outlineState != "" {
+		if shapeProps.Outline == nil {
+			shapeProps.Outline = &slides.Outline{}
+		}
+		fields = applyOutlineState(shapeProps.Outline, outlineState, fields)
+	}
+
 	if len(fields) == 0 {
 		return nil
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"reflect"
 	"testing"
 
 	"golang.org/x/oauth2"
@@ -102,6 +103,64 @@ func TestBatchUpdate_MultipleOperations(t *testing.T) {
 	}
 }
 
+func TestBatchUpdate_ReportsProgress(t *testing.T) {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				Slides:         []*slides.Page{{ObjectId: "slide-1"}},
+				Layouts: []*slides.Page{
+					{ObjectId: "layout-blank", LayoutProperties: &slides.LayoutProperties{Name: "BLANK"}},
+				},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			replies := make([]*slides.Response, len(requests))
+			for i, req := range requests {
+				replies[i] = &slides.Response{}
+				if req.CreateSlide != nil {
+					replies[i].CreateSlide = &slides.CreateSlideResponse{ObjectId: "new-slide-id"}
+				}
+			}
+			return &slides.BatchUpdatePresentationResponse{Replies: replies}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	addSlideParams, _ := json.Marshal(AddSlideInput{Layout: "BLANK"})
+	addVideoParams, _ := json.Marshal(AddVideoInput{SlideIndex: 1, VideoSource: "youtube", VideoID: "dQw4w9WgXcQ"})
+
+	recorder := &progressRecorder{}
+	ctx := WithProgress(context.Background(), recorder)
+	output, err := tools.BatchUpdate(ctx, &mockTokenSource{}, BatchUpdateInput{
+		PresentationID: "test-pres-id",
+		Operations: []BatchOperation{
+			{ToolName: "add_slide", Parameters: addSlideParams},
+			{ToolName: "add_slide", Parameters: addSlideParams},
+			{ToolName: "add_video", Parameters: addVideoParams},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.SuccessCount != 3 {
+		t.Fatalf("expected 3 successful operations, got %+v", output.Results)
+	}
+
+	// One step for the combined batch call plus one for the operation run on its own
+	wantCompleted := []int{1, 2}
+	if len(recorder.completed) != len(wantCompleted) {
+		t.Fatalf("expected %d progress reports, got %d", len(wantCompleted), len(recorder.completed))
+	}
+	for i, want := range wantCompleted {
+		if recorder.completed[i] != want || recorder.totals[i] != 2 {
+			t.Errorf("report %d = %d/%d, want %d/2", i, recorder.completed[i], recorder.totals[i], want)
+		}
+	}
+}
+
 func TestBatchUpdate_OnErrorStop(t *testing.T) {
 	callCount := 0
 	mockService := &mockSlidesService{
@@ -404,6 +463,41 @@ func TestBatchUpdate_EmptyOperations(t *testing.T) {
 	}
 }
 
+func TestBatchUpdate_TooManyOperations(t *testing.T) {
+	factoryCalls := 0
+	config := DefaultToolsConfig()
+	config.MaxBatchOperations = 2
+	tools := NewTools(config, func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		factoryCalls++
+		return &mockSlidesService{}, nil
+	})
+
+	params := json.RawMessage(`{"object_id":"shape-1","action":"delete"}`)
+	_, err := tools.BatchUpdate(context.Background(), &mockTokenSource{}, BatchUpdateInput{
+		PresentationID: "test-pres-id",
+		Operations: []BatchOperation{
+			{ToolName: "modify_text", Parameters: params},
+			{ToolName: "modify_text", Parameters: params},
+			{ToolName: "modify_text", Parameters: params},
+		},
+	})
+
+	if !errors.Is(err, ErrTooManyOperations) {
+		t.Errorf("expected ErrTooManyOperations, got %v", err)
+	}
+	if factoryCalls != 0 {
+		t.Errorf("expected rejection before any service is created, got %d factory calls", factoryCalls)
+	}
+}
+
+func TestNewTools_DefaultMaxBatchOperations(t *testing.T) {
+	// Configs built without DefaultToolsConfig still get a finite limit
+	tools := NewTools(ToolsConfig{}, nil)
+	if tools.config.MaxBatchOperations != DefaultMaxBatchOperations {
+		t.Errorf("MaxBatchOperations = %d, want %d", tools.config.MaxBatchOperations, DefaultMaxBatchOperations)
+	}
+}
+
 func TestBatchUpdate_InvalidOnErrorMode(t *testing.T) {
 	tools := NewTools(DefaultToolsConfig(), nil)
 	tokenSource := &mockTokenSource{}
@@ -520,3 +614,400 @@ func TestBatchUpdate_DefaultOnErrorMode(t *testing.T) {
 		t.Errorf("expected 1 result, got %d", len(output.Results))
 	}
 }
+
+func TestBatchUpdate_ChangeZOrder(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{PresentationId: presentationID}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{Replies: make([]*slides.Response, len(requests))}, nil
+		},
+	}
+
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	frontParams, _ := json.Marshal(ChangeZOrderInput{ObjectID: "shape-1", Action: "bring_to_front"})
+	backParams, _ := json.Marshal(ChangeZOrderInput{ObjectID: "shape-2", Action: "SEND_BACKWARD"})
+
+	output, err := tools.BatchUpdate(context.Background(), &mockTokenSource{}, BatchUpdateInput{
+		PresentationID: "test-pres-id",
+		Operations: []BatchOperation{
+			{ToolName: "change_z_order", Parameters: frontParams},
+			{ToolName: "change_z_order", Parameters: backParams},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.APICallCount != 1 {
+		t.Errorf("expected 1 API call, got %d", output.APICallCount)
+	}
+	if len(capturedRequests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(capturedRequests))
+	}
+
+	want := []struct {
+		objectID  string
+		operation string
+	}{
+		{"shape-1", "BRING_TO_FRONT"},
+		{"shape-2", "SEND_BACKWARD"},
+	}
+	for i, w := range want {
+		req := capturedRequests[i].UpdatePageElementsZOrder
+		if req == nil {
+			t.Fatalf("request %d: expected UpdatePageElementsZOrder", i)
+		}
+		if len(req.PageElementObjectIds) != 1 || req.PageElementObjectIds[0] != w.objectID {
+			t.Errorf("request %d: object IDs = %v, want [%s]", i, req.PageElementObjectIds, w.objectID)
+		}
+		if req.Operation != w.operation {
+			t.Errorf("request %d: operation = %q, want %q", i, req.Operation, w.operation)
+		}
+	}
+
+	for i, result := range output.Results {
+		if !result.Success {
+			t.Errorf("operation %d failed: %s", i, result.Error)
+		}
+	}
+}
+
+func TestBatchUpdate_ChangeZOrderInvalidAction(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	_, _, err := tools.changeZOrderToRequests(json.RawMessage(`{"object_id":"shape-1","action":"sideways"}`), "test-pres-id")
+	if !errors.Is(err, ErrInvalidZOrderAction) {
+		t.Errorf("expected ErrInvalidZOrderAction, got %v", err)
+	}
+
+	_, _, err = tools.changeZOrderToRequests(json.RawMessage(`{"action":"bring_to_front"}`), "test-pres-id")
+	if !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("expected ErrObjectNotFound, got %v", err)
+	}
+}
+
+func TestBatchUpdate_AddSlideLayoutObjectID(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	requests, _, err := tools.addSlideToRequests(json.RawMessage(`{"layout_object_id":"layout-custom","position":2}`), "test-pres-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ref := requests[0].CreateSlide.SlideLayoutReference
+	if ref == nil || ref.LayoutId != "layout-custom" || ref.PredefinedLayout != "" {
+		t.Errorf("expected LayoutId 'layout-custom', got %+v", ref)
+	}
+
+	_, _, err = tools.addSlideToRequests(json.RawMessage(`{"layout":"BLANK","layout_object_id":"layout-custom"}`), "test-pres-id")
+	if !errors.Is(err, ErrInvalidLayout) {
+		t.Errorf("expected ErrInvalidLayout, got %v", err)
+	}
+}
+
+func TestBatchUpdate_ModifyTextDeleteRange(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	requests, _, err := tools.modifyTextToRequests(json.RawMessage(`{"object_id":"shape-1","action":"delete","start_index":2,"end_index":7}`), "test-pres-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) != 1 || requests[0].DeleteText == nil {
+		t.Fatalf("expected a single DeleteText request, got %+v", requests)
+	}
+	textRange := requests[0].DeleteText.TextRange
+	if textRange.Type != "FIXED_RANGE" || *textRange.StartIndex != 2 || *textRange.EndIndex != 7 {
+		t.Errorf("TextRange = %s [%d, %d), want FIXED_RANGE [2, 7)", textRange.Type, *textRange.StartIndex, *textRange.EndIndex)
+	}
+
+	// Without indices the whole text is still deleted
+	requests, _, err = tools.modifyTextToRequests(json.RawMessage(`{"object_id":"shape-1","action":"delete"}`), "test-pres-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests[0].DeleteText.TextRange.Type != "ALL" {
+		t.Errorf("TextRange type = %q, want ALL", requests[0].DeleteText.TextRange.Type)
+	}
+
+	_, _, err = tools.modifyTextToRequests(json.RawMessage(`{"object_id":"shape-1","action":"delete","start_index":5,"end_index":5}`), "test-pres-id")
+	if !errors.Is(err, ErrInvalidTextRange) {
+		t.Errorf("expected ErrInvalidTextRange, got %v", err)
+	}
+}
+
+func TestBatchUpdate_CreateNumberedListStartNumber(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	requests, postFunc, err := tools.createNumberedListToRequests(json.RawMessage(`{"object_id":"shape-1","number_style":"DECIMAL","start_number":4}`), "test-pres-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) != 1 || requests[0].CreateParagraphBullets == nil {
+		t.Fatalf("expected a single CreateParagraphBullets request, got %+v", requests)
+	}
+	if preset := requests[0].CreateParagraphBullets.BulletPreset; preset != "NUMBERED_DECIMAL_ALPHA_ROMAN" {
+		t.Errorf("BulletPreset = %q, want NUMBERED_DECIMAL_ALPHA_ROMAN", preset)
+	}
+
+	raw, err := postFunc(&slides.BatchUpdatePresentationResponse{}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result CreateNumberedListOutput
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("failed to decode result: %v", err)
+	}
+	if result.StartNumber != 4 || !result.StartNumberIgnored {
+		t.Errorf("expected start_number 4 reported as ignored, got %+v", result)
+	}
+
+	_, _, err = tools.createNumberedListToRequests(json.RawMessage(`{"object_id":"shape-1","number_style":"DECIMAL","start_number":-2}`), "test-pres-id")
+	if !errors.Is(err, ErrInvalidStartNumber) {
+		t.Errorf("expected ErrInvalidStartNumber, got %v", err)
+	}
+}
+
+func TestBatchUpdate_CreateBulletListGlyphStyle(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	requests, postFunc, err := tools.createBulletListToRequests(json.RawMessage(`{"object_id":"shape-1","bullet_style":"DISC","bullet_color":"#FF0000","bullet_size":14}`), "test-pres-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) != 2 || requests[0].CreateParagraphBullets == nil || requests[1].UpdateTextStyle == nil {
+		t.Fatalf("expected CreateParagraphBullets followed by UpdateTextStyle, got %+v", requests)
+	}
+	if fields := requests[1].UpdateTextStyle.Fields; fields != "foregroundColor,fontSize" {
+		t.Errorf("Fields = %q, want foregroundColor,fontSize", fields)
+	}
+
+	raw, err := postFunc(&slides.BatchUpdatePresentationResponse{}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result CreateBulletListOutput
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatalf("failed to decode result: %v", err)
+	}
+	if result.BulletColor != "#FF0000" || result.BulletSize != 14 {
+		t.Errorf("expected the bullet color and size to be reported, got %+v", result)
+	}
+
+	_, _, err = tools.createBulletListToRequests(json.RawMessage(`{"object_id":"shape-1","bullet_style":"DISC","bullet_color":"red"}`), "test-pres-id")
+	if !errors.Is(err, ErrInvalidBulletColor) {
+		t.Errorf("expected ErrInvalidBulletColor, got %v", err)
+	}
+}
+
+func TestBatchUpdate_ModifyTextPreserveStyleNotBatchable(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	_, _, err := tools.modifyTextToRequests(json.RawMessage(`{"object_id":"shape-1","action":"replace","text":"New","preserve_style":true}`), "test-pres-id")
+	if !errors.Is(err, ErrUnsupportedToolName) {
+		t.Errorf("expected ErrUnsupportedToolName so the operation runs individually, got %v", err)
+	}
+}
+
+func TestBatchUpdate_SolidBackgroundBatched(t *testing.T) {
+	var batchCalls int
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{PresentationId: presentationID}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			batchCalls++
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{Replies: make([]*slides.Response, len(requests))}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.BatchUpdate(context.Background(), &mockTokenSource{}, BatchUpdateInput{
+		PresentationID: "test-pres-id",
+		Operations: []BatchOperation{
+			{ToolName: "set_background", Parameters: json.RawMessage(`{"scope":"slide","slide_id":"slide-1","background_type":"solid","color":"#112233"}`)},
+			{ToolName: "change_z_order", Parameters: json.RawMessage(`{"object_id":"shape-1","action":"bring_to_front"}`)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 || output.APICallCount != 1 {
+		t.Errorf("expected a single API call, got %d (reported %d)", batchCalls, output.APICallCount)
+	}
+	if len(capturedRequests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(capturedRequests))
+	}
+
+	update := capturedRequests[0].UpdatePageProperties
+	if update == nil || update.ObjectId != "slide-1" || update.Fields != "pageBackgroundFill" {
+		t.Fatalf("request 0: expected UpdatePageProperties on slide-1, got %+v", capturedRequests[0])
+	}
+	rgb := update.PageProperties.PageBackgroundFill.SolidFill.Color.RgbColor
+	if rgb == nil || rgb.Red == 0 || rgb.Blue == 0 {
+		t.Errorf("unexpected background color %+v", rgb)
+	}
+	if capturedRequests[1].UpdatePageElementsZOrder == nil {
+		t.Errorf("request 1: expected UpdatePageElementsZOrder, got %+v", capturedRequests[1])
+	}
+
+	var result SetBackgroundOutput
+	if err := json.Unmarshal(output.Results[0].Result, &result); err != nil {
+		t.Fatalf("failed to decode set_background result: %v", err)
+	}
+	if !result.Success || len(result.AffectedSlides) != 1 || result.AffectedSlides[0] != "slide-1" {
+		t.Errorf("unexpected set_background result %+v", result)
+	}
+}
+
+func TestSetBackgroundSolidToRequests_Routing(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	tests := []struct {
+		name    string
+		params  string
+		wantErr error
+	}{
+		{"image stays individual", `{"scope":"slide","slide_id":"slide-1","background_type":"image","image_url":"https://example.com/a.png"}`, ErrUnsupportedToolName},
+		{"gradient stays individual", `{"scope":"slide","slide_id":"slide-1","background_type":"gradient","start_color":"#000000","end_color":"#FFFFFF"}`, ErrUnsupportedToolName},
+		{"all scope stays individual", `{"scope":"all","background_type":"solid","color":"#000000"}`, ErrUnsupportedToolName},
+		{"slide index stays individual", `{"scope":"slide","slide_index":2,"background_type":"solid","color":"#000000"}`, ErrUnsupportedToolName},
+		{"invalid color", `{"scope":"slide","slide_id":"slide-1","background_type":"solid","color":"red"}`, ErrMissingBackgroundColor},
+		{"solid by slide ID", `{"scope":"slide","slide_id":"slide-1","background_type":"SOLID","color":"#000000"}`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests, _, err := tools.setBackgroundSolidToRequests(json.RawMessage(tt.params), "test-pres-id")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && len(requests) != 1 {
+				t.Errorf("expected 1 request, got %d", len(requests))
+			}
+		})
+	}
+}
+
+func TestBatchUpdate_EstimateMatchesExecution(t *testing.T) {
+	var batchCalls int
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				Slides: []*slides.Page{{
+					ObjectId: "slide-1",
+					PageElements: []*slides.PageElement{{
+						ObjectId: "shape-1",
+						Shape: &slides.Shape{
+							ShapeType: "TEXT_BOX",
+							Text:      &slides.TextContent{TextElements: createTextElementsNoLink("Hello")},
+						},
+					}},
+				}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			batchCalls++
+			return &slides.BatchUpdatePresentationResponse{Replies: make([]*slides.Response, len(requests))}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	input := BatchUpdateInput{
+		PresentationID: "test-pres-id",
+		Operations: []BatchOperation{
+			{ToolName: "modify_text", Parameters: json.RawMessage(`{"object_id":"shape-1","action":"replace","text":"New"}`)},
+			{ToolName: "set_background", Parameters: json.RawMessage(`{"scope":"all","background_type":"solid","color":"#112233"}`)},
+			{ToolName: "change_z_order", Parameters: json.RawMessage(`{"object_id":"shape-1","action":"bring_to_front"}`)},
+			{ToolName: "modify_text", Parameters: json.RawMessage(`{"object_id":"shape-1","action":"replace","text":"Kept","preserve_style":true}`)},
+		},
+		OnError: OnErrorContinue,
+	}
+
+	estimateInput := input
+	estimateInput.EstimateOnly = true
+	estimated, err := tools.BatchUpdate(context.Background(), &mockTokenSource{}, estimateInput)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if batchCalls != 0 {
+		t.Fatalf("estimate must not execute anything, got %d batch calls", batchCalls)
+	}
+
+	want := &BatchCostEstimate{
+		APICallCount:         3,
+		BatchedOperations:    []int{0, 2},
+		IndividualOperations: []int{1, 3},
+	}
+	if !reflect.DeepEqual(estimated.Estimate, want) {
+		t.Errorf("Estimate = %+v, want %+v", estimated.Estimate, want)
+	}
+
+	executed, err := tools.BatchUpdate(context.Background(), &mockTokenSource{}, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if executed.FailureCount != 0 {
+		t.Fatalf("expected every operation to succeed, got %+v", executed.Results)
+	}
+	if estimated.APICallCount != executed.APICallCount {
+		t.Errorf("estimated %d API calls, execution reported %d", estimated.APICallCount, executed.APICallCount)
+	}
+}
+
+func TestEstimateBatchCost_InvalidOperations(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	estimate := tools.estimateBatchCost([]BatchOperation{
+		{ToolName: "add_slide", Parameters: json.RawMessage(`{"layout":"BLANK"}`)},
+		{ToolName: "change_z_order", Parameters: json.RawMessage(`{"object_id":"shape-1","action":"sideways"}`)},
+	}, "test-pres-id")
+
+	if estimate.APICallCount != 1 {
+		t.Errorf("APICallCount = %d, want 1", estimate.APICallCount)
+	}
+	if !reflect.DeepEqual(estimate.InvalidOperations, []int{1}) {
+		t.Errorf("InvalidOperations = %v, want [1]", estimate.InvalidOperations)
+	}
+}
+
+func TestCreateShapeToRequests_OutlineState(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	for _, state := range []string{"RENDERED", "NOT_RENDERED", "INHERIT"} {
+		t.Run(state, func(t *testing.T) {
+			params := `{"slide_id":"slide-1","shape_type":"RECTANGLE","size":{"width":100,"height":50},"outline_state":"` + state + `"}`
+			requests, _, err := tools.createShapeToRequests(json.RawMessage(params), "test-pres-id")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(requests) != 2 || requests[1].UpdateShapeProperties == nil {
+				t.Fatalf("expected create and style requests, got %d", len(requests))
+			}
+			update := requests[1].UpdateShapeProperties
+			if update.Fields != "outline.propertyState" || update.ShapeProperties.Outline.PropertyState != state {
+				t.Errorf("expected %s with field outline.propertyState, got %q fields=%q", state, update.ShapeProperties.Outline.PropertyState, update.Fields)
+			}
+		})
+	}
+
+	t.Run("invalid state", func(t *testing.T) {
+		params := `{"slide_id":"slide-1","shape_type":"RECTANGLE","size":{"width":100,"height":50},"outline_state":"HIDDEN"}`
+		if _, _, err := tools.createShapeToRequests(json.RawMessage(params), "test-pres-id"); !errors.Is(err, ErrInvalidOutlineState) {
+			t.Errorf("expected ErrInvalidOutlineState, got %v", err)
+		}
+	})
+}
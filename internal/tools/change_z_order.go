@@ -33,17 +33,13 @@ type ChangeZOrderOutput struct {
 	TotalLayers int    `json:"total_layers"` // Total number of objects on the slide
 }
 
-// validZOrderActions maps user-friendly action names to API operations.
+// validZOrderActions maps action names to API operations. Lookups are upper-cased first
+// (see normalizeZOrderAction), so lowercase aliases need no entries of their own.
 var validZOrderActions = map[string]string{
 	"BRING_TO_FRONT":  "BRING_TO_FRONT",
 	"SEND_TO_BACK":    "SEND_TO_BACK",
 	"BRING_FORWARD":   "BRING_FORWARD",
 	"SEND_BACKWARD":   "SEND_BACKWARD",
-	// User-friendly aliases (lowercase will be normalized)
-	"bring_to_front":  "BRING_TO_FRONT",
-	"send_to_back":    "SEND_TO_BACK",
-	"bring_forward":   "BRING_FORWARD",
-	"send_backward":   "SEND_BACKWARD",
 }
 
 // ChangeZOrder changes the z-order (layering) of an object on a slide.
@@ -59,18 +55,9 @@ func (t *Tools) ChangeZOrder(ctx context.Context, tokenSource oauth2.TokenSource
 		return nil, fmt.Errorf("%w: action is required (bring_to_front, send_to_back, bring_forward, send_backward)", ErrInvalidZOrderAction)
 	}
 
-	// Normalize action
-	normalizedAction := strings.ToUpper(strings.TrimSpace(input.Action))
-	// Handle underscore-separated user input
-	normalizedAction = strings.ReplaceAll(normalizedAction, "_", "_")
-
-	apiOperation, validAction := validZOrderActions[normalizedAction]
-	if !validAction {
-		// Try lowercase version
-		apiOperation, validAction = validZOrderActions[strings.ToLower(input.Action)]
-	}
-	if !validAction {
-		return nil, fmt.Errorf("%w: '%s' is not a valid action (use bring_to_front, send_to_back, bring_forward, send_backward)", ErrInvalidZOrderAction, input.Action)
+	apiOperation, err := normalizeZOrderAction(input.Action)
+	if err != nil {
+		return nil, err
 	}
 
 	t.config.Logger.Info("changing z-order",
@@ -122,12 +109,7 @@ func (t *Tools) ChangeZOrder(ctx context.Context, tokenSource oauth2.TokenSource
 	}
 
 	// Execute z-order change
-	req := &slides.Request{
-		UpdatePageElementsZOrder: &slides.UpdatePageElementsZOrderRequest{
-			PageElementObjectIds: []string{input.ObjectID},
-			Operation:            apiOperation,
-		},
-	}
+	req := buildZOrderRequest(input.ObjectID, apiOperation)
 
 	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, []*slides.Request{req})
 	if err != nil {
@@ -173,6 +155,25 @@ func (t *Tools) ChangeZOrder(ctx context.Context, tokenSource oauth2.TokenSource
 	}, nil
 }
 
+// normalizeZOrderAction maps an action name (API enum or lowercase alias) to its API operation.
+func normalizeZOrderAction(action string) (string, error) {
+	apiOperation, ok := validZOrderActions[strings.ToUpper(strings.TrimSpace(action))]
+	if !ok {
+		return "", fmt.Errorf("%w: '%s' is not a valid action (use bring_to_front, send_to_back, bring_forward, send_backward)", ErrInvalidZOrderAction, action)
+	}
+	return apiOperation, nil
+}
+
+// buildZOrderRequest creates the request that moves an object within its slide's layers.
+func buildZOrderRequest(objectID, apiOperation string) *slides.Request {
+	return &slides.Request{
+		UpdatePageElementsZOrder: &slides.UpdatePageElementsZOrderRequest{
+			PageElementObjectIds: []string{objectID},
+			Operation:            apiOperation,
+		},
+	}
+}
+
 // findElementAndCheckGroup searches for an element by ID and returns whether it's inside a group.
 // Returns (element, isInGroup).
 func findElementAndCheckGroup(elements []*slides.PageElement, objectID string) (*slides.PageElement, bool) {
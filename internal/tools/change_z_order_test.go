@@ -493,3 +493,35 @@ func TestFindElementAndCheckGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeZOrderAction(t *testing.T) {
+	tests := []struct {
+		action  string
+		want    string
+		wantErr bool
+	}{
+		{action: "bring_to_front", want: "BRING_TO_FRONT"},
+		{action: "SEND_TO_BACK", want: "SEND_TO_BACK"},
+		{action: " Bring_Forward ", want: "BRING_FORWARD"},
+		{action: "send_backward", want: "SEND_BACKWARD"},
+		{action: "to_top", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			got, err := normalizeZOrderAction(tt.action)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidZOrderAction) {
+					t.Errorf("expected ErrInvalidZOrderAction, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeZOrderAction(%q) = %q, want %q", tt.action, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for clear_all_hyperlinks tool.
+var (
+	ErrClearHyperlinksFailed = errors.New("failed to clear hyperlinks")
+)
+
+// ClearAllHyperlinksInput represents the input for the clear_all_hyperlinks tool.
+type ClearAllHyperlinksInput struct {
+	PresentationID string `json:"presentation_id"`
+	IncludeMasters bool   `json:"include_masters,omitempty"` // Also clear links on master pages
+	IncludeLayouts bool   `json:"include_layouts,omitempty"` // Also clear links on layout pages
+}
+
+// ClearAllHyperlinksOutput represents the output of the clear_all_hyperlinks tool.
+type ClearAllHyperlinksOutput struct {
+	PresentationID string          `json:"presentation_id"`
+	ClearedCount   int             `json:"cleared_count"`
+	Links          []HyperlinkInfo `json:"links"` // The links that were removed
+}
+
+// ClearAllHyperlinks removes every hyperlink in the deck in a single batch update, for example
+// before sharing it externally. Links are found with the manage_hyperlinks list traversal.
+func (t *Tools) ClearAllHyperlinks(ctx context.Context, tokenSource oauth2.TokenSource, input ClearAllHyperlinksInput) (*ClearAllHyperlinksOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	t.config.Logger.Info("clearing all hyperlinks",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Bool("include_masters", input.IncludeMasters),
+		slog.Bool("include_layouts", input.IncludeLayouts),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	output := &ClearAllHyperlinksOutput{
+		PresentationID: input.PresentationID,
+		Links:          []HyperlinkInfo{},
+	}
+
+	var requests []*slides.Request
+	for _, page := range traversalPages(presentation, input.IncludeMasters, input.IncludeLayouts) {
+		links := extractLinksFromSlide(page.Page, page.slideIndex(), "")
+		if pageType := page.nonSlideType(); pageType != "" {
+			for i := range links {
+				links[i].PageType = pageType
+			}
+		}
+		requests = append(requests, buildClearLinkRequests(page.Page.PageElements, links)...)
+		output.Links = append(output.Links, links...)
+	}
+	output.ClearedCount = len(output.Links)
+
+	if len(requests) == 0 {
+		t.config.Logger.Info("no hyperlinks to clear",
+			slog.String("presentation_id", input.PresentationID),
+		)
+		return output, nil
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrClearHyperlinksFailed, err)
+	}
+
+	t.config.Logger.Info("hyperlinks cleared successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("cleared_count", output.ClearedCount),
+		slog.Int("requests", len(requests)),
+	)
+
+	return output, nil
+}
+
+// buildClearLinkRequests returns the requests that clear the links found on one page. Text links
+// are cleared with one request over the whole text of their shape or table cell, which avoids
+// index arithmetic and covers several links at once; shape and image links reuse the
+// manage_hyperlinks remove request.
+func buildClearLinkRequests(elements []*slides.PageElement, links []HyperlinkInfo) []*slides.Request {
+	var requests []*slides.Request
+	cleared := make(map[string]bool)
+
+	for _, link := range links {
+		switch {
+		case link.ObjectType == "TABLE_CELL":
+			if cleared["cell:"+link.ObjectID] {
+				continue
+			}
+			tableID, row, column, ok := parseTableCellLinkID(link.ObjectID)
+			if !ok {
+				continue
+			}
+			cleared["cell:"+link.ObjectID] = true
+			requests = append(requests, &slides.Request{
+				UpdateTextStyle: &slides.UpdateTextStyleRequest{
+					ObjectId:     tableID,
+					CellLocation: &slides.TableCellLocation{RowIndex: row, ColumnIndex: column},
+					Style:        &slides.TextStyle{},
+					TextRange:    &slides.Range{Type: "ALL"},
+					Fields:       "link",
+				},
+			})
+
+		case link.EndIndex < 0:
+			// Link on the whole shape or image
+			if cleared["object:"+link.ObjectID] {
+				continue
+			}
+			element := findElementByID(elements, link.ObjectID)
+			if element == nil {
+				continue
+			}
+			if request := buildHyperlinkRequest(element, link.ObjectID, nil, nil, nil); request != nil {
+				cleared["object:"+link.ObjectID] = true
+				requests = append(requests, request)
+			}
+
+		default:
+			if cleared["text:"+link.ObjectID] {
+				continue
+			}
+			cleared["text:"+link.ObjectID] = true
+			requests = append(requests, &slides.Request{
+				UpdateTextStyle: &slides.UpdateTextStyleRequest{
+					ObjectId:  link.ObjectID,
+					Style:     &slides.TextStyle{},
+					TextRange: &slides.Range{Type: "ALL"},
+					Fields:    "link",
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// parseTableCellLinkID splits a table cell link ID of the form "tableId[row,col]".
+func parseTableCellLinkID(cellID string) (string, int64, int64, bool) {
+	open := strings.LastIndex(cellID, "[")
+	if open <= 0 {
+		return "", 0, 0, false
+	}
+
+	var row, column int64
+	if _, err := fmt.Sscanf(cellID[open:], "[%d,%d]", &row, &column); err != nil {
+		return "", 0, 0, false
+	}
+	return cellID[:open], row, column, true
+}
@@ -0,0 +1,182 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestClearAllHyperlinks_MixedLinkTypes(t *testing.T) {
+	textElements := append(createTextElementsWithLink("Docs", "https://example.com/docs"),
+		createTextElementsWithLink(" and more", "https://example.com/more")...)
+
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					{ObjectId: "text-1", Shape: &slides.Shape{ShapeType: "TEXT_BOX", Text: &slides.TextContent{TextElements: textElements}}},
+					{ObjectId: "shape-1", Shape: &slides.Shape{
+						ShapeType:       "RECTANGLE",
+						ShapeProperties: &slides.ShapeProperties{Link: &slides.Link{Url: "https://example.com/shape"}},
+					}},
+					{ObjectId: "plain-1", Shape: &slides.Shape{ShapeType: "TEXT_BOX", Text: &slides.TextContent{TextElements: createTextElementsNoLink("No link")}}},
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					{ObjectId: "image-1", Image: &slides.Image{
+						ImageProperties: &slides.ImageProperties{Link: &slides.Link{RelativeLink: "NEXT_SLIDE"}},
+					}},
+					{ObjectId: "table-1", Table: &slides.Table{
+						Rows: 1, Columns: 2,
+						TableRows: []*slides.TableRow{{TableCells: []*slides.TableCell{
+							{Text: &slides.TextContent{TextElements: createTextElementsNoLink("Name")}},
+							{Text: &slides.TextContent{TextElements: createTextElementsWithLink("Site", "https://example.com/cell")}},
+						}}},
+					}},
+				},
+			},
+		},
+	}
+
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(presentation, nil, nil, &batchCalls, &captured)
+
+	output, err := tools.ClearAllHyperlinks(context.Background(), nil, ClearAllHyperlinksInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 {
+		t.Fatalf("expected a single batch update, got %d", batchCalls)
+	}
+	if output.ClearedCount != 5 || len(output.Links) != 5 {
+		t.Errorf("expected 5 cleared links, got %d (%d listed)", output.ClearedCount, len(output.Links))
+	}
+
+	// Two text runs in text-1 share one request
+	if len(captured) != 4 {
+		t.Fatalf("expected 4 requests, got %d", len(captured))
+	}
+
+	var textCleared, cellCleared, shapeCleared, imageCleared bool
+	for _, req := range captured {
+		switch {
+		case req.UpdateTextStyle != nil:
+			style := req.UpdateTextStyle
+			if style.Fields != "link" || style.Style.Link != nil || style.TextRange.Type != "ALL" {
+				t.Errorf("unexpected text style request: %+v", style)
+			}
+			if style.ObjectId == "text-1" && style.CellLocation == nil {
+				textCleared = true
+			}
+			if style.ObjectId == "table-1" && style.CellLocation != nil &&
+				style.CellLocation.RowIndex == 0 && style.CellLocation.ColumnIndex == 1 {
+				cellCleared = true
+			}
+		case req.UpdateShapeProperties != nil:
+			props := req.UpdateShapeProperties
+			shapeCleared = props.ObjectId == "shape-1" && props.Fields == "link" && props.ShapeProperties.Link == nil
+		case req.UpdateImageProperties != nil:
+			props := req.UpdateImageProperties
+			imageCleared = props.ObjectId == "image-1" && props.Fields == "link" && props.ImageProperties.Link == nil
+		default:
+			t.Errorf("unexpected request: %+v", req)
+		}
+	}
+	if !textCleared || !cellCleared || !shapeCleared || !imageCleared {
+		t.Errorf("missing remove requests: text=%v cell=%v shape=%v image=%v", textCleared, cellCleared, shapeCleared, imageCleared)
+	}
+}
+
+func TestClearAllHyperlinks_NoLinks(t *testing.T) {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{{ObjectId: "slide-1", PageElements: []*slides.PageElement{
+			{ObjectId: "plain-1", Shape: &slides.Shape{Text: &slides.TextContent{TextElements: createTextElementsNoLink("Plain")}}},
+		}}},
+	}
+
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(presentation, nil, nil, &batchCalls, &captured)
+
+	output, err := tools.ClearAllHyperlinks(context.Background(), nil, ClearAllHyperlinksInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.ClearedCount != 0 {
+		t.Errorf("expected 0 cleared links, got %d", output.ClearedCount)
+	}
+	if batchCalls != 0 {
+		t.Errorf("expected no batch update, got %d", batchCalls)
+	}
+}
+
+func TestClearAllHyperlinks_Errors(t *testing.T) {
+	linked := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{{ObjectId: "slide-1", PageElements: []*slides.PageElement{
+			{ObjectId: "text-1", Shape: &slides.Shape{Text: &slides.TextContent{TextElements: createTextElementsWithLink("Go", "https://example.com")}}},
+		}}},
+	}
+
+	tests := []struct {
+		name     string
+		input    ClearAllHyperlinksInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", ClearAllHyperlinksInput{}, nil, nil, ErrInvalidPresentationID},
+		{"presentation not found", ClearAllHyperlinksInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", ClearAllHyperlinksInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", ClearAllHyperlinksInput{PresentationID: "pres-1"}, nil, errors.New("boom"), ErrClearHyperlinksFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newBatchCaptureTestTools(linked, tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.ClearAllHyperlinks(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseTableCellLinkID(t *testing.T) {
+	tests := []struct {
+		cellID     string
+		wantTable  string
+		wantRow    int64
+		wantColumn int64
+		wantOK     bool
+	}{
+		{"table-1[0,1]", "table-1", 0, 1, true},
+		{"t[x][3,12]", "t[x]", 3, 12, true},
+		{"table-1", "", 0, 0, false},
+		{"[1,2]", "", 0, 0, false},
+		{"table-1[a,b]", "", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cellID, func(t *testing.T) {
+			table, row, column, ok := parseTableCellLinkID(tt.cellID)
+			if table != tt.wantTable || row != tt.wantRow || column != tt.wantColumn || ok != tt.wantOK {
+				t.Errorf("got (%q, %d, %d, %v), want (%q, %d, %d, %v)",
+					table, row, column, ok, tt.wantTable, tt.wantRow, tt.wantColumn, tt.wantOK)
+			}
+		})
+	}
+}
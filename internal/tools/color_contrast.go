@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"math"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// Text colors chosen by auto text color.
+const (
+	contrastDarkText  = "#000000"
+	contrastLightText = "#FFFFFF"
+)
+
+// defaultSlideBackground is assumed when no solid background can be resolved.
+var defaultSlideBackground = &slides.RgbColor{Red: 1, Green: 1, Blue: 1}
+
+// relativeLuminance returns the WCAG 2.x relative luminance (0 = black, 1 = white) of a color.
+func relativeLuminance(rgb *slides.RgbColor) float64 {
+	if rgb == nil {
+		return 0
+	}
+
+	channel := func(c float64) float64 {
+		if c <= 0.03928 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+
+	return 0.2126*channel(rgb.Red) + 0.7152*channel(rgb.Green) + 0.0722*channel(rgb.Blue)
+}
+
+// contrastRatio returns the WCAG contrast ratio (1 to 21) between two colors.
+func contrastRatio(a, b *slides.RgbColor) float64 {
+	la := relativeLuminance(a)
+	lb := relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// contrastingTextColor returns black or white, whichever contrasts more with the background.
+func contrastingTextColor(background *slides.RgbColor) string {
+	black := &slides.RgbColor{}
+	white := &slides.RgbColor{Red: 1, Green: 1, Blue: 1}
+	if contrastRatio(background, black) >= contrastRatio(background, white) {
+		return contrastDarkText
+	}
+	return contrastLightText
+}
+
+// resolveBackgroundAt returns the solid color visible behind the point (x, y), in EMU.
+// The topmost shape with a solid fill covering the point wins, then the slide's own
+// solid background. White is assumed when neither is found.
+func resolveBackgroundAt(slide *slides.Page, x, y float64) *slides.RgbColor {
+	if slide == nil {
+		return defaultSlideBackground
+	}
+
+	// Later elements are drawn on top
+	for i := len(slide.PageElements) - 1; i >= 0; i-- {
+		element := slide.PageElements[i]
+		if element == nil || element.Shape == nil || element.Shape.ShapeProperties == nil {
+			continue
+		}
+		fill := element.Shape.ShapeProperties.ShapeBackgroundFill
+		if fill == nil || fill.PropertyState == "NOT_RENDERED" || fill.SolidFill == nil {
+			continue
+		}
+		rgb := solidFillRgb(fill.SolidFill)
+		if rgb == nil {
+			continue
+		}
+		if elementContainsPoint(element, x, y) {
+			return rgb
+		}
+	}
+
+	if slide.PageProperties != nil && slide.PageProperties.PageBackgroundFill != nil {
+		fill := slide.PageProperties.PageBackgroundFill
+		if fill.PropertyState != "NOT_RENDERED" && fill.SolidFill != nil {
+			if rgb := solidFillRgb(fill.SolidFill); rgb != nil {
+				return rgb
+			}
+		}
+	}
+
+	return defaultSlideBackground
+}
+
+// solidFillRgb returns the RGB color of a solid fill, or nil for theme or missing colors.
+func solidFillRgb(fill *slides.SolidFill) *slides.RgbColor {
+	if fill == nil || fill.Color == nil {
+		return nil
+	}
+	return fill.Color.RgbColor
+}
+
+// elementContainsPoint reports whether an element's rendered bounds contain (x, y), in EMU.
+func elementContainsPoint(element *slides.PageElement, x, y float64) bool {
+	if element.Size == nil || element.Size.Width == nil || element.Size.Height == nil {
+		return false
+	}
+
+	var left, top float64
+	scaleX, scaleY := 1.0, 1.0
+	if element.Transform != nil {
+		left = element.Transform.TranslateX
+		top = element.Transform.TranslateY
+		if element.Transform.ScaleX != 0 {
+			scaleX = element.Transform.ScaleX
+		}
+		if element.Transform.ScaleY != 0 {
+			scaleY = element.Transform.ScaleY
+		}
+	}
+
+	width := element.Size.Width.Magnitude * scaleX
+	height := element.Size.Height.Magnitude * scaleY
+
+	return x >= left && x <= left+width && y >= top && y <= top+height
+}
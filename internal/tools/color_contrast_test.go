@@ -0,0 +1,207 @@
+package tools
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func rgbEqual(a, b *slides.RgbColor) bool {
+	return a.Red == b.Red && a.Green == b.Green && a.Blue == b.Blue
+}
+
+func TestContrastingTextColor(t *testing.T) {
+	tests := []struct {
+		name       string
+		background string
+		want       string
+	}{
+		{name: "black background", background: "#000000", want: contrastLightText},
+		{name: "navy background", background: "#1A237E", want: contrastLightText},
+		{name: "dark red background", background: "#8B0000", want: contrastLightText},
+		{name: "white background", background: "#FFFFFF", want: contrastDarkText},
+		{name: "yellow background", background: "#FFEB3B", want: contrastDarkText},
+		{name: "light gray background", background: "#D0D0D0", want: contrastDarkText},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := contrastingTextColor(parseHexColor(tt.background))
+			if got != tt.want {
+				t.Errorf("contrastingTextColor(%s) = %s, want %s", tt.background, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	black := &slides.RgbColor{}
+	white := &slides.RgbColor{Red: 1, Green: 1, Blue: 1}
+
+	if got := contrastRatio(black, white); math.Abs(got-21) > 0.01 {
+		t.Errorf("contrastRatio(black, white) = %.2f, want 21", got)
+	}
+	if got := contrastRatio(white, white); math.Abs(got-1) > 0.01 {
+		t.Errorf("contrastRatio(white, white) = %.2f, want 1", got)
+	}
+}
+
+func TestResolveBackgroundAt(t *testing.T) {
+	dark := parseHexColor("#202020")
+	red := parseHexColor("#FF0000")
+
+	shape := func(id string, x, y, w, h float64, rgb *slides.RgbColor) *slides.PageElement {
+		return &slides.PageElement{
+			ObjectId: id,
+			Transform: &slides.AffineTransform{
+				ScaleX:     1,
+				ScaleY:     1,
+				TranslateX: pointsToEMU(x),
+				TranslateY: pointsToEMU(y),
+			},
+			Size: &slides.Size{
+				Width:  &slides.Dimension{Magnitude: pointsToEMU(w), Unit: "EMU"},
+				Height: &slides.Dimension{Magnitude: pointsToEMU(h), Unit: "EMU"},
+			},
+			Shape: &slides.Shape{
+				ShapeProperties: &slides.ShapeProperties{
+					ShapeBackgroundFill: &slides.ShapeBackgroundFill{
+						SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{RgbColor: rgb}},
+					},
+				},
+			},
+		}
+	}
+
+	slide := &slides.Page{
+		PageProperties: &slides.PageProperties{
+			PageBackgroundFill: &slides.PageBackgroundFill{
+				SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{RgbColor: dark}},
+			},
+		},
+		PageElements: []*slides.PageElement{
+			shape("big", 0, 0, 400, 200, red),
+			shape("small", 50, 50, 100, 100, parseHexColor("#FFFFFF")),
+		},
+	}
+
+	tests := []struct {
+		name string
+		x, y float64
+		want *slides.RgbColor
+	}{
+		{name: "topmost shape wins", x: 100, y: 100, want: parseHexColor("#FFFFFF")},
+		{name: "lower shape", x: 300, y: 100, want: red},
+		{name: "slide background", x: 600, y: 300, want: dark},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveBackgroundAt(slide, pointsToEMU(tt.x), pointsToEMU(tt.y))
+			if !rgbEqual(got, tt.want) {
+				t.Errorf("resolveBackgroundAt = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if got := resolveBackgroundAt(&slides.Page{}, 0, 0); !rgbEqual(got, defaultSlideBackground) {
+		t.Errorf("expected default white background, got %+v", got)
+	}
+}
+
+func TestAddTextBox_AutoTextColor(t *testing.T) {
+	tests := []struct {
+		name       string
+		background string
+		wantColor  string
+		wantRgb    *slides.RgbColor
+	}{
+		{name: "white text on dark background", background: "#101010", wantColor: "#FFFFFF", wantRgb: &slides.RgbColor{Red: 1, Green: 1, Blue: 1}},
+		{name: "black text on light background", background: "#F5F5DC", wantColor: "#000000", wantRgb: &slides.RgbColor{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedRequests []*slides.Request
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return &slides.Presentation{
+						Slides: []*slides.Page{{
+							ObjectId: "slide-1",
+							PageProperties: &slides.PageProperties{
+								PageBackgroundFill: &slides.PageBackgroundFill{
+									SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{RgbColor: parseHexColor(tt.background)}},
+								},
+							},
+						}},
+					}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					capturedRequests = requests
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			output, err := tools.AddTextBox(context.Background(), nil, AddTextBoxInput{
+				PresentationID: "test-presentation",
+				SlideIndex:     1,
+				Text:           "Caption",
+				Size:           &SizeInput{Width: 200, Height: 50},
+				Style:          &TextStyleInput{Bold: true},
+				AutoTextColor:  true,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.AutoTextColor != tt.wantColor {
+				t.Errorf("AutoTextColor = %q, want %q", output.AutoTextColor, tt.wantColor)
+			}
+
+			styleReq := capturedRequests[len(capturedRequests)-1].UpdateTextStyle
+			if styleReq == nil || styleReq.Style.ForegroundColor == nil {
+				t.Fatal("expected UpdateTextStyle with foreground color")
+			}
+			if got := styleReq.Style.ForegroundColor.OpaqueColor.RgbColor; !rgbEqual(got, tt.wantRgb) {
+				t.Errorf("foreground = %+v, want %+v", got, tt.wantRgb)
+			}
+			if styleReq.Fields != "bold,foregroundColor" {
+				t.Errorf("fields = %q, want bold,foregroundColor", styleReq.Fields)
+			}
+		})
+	}
+}
+
+func TestAddTextBox_AutoTextColorExplicitColorWins(t *testing.T) {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.AddTextBox(context.Background(), nil, AddTextBoxInput{
+		PresentationID: "test-presentation",
+		SlideIndex:     1,
+		Text:           "Caption",
+		Size:           &SizeInput{Width: 200, Height: 50},
+		Style:          &TextStyleInput{Color: "#00FF00"},
+		AutoTextColor:  true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.AutoTextColor != "" {
+		t.Errorf("expected no auto color when style.color is set, got %q", output.AutoTextColor)
+	}
+}
@@ -0,0 +1,205 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for convert_list tool.
+var (
+	ErrConvertListFailed = errors.New("failed to convert list")
+	ErrInvalidListStyle  = errors.New("invalid target list style")
+)
+
+// ConvertListInput represents the input for the convert_list tool.
+type ConvertListInput struct {
+	PresentationID string `json:"presentation_id"`
+	ObjectID       string `json:"object_id"`
+	StartIndex     *int   `json:"start_index,omitempty"` // Optional, whole text if omitted
+	EndIndex       *int   `json:"end_index,omitempty"`   // Optional, whole text if omitted
+	TargetStyle    string `json:"target_style"`          // Bullet style (DISC, ARROW, ...), number style (DECIMAL, ROMAN_UPPER, ...) or full preset name
+}
+
+// ConvertListOutput represents the output of the convert_list tool.
+type ConvertListOutput struct {
+	ObjectID     string `json:"object_id"`
+	ListType     string `json:"list_type"`     // "bullet" or "numbered"
+	BulletPreset string `json:"bullet_preset"` // The actual preset applied
+	TextRange    string `json:"text_range"`    // "ALL" or "FIXED_RANGE (start-end)"
+}
+
+// ConvertList switches existing paragraphs between bullet and numbered lists (or between presets)
+// by removing the current bullets and creating new ones in a single batch.
+func (t *Tools) ConvertList(ctx context.Context, tokenSource oauth2.TokenSource, input ConvertListInput) (*ConvertListOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	requests, output, err := buildConvertListRequests(input)
+	if err != nil {
+		return nil, err
+	}
+
+	t.config.Logger.Info("converting list",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("object_id", input.ObjectID),
+		slog.String("preset", output.BulletPreset),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to verify the object holds text
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	var targetElement *slides.PageElement
+	for _, slide := range presentation.Slides {
+		if targetElement = findElementByID(slide.PageElements, input.ObjectID); targetElement != nil {
+			break
+		}
+	}
+	if targetElement == nil {
+		return nil, fmt.Errorf("%w: object '%s' not found in presentation", ErrObjectNotFound, input.ObjectID)
+	}
+	if targetElement.Shape == nil || targetElement.Shape.Text == nil {
+		if targetElement.Table != nil {
+			return nil, fmt.Errorf("%w: tables must have lists converted cell by cell", ErrNotTextObject)
+		}
+		return nil, fmt.Errorf("%w: object '%s' does not contain text", ErrNotTextObject, input.ObjectID)
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrConvertListFailed, err)
+	}
+
+	t.config.Logger.Info("list converted successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("object_id", input.ObjectID),
+		slog.String("list_type", output.ListType),
+	)
+
+	return output, nil
+}
+
+// buildConvertListRequests validates everything that does not need the presentation and builds
+// the DeleteParagraphBullets + CreateParagraphBullets pair. It is shared with batch_update.
+func buildConvertListRequests(input ConvertListInput) ([]*slides.Request, *ConvertListOutput, error) {
+	if input.ObjectID == "" {
+		return nil, nil, fmt.Errorf("%w: object_id is required", ErrInvalidObjectID)
+	}
+
+	listType, preset, err := resolveListPreset(input.TargetStyle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if input.StartIndex != nil && *input.StartIndex < 0 {
+		return nil, nil, fmt.Errorf("%w: start_index cannot be negative", ErrInvalidTextRange)
+	}
+	if input.EndIndex != nil && *input.EndIndex < 0 {
+		return nil, nil, fmt.Errorf("%w: end_index cannot be negative", ErrInvalidTextRange)
+	}
+	if input.StartIndex != nil && input.EndIndex != nil && *input.StartIndex > *input.EndIndex {
+		return nil, nil, fmt.Errorf("%w: start_index cannot be greater than end_index", ErrInvalidTextRange)
+	}
+
+	textRange, textRangeDesc := buildListTextRange(input.StartIndex, input.EndIndex)
+
+	requests := []*slides.Request{
+		{
+			DeleteParagraphBullets: &slides.DeleteParagraphBulletsRequest{
+				ObjectId:  input.ObjectID,
+				TextRange: textRange,
+			},
+		},
+		{
+			CreateParagraphBullets: &slides.CreateParagraphBulletsRequest{
+				ObjectId:     input.ObjectID,
+				TextRange:    textRange,
+				BulletPreset: preset,
+			},
+		},
+	}
+
+	output := &ConvertListOutput{
+		ObjectID:     input.ObjectID,
+		ListType:     listType,
+		BulletPreset: preset,
+		TextRange:    textRangeDesc,
+	}
+	return requests, output, nil
+}
+
+// resolveListPreset maps a bullet or number style name to its API preset.
+func resolveListPreset(style string) (string, string, error) {
+	styleUpper := strings.ToUpper(strings.TrimSpace(style))
+	if styleUpper == "" {
+		return "", "", fmt.Errorf("%w: target_style is required", ErrInvalidListStyle)
+	}
+	if preset, ok := validBulletStyles[styleUpper]; ok {
+		return "bullet", preset, nil
+	}
+	if preset, ok := validNumberStyles[styleUpper]; ok {
+		return "numbered", preset, nil
+	}
+	return "", "", fmt.Errorf("%w: '%s' is not a valid bullet or number style", ErrInvalidListStyle, style)
+}
+
+// buildListTextRange converts optional character indices to an API range.
+func buildListTextRange(startIndex, endIndex *int) (*slides.Range, string) {
+	if startIndex == nil && endIndex == nil {
+		return &slides.Range{Type: "ALL"}, "ALL"
+	}
+
+	textRange := &slides.Range{}
+	var desc string
+	switch {
+	case startIndex != nil && endIndex != nil:
+		textRange.Type = "FIXED_RANGE"
+		desc = fmt.Sprintf("FIXED_RANGE (%d-%d)", *startIndex, *endIndex)
+	case startIndex != nil:
+		textRange.Type = "FROM_START_INDEX"
+		desc = fmt.Sprintf("FROM_START_INDEX (%d)", *startIndex)
+	default:
+		textRange.Type = "FIXED_RANGE"
+		desc = fmt.Sprintf("FIXED_RANGE (0-%d)", *endIndex)
+		zero := 0
+		startIndex = &zero
+	}
+	if startIndex != nil {
+		start := int64(*startIndex)
+		textRange.StartIndex = &start
+	}
+	if endIndex != nil {
+		end := int64(*endIndex)
+		textRange.EndIndex = &end
+	}
+	return textRange, desc
+}
@@ -0,0 +1,167 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func newConvertListTestTools(captured *[]*slides.Request, batchErr error) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				Slides: []*slides.Page{
+					{
+						ObjectId: "slide-1",
+						PageElements: []*slides.PageElement{
+							{ObjectId: "list-1", Shape: &slides.Shape{ShapeType: "TEXT_BOX", Text: &slides.TextContent{TextElements: createTextElementsNoLink("One\nTwo\n")}}},
+							{ObjectId: "image-1", Image: &slides.Image{}},
+							{ObjectId: "table-1", Table: &slides.Table{}},
+						},
+					},
+				},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*captured = requests
+			return &slides.BatchUpdatePresentationResponse{Replies: make([]*slides.Response, len(requests))}, batchErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestConvertList(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        ConvertListInput
+		wantListType string
+		wantPreset   string
+		wantRange    string
+	}{
+		{
+			name:         "bullets to numbers",
+			input:        ConvertListInput{ObjectID: "list-1", TargetStyle: "decimal"},
+			wantListType: "numbered",
+			wantPreset:   "NUMBERED_DECIMAL_ALPHA_ROMAN",
+			wantRange:    "ALL",
+		},
+		{
+			name:         "numbers to bullets in a range",
+			input:        ConvertListInput{ObjectID: "list-1", TargetStyle: "ARROW", StartIndex: intPtr(0), EndIndex: intPtr(4)},
+			wantListType: "bullet",
+			wantPreset:   "BULLET_ARROW_DIAMOND_DISC",
+			wantRange:    "FIXED_RANGE (0-4)",
+		},
+		{
+			name:         "full preset name",
+			input:        ConvertListInput{ObjectID: "list-1", TargetStyle: "NUMBERED_DECIMAL_NESTED"},
+			wantListType: "numbered",
+			wantPreset:   "NUMBERED_DECIMAL_NESTED",
+			wantRange:    "ALL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []*slides.Request
+			tools := newConvertListTestTools(&captured, nil)
+
+			tt.input.PresentationID = "pres-1"
+			output, err := tools.ConvertList(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.ListType != tt.wantListType || output.BulletPreset != tt.wantPreset || output.TextRange != tt.wantRange {
+				t.Errorf("output = %+v, want %s/%s/%s", output, tt.wantListType, tt.wantPreset, tt.wantRange)
+			}
+
+			// Old bullets are removed before the new preset is applied to the same range
+			if len(captured) != 2 {
+				t.Fatalf("expected 2 requests, got %d", len(captured))
+			}
+			deleteReq := captured[0].DeleteParagraphBullets
+			if deleteReq == nil || deleteReq.ObjectId != "list-1" {
+				t.Fatalf("request 0: expected DeleteParagraphBullets on list-1, got %+v", captured[0])
+			}
+			createReq := captured[1].CreateParagraphBullets
+			if createReq == nil || createReq.ObjectId != "list-1" || createReq.BulletPreset != tt.wantPreset {
+				t.Fatalf("request 1: expected CreateParagraphBullets %s on list-1, got %+v", tt.wantPreset, captured[1])
+			}
+			if deleteReq.TextRange != createReq.TextRange {
+				t.Error("expected both requests to share the same text range")
+			}
+			if tt.input.StartIndex != nil {
+				if createReq.TextRange.Type != "FIXED_RANGE" || *createReq.TextRange.StartIndex != 0 || *createReq.TextRange.EndIndex != 4 {
+					t.Errorf("unexpected range %+v", createReq.TextRange)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertList_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    ConvertListInput
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", ConvertListInput{ObjectID: "list-1", TargetStyle: "DISC"}, nil, ErrInvalidPresentationID},
+		{"missing object", ConvertListInput{PresentationID: "pres-1", TargetStyle: "DISC"}, nil, ErrInvalidObjectID},
+		{"missing style", ConvertListInput{PresentationID: "pres-1", ObjectID: "list-1"}, nil, ErrInvalidListStyle},
+		{"unknown style", ConvertListInput{PresentationID: "pres-1", ObjectID: "list-1", TargetStyle: "HEXAGON"}, nil, ErrInvalidListStyle},
+		{"inverted range", ConvertListInput{PresentationID: "pres-1", ObjectID: "list-1", TargetStyle: "DISC", StartIndex: intPtr(5), EndIndex: intPtr(2)}, nil, ErrInvalidTextRange},
+		{"unknown object", ConvertListInput{PresentationID: "pres-1", ObjectID: "missing", TargetStyle: "DISC"}, nil, ErrObjectNotFound},
+		{"image", ConvertListInput{PresentationID: "pres-1", ObjectID: "image-1", TargetStyle: "DISC"}, nil, ErrNotTextObject},
+		{"table", ConvertListInput{PresentationID: "pres-1", ObjectID: "table-1", TargetStyle: "DISC"}, nil, ErrNotTextObject},
+		{"batch update failure", ConvertListInput{PresentationID: "pres-1", ObjectID: "list-1", TargetStyle: "DISC"}, errors.New("boom"), ErrConvertListFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []*slides.Request
+			tools := newConvertListTestTools(&captured, tt.batchErr)
+
+			_, err := tools.ConvertList(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestConvertList_Batchable(t *testing.T) {
+	var captured []*slides.Request
+	tools := newConvertListTestTools(&captured, nil)
+
+	params, _ := json.Marshal(ConvertListInput{ObjectID: "list-1", TargetStyle: "ROMAN_UPPER"})
+	output, err := tools.BatchUpdate(context.Background(), &mockTokenSource{}, BatchUpdateInput{
+		PresentationID: "pres-1",
+		Operations:     []BatchOperation{{ToolName: "convert_list", Parameters: params}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.APICallCount != 1 || !output.Results[0].Success {
+		t.Fatalf("expected one successful batched call, got %+v", output)
+	}
+	if len(captured) != 2 || captured[0].DeleteParagraphBullets == nil || captured[1].CreateParagraphBullets == nil {
+		t.Fatalf("expected DeleteParagraphBullets then CreateParagraphBullets, got %+v", captured)
+	}
+
+	var result ConvertListOutput
+	if err := json.Unmarshal(output.Results[0].Result, &result); err != nil {
+		t.Fatalf("failed to decode result: %v", err)
+	}
+	if result.BulletPreset != "NUMBERED_UPPERROMAN_UPPERALPHA_DECIMAL" || result.ListType != "numbered" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
@@ -0,0 +1,191 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for copy_paragraph_style tool.
+var (
+	ErrNoParagraphStyle         = errors.New("source has no paragraph style to copy")
+	ErrCopyParagraphStyleFailed = errors.New("failed to copy paragraph style")
+)
+
+// CopyParagraphStyleInput represents the input for the copy_paragraph_style tool.
+type CopyParagraphStyleInput struct {
+	PresentationID string `json:"presentation_id"`
+	SourceObjectID string `json:"source_object_id"` // Style is read from its first paragraph
+	TargetObjectID string `json:"target_object_id"`
+	ParagraphIndex *int   `json:"paragraph_index,omitempty"` // Target paragraph, all if omitted
+}
+
+// CopyParagraphStyleOutput represents the output of the copy_paragraph_style tool.
+type CopyParagraphStyleOutput struct {
+	SourceObjectID    string   `json:"source_object_id"`
+	TargetObjectID    string   `json:"target_object_id"`
+	AppliedFormatting []string `json:"applied_formatting"` // Same notation as format_paragraph
+	ParagraphScope    string   `json:"paragraph_scope"`    // "ALL" or "INDEX (N)"
+}
+
+// CopyParagraphStyle reads the alignment, line spacing, paragraph spacing and indents of the
+// source's first paragraph and applies them to the target's paragraphs. Only properties set
+// on the source paragraph are copied; inherited ones are left alone on the target.
+func (t *Tools) CopyParagraphStyle(ctx context.Context, tokenSource oauth2.TokenSource, input CopyParagraphStyleInput) (*CopyParagraphStyleOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.SourceObjectID == "" || input.TargetObjectID == "" {
+		return nil, fmt.Errorf("%w: source_object_id and target_object_id are required", ErrInvalidObjectID)
+	}
+	if input.SourceObjectID == input.TargetObjectID {
+		return nil, fmt.Errorf("%w: source and target are the same object", ErrInvalidObjectID)
+	}
+	if input.ParagraphIndex != nil && *input.ParagraphIndex < 0 {
+		return nil, fmt.Errorf("%w: paragraph_index cannot be negative", ErrInvalidParagraphIndex)
+	}
+
+	t.config.Logger.Info("copying paragraph style",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("source_object_id", input.SourceObjectID),
+		slog.String("target_object_id", input.TargetObjectID),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to read the source style and the target text
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	sourceText, err := findTextObject(presentation, input.SourceObjectID)
+	if err != nil {
+		return nil, err
+	}
+	targetText, err := findTextObject(presentation, input.TargetObjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ParagraphIndex != nil {
+		paragraphCount := countParagraphs(targetText)
+		if *input.ParagraphIndex >= paragraphCount {
+			return nil, fmt.Errorf("%w: paragraph index %d is out of range (object has %d paragraphs)", ErrInvalidParagraphIndex, *input.ParagraphIndex, paragraphCount)
+		}
+	}
+
+	// The request is built exactly as format_paragraph would for the same values
+	formatting := paragraphStyleToFormatting(firstParagraphStyle(sourceText))
+	request, appliedFormatting := buildFormatParagraphRequest(FormatParagraphInput{
+		ObjectID:       input.TargetObjectID,
+		ParagraphIndex: input.ParagraphIndex,
+		Formatting:     formatting,
+	}, targetText)
+	if request == nil {
+		return nil, fmt.Errorf("%w: the first paragraph of '%s' sets no alignment, spacing or indent", ErrNoParagraphStyle, input.SourceObjectID)
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, []*slides.Request{request})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCopyParagraphStyleFailed, err)
+	}
+
+	output := &CopyParagraphStyleOutput{
+		SourceObjectID:    input.SourceObjectID,
+		TargetObjectID:    input.TargetObjectID,
+		AppliedFormatting: appliedFormatting,
+		ParagraphScope:    "ALL",
+	}
+	if input.ParagraphIndex != nil {
+		output.ParagraphScope = fmt.Sprintf("INDEX (%d)", *input.ParagraphIndex)
+	}
+
+	t.config.Logger.Info("paragraph style copied successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("target_object_id", input.TargetObjectID),
+		slog.Int("formatting_count", len(appliedFormatting)),
+	)
+
+	return output, nil
+}
+
+// findTextObject returns the text of a shape anywhere in the presentation, or an error when the
+// object is missing or holds no text.
+func findTextObject(presentation *slides.Presentation, objectID string) (*slides.TextContent, error) {
+	slide := findSlideContainingObject(presentation.Slides, objectID)
+	if slide == nil {
+		return nil, fmt.Errorf("%w: object '%s' not found in presentation", ErrObjectNotFound, objectID)
+	}
+	element := findElementByID(slide.PageElements, objectID)
+	if element.Shape == nil || element.Shape.Text == nil {
+		if element.Table != nil {
+			return nil, fmt.Errorf("%w: tables must be formatted cell by cell", ErrNotTextObject)
+		}
+		return nil, fmt.Errorf("%w: object '%s' does not contain text", ErrNotTextObject, objectID)
+	}
+	return element.Shape.Text, nil
+}
+
+// firstParagraphStyle returns the style of the first paragraph marker, nil if there is none.
+func firstParagraphStyle(text *slides.TextContent) *slides.ParagraphStyle {
+	for _, element := range text.TextElements {
+		if element.ParagraphMarker != nil {
+			return element.ParagraphMarker.Style
+		}
+	}
+	return nil
+}
+
+// paragraphStyleToFormatting converts the explicitly set properties of a paragraph style into
+// format_paragraph options, with dimensions in points.
+func paragraphStyleToFormatting(style *slides.ParagraphStyle) *ParagraphFormattingOptions {
+	formatting := &ParagraphFormattingOptions{}
+	if style == nil {
+		return formatting
+	}
+
+	points := func(dim *slides.Dimension) *float64 {
+		if dim == nil {
+			return nil
+		}
+		value := convertToPoints(dim)
+		return &value
+	}
+
+	if style.Alignment != "ALIGNMENT_UNSPECIFIED" {
+		formatting.Alignment = style.Alignment
+	}
+	if style.LineSpacing != 0 {
+		lineSpacing := style.LineSpacing
+		formatting.LineSpacing = &lineSpacing
+	}
+	formatting.SpaceAbove = points(style.SpaceAbove)
+	formatting.SpaceBelow = points(style.SpaceBelow)
+	formatting.IndentFirstLine = points(style.IndentFirstLine)
+	formatting.IndentStart = points(style.IndentStart)
+	formatting.IndentEnd = points(style.IndentEnd)
+	return formatting
+}
@@ -0,0 +1,183 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newParagraphStyleTestText(style *slides.ParagraphStyle, paragraphs ...string) *slides.TextContent {
+	text := &slides.TextContent{}
+	var index int64
+	for _, paragraph := range paragraphs {
+		end := index + int64(len(paragraph)) + 1
+		text.TextElements = append(text.TextElements,
+			&slides.TextElement{StartIndex: index, EndIndex: end, ParagraphMarker: &slides.ParagraphMarker{Style: style}},
+			&slides.TextElement{StartIndex: index, EndIndex: end, TextRun: &slides.TextRun{Content: paragraph + "\n"}},
+		)
+		index = end
+	}
+	return text
+}
+
+func newCopyParagraphStyleTestTools(getErr, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	sourceStyle := &slides.ParagraphStyle{
+		Alignment:       "CENTER",
+		LineSpacing:     150,
+		SpaceAbove:      &slides.Dimension{Magnitude: 6, Unit: "PT"},
+		IndentStart:     &slides.Dimension{Magnitude: 254000, Unit: "EMU"}, // 20pt
+		IndentFirstLine: &slides.Dimension{Magnitude: 10, Unit: "PT"},
+	}
+	otherStyle := &slides.ParagraphStyle{Alignment: "END"}
+
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1", PageElements: []*slides.PageElement{
+				{ObjectId: "source", Shape: &slides.Shape{ShapeType: "TEXT_BOX", Text: newParagraphStyleTestText(sourceStyle, "First", "Second")}},
+				{ObjectId: "plain", Shape: &slides.Shape{ShapeType: "TEXT_BOX", Text: newParagraphStyleTestText(nil, "Inherited")}},
+				{ObjectId: "table", Table: &slides.Table{}},
+			}},
+			{ObjectId: "slide-2", PageElements: []*slides.PageElement{
+				{ObjectId: "target", Shape: &slides.Shape{ShapeType: "TEXT_BOX", Text: newParagraphStyleTestText(otherStyle, "One", "Two", "Three")}},
+				{ObjectId: "rectangle", Shape: &slides.Shape{ShapeType: "RECTANGLE"}},
+			}},
+		},
+	}
+
+	return newBatchCaptureTestTools(presentation, getErr, batchErr, batchCalls, captured)
+}
+
+func TestCopyParagraphStyle(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newCopyParagraphStyleTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.CopyParagraphStyle(context.Background(), nil, CopyParagraphStyleInput{
+		PresentationID: "pres-1",
+		SourceObjectID: "source",
+		TargetObjectID: "target",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 || len(captured) != 1 || captured[0].UpdateParagraphStyle == nil {
+		t.Fatalf("expected a single UpdateParagraphStyle request, got %d calls and %+v", batchCalls, captured)
+	}
+	update := captured[0].UpdateParagraphStyle
+	if update.ObjectId != "target" || update.TextRange.Type != "ALL" {
+		t.Errorf("expected all paragraphs of target, got %s over %+v", update.ObjectId, update.TextRange)
+	}
+	if update.Fields != "alignment,lineSpacing,spaceAbove,indentFirstLine,indentStart" {
+		t.Errorf("unexpected field mask: %s", update.Fields)
+	}
+
+	style := update.Style
+	if style.Alignment != "CENTER" || style.LineSpacing != 150 {
+		t.Errorf("expected centered 150%% spacing, got %s and %v", style.Alignment, style.LineSpacing)
+	}
+	if style.SpaceAbove.Magnitude != 6 || style.IndentFirstLine.Magnitude != 10 {
+		t.Errorf("unexpected spacing or first-line indent: %+v, %+v", style.SpaceAbove, style.IndentFirstLine)
+	}
+	if style.IndentStart.Magnitude != 20 || style.IndentStart.Unit != "PT" {
+		t.Errorf("expected the EMU indent converted to 20pt, got %+v", style.IndentStart)
+	}
+	if style.SpaceBelow != nil || style.IndentEnd != nil {
+		t.Errorf("expected unset source properties to be left out, got %+v", style)
+	}
+
+	if output.ParagraphScope != "ALL" || len(output.AppliedFormatting) != 5 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+}
+
+func TestCopyParagraphStyle_SingleParagraph(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newCopyParagraphStyleTestTools(nil, nil, &batchCalls, &captured)
+
+	paragraph := 1
+	output, err := tools.CopyParagraphStyle(context.Background(), nil, CopyParagraphStyleInput{
+		PresentationID: "pres-1",
+		SourceObjectID: "source",
+		TargetObjectID: "target",
+		ParagraphIndex: &paragraph,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textRange := captured[0].UpdateParagraphStyle.TextRange
+	if textRange.Type != "FIXED_RANGE" || *textRange.StartIndex != 4 || *textRange.EndIndex != 8 {
+		t.Errorf("expected the second paragraph (4-8), got %+v", textRange)
+	}
+	if output.ParagraphScope != "INDEX (1)" {
+		t.Errorf("unexpected scope: %s", output.ParagraphScope)
+	}
+}
+
+func TestCopyParagraphStyle_Errors(t *testing.T) {
+	valid := CopyParagraphStyleInput{PresentationID: "pres-1", SourceObjectID: "source", TargetObjectID: "target"}
+	with := func(modify func(*CopyParagraphStyleInput)) CopyParagraphStyleInput {
+		input := valid
+		modify(&input)
+		return input
+	}
+
+	tests := []struct {
+		name     string
+		input    CopyParagraphStyleInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", with(func(i *CopyParagraphStyleInput) { i.PresentationID = "" }), nil, nil, ErrInvalidPresentationID},
+		{"missing source", with(func(i *CopyParagraphStyleInput) { i.SourceObjectID = "" }), nil, nil, ErrInvalidObjectID},
+		{"same object", with(func(i *CopyParagraphStyleInput) { i.TargetObjectID = "source" }), nil, nil, ErrInvalidObjectID},
+		{"negative paragraph", with(func(i *CopyParagraphStyleInput) { i.ParagraphIndex = intPtr(-1) }), nil, nil, ErrInvalidParagraphIndex},
+		{"paragraph out of range", with(func(i *CopyParagraphStyleInput) { i.ParagraphIndex = intPtr(3) }), nil, nil, ErrInvalidParagraphIndex},
+		{"unknown source", with(func(i *CopyParagraphStyleInput) { i.SourceObjectID = "nope" }), nil, nil, ErrObjectNotFound},
+		{"table source", with(func(i *CopyParagraphStyleInput) { i.SourceObjectID = "table" }), nil, nil, ErrNotTextObject},
+		{"target without text", with(func(i *CopyParagraphStyleInput) { i.TargetObjectID = "rectangle" }), nil, nil, ErrNotTextObject},
+		{"source without style", with(func(i *CopyParagraphStyleInput) { i.SourceObjectID = "plain" }), nil, nil, ErrNoParagraphStyle},
+		{"presentation not found", valid, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", valid, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", valid, nil, errors.New("boom"), ErrCopyParagraphStyleFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newCopyParagraphStyleTestTools(tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.CopyParagraphStyle(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", batchCalls)
+			}
+		})
+	}
+}
+
+func TestParagraphStyleToFormatting(t *testing.T) {
+	formatting := paragraphStyleToFormatting(&slides.ParagraphStyle{
+		Alignment:  "ALIGNMENT_UNSPECIFIED",
+		SpaceBelow: &slides.Dimension{Unit: "PT"}, // Explicit zero
+	})
+	if formatting.Alignment != "" || formatting.LineSpacing != nil {
+		t.Errorf("expected unspecified alignment and spacing to be skipped, got %+v", formatting)
+	}
+	if formatting.SpaceBelow == nil || *formatting.SpaceBelow != 0 {
+		t.Errorf("expected an explicit zero space below to be kept, got %v", formatting.SpaceBelow)
+	}
+	if empty := paragraphStyleToFormatting(nil); *empty != (ParagraphFormattingOptions{}) {
+		t.Errorf("expected no options for a nil style, got %+v", empty)
+	}
+}
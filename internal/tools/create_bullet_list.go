@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"strings"
 
 	"golang.org/x/oauth2"
@@ -15,8 +16,13 @@ import (
 var (
 	ErrCreateBulletListFailed = errors.New("failed to create bullet list")
 	ErrInvalidBulletStyle     = errors.New("invalid bullet style")
+	ErrInvalidBulletColor     = errors.New("invalid bullet color")
+	ErrInvalidBulletSize      = errors.New("invalid bullet size")
 )
 
+// maxBulletSize is the largest bullet_size accepted, in points, matching the editor's font size limit.
+const maxBulletSize = 400.0
+
 // Valid bullet styles for bullet lists.
 var validBulletStyles = map[string]string{
 	// User-friendly names to API preset names
@@ -46,14 +52,16 @@ type CreateBulletListInput struct {
 	ParagraphIndices []int   `json:"paragraph_indices,omitempty"` // Optional, all paragraphs if omitted
 	BulletStyle      string  `json:"bullet_style"`                // DISC, CIRCLE, SQUARE, DIAMOND, ARROW, STAR, CHECKBOX or full preset name
 	BulletColor      string  `json:"bullet_color,omitempty"`      // Hex color string (e.g., "#FF0000")
+	BulletSize       float64 `json:"bullet_size,omitempty"`       // Points; also resizes the paragraph text, see buildBulletGlyphStyleRequest
 }
 
 // CreateBulletListOutput represents the output of the create_bullet_list tool.
 type CreateBulletListOutput struct {
-	ObjectID       string `json:"object_id"`
-	BulletPreset   string `json:"bullet_preset"`    // The actual preset applied
-	ParagraphScope string `json:"paragraph_scope"`  // "ALL" or "INDICES [1, 2, 3]"
-	BulletColor    string `json:"bullet_color,omitempty"` // The color applied, if any
+	ObjectID       string  `json:"object_id"`
+	BulletPreset   string  `json:"bullet_preset"`          // The actual preset applied
+	ParagraphScope string  `json:"paragraph_scope"`        // "ALL" or "INDICES [1, 2, 3]"
+	BulletColor    string  `json:"bullet_color,omitempty"` // The color applied, if any
+	BulletSize     float64 `json:"bullet_size,omitempty"`  // The size applied in points, if any
 }
 
 // CreateBulletList converts text to a bullet list or adds bullets to existing text.
@@ -76,6 +84,10 @@ func (t *Tools) CreateBulletList(ctx context.Context, tokenSource oauth2.TokenSo
 		return nil, fmt.Errorf("%w: '%s' is not a valid bullet style; use DISC, CIRCLE, SQUARE, DIAMOND, ARROW, STAR, CHECKBOX, or a full preset name", ErrInvalidBulletStyle, input.BulletStyle)
 	}
 
+	if err := validateBulletGlyphStyle(input.BulletColor, input.BulletSize); err != nil {
+		return nil, err
+	}
+
 	// Validate paragraph indices
 	for _, idx := range input.ParagraphIndices {
 		if idx < 0 {
@@ -169,6 +181,7 @@ func (t *Tools) CreateBulletList(ctx context.Context, tokenSource oauth2.TokenSo
 	if input.BulletColor != "" {
 		output.BulletColor = input.BulletColor
 	}
+	output.BulletSize = input.BulletSize
 
 	t.config.Logger.Info("bullet list created successfully",
 		slog.String("presentation_id", input.PresentationID),
@@ -196,34 +209,55 @@ func buildCreateBulletListRequests(input CreateBulletListInput, bulletPreset str
 	}
 	requests = append(requests, bulletRequest)
 
-	// If bullet color is specified, apply it using UpdateTextStyle
-	// Note: Bullet text styles cannot be modified directly via Slides API.
-	// However, using UpdateTextStyleRequest on a paragraph with a bullet
-	// will also update the bullet glyph's text style.
-	if input.BulletColor != "" {
-		rgb := parseHexColor(input.BulletColor)
-		if rgb != nil {
-			colorRequest := &slides.Request{
-				UpdateTextStyle: &slides.UpdateTextStyleRequest{
-					ObjectId:  input.ObjectID,
-					TextRange: textRange,
-					Style: &slides.TextStyle{
-						ForegroundColor: &slides.OptionalColor{
-							OpaqueColor: &slides.OpaqueColor{
-								RgbColor: rgb,
-							},
-						},
-					},
-					Fields: "foregroundColor",
-				},
-			}
-			requests = append(requests, colorRequest)
-		}
+	if glyphRequest := buildBulletGlyphStyleRequest(input.ObjectID, textRange, input.BulletColor, input.BulletSize); glyphRequest != nil {
+		requests = append(requests, glyphRequest)
 	}
 
 	return requests
 }
 
+// validateBulletGlyphStyle checks the optional bullet color and size.
+func validateBulletGlyphStyle(color string, size float64) error {
+	if color != "" && parseHexColor(color) == nil {
+		return fmt.Errorf("%w: '%s' is not a #RRGGBB color", ErrInvalidBulletColor, color)
+	}
+	if size < 0 || size > maxBulletSize || math.IsNaN(size) {
+		return fmt.Errorf("%w: bullet_size must be between 0 and %g points", ErrInvalidBulletSize, maxBulletSize)
+	}
+	return nil
+}
+
+// buildBulletGlyphStyleRequest styles the bullets of textRange, or returns nil when neither a
+// valid color nor a size is given.
+//
+// The Slides API has no glyph-only styling: a bullet's style is read-only and derived from its
+// paragraph's text. Styling the paragraphs is the only way to reach the glyphs, so the color
+// and size also apply to the list text itself.
+func buildBulletGlyphStyleRequest(objectID string, textRange *slides.Range, color string, size float64) *slides.Request {
+	style := &slides.TextStyle{}
+	var fields []string
+	if rgb := parseHexColor(color); color != "" && rgb != nil {
+		style.ForegroundColor = &slides.OptionalColor{OpaqueColor: &slides.OpaqueColor{RgbColor: rgb}}
+		fields = append(fields, "foregroundColor")
+	}
+	if size > 0 {
+		style.FontSize = &slides.Dimension{Magnitude: size, Unit: "PT"}
+		fields = append(fields, "fontSize")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &slides.Request{
+		UpdateTextStyle: &slides.UpdateTextStyleRequest{
+			ObjectId:  objectID,
+			TextRange: textRange,
+			Style:     style,
+			Fields:    strings.Join(fields, ","),
+		},
+	}
+}
+
 // getBulletTextRange returns the text range for applying bullets.
 func getBulletTextRange(text *slides.TextContent, paragraphIndices []int) *slides.Range {
 	if len(paragraphIndices) == 0 {
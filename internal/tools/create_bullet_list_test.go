@@ -254,7 +254,7 @@ func TestCreateBulletList(t *testing.T) {
 			},
 		},
 		{
-			name: "create bullet list with invalid color - no color request",
+			name: "create bullet list with invalid color",
 			input: CreateBulletListInput{
 				PresentationID: "test-presentation-id",
 				ObjectID:       "textbox-1",
@@ -262,13 +262,84 @@ func TestCreateBulletList(t *testing.T) {
 				BulletColor:    "invalid-color",
 			},
 			presentation: createTestPresentation(),
+			wantErr:      ErrInvalidBulletColor,
+		},
+		{
+			name: "create bullet list with color and size",
+			input: CreateBulletListInput{
+				PresentationID: "test-presentation-id",
+				ObjectID:       "textbox-1",
+				BulletStyle:    "DISC",
+				BulletColor:    "#0000FF",
+				BulletSize:     18,
+			},
+			presentation: createTestPresentation(),
+			checkOutput: func(t *testing.T, output *CreateBulletListOutput) {
+				if output.BulletSize != 18 {
+					t.Errorf("expected bullet_size 18, got %v", output.BulletSize)
+				}
+			},
 			checkRequests: func(t *testing.T, requests []*slides.Request) {
-				// Invalid color should be silently ignored
-				if len(requests) != 1 {
-					t.Fatalf("expected 1 request (invalid color ignored), got %d", len(requests))
+				if len(requests) != 2 || requests[0].CreateParagraphBullets == nil {
+					t.Fatalf("expected bullets followed by a style request, got %d requests", len(requests))
+				}
+				styleReq := requests[1].UpdateTextStyle
+				if styleReq == nil {
+					t.Fatalf("second request should be UpdateTextStyle, got nil")
+				}
+				if styleReq.Fields != "foregroundColor,fontSize" {
+					t.Errorf("expected fields 'foregroundColor,fontSize', got %s", styleReq.Fields)
+				}
+				if styleReq.Style.FontSize == nil || styleReq.Style.FontSize.Magnitude != 18 || styleReq.Style.FontSize.Unit != "PT" {
+					t.Errorf("expected an 18pt font size, got %+v", styleReq.Style.FontSize)
+				}
+				// The style covers the same paragraphs as the bullets
+				bulletRange := requests[0].CreateParagraphBullets.TextRange
+				if styleReq.TextRange.Type != bulletRange.Type {
+					t.Errorf("expected style range %s to match bullet range %s", styleReq.TextRange.Type, bulletRange.Type)
 				}
 			},
 		},
+		{
+			name: "create bullet list with size only",
+			input: CreateBulletListInput{
+				PresentationID: "test-presentation-id",
+				ObjectID:       "textbox-1",
+				BulletStyle:    "DISC",
+				BulletSize:     10.5,
+			},
+			presentation: createTestPresentation(),
+			checkRequests: func(t *testing.T, requests []*slides.Request) {
+				if len(requests) != 2 || requests[1].UpdateTextStyle == nil {
+					t.Fatalf("expected bullets followed by a style request, got %d requests", len(requests))
+				}
+				if fields := requests[1].UpdateTextStyle.Fields; fields != "fontSize" {
+					t.Errorf("expected fields 'fontSize', got %s", fields)
+				}
+			},
+		},
+		{
+			name: "create bullet list with negative size",
+			input: CreateBulletListInput{
+				PresentationID: "test-presentation-id",
+				ObjectID:       "textbox-1",
+				BulletStyle:    "DISC",
+				BulletSize:     -1,
+			},
+			presentation: createTestPresentation(),
+			wantErr:      ErrInvalidBulletSize,
+		},
+		{
+			name: "create bullet list with oversized bullets",
+			input: CreateBulletListInput{
+				PresentationID: "test-presentation-id",
+				ObjectID:       "textbox-1",
+				BulletStyle:    "DISC",
+				BulletSize:     maxBulletSize + 1,
+			},
+			presentation: createTestPresentation(),
+			wantErr:      ErrInvalidBulletSize,
+		},
 
 		// === Paragraph indices tests ===
 		{
@@ -0,0 +1,251 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for create_comparison_slide tool.
+var (
+	ErrCreateComparisonSlideFailed = errors.New("failed to create comparison slide")
+)
+
+// comparisonLayout is the predefined layout used for comparison slides: a title and two body
+// columns. Slides has no dedicated comparison layout, so column headings become the first,
+// bold paragraph of each column.
+const comparisonLayout = "TITLE_AND_TWO_COLUMNS"
+
+// comparisonBulletPreset is the bullet preset applied to column items.
+const comparisonBulletPreset = "BULLET_DISC_CIRCLE_SQUARE"
+
+// ComparisonColumn is the content of one column of a comparison slide.
+type ComparisonColumn struct {
+	Title string   `json:"title,omitempty"` // Bold heading paragraph
+	Items []string `json:"items,omitempty"` // One bulleted paragraph each
+}
+
+// CreateComparisonSlideInput represents the input for the create_comparison_slide tool.
+type CreateComparisonSlideInput struct {
+	PresentationID string           `json:"presentation_id"`
+	Title          string           `json:"title,omitempty"` // Slide title
+	Left           ComparisonColumn `json:"left"`
+	Right          ComparisonColumn `json:"right"`
+	Position       int              `json:"position,omitempty"` // 1-based position (0 or omitted = end)
+}
+
+// CreateComparisonSlideOutput represents the output of the create_comparison_slide tool.
+type CreateComparisonSlideOutput struct {
+	SlideIndex    int    `json:"slide_index"` // 1-based index of the new slide
+	SlideID       string `json:"slide_id"`
+	LayoutID      string `json:"layout_id"`
+	TitleObjectID string `json:"title_object_id,omitempty"` // Only set when a title was inserted
+	LeftObjectID  string `json:"left_object_id"`
+	RightObjectID string `json:"right_object_id"`
+}
+
+// CreateComparisonSlide inserts a TITLE_AND_TWO_COLUMNS slide and fills its title and both
+// column placeholders in a single batch update. Each column gets an optional bold heading
+// followed by its items as bullets.
+func (t *Tools) CreateComparisonSlide(ctx context.Context, tokenSource oauth2.TokenSource, input CreateComparisonSlideInput) (*CreateComparisonSlideOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if err := validateComparisonColumn("left", input.Left); err != nil {
+		return nil, err
+	}
+	if err := validateComparisonColumn("right", input.Right); err != nil {
+		return nil, err
+	}
+
+	t.config.Logger.Info("creating comparison slide",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("left_items", len(input.Left.Items)),
+		slog.Int("right_items", len(input.Right.Items)),
+		slog.Int("position", input.Position),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to find the layout and its placeholders
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// As for section slides, the placeholders must be known up front
+	layout := findLayoutPageByType(presentation.Layouts, comparisonLayout)
+	if layout == nil {
+		return nil, fmt.Errorf("%w: presentation has no %s layout", ErrInvalidLayout, comparisonLayout)
+	}
+	columns := findColumnPlaceholders(layout.PageElements)
+	if len(columns) < 2 {
+		return nil, fmt.Errorf("%w: %s layout has %d body placeholder(s), need 2", ErrInvalidLayout, comparisonLayout, len(columns))
+	}
+	var titlePlaceholder *slides.PageElement
+	if input.Title != "" {
+		titlePlaceholder = findPlaceholderByType(layout.PageElements, "TITLE")
+		if titlePlaceholder == nil {
+			return nil, fmt.Errorf("%w: %s layout has no title placeholder", ErrInvalidLayout, comparisonLayout)
+		}
+	}
+
+	insertionIndex := len(presentation.Slides)
+	if input.Position > 0 && input.Position <= len(presentation.Slides) {
+		insertionIndex = input.Position - 1
+	}
+
+	output := &CreateComparisonSlideOutput{
+		SlideIndex:    insertionIndex + 1,
+		SlideID:       newObjectID("comparison"),
+		LayoutID:      layout.ObjectId,
+		LeftObjectID:  newObjectID("comparison_left"),
+		RightObjectID: newObjectID("comparison_right"),
+	}
+	if titlePlaceholder != nil {
+		output.TitleObjectID = newObjectID("comparison_title")
+	}
+
+	createSlide := &slides.CreateSlideRequest{
+		ObjectId:             output.SlideID,
+		InsertionIndex:       int64(insertionIndex),
+		SlideLayoutReference: &slides.LayoutReference{LayoutId: output.LayoutID},
+		PlaceholderIdMappings: []*slides.LayoutPlaceholderIdMapping{
+			{LayoutPlaceholder: layoutPlaceholderRef(columns[0]), ObjectId: output.LeftObjectID},
+			{LayoutPlaceholder: layoutPlaceholderRef(columns[1]), ObjectId: output.RightObjectID},
+		},
+	}
+	requests := []*slides.Request{{CreateSlide: createSlide}}
+	if titlePlaceholder != nil {
+		createSlide.PlaceholderIdMappings = append(createSlide.PlaceholderIdMappings, &slides.LayoutPlaceholderIdMapping{
+			LayoutPlaceholder: layoutPlaceholderRef(titlePlaceholder),
+			ObjectId:          output.TitleObjectID,
+		})
+		requests = append(requests, &slides.Request{
+			InsertText: &slides.InsertTextRequest{ObjectId: output.TitleObjectID, Text: normalizeLineBreaks(input.Title, false)},
+		})
+	}
+	requests = append(requests, buildComparisonColumnRequests(output.LeftObjectID, input.Left)...)
+	requests = append(requests, buildComparisonColumnRequests(output.RightObjectID, input.Right)...)
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCreateComparisonSlideFailed, err)
+	}
+
+	t.config.Logger.Info("comparison slide created successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("slide_id", output.SlideID),
+		slog.Int("slide_index", output.SlideIndex),
+	)
+
+	return output, nil
+}
+
+// validateComparisonColumn requires a heading or at least one item, and no empty items.
+func validateComparisonColumn(side string, column ComparisonColumn) error {
+	if column.Title == "" && len(column.Items) == 0 {
+		return fmt.Errorf("%w: %s column needs a title or items", ErrInvalidText, side)
+	}
+	for i, item := range column.Items {
+		if strings.TrimSpace(item) == "" {
+			return fmt.Errorf("%w: %s column item %d is empty", ErrInvalidText, side, i+1)
+		}
+	}
+	return nil
+}
+
+// findColumnPlaceholders returns the layout's BODY placeholders ordered left to right.
+func findColumnPlaceholders(elements []*slides.PageElement) []*slides.PageElement {
+	var columns []*slides.PageElement
+	for _, element := range elements {
+		if element != nil && element.Shape != nil && element.Shape.Placeholder != nil && element.Shape.Placeholder.Type == "BODY" {
+			columns = append(columns, element)
+		}
+	}
+	sort.SliceStable(columns, func(i, j int) bool {
+		return placeholderLeft(columns[i]) < placeholderLeft(columns[j])
+	})
+	return columns
+}
+
+// placeholderLeft returns an element's X translation, 0 when it has no transform.
+func placeholderLeft(element *slides.PageElement) float64 {
+	if element.Transform == nil {
+		return 0
+	}
+	return element.Transform.TranslateX
+}
+
+// buildComparisonColumnRequests inserts a column's heading and items as paragraphs, bolds the
+// heading and bullets the items. Line breaks inside an entry become soft breaks so every entry
+// stays one paragraph.
+func buildComparisonColumnRequests(objectID string, column ComparisonColumn) []*slides.Request {
+	paragraphs := make([]string, 0, len(column.Items)+1)
+	if column.Title != "" {
+		paragraphs = append(paragraphs, normalizeLineBreaks(column.Title, true))
+	}
+	for _, item := range column.Items {
+		paragraphs = append(paragraphs, normalizeLineBreaks(item, true))
+	}
+	text := strings.Join(paragraphs, "\n")
+
+	requests := []*slides.Request{
+		{InsertText: &slides.InsertTextRequest{ObjectId: objectID, Text: text}},
+	}
+
+	itemsStart := 0
+	if column.Title != "" {
+		headingStart, headingEnd := int64(0), int64(utf16Len(paragraphs[0]))
+		headingRange := &slides.Range{Type: "FIXED_RANGE", StartIndex: &headingStart, EndIndex: &headingEnd}
+		requests = append(requests,
+			&slides.Request{UpdateTextStyle: &slides.UpdateTextStyleRequest{
+				ObjectId:  objectID,
+				TextRange: headingRange,
+				Style:     &slides.TextStyle{Bold: true},
+				Fields:    "bold",
+			}},
+			// Body placeholders may be bulleted by the theme; the heading never is
+			&slides.Request{DeleteParagraphBullets: &slides.DeleteParagraphBulletsRequest{
+				ObjectId:  objectID,
+				TextRange: headingRange,
+			}},
+		)
+		itemsStart = int(headingEnd) + 1
+	}
+
+	if len(column.Items) > 0 {
+		start := int64(itemsStart)
+		end := int64(utf16Len(text))
+		requests = append(requests, &slides.Request{CreateParagraphBullets: &slides.CreateParagraphBulletsRequest{
+			ObjectId:     objectID,
+			TextRange:    &slides.Range{Type: "FIXED_RANGE", StartIndex: &start, EndIndex: &end},
+			BulletPreset: comparisonBulletPreset,
+		}})
+	}
+	return requests
+}
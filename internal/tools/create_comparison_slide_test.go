@@ -0,0 +1,218 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newComparisonSlideTestPresentation(bodyPlaceholders int) *slides.Presentation {
+	placeholder := func(id, placeholderType string, index int64, x float64) *slides.PageElement {
+		return &slides.PageElement{
+			ObjectId:  id,
+			Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: pointsToEMU(x)},
+			Shape: &slides.Shape{
+				ShapeType:   "TEXT_BOX",
+				Placeholder: &slides.Placeholder{Type: placeholderType, Index: index},
+			},
+		}
+	}
+
+	// The right column comes first in the layout to check columns are ordered by position
+	elements := []*slides.PageElement{placeholder("lc-title", "TITLE", 0, 30)}
+	positions := []float64{370, 30}
+	for i := 0; i < bodyPlaceholders; i++ {
+		elements = append(elements, placeholder([]string{"lc-body-right", "lc-body-left"}[i], "BODY", int64(i+1), positions[i]))
+	}
+
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides:         []*slides.Page{{ObjectId: "slide-1"}, {ObjectId: "slide-2"}},
+		Layouts: []*slides.Page{{
+			ObjectId:         "layout-two-columns",
+			LayoutProperties: &slides.LayoutProperties{Name: "TITLE_AND_TWO_COLUMNS"},
+			PageElements:     elements,
+		}},
+	}
+}
+
+func TestCreateComparisonSlide(t *testing.T) {
+	stubObjectIDSuffix(t, "abc123")
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(newComparisonSlideTestPresentation(2), nil, nil, &batchCalls, &captured)
+
+	output, err := tools.CreateComparisonSlide(context.Background(), nil, CreateComparisonSlideInput{
+		PresentationID: "pres-1",
+		Title:          "Build vs buy",
+		Left:           ComparisonColumn{Title: "Build", Items: []string{"Full control", "Higher cost"}},
+		Right:          ComparisonColumn{Title: "Buy", Items: []string{"Fast start"}},
+		Position:       2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := CreateComparisonSlideOutput{
+		SlideIndex:    2,
+		SlideID:       "comparison_abc123",
+		LayoutID:      "layout-two-columns",
+		TitleObjectID: "comparison_title_abc123",
+		LeftObjectID:  "comparison_left_abc123",
+		RightObjectID: "comparison_right_abc123",
+	}
+	if *output != want {
+		t.Errorf("output = %+v, want %+v", *output, want)
+	}
+
+	if batchCalls != 1 {
+		t.Fatalf("expected a single batch update, got %d", batchCalls)
+	}
+	create := captured[0].CreateSlide
+	if create == nil || create.InsertionIndex != 1 || create.SlideLayoutReference.LayoutId != "layout-two-columns" {
+		t.Fatalf("unexpected create slide request: %+v", create)
+	}
+	mappings := map[string]string{}
+	for _, mapping := range create.PlaceholderIdMappings {
+		mappings[mapping.ObjectId] = mapping.LayoutPlaceholder.Type
+		if mapping.ObjectId == want.LeftObjectID && mapping.LayoutPlaceholder.Index != 2 {
+			t.Errorf("expected the left column to map the leftmost body placeholder (index 2), got %d", mapping.LayoutPlaceholder.Index)
+		}
+	}
+	if mappings[want.TitleObjectID] != "TITLE" || mappings[want.LeftObjectID] != "BODY" || mappings[want.RightObjectID] != "BODY" {
+		t.Errorf("unexpected placeholder mappings: %v", mappings)
+	}
+
+	// Both columns get their heading and items
+	texts := map[string]string{}
+	bullets := map[string]*slides.CreateParagraphBulletsRequest{}
+	for _, request := range captured {
+		if request.InsertText != nil {
+			texts[request.InsertText.ObjectId] = request.InsertText.Text
+		}
+		if request.CreateParagraphBullets != nil {
+			bullets[request.CreateParagraphBullets.ObjectId] = request.CreateParagraphBullets
+		}
+	}
+	if texts[want.TitleObjectID] != "Build vs buy" {
+		t.Errorf("unexpected title text: %q", texts[want.TitleObjectID])
+	}
+	if texts[want.LeftObjectID] != "Build\nFull control\nHigher cost" {
+		t.Errorf("unexpected left column text: %q", texts[want.LeftObjectID])
+	}
+	if texts[want.RightObjectID] != "Buy\nFast start" {
+		t.Errorf("unexpected right column text: %q", texts[want.RightObjectID])
+	}
+
+	// Items are bulleted from after the heading to the end of the column
+	left := bullets[want.LeftObjectID]
+	if left == nil || *left.TextRange.StartIndex != 6 || *left.TextRange.EndIndex != 30 || left.BulletPreset != comparisonBulletPreset {
+		t.Errorf("unexpected left bullets: %+v", left)
+	}
+	right := bullets[want.RightObjectID]
+	if right == nil || *right.TextRange.StartIndex != 4 || *right.TextRange.EndIndex != 14 {
+		t.Errorf("unexpected right bullets: %+v", right)
+	}
+}
+
+func TestBuildComparisonColumnRequests(t *testing.T) {
+	tests := []struct {
+		name       string
+		column     ComparisonColumn
+		wantText   string
+		wantBold   bool
+		wantBullet [2]int64 // Zero when no bullets are expected
+	}{
+		{"heading and items", ComparisonColumn{Title: "Café", Items: []string{"A", "B"}}, "Café\nA\nB", true, [2]int64{5, 8}},
+		{"items only", ComparisonColumn{Items: []string{"One", "Two\nlines"}}, "One\nTwo\vlines", false, [2]int64{0, 13}},
+		{"heading only", ComparisonColumn{Title: "Just a heading"}, "Just a heading", true, [2]int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := buildComparisonColumnRequests("col", tt.column)
+
+			var gotBold bool
+			var gotBullet [2]int64
+			for _, request := range requests {
+				switch {
+				case request.InsertText != nil && request.InsertText.Text != tt.wantText:
+					t.Errorf("text = %q, want %q", request.InsertText.Text, tt.wantText)
+				case request.UpdateTextStyle != nil:
+					gotBold = request.UpdateTextStyle.Style.Bold
+				case request.CreateParagraphBullets != nil:
+					r := request.CreateParagraphBullets.TextRange
+					gotBullet = [2]int64{*r.StartIndex, *r.EndIndex}
+				}
+			}
+			if gotBold != tt.wantBold {
+				t.Errorf("bold heading = %v, want %v", gotBold, tt.wantBold)
+			}
+			if gotBullet != tt.wantBullet {
+				t.Errorf("bullet range = %v, want %v", gotBullet, tt.wantBullet)
+			}
+		})
+	}
+}
+
+func TestCreateComparisonSlide_Errors(t *testing.T) {
+	valid := CreateComparisonSlideInput{
+		PresentationID: "pres-1",
+		Left:           ComparisonColumn{Items: []string{"a"}},
+		Right:          ComparisonColumn{Items: []string{"b"}},
+	}
+	with := func(modify func(*CreateComparisonSlideInput)) CreateComparisonSlideInput {
+		input := valid
+		modify(&input)
+		return input
+	}
+
+	tests := []struct {
+		name         string
+		input        CreateComparisonSlideInput
+		placeholders int
+		getErr       error
+		batchErr     error
+		wantErr      error
+	}{
+		{"missing presentation", with(func(i *CreateComparisonSlideInput) { i.PresentationID = "" }), 2, nil, nil, ErrInvalidPresentationID},
+		{"empty left column", with(func(i *CreateComparisonSlideInput) { i.Left = ComparisonColumn{} }), 2, nil, nil, ErrInvalidText},
+		{"blank right item", with(func(i *CreateComparisonSlideInput) { i.Right.Items = []string{"b", " "} }), 2, nil, nil, ErrInvalidText},
+		{"one body placeholder", valid, 1, nil, nil, ErrInvalidLayout},
+		{"presentation not found", valid, 2, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", valid, 2, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", valid, 2, nil, errors.New("boom"), ErrCreateComparisonSlideFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newBatchCaptureTestTools(newComparisonSlideTestPresentation(tt.placeholders), tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.CreateComparisonSlide(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", batchCalls)
+			}
+		})
+	}
+
+	t.Run("missing layout", func(t *testing.T) {
+		presentation := newComparisonSlideTestPresentation(2)
+		presentation.Layouts[0].LayoutProperties.Name = "TITLE_AND_BODY"
+		var batchCalls int
+		var captured []*slides.Request
+		tools := newBatchCaptureTestTools(presentation, nil, nil, &batchCalls, &captured)
+
+		_, err := tools.CreateComparisonSlide(context.Background(), nil, valid)
+		if !errors.Is(err, ErrInvalidLayout) {
+			t.Errorf("expected ErrInvalidLayout, got %v", err)
+		}
+	})
+}
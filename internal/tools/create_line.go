@@ -77,20 +77,8 @@ func (t *Tools) CreateLine(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
 	}
 
-	// Get the presentation to find the target slide
-	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
-	if err != nil {
-		if isNotFoundError(err) {
-			return nil, ErrPresentationNotFound
-		}
-		if isForbiddenError(err) {
-			return nil, ErrAccessDenied
-		}
-		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
-	}
-
 	// Find the target slide
-	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	slideID, _, err := t.resolveSlideID(ctx, slidesService, input.PresentationID, input.SlideIndex, input.SlideID)
 	if err != nil {
 		return nil, err
 	}
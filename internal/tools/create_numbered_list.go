@@ -51,7 +51,10 @@ type CreateNumberedListOutput struct {
 	ObjectID       string `json:"object_id"`
 	NumberPreset   string `json:"number_preset"`   // The actual preset applied
 	ParagraphScope string `json:"paragraph_scope"` // "ALL" or "INDICES [1, 2, 3]"
-	StartNumber    int    `json:"start_number"`    // The start number applied
+	StartNumber    int    `json:"start_number"`    // The start number requested
+	// StartNumberIgnored is true when a start number other than 1 was requested. The Slides
+	// API has no start number setting, so the list still counts from 1.
+	StartNumberIgnored bool `json:"start_number_ignored,omitempty"`
 }
 
 // CreateNumberedList converts text to a numbered list or adds numbering to existing text.
@@ -74,13 +77,9 @@ func (t *Tools) CreateNumberedList(ctx context.Context, tokenSource oauth2.Token
 		return nil, fmt.Errorf("%w: '%s' is not a valid number style; use DECIMAL, ALPHA_UPPER, ALPHA_LOWER, ROMAN_UPPER, ROMAN_LOWER, or a full preset name", ErrInvalidNumberStyle, input.NumberStyle)
 	}
 
-	// Set default start number if not provided
-	startNumber := input.StartNumber
-	if startNumber == 0 {
-		startNumber = 1
-	}
-	if startNumber < 1 {
-		return nil, fmt.Errorf("%w: start_number must be at least 1", ErrInvalidStartNumber)
+	startNumber, err := normalizeStartNumber(input.StartNumber)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate paragraph indices
@@ -169,10 +168,18 @@ func (t *Tools) CreateNumberedList(ctx context.Context, tokenSource oauth2.Token
 	}
 
 	output := &CreateNumberedListOutput{
-		ObjectID:       input.ObjectID,
-		NumberPreset:   numberPreset,
-		ParagraphScope: paragraphScope,
-		StartNumber:    startNumber,
+		ObjectID:           input.ObjectID,
+		NumberPreset:       numberPreset,
+		ParagraphScope:     paragraphScope,
+		StartNumber:        startNumber,
+		StartNumberIgnored: startNumber != 1,
+	}
+
+	if output.StartNumberIgnored {
+		t.config.Logger.Warn("start number is not supported by the Slides API; numbering starts at 1",
+			slog.String("object_id", input.ObjectID),
+			slog.Int("start_number", startNumber),
+		)
 	}
 
 	t.config.Logger.Info("numbered list created successfully",
@@ -185,6 +192,17 @@ func (t *Tools) CreateNumberedList(ctx context.Context, tokenSource oauth2.Token
 	return output, nil
 }
 
+// normalizeStartNumber defaults an unset start number to 1 and rejects values below 1.
+func normalizeStartNumber(startNumber int) (int, error) {
+	if startNumber == 0 {
+		return 1, nil
+	}
+	if startNumber < 1 {
+		return 0, fmt.Errorf("%w: start_number must be at least 1", ErrInvalidStartNumber)
+	}
+	return startNumber, nil
+}
+
 // buildCreateNumberedListRequests creates the requests for creating numbered lists.
 // Note: The startNumber parameter is accepted for API completeness but Google Slides API
 // CreateParagraphBulletsRequest does not support custom start numbers directly.
@@ -113,6 +113,9 @@ func TestCreateNumberedList(t *testing.T) {
 				if output.StartNumber != 1 {
 					t.Errorf("expected start_number 1, got %d", output.StartNumber)
 				}
+				if output.StartNumberIgnored {
+					t.Error("expected start_number_ignored to be false for the default start")
+				}
 			},
 			checkRequests: func(t *testing.T, requests []*slides.Request) {
 				if len(requests) != 1 {
@@ -232,6 +235,9 @@ func TestCreateNumberedList(t *testing.T) {
 				if output.StartNumber != 1 {
 					t.Errorf("expected start_number 1, got %d", output.StartNumber)
 				}
+				if output.StartNumberIgnored {
+					t.Error("expected start_number_ignored to be false for the default start")
+				}
 			},
 		},
 		{
@@ -249,6 +255,9 @@ func TestCreateNumberedList(t *testing.T) {
 				if output.StartNumber != 5 {
 					t.Errorf("expected start_number 5, got %d", output.StartNumber)
 				}
+				if !output.StartNumberIgnored {
+					t.Error("expected start_number_ignored for a start other than 1")
+				}
 			},
 		},
 
@@ -16,32 +16,66 @@ var (
 	ErrCreateFailed       = errors.New("failed to create presentation")
 	ErrInvalidCreateTitle = errors.New("invalid title for presentation")
 	ErrFolderNotFound     = errors.New("destination folder not found or inaccessible")
+	ErrInvalidSizePreset  = errors.New("invalid size preset")
 )
 
+// slideSizePresets maps size preset names to landscape page dimensions in EMU.
+var slideSizePresets = map[string]struct{ width, height float64 }{
+	"16:9":   {inchesToEMU(10), inchesToEMU(5.625)}, // Google Slides default
+	"4:3":    {inchesToEMU(10), inchesToEMU(7.5)},
+	"16:10":  {inchesToEMU(10), inchesToEMU(6.25)},
+	"A4":     {cmToEMU(29.7), cmToEMU(21)},
+	"LETTER": {inchesToEMU(11), inchesToEMU(8.5)},
+}
+
 // CreatePresentationInput represents the input for the create_presentation tool.
 type CreatePresentationInput struct {
-	Title    string `json:"title"`
-	FolderID string `json:"folder_id,omitempty"`
+	Title         string   `json:"title"`
+	FolderID      string   `json:"folder_id,omitempty"`
+	InitialSlides []string `json:"initial_slides,omitempty"` // Layout types for slides appended after creation
+	SizePreset    string   `json:"size_preset,omitempty"`    // 16:9, 4:3, 16:10, A4 or LETTER; API default if empty
 }
 
 // CreatePresentationOutput represents the output of the create_presentation tool.
 type CreatePresentationOutput struct {
-	PresentationID string `json:"presentation_id"`
-	Title          string `json:"title"`
-	URL            string `json:"url"`
-	FolderID       string `json:"folder_id,omitempty"`
+	PresentationID string   `json:"presentation_id"`
+	Title          string   `json:"title"`
+	URL            string   `json:"url"`
+	FolderID       string   `json:"folder_id,omitempty"`
+	SlideIDs       []string `json:"slide_ids,omitempty"`   // Object IDs of the initial slides, in order
+	SizePreset     string   `json:"size_preset,omitempty"` // Normalized preset name when one was requested
 }
 
 // CreatePresentation creates a new empty Google Slides presentation.
+// The page size can only be chosen here: the API offers no way to resize an existing deck.
 func (t *Tools) CreatePresentation(ctx context.Context, tokenSource oauth2.TokenSource, input CreatePresentationInput) (*CreatePresentationOutput, error) {
 	// Validate input
-	if input.Title == "" {
+	if strings.TrimSpace(input.Title) == "" {
 		return nil, fmt.Errorf("%w: title is required", ErrInvalidCreateTitle)
 	}
 
+	// Resolve the page size before creating anything
+	var pageSize *slides.Size
+	if input.SizePreset != "" {
+		var err error
+		pageSize, err = sizePresetPageSize(input.SizePreset)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate initial slide layouts before creating anything
+	for i, layout := range input.InitialSlides {
+		if !validLayoutTypes[layout] {
+			return nil, fmt.Errorf("%w: unsupported layout '%s' at initial_slides[%d]", ErrInvalidLayout, layout, i)
+		}
+	}
+
 	t.config.Logger.Info("creating presentation",
 		slog.String("title", input.Title),
 		slog.String("folder_id", input.FolderID),
+		slog.Int("initial_slides", len(input.InitialSlides)),
+		slog.String("size_preset", input.SizePreset),
 	)
 
 	// Create Slides service
@@ -52,7 +86,8 @@ func (t *Tools) CreatePresentation(ctx context.Context, tokenSource oauth2.Token
 
 	// Create the presentation using Slides API
 	presentation := &slides.Presentation{
-		Title: input.Title,
+		Title:    input.Title,
+		PageSize: pageSize,
 	}
 
 	createdPresentation, err := slidesService.CreatePresentation(ctx, presentation)
@@ -63,6 +98,27 @@ func (t *Tools) CreatePresentation(ctx context.Context, tokenSource oauth2.Token
 		return nil, fmt.Errorf("%w: %v", ErrCreateFailed, err)
 	}
 
+	// Add the requested initial slides in a single follow-up batch
+	var slideIDs []string
+	if len(input.InitialSlides) > 0 {
+		requests := buildInitialSlideRequests(input.InitialSlides)
+
+		response, err := slidesService.BatchUpdate(ctx, createdPresentation.PresentationId, requests)
+		if err != nil {
+			if isForbiddenError(err) {
+				return nil, fmt.Errorf("%w: access denied", ErrAccessDenied)
+			}
+			return nil, fmt.Errorf("%w: presentation '%s' was created but initial slides could not be added: %v",
+				ErrAddSlideFailed, createdPresentation.PresentationId, err)
+		}
+
+		for _, reply := range response.Replies {
+			if reply != nil && reply.CreateSlide != nil {
+				slideIDs = append(slideIDs, reply.CreateSlide.ObjectId)
+			}
+		}
+	}
+
 	// If folder is specified, move the presentation to that folder
 	if input.FolderID != "" {
 		driveService, err := t.driveServiceFactory(ctx, tokenSource)
@@ -95,6 +151,10 @@ func (t *Tools) CreatePresentation(ctx context.Context, tokenSource oauth2.Token
 		PresentationID: createdPresentation.PresentationId,
 		Title:          createdPresentation.Title,
 		URL:            presentationURL,
+		SlideIDs:       slideIDs,
+	}
+	if pageSize != nil {
+		output.SizePreset = strings.ToUpper(strings.TrimSpace(input.SizePreset))
 	}
 
 	if input.FolderID != "" {
@@ -109,6 +169,34 @@ func (t *Tools) CreatePresentation(ctx context.Context, tokenSource oauth2.Token
 	return output, nil
 }
 
+// buildInitialSlideRequests creates one CreateSlide request per layout.
+// Slides are appended after the default slide in the order given.
+func buildInitialSlideRequests(layouts []string) []*slides.Request {
+	requests := make([]*slides.Request, 0, len(layouts))
+	for _, layout := range layouts {
+		requests = append(requests, &slides.Request{
+			CreateSlide: &slides.CreateSlideRequest{
+				SlideLayoutReference: &slides.LayoutReference{
+					PredefinedLayout: layout,
+				},
+			},
+		})
+	}
+	return requests
+}
+
+// sizePresetPageSize returns the page size for a preset name, matched case-insensitively.
+func sizePresetPageSize(name string) (*slides.Size, error) {
+	preset, ok := slideSizePresets[strings.ToUpper(strings.TrimSpace(name))]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s' (valid presets: 16:9, 4:3, 16:10, A4, LETTER)", ErrInvalidSizePreset, name)
+	}
+	return &slides.Size{
+		Width:  &slides.Dimension{Magnitude: preset.width, Unit: "EMU"},
+		Height: &slides.Dimension{Magnitude: preset.height, Unit: "EMU"},
+	}, nil
+}
+
 // isFolderNotFoundError checks if an error indicates the folder was not found.
 func isFolderNotFoundError(err error) bool {
 	if err == nil {
@@ -421,3 +421,195 @@ func TestCreatePresentation_ReturnsPresentationID(t *testing.T) {
 		t.Errorf("expected presentation ID 'unique-pres-12345', got '%s'", output.PresentationID)
 	}
 }
+
+func TestCreatePresentation_WithInitialSlides(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockSlidesService := &mockSlidesService{
+		CreatePresentationFunc: func(ctx context.Context, presentation *slides.Presentation) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "new-presentation-id",
+				Title:          presentation.Title,
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			if presentationID != "new-presentation-id" {
+				t.Errorf("expected presentation ID 'new-presentation-id', got '%s'", presentationID)
+			}
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{
+				Replies: []*slides.Response{
+					{CreateSlide: &slides.CreateSlideResponse{ObjectId: "slide-a"}},
+					{CreateSlide: &slides.CreateSlideResponse{ObjectId: "slide-b"}},
+				},
+			}, nil
+		},
+	}
+
+	slidesFactory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlidesService, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, nil)
+
+	output, err := tools.CreatePresentation(context.Background(), &mockTokenSource{}, CreatePresentationInput{
+		Title:         "Deck",
+		InitialSlides: []string{"TITLE", "TITLE_AND_BODY"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(capturedRequests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(capturedRequests))
+	}
+	expectedLayouts := []string{"TITLE", "TITLE_AND_BODY"}
+	for i, req := range capturedRequests {
+		if req.CreateSlide == nil {
+			t.Fatalf("request %d: expected CreateSlide request", i)
+		}
+		if got := req.CreateSlide.SlideLayoutReference.PredefinedLayout; got != expectedLayouts[i] {
+			t.Errorf("request %d: expected layout '%s', got '%s'", i, expectedLayouts[i], got)
+		}
+	}
+
+	if len(output.SlideIDs) != 2 || output.SlideIDs[0] != "slide-a" || output.SlideIDs[1] != "slide-b" {
+		t.Errorf("expected slide IDs [slide-a slide-b], got %v", output.SlideIDs)
+	}
+}
+
+func TestCreatePresentation_InvalidInitialLayout(t *testing.T) {
+	createCalled := false
+	mockSlidesService := &mockSlidesService{
+		CreatePresentationFunc: func(ctx context.Context, presentation *slides.Presentation) (*slides.Presentation, error) {
+			createCalled = true
+			return &slides.Presentation{PresentationId: "new-presentation-id"}, nil
+		},
+	}
+
+	slidesFactory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlidesService, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, nil)
+
+	_, err := tools.CreatePresentation(context.Background(), &mockTokenSource{}, CreatePresentationInput{
+		Title:         "Deck",
+		InitialSlides: []string{"TITLE", "NOT_A_LAYOUT"},
+	})
+	if !errors.Is(err, ErrInvalidLayout) {
+		t.Errorf("expected ErrInvalidLayout, got %v", err)
+	}
+	if createCalled {
+		t.Error("expected presentation not to be created when a layout is invalid")
+	}
+}
+
+func TestCreatePresentation_WhitespaceTitle(t *testing.T) {
+	tools := NewToolsWithDrive(DefaultToolsConfig(), nil, nil)
+
+	_, err := tools.CreatePresentation(context.Background(), &mockTokenSource{}, CreatePresentationInput{
+		Title: "   ",
+	})
+	if !errors.Is(err, ErrInvalidCreateTitle) {
+		t.Errorf("expected ErrInvalidCreateTitle, got %v", err)
+	}
+}
+
+func TestCreatePresentation_InitialSlidesBatchError(t *testing.T) {
+	mockSlidesService := &mockSlidesService{
+		CreatePresentationFunc: func(ctx context.Context, presentation *slides.Presentation) (*slides.Presentation, error) {
+			return &slides.Presentation{PresentationId: "new-presentation-id"}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return nil, errors.New("boom")
+		},
+	}
+
+	slidesFactory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlidesService, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, nil)
+
+	_, err := tools.CreatePresentation(context.Background(), &mockTokenSource{}, CreatePresentationInput{
+		Title:         "Deck",
+		InitialSlides: []string{"BLANK"},
+	})
+	if !errors.Is(err, ErrAddSlideFailed) {
+		t.Errorf("expected ErrAddSlideFailed, got %v", err)
+	}
+}
+
+func TestSizePresetPageSize(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantWidth  float64
+		wantHeight float64
+	}{
+		{"16:9", 9144000, 5143500},
+		{"4:3", 9144000, 6858000},
+		{"16:10", 9144000, 5715000},
+		{"A4", 10692000, 7560000},
+		{"LETTER", 10058400, 7772400},
+		{"letter", 10058400, 7772400},
+		{" a4 ", 10692000, 7560000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, err := sizePresetPageSize(tt.name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if size.Width.Unit != "EMU" || size.Height.Unit != "EMU" {
+				t.Errorf("expected EMU dimensions, got %s x %s", size.Width.Unit, size.Height.Unit)
+			}
+			if size.Width.Magnitude != tt.wantWidth || size.Height.Magnitude != tt.wantHeight {
+				t.Errorf("expected %v x %v, got %v x %v", tt.wantWidth, tt.wantHeight, size.Width.Magnitude, size.Height.Magnitude)
+			}
+		})
+	}
+}
+
+func TestCreatePresentation_SizePreset(t *testing.T) {
+	var captured *slides.Presentation
+	mockSlidesService := &mockSlidesService{
+		CreatePresentationFunc: func(ctx context.Context, presentation *slides.Presentation) (*slides.Presentation, error) {
+			captured = presentation
+			return &slides.Presentation{PresentationId: "new-presentation-id", Title: presentation.Title}, nil
+		},
+	}
+
+	slidesFactory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlidesService, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, nil)
+
+	output, err := tools.CreatePresentation(context.Background(), &mockTokenSource{}, CreatePresentationInput{
+		Title:      "Deck",
+		SizePreset: "4:3",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.PageSize == nil || captured.PageSize.Width.Magnitude != 9144000 || captured.PageSize.Height.Magnitude != 6858000 {
+		t.Errorf("expected a 4:3 page size on the create request, got %+v", captured.PageSize)
+	}
+	if output.SizePreset != "4:3" {
+		t.Errorf("expected size preset '4:3', got '%s'", output.SizePreset)
+	}
+}
+
+func TestCreatePresentation_InvalidSizePreset(t *testing.T) {
+	tools := NewToolsWithDrive(DefaultToolsConfig(), nil, nil)
+
+	_, err := tools.CreatePresentation(context.Background(), &mockTokenSource{}, CreatePresentationInput{
+		Title:      "Deck",
+		SizePreset: "21:9",
+	})
+	if !errors.Is(err, ErrInvalidSizePreset) {
+		t.Errorf("expected ErrInvalidSizePreset, got %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for create_section_slide tool.
+var (
+	ErrCreateSectionSlideFailed = errors.New("failed to create section slide")
+)
+
+// sectionHeaderLayout is the predefined layout used for section slides.
+const sectionHeaderLayout = "SECTION_HEADER"
+
+// CreateSectionSlideInput represents the input for the create_section_slide tool.
+type CreateSectionSlideInput struct {
+	PresentationID string `json:"presentation_id"`
+	Title          string `json:"title"`
+	Subtitle       string `json:"subtitle,omitempty"`
+	Position       int    `json:"position,omitempty"` // 1-based position (0 or omitted = end)
+}
+
+// CreateSectionSlideOutput represents the output of the create_section_slide tool.
+type CreateSectionSlideOutput struct {
+	SlideIndex       int    `json:"slide_index"` // 1-based index of the new slide
+	SlideID          string `json:"slide_id"`
+	LayoutID         string `json:"layout_id"`
+	TitleObjectID    string `json:"title_object_id"`
+	SubtitleObjectID string `json:"subtitle_object_id,omitempty"` // Only set when a subtitle was inserted
+}
+
+// CreateSectionSlide inserts a SECTION_HEADER slide and fills its title and subtitle
+// placeholders in a single batch update.
+func (t *Tools) CreateSectionSlide(ctx context.Context, tokenSource oauth2.TokenSource, input CreateSectionSlideInput) (*CreateSectionSlideOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	if input.Title == "" {
+		return nil, fmt.Errorf("%w: title is required", ErrInvalidText)
+	}
+
+	t.config.Logger.Info("creating section slide",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("position", input.Position),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to find the layout and its placeholders
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// Unlike add_slide there is no fallback layout: the placeholders must be known up front
+	layout := findLayoutPageByType(presentation.Layouts, sectionHeaderLayout)
+	if layout == nil {
+		return nil, fmt.Errorf("%w: presentation has no %s layout", ErrInvalidLayout, sectionHeaderLayout)
+	}
+
+	titlePlaceholder := findPlaceholderByType(layout.PageElements, "TITLE")
+	if titlePlaceholder == nil {
+		return nil, fmt.Errorf("%w: %s layout has no title placeholder", ErrInvalidLayout, sectionHeaderLayout)
+	}
+
+	var subtitlePlaceholder *slides.PageElement
+	if input.Subtitle != "" {
+		subtitlePlaceholder = findPlaceholderByType(layout.PageElements, "SUBTITLE")
+		if subtitlePlaceholder == nil {
+			subtitlePlaceholder = findPlaceholderByType(layout.PageElements, "BODY")
+		}
+		if subtitlePlaceholder == nil {
+			return nil, fmt.Errorf("%w: %s layout has no subtitle or body placeholder", ErrInvalidLayout, sectionHeaderLayout)
+		}
+	}
+
+	insertionIndex := len(presentation.Slides)
+	if input.Position > 0 && input.Position <= len(presentation.Slides) {
+		insertionIndex = input.Position - 1
+	}
+
+	output := &CreateSectionSlideOutput{
+		SlideIndex:    insertionIndex + 1,
+		SlideID:       newObjectID("section"),
+		LayoutID:      layout.ObjectId,
+		TitleObjectID: newObjectID("section_title"),
+	}
+	if subtitlePlaceholder != nil {
+		output.SubtitleObjectID = newObjectID("section_subtitle")
+	}
+
+	requests := buildCreateSectionSlideRequests(output, insertionIndex, titlePlaceholder, subtitlePlaceholder, input)
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCreateSectionSlideFailed, err)
+	}
+
+	t.config.Logger.Info("section slide created successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("slide_id", output.SlideID),
+		slog.Int("slide_index", output.SlideIndex),
+	)
+
+	return output, nil
+}
+
+// buildCreateSectionSlideRequests creates the slide with its placeholders mapped to the IDs in
+// output, then inserts the texts into them.
+func buildCreateSectionSlideRequests(output *CreateSectionSlideOutput, insertionIndex int, titlePlaceholder, subtitlePlaceholder *slides.PageElement, input CreateSectionSlideInput) []*slides.Request {
+	createSlide := &slides.CreateSlideRequest{
+		ObjectId:             output.SlideID,
+		InsertionIndex:       int64(insertionIndex),
+		SlideLayoutReference: &slides.LayoutReference{LayoutId: output.LayoutID},
+		PlaceholderIdMappings: []*slides.LayoutPlaceholderIdMapping{
+			{LayoutPlaceholder: layoutPlaceholderRef(titlePlaceholder), ObjectId: output.TitleObjectID},
+		},
+	}
+	if subtitlePlaceholder != nil {
+		createSlide.PlaceholderIdMappings = append(createSlide.PlaceholderIdMappings, &slides.LayoutPlaceholderIdMapping{
+			LayoutPlaceholder: layoutPlaceholderRef(subtitlePlaceholder),
+			ObjectId:          output.SubtitleObjectID,
+		})
+	}
+
+	requests := []*slides.Request{
+		{CreateSlide: createSlide},
+		{InsertText: &slides.InsertTextRequest{ObjectId: output.TitleObjectID, Text: normalizeLineBreaks(input.Title, false)}},
+	}
+	if subtitlePlaceholder != nil {
+		requests = append(requests, &slides.Request{
+			InsertText: &slides.InsertTextRequest{ObjectId: output.SubtitleObjectID, Text: normalizeLineBreaks(input.Subtitle, false)},
+		})
+	}
+	return requests
+}
+
+// layoutPlaceholderRef identifies a layout placeholder for a placeholder ID mapping.
+func layoutPlaceholderRef(element *slides.PageElement) *slides.Placeholder {
+	placeholder := element.Shape.Placeholder
+	return &slides.Placeholder{Type: placeholder.Type, Index: placeholder.Index}
+}
+
+// findLayoutPageByType returns the layout page with the given predefined name.
+func findLayoutPageByType(layouts []*slides.Page, layoutType string) *slides.Page {
+	for _, layout := range layouts {
+		if layout.LayoutProperties != nil && layout.LayoutProperties.Name == layoutType {
+			return layout
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func newSectionSlideTestPresentation(withSectionLayout bool) *slides.Presentation {
+	placeholder := func(id, placeholderType string) *slides.PageElement {
+		return &slides.PageElement{ObjectId: id, Shape: &slides.Shape{
+			ShapeType:   "TEXT_BOX",
+			Placeholder: &slides.Placeholder{Type: placeholderType},
+		}}
+	}
+
+	layouts := []*slides.Page{
+		{
+			ObjectId:         "layout-title-body",
+			LayoutProperties: &slides.LayoutProperties{Name: "TITLE_AND_BODY"},
+			PageElements:     []*slides.PageElement{placeholder("lt-title", "TITLE"), placeholder("lt-body", "BODY")},
+		},
+	}
+	if withSectionLayout {
+		layouts = append(layouts, &slides.Page{
+			ObjectId:         "layout-section",
+			LayoutProperties: &slides.LayoutProperties{Name: "SECTION_HEADER"},
+			PageElements:     []*slides.PageElement{placeholder("ls-title", "TITLE"), placeholder("ls-subtitle", "SUBTITLE")},
+		})
+	}
+
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides:         []*slides.Page{{ObjectId: "slide-1"}, {ObjectId: "slide-2"}},
+		Layouts:        layouts,
+	}
+}
+
+func newSectionSlideTestTools(presentation *slides.Presentation, captured *[]*slides.Request, batchErr error) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*captured = requests
+			return &slides.BatchUpdatePresentationResponse{}, batchErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestCreateSectionSlide(t *testing.T) {
+	stubObjectIDSuffix(t, "abc123")
+
+	var captured []*slides.Request
+	tools := newSectionSlideTestTools(newSectionSlideTestPresentation(true), &captured, nil)
+
+	output, err := tools.CreateSectionSlide(context.Background(), nil, CreateSectionSlideInput{
+		PresentationID: "pres-1",
+		Title:          "Part 2",
+		Subtitle:       "Results",
+		Position:       2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := CreateSectionSlideOutput{
+		SlideIndex:       2,
+		SlideID:          "section_abc123",
+		LayoutID:         "layout-section",
+		TitleObjectID:    "section_title_abc123",
+		SubtitleObjectID: "section_subtitle_abc123",
+	}
+	if *output != want {
+		t.Errorf("output = %+v, want %+v", *output, want)
+	}
+
+	if len(captured) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(captured))
+	}
+
+	createSlide := captured[0].CreateSlide
+	if createSlide == nil {
+		t.Fatal("request 0: expected CreateSlide")
+	}
+	if createSlide.ObjectId != "section_abc123" || createSlide.InsertionIndex != 1 {
+		t.Errorf("unexpected slide ID or insertion index: %+v", createSlide)
+	}
+	if createSlide.SlideLayoutReference == nil || createSlide.SlideLayoutReference.LayoutId != "layout-section" {
+		t.Errorf("expected layout reference to layout-section, got %+v", createSlide.SlideLayoutReference)
+	}
+	if len(createSlide.PlaceholderIdMappings) != 2 {
+		t.Fatalf("expected 2 placeholder mappings, got %d", len(createSlide.PlaceholderIdMappings))
+	}
+	mappings := map[string]string{}
+	for _, mapping := range createSlide.PlaceholderIdMappings {
+		mappings[mapping.LayoutPlaceholder.Type] = mapping.ObjectId
+	}
+	if mappings["TITLE"] != "section_title_abc123" || mappings["SUBTITLE"] != "section_subtitle_abc123" {
+		t.Errorf("unexpected placeholder mappings: %v", mappings)
+	}
+
+	if req := captured[1].InsertText; req == nil || req.ObjectId != "section_title_abc123" || req.Text != "Part 2" {
+		t.Errorf("request 1: expected title insert, got %+v", captured[1])
+	}
+	if req := captured[2].InsertText; req == nil || req.ObjectId != "section_subtitle_abc123" || req.Text != "Results" {
+		t.Errorf("request 2: expected subtitle insert, got %+v", captured[2])
+	}
+}
+
+func TestCreateSectionSlide_TitleOnlyAppends(t *testing.T) {
+	stubObjectIDSuffix(t, "abc123")
+
+	var captured []*slides.Request
+	tools := newSectionSlideTestTools(newSectionSlideTestPresentation(true), &captured, nil)
+
+	output, err := tools.CreateSectionSlide(context.Background(), nil, CreateSectionSlideInput{
+		PresentationID: "pres-1",
+		Title:          "Appendix",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.SlideIndex != 3 || output.SubtitleObjectID != "" {
+		t.Errorf("expected slide 3 without subtitle, got %+v", output)
+	}
+	if len(captured) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(captured))
+	}
+	if got := len(captured[0].CreateSlide.PlaceholderIdMappings); got != 1 {
+		t.Errorf("expected 1 placeholder mapping, got %d", got)
+	}
+}
+
+func TestCreateSectionSlide_Errors(t *testing.T) {
+	valid := CreateSectionSlideInput{PresentationID: "pres-1", Title: "Part 2", Subtitle: "Results"}
+	tests := []struct {
+		name          string
+		input         CreateSectionSlideInput
+		sectionLayout bool
+		batchErr      error
+		wantErr       error
+	}{
+		{"missing presentation", CreateSectionSlideInput{Title: "Part 2"}, true, nil, ErrInvalidPresentationID},
+		{"missing title", CreateSectionSlideInput{PresentationID: "pres-1"}, true, nil, ErrInvalidText},
+		{"no section layout", valid, false, nil, ErrInvalidLayout},
+		{"batch update failure", valid, true, errors.New("boom"), ErrCreateSectionSlideFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []*slides.Request
+			tools := newSectionSlideTestTools(newSectionSlideTestPresentation(tt.sectionLayout), &captured, tt.batchErr)
+
+			_, err := tools.CreateSectionSlide(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
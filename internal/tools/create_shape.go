@@ -5,8 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"strings"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -14,27 +14,65 @@ import (
 
 // Sentinel errors for create_shape tool.
 var (
-	ErrCreateShapeFailed  = errors.New("failed to create shape")
-	ErrInvalidShapeType   = errors.New("invalid shape type")
-	ErrInvalidOutlineWeight = errors.New("outline weight must be positive")
+	ErrCreateShapeFailed      = errors.New("failed to create shape")
+	ErrInvalidShapeType       = errors.New("invalid shape type")
+	ErrInvalidOutlineWeight   = errors.New("outline weight must be positive")
+	ErrAdjustmentsUnsupported = errors.New("shape adjustments are not supported by the Slides API")
+	ErrInvalidTextInset       = errors.New("invalid text inset")
+	ErrInvalidOpacity         = errors.New("opacity must be between 0 and 1")
+	ErrInvalidOutlineState    = errors.New("invalid outline state")
 )
 
 // CreateShapeInput represents the input for the create_shape tool.
 type CreateShapeInput struct {
 	PresentationID string         `json:"presentation_id"`
-	SlideIndex     int            `json:"slide_index,omitempty"` // 1-based index
-	SlideID        string         `json:"slide_id,omitempty"`    // Alternative to slide_index
-	ShapeType      string         `json:"shape_type"`            // RECTANGLE, ELLIPSE, etc.
-	Position       *PositionInput `json:"position"`              // Position in points
-	Size           *SizeInput     `json:"size"`                  // Size in points
-	FillColor      string         `json:"fill_color,omitempty"`  // Hex color string (e.g., "#FF0000") or "transparent"
-	OutlineColor   string         `json:"outline_color,omitempty"` // Hex color string or "transparent"
+	SlideIndex     int            `json:"slide_index,omitempty"`    // 1-based index
+	SlideID        string         `json:"slide_id,omitempty"`       // Alternative to slide_index
+	ShapeType      string         `json:"shape_type"`               // RECTANGLE, ELLIPSE, etc.
+	Position       *PositionInput `json:"position"`                 // Position in points
+	Size           *SizeInput     `json:"size"`                     // Size in points
+	FillColor      string         `json:"fill_color,omitempty"`     // Hex color string (e.g., "#FF0000") or "transparent"
+	OutlineColor   string         `json:"outline_color,omitempty"`  // Hex color string or "transparent"
 	OutlineWeight  *float64       `json:"outline_weight,omitempty"` // Weight in points
+	OutlineState   string         `json:"outline_state,omitempty"`  // RENDERED, NOT_RENDERED or INHERIT, independent of color
+	Adjustments    []float64      `json:"adjustments,omitempty"`    // Rejected: the Slides API cannot set adjustment handles
+	TopInset       *float64       `json:"top_inset,omitempty"`      // Text padding in points
+	BottomInset    *float64       `json:"bottom_inset,omitempty"`   // Text padding in points
+	LeftInset      *float64       `json:"left_inset,omitempty"`     // Text padding in points
+	RightInset     *float64       `json:"right_inset,omitempty"`    // Text padding in points
+	Opacity        *float64       `json:"opacity,omitempty"`        // 0 (invisible) to 1 (opaque), applied to fill and outline
 }
 
 // CreateShapeOutput represents the output of the create_shape tool.
 type CreateShapeOutput struct {
-	ObjectID string `json:"object_id"`
+	ObjectID      string `json:"object_id"`
+	InsetsIgnored bool   `json:"insets_ignored,omitempty"` // True when text insets were given but not applied
+}
+
+// validateTextInsets checks that the given text insets are non-negative and reports whether
+// any inset was set.
+func validateTextInsets(top, bottom, left, right *float64) (bool, error) {
+	insets := []struct {
+		name  string
+		value *float64
+	}{
+		{"top_inset", top},
+		{"bottom_inset", bottom},
+		{"left_inset", left},
+		{"right_inset", right},
+	}
+
+	set := false
+	for _, inset := range insets {
+		if inset.value == nil {
+			continue
+		}
+		if *inset.value < 0 || math.IsNaN(*inset.value) {
+			return false, fmt.Errorf("%w: %s must be non-negative, got %g", ErrInvalidTextInset, inset.name, *inset.value)
+		}
+		set = true
+	}
+	return set, nil
 }
 
 // validShapeTypes contains the allowed shape types for the create_shape tool.
@@ -56,50 +94,50 @@ var validShapeTypes = map[string]bool{
 	"TRAPEZOID":       true,
 
 	// Star shapes
-	"STAR_4":          true,
-	"STAR_5":          true,
-	"STAR_6":          true,
-	"STAR_7":          true,
-	"STAR_8":          true,
-	"STAR_10":         true,
-	"STAR_12":         true,
-	"STAR_16":         true,
-	"STAR_24":         true,
-	"STAR_32":         true,
+	"STAR_4":  true,
+	"STAR_5":  true,
+	"STAR_6":  true,
+	"STAR_7":  true,
+	"STAR_8":  true,
+	"STAR_10": true,
+	"STAR_12": true,
+	"STAR_16": true,
+	"STAR_24": true,
+	"STAR_32": true,
 
 	// Arrow shapes
-	"ARROW_RIGHT":          true,
-	"ARROW_LEFT":           true,
-	"ARROW_UP":             true,
-	"ARROW_DOWN":           true,
-	"ARROW_LEFT_RIGHT":     true,
-	"ARROW_UP_DOWN":        true,
-	"NOTCHED_RIGHT_ARROW":  true,
-	"BENT_ARROW":           true,
-	"U_TURN_ARROW":         true,
-	"CURVED_RIGHT_ARROW":   true,
-	"CURVED_LEFT_ARROW":    true,
-	"CURVED_UP_ARROW":      true,
-	"CURVED_DOWN_ARROW":    true,
-	"STRIPED_RIGHT_ARROW":  true,
-	"CHEVRON":              true,
-	"HOME_PLATE":           true,
+	"ARROW_RIGHT":         true,
+	"ARROW_LEFT":          true,
+	"ARROW_UP":            true,
+	"ARROW_DOWN":          true,
+	"ARROW_LEFT_RIGHT":    true,
+	"ARROW_UP_DOWN":       true,
+	"NOTCHED_RIGHT_ARROW": true,
+	"BENT_ARROW":          true,
+	"U_TURN_ARROW":        true,
+	"CURVED_RIGHT_ARROW":  true,
+	"CURVED_LEFT_ARROW":   true,
+	"CURVED_UP_ARROW":     true,
+	"CURVED_DOWN_ARROW":   true,
+	"STRIPED_RIGHT_ARROW": true,
+	"CHEVRON":             true,
+	"HOME_PLATE":          true,
 
 	// Callout shapes
-	"RECTANGULAR_CALLOUT":        true,
+	"RECTANGULAR_CALLOUT":         true,
 	"ROUNDED_RECTANGULAR_CALLOUT": true,
-	"ELLIPTICAL_CALLOUT":         true,
-	"WEDGE_RECTANGLE_CALLOUT":    true,
-	"WEDGE_ROUND_RECT_CALLOUT":   true,
-	"WEDGE_ELLIPSE_CALLOUT":      true,
-	"CLOUD_CALLOUT":              true,
+	"ELLIPTICAL_CALLOUT":          true,
+	"WEDGE_RECTANGLE_CALLOUT":     true,
+	"WEDGE_ROUND_RECT_CALLOUT":    true,
+	"WEDGE_ELLIPSE_CALLOUT":       true,
+	"CLOUD_CALLOUT":               true,
 
 	// Process shapes
-	"QUAD_ARROW":            true,
-	"LEFT_RIGHT_UP_ARROW":   true,
-	"BENT_UP_ARROW":         true,
-	"LEFT_UP_ARROW":         true,
-	"CIRCULAR_ARROW":        true,
+	"QUAD_ARROW":          true,
+	"LEFT_RIGHT_UP_ARROW": true,
+	"BENT_UP_ARROW":       true,
+	"LEFT_UP_ARROW":       true,
+	"CIRCULAR_ARROW":      true,
 
 	// Flowchart shapes
 	"FLOWCHART_PROCESS":            true,
@@ -141,61 +179,58 @@ var validShapeTypes = map[string]bool{
 	"NOT_EQUAL": true,
 
 	// Block shapes
-	"CUBE":             true,
-	"CAN":              true,
-	"BEVEL":            true,
-	"FOLDED_CORNER":    true,
-	"SMILEY_FACE":      true,
-	"DONUT":            true,
-	"NO_SMOKING":       true,
-	"BLOCK_ARC":        true,
-	"HEART":            true,
-	"LIGHTNING_BOLT":   true,
-	"SUN":              true,
-	"MOON":             true,
-	"CLOUD":            true,
-	"ARC":              true,
-	"PLAQUE":           true,
-	"FRAME":            true,
-	"HALF_FRAME":       true,
-	"CORNER":           true,
-	"DIAGONAL_STRIPE":  true,
-	"CHORD":            true,
-	"PIE":              true,
-	"L_SHAPE":          true,
-	"CORNER_RIBBON":    true,
-	"RIBBON":           true,
-	"RIBBON_2":         true,
-	"WAVE":             true,
-	"DOUBLE_WAVE":      true,
-	"CROSS":            true,
-	"IRREGULAR_SEAL_1": true,
-	"IRREGULAR_SEAL_2": true,
-	"TEARDROP":         true,
-	"SNIP_1_RECTANGLE":      true,
-	"SNIP_2_SAME_RECTANGLE": true,
-	"SNIP_2_DIAGONAL_RECTANGLE": true,
-	"SNIP_ROUND_RECTANGLE":   true,
-	"ROUND_1_RECTANGLE":      true,
-	"ROUND_2_SAME_RECTANGLE": true,
+	"CUBE":                       true,
+	"CAN":                        true,
+	"BEVEL":                      true,
+	"FOLDED_CORNER":              true,
+	"SMILEY_FACE":                true,
+	"DONUT":                      true,
+	"NO_SMOKING":                 true,
+	"BLOCK_ARC":                  true,
+	"HEART":                      true,
+	"LIGHTNING_BOLT":             true,
+	"SUN":                        true,
+	"MOON":                       true,
+	"CLOUD":                      true,
+	"ARC":                        true,
+	"PLAQUE":                     true,
+	"FRAME":                      true,
+	"HALF_FRAME":                 true,
+	"CORNER":                     true,
+	"DIAGONAL_STRIPE":            true,
+	"CHORD":                      true,
+	"PIE":                        true,
+	"L_SHAPE":                    true,
+	"CORNER_RIBBON":              true,
+	"RIBBON":                     true,
+	"RIBBON_2":                   true,
+	"WAVE":                       true,
+	"DOUBLE_WAVE":                true,
+	"CROSS":                      true,
+	"IRREGULAR_SEAL_1":           true,
+	"IRREGULAR_SEAL_2":           true,
+	"TEARDROP":                   true,
+	"SNIP_1_RECTANGLE":           true,
+	"SNIP_2_SAME_RECTANGLE":      true,
+	"SNIP_2_DIAGONAL_RECTANGLE":  true,
+	"SNIP_ROUND_RECTANGLE":       true,
+	"ROUND_1_RECTANGLE":          true,
+	"ROUND_2_SAME_RECTANGLE":     true,
 	"ROUND_2_DIAGONAL_RECTANGLE": true,
 
 	// Bracket shapes
-	"LEFT_BRACKET":         true,
-	"RIGHT_BRACKET":        true,
-	"LEFT_BRACE":           true,
-	"RIGHT_BRACE":          true,
-	"LEFT_RIGHT_BRACKET":   true,
-	"BRACKET_PAIR":         true,
-	"BRACE_PAIR":           true,
+	"LEFT_BRACKET":       true,
+	"RIGHT_BRACKET":      true,
+	"LEFT_BRACE":         true,
+	"RIGHT_BRACE":        true,
+	"LEFT_RIGHT_BRACKET": true,
+	"BRACKET_PAIR":       true,
+	"BRACE_PAIR":         true,
 }
 
-// shapeTimeNowFunc allows overriding the time function for tests.
-var shapeTimeNowFunc = time.Now
-
 // generateShapeObjectID generates a unique object ID for a new shape element.
 func generateShapeObjectID() string {
-	return fmt.Sprintf("shape_%d", shapeTimeNowFunc().UnixNano())
+	return newObjectID("shape")
 }
 
 // CreateShape creates a new shape on a slide.
@@ -233,6 +268,26 @@ func (t *Tools) CreateShape(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, ErrInvalidOutlineWeight
 	}
 
+	// Neither CreateShape nor UpdateShapeProperties has a field for adjustment handles
+	if len(input.Adjustments) > 0 {
+		return nil, fmt.Errorf("%w: omit adjustments to create %s with its default geometry", ErrAdjustmentsUnsupported, shapeType)
+	}
+
+	if err := validateOpacity(input.Opacity); err != nil {
+		return nil, err
+	}
+
+	outlineState, err := normalizeOutlineState(input.OutlineState, input.OutlineColor)
+	if err != nil {
+		return nil, err
+	}
+	input.OutlineState = outlineState
+
+	insetsSet, err := validateTextInsets(input.TopInset, input.BottomInset, input.LeftInset, input.RightInset)
+	if err != nil {
+		return nil, err
+	}
+
 	t.config.Logger.Info("creating shape on slide",
 		slog.String("presentation_id", input.PresentationID),
 		slog.Int("slide_index", input.SlideIndex),
@@ -246,20 +301,8 @@ func (t *Tools) CreateShape(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
 	}
 
-	// Get the presentation to find the target slide
-	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
-	if err != nil {
-		if isNotFoundError(err) {
-			return nil, ErrPresentationNotFound
-		}
-		if isForbiddenError(err) {
-			return nil, ErrAccessDenied
-		}
-		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
-	}
-
 	// Find the target slide
-	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	slideID, _, err := t.resolveSlideID(ctx, slidesService, input.PresentationID, input.SlideIndex, input.SlideID)
 	if err != nil {
 		return nil, err
 	}
@@ -286,6 +329,14 @@ func (t *Tools) CreateShape(ctx context.Context, tokenSource oauth2.TokenSource,
 		ObjectID: objectID,
 	}
 
+	// ShapeProperties has no text inset fields, so report them as ignored
+	if insetsSet {
+		output.InsetsIgnored = true
+		t.config.Logger.Warn("text insets are not supported by the Slides API and were ignored",
+			slog.String("object_id", objectID),
+		)
+	}
+
 	t.config.Logger.Info("shape created successfully",
 		slog.String("presentation_id", input.PresentationID),
 		slog.String("object_id", output.ObjectID),
@@ -329,7 +380,7 @@ func buildCreateShapeRequests(objectID, slideID, shapeType string, input CreateS
 	requests = append(requests, createShapeRequest)
 
 	// Build shape properties update request if fill or outline is specified
-	shapePropertiesRequest := buildShapePropertiesRequest(objectID, input)
+	shapePropertiesRequest := withShapeOpacity(buildShapePropertiesRequest(objectID, input), objectID, input.Opacity)
 	if shapePropertiesRequest != nil {
 		requests = append(requests, shapePropertiesRequest)
 	}
@@ -411,6 +462,14 @@ func buildShapePropertiesRequest(objectID string, input CreateShapeInput) *slide
 		}
 	}
 
+	// Explicit outline state, set on its own field so the color is left untouched
+	if input.OutlineState != "" {
+		if shapeProperties.Outline == nil {
+			shapeProperties.Outline = &slides.Outline{}
+		}
+		fields = applyOutlineState(shapeProperties.Outline, input.OutlineState, fields)
+	}
+
 	if len(fields) == 0 {
 		return nil
 	}
@@ -423,3 +482,113 @@ func buildShapePropertiesRequest(objectID string, input CreateShapeInput) *slide
 		},
 	}
 }
+
+// validOutlineStates are the Outline.PropertyState values accepted as outline_state.
+var validOutlineStates = map[string]bool{
+	"RENDERED":     true,
+	"NOT_RENDERED": true,
+	"INHERIT":      true,
+}
+
+// normalizeOutlineState upper-cases and validates an optional outline state. A "transparent"
+// outline color already means NOT_RENDERED, so any other state alongside it is rejected.
+func normalizeOutlineState(state, outlineColor string) (string, error) {
+	state = strings.ToUpper(strings.TrimSpace(state))
+	if state == "" {
+		return "", nil
+	}
+	if !validOutlineStates[state] {
+		return "", fmt.Errorf("%w: '%s' must be RENDERED, NOT_RENDERED or INHERIT", ErrInvalidOutlineState, state)
+	}
+	if strings.EqualFold(strings.TrimSpace(outlineColor), "transparent") && state != "NOT_RENDERED" {
+		return "", fmt.Errorf("%w: outline_color 'transparent' conflicts with outline_state %s", ErrInvalidOutlineState, state)
+	}
+	return state, nil
+}
+
+// applyOutlineState sets the outline's property state and adds its field mask entry, unless the
+// fields already cover it.
+func applyOutlineState(outline *slides.Outline, state string, fields []string) []string {
+	outline.PropertyState = state
+	for _, field := range fields {
+		if field == "outline" || field == "outline.propertyState" {
+			return fields
+		}
+	}
+	return append(fields, "outline.propertyState")
+}
+
+// validateOpacity checks an optional opacity is within 0-1.
+func validateOpacity(opacity *float64) error {
+	if opacity != nil && (*opacity < 0 || *opacity > 1 || math.IsNaN(*opacity)) {
+		return fmt.Errorf("%w: got %g", ErrInvalidOpacity, *opacity)
+	}
+	return nil
+}
+
+// withShapeOpacity sets the alpha of both the solid fill and the outline fill of an
+// UpdateShapeProperties request, creating the request when there is none. A fill or outline
+// being made transparent in the same request is left alone. Returns the request unchanged
+// when opacity is nil.
+func withShapeOpacity(request *slides.Request, objectID string, opacity *float64) *slides.Request {
+	if opacity == nil {
+		return request
+	}
+	if request == nil {
+		request = &slides.Request{
+			UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
+				ObjectId:        objectID,
+				ShapeProperties: &slides.ShapeProperties{},
+			},
+		}
+	}
+
+	update := request.UpdateShapeProperties
+	props := update.ShapeProperties
+	var fields []string
+	if update.Fields != "" {
+		fields = strings.Split(update.Fields, ",")
+	}
+
+	// Alpha 0 must be sent explicitly or the API treats it as unset
+	withAlpha := func(fill *slides.SolidFill) *slides.SolidFill {
+		if fill == nil {
+			fill = &slides.SolidFill{}
+		}
+		fill.Alpha = *opacity
+		fill.ForceSendFields = append(fill.ForceSendFields, "Alpha")
+		return fill
+	}
+
+	if props.ShapeBackgroundFill == nil {
+		props.ShapeBackgroundFill = &slides.ShapeBackgroundFill{}
+	}
+	if props.ShapeBackgroundFill.PropertyState != "NOT_RENDERED" {
+		props.ShapeBackgroundFill.SolidFill = withAlpha(props.ShapeBackgroundFill.SolidFill)
+		fields = addFieldMask(fields, "shapeBackgroundFill.solidFill.alpha")
+	}
+
+	if props.Outline == nil {
+		props.Outline = &slides.Outline{}
+	}
+	if props.Outline.PropertyState != "NOT_RENDERED" {
+		if props.Outline.OutlineFill == nil {
+			props.Outline.OutlineFill = &slides.OutlineFill{}
+		}
+		props.Outline.OutlineFill.SolidFill = withAlpha(props.Outline.OutlineFill.SolidFill)
+		fields = addFieldMask(fields, "outline.outlineFill.solidFill.alpha")
+	}
+
+	update.Fields = strings.Join(fields, ",")
+	return request
+}
+
+// addFieldMask appends path to a field mask unless it, or one of its parents, is already listed.
+func addFieldMask(fields []string, path string) []string {
+	for _, field := range fields {
+		if field == path || strings.HasPrefix(path, field+".") {
+			return fields
+		}
+	}
+	return append(fields, path)
+}
@@ -2,23 +2,16 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
 )
 
 func TestCreateShape(t *testing.T) {
-	// Save original time function and restore after test
-	originalTimeFunc := shapeTimeNowFunc
-	shapeTimeNowFunc = func() time.Time {
-		return time.Unix(1234567890, 123456789)
-	}
-	defer func() {
-		shapeTimeNowFunc = originalTimeFunc
-	}()
+	stubObjectIDSuffix(t, "1234567890123456789")
 
 	tests := []struct {
 		name           string
@@ -336,10 +329,10 @@ func TestCreateShape(t *testing.T) {
 					},
 					BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
 						shape := requests[0].CreateShape
-						expectedWidth := 200 * 12700.0
-						expectedHeight := 100 * 12700.0
-						expectedX := 100 * 12700.0
-						expectedY := 50 * 12700.0
+						expectedWidth := 200 * emuPerPoint
+						expectedHeight := 100 * emuPerPoint
+						expectedX := 100 * emuPerPoint
+						expectedY := 50 * emuPerPoint
 
 						if shape.ElementProperties.Size.Width.Magnitude != expectedWidth {
 							t.Errorf("expected width %f EMU, got %f", expectedWidth, shape.ElementProperties.Size.Width.Magnitude)
@@ -873,14 +866,7 @@ func TestBuildShapePropertiesRequest(t *testing.T) {
 }
 
 func TestGenerateShapeObjectID(t *testing.T) {
-	originalFunc := shapeTimeNowFunc
-	defer func() {
-		shapeTimeNowFunc = originalFunc
-	}()
-
-	shapeTimeNowFunc = func() time.Time {
-		return time.Unix(1234567890, 987654321)
-	}
+	stubObjectIDSuffix(t, "1234567890987654321")
 
 	objectID := generateShapeObjectID()
 	expected := "shape_1234567890987654321"
@@ -915,3 +901,385 @@ func TestValidShapeTypes(t *testing.T) {
 }
 
 // Note: ptrFloat64 and containsString are already defined in other test files in this package
+
+func TestCreateShape_Adjustments(t *testing.T) {
+	tests := []struct {
+		name           string
+		adjustments    []float64
+		wantErr        error
+		wantBatchCalls int
+	}{
+		{name: "adjustments are rejected before any API call", adjustments: []float64{0.4, 0.3}, wantErr: ErrAdjustmentsUnsupported},
+		{name: "no adjustments creates the shape", wantBatchCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batchCalls := 0
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return &slides.Presentation{
+						PresentationId: presentationID,
+						Slides:         []*slides.Page{{ObjectId: "slide-1"}},
+					}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					batchCalls++
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			_, err := tools.CreateShape(context.Background(), &mockTokenSource{}, CreateShapeInput{
+				PresentationID: "test-presentation",
+				SlideIndex:     1,
+				ShapeType:      "ARROW_RIGHT",
+				Size:           &SizeInput{Width: 200, Height: 80},
+				Adjustments:    tt.adjustments,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if batchCalls != tt.wantBatchCalls {
+				t.Errorf("expected %d batch updates, got %d", tt.wantBatchCalls, batchCalls)
+			}
+		})
+	}
+}
+
+func TestCreateShapeToRequests_RejectsAdjustments(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	params := `{"slide_id":"slide-1","shape_type":"ARROW_RIGHT","size":{"width":200,"height":80},"adjustments":[0.4]}`
+	if _, _, err := tools.createShapeToRequests(json.RawMessage(params), "test-pres-id"); !errors.Is(err, ErrAdjustmentsUnsupported) {
+		t.Errorf("expected ErrAdjustmentsUnsupported, got %v", err)
+	}
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func TestValidateTextInsets(t *testing.T) {
+	tests := []struct {
+		name                     string
+		top, bottom, left, right *float64
+		wantSet                  bool
+		wantErr                  bool
+	}{
+		{name: "none set"},
+		{name: "zero is allowed", top: float64Ptr(0), wantSet: true},
+		{name: "all set", top: float64Ptr(4), bottom: float64Ptr(4), left: float64Ptr(7.2), right: float64Ptr(7.2), wantSet: true},
+		{name: "negative", left: float64Ptr(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := validateTextInsets(tt.top, tt.bottom, tt.left, tt.right)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidTextInset) {
+					t.Fatalf("expected ErrInvalidTextInset, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if set != tt.wantSet {
+				t.Errorf("set = %v, want %v", set, tt.wantSet)
+			}
+		})
+	}
+}
+
+func TestCreateShape_TextInsets(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.CreateShape(context.Background(), &mockTokenSource{}, CreateShapeInput{
+		PresentationID: "test-presentation",
+		SlideIndex:     1,
+		ShapeType:      "RECTANGLE",
+		Size:           &SizeInput{Width: 200, Height: 80},
+		TopInset:       float64Ptr(10),
+		LeftInset:      float64Ptr(12),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !output.InsetsIgnored {
+		t.Error("expected InsetsIgnored to be true")
+	}
+	// Only the shape itself is created; the API has no inset field to send
+	if len(capturedRequests) != 1 || capturedRequests[0].CreateShape == nil {
+		t.Errorf("expected a single CreateShape request, got %d requests", len(capturedRequests))
+	}
+
+	capturedRequests = nil
+	_, err = tools.CreateShape(context.Background(), &mockTokenSource{}, CreateShapeInput{
+		PresentationID: "test-presentation",
+		SlideIndex:     1,
+		ShapeType:      "RECTANGLE",
+		Size:           &SizeInput{Width: 200, Height: 80},
+		BottomInset:    float64Ptr(-2),
+	})
+	if !errors.Is(err, ErrInvalidTextInset) {
+		t.Fatalf("expected ErrInvalidTextInset, got %v", err)
+	}
+	if capturedRequests != nil {
+		t.Error("expected no batch update for invalid insets")
+	}
+}
+
+func TestWithShapeOpacity(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       CreateShapeInput
+		wantFields  string
+		wantFill    bool
+		wantOutline bool
+		wantFillRGB bool
+		wantLineRGB bool
+	}{
+		{
+			name:        "opacity alone sets both alphas",
+			input:       CreateShapeInput{Opacity: float64Ptr(0.4)},
+			wantFields:  "shapeBackgroundFill.solidFill.alpha,outline.outlineFill.solidFill.alpha",
+			wantFill:    true,
+			wantOutline: true,
+		},
+		{
+			name:        "opacity with colors keeps the colors",
+			input:       CreateShapeInput{FillColor: "#FF0000", OutlineColor: "#0000FF", Opacity: float64Ptr(0.4)},
+			wantFields:  "shapeBackgroundFill,outline.outlineFill.solidFill.color,outline.outlineFill.solidFill.alpha",
+			wantFill:    true,
+			wantOutline: true,
+			wantFillRGB: true,
+			wantLineRGB: true,
+		},
+		{
+			name:        "transparent fill is left alone",
+			input:       CreateShapeInput{FillColor: "transparent", Opacity: float64Ptr(0.4)},
+			wantFields:  "shapeBackgroundFill.propertyState,outline.outlineFill.solidFill.alpha",
+			wantOutline: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := withShapeOpacity(buildShapePropertiesRequest("shape-1", tt.input), "shape-1", tt.input.Opacity)
+			if request == nil || request.UpdateShapeProperties == nil {
+				t.Fatal("expected an UpdateShapeProperties request")
+			}
+			update := request.UpdateShapeProperties
+			if update.ObjectId != "shape-1" {
+				t.Errorf("ObjectId = %q, want shape-1", update.ObjectId)
+			}
+			if update.Fields != tt.wantFields {
+				t.Errorf("Fields = %q, want %q", update.Fields, tt.wantFields)
+			}
+
+			fill := update.ShapeProperties.ShapeBackgroundFill.SolidFill
+			if tt.wantFill {
+				if fill == nil || fill.Alpha != 0.4 {
+					t.Errorf("expected fill alpha 0.4, got %+v", fill)
+				}
+				if tt.wantFillRGB != (fill.Color != nil && fill.Color.RgbColor != nil) {
+					t.Errorf("unexpected fill color %+v", fill.Color)
+				}
+			} else if fill != nil {
+				t.Errorf("expected no solid fill, got %+v", fill)
+			}
+
+			line := update.ShapeProperties.Outline.OutlineFill
+			if tt.wantOutline {
+				if line == nil || line.SolidFill == nil || line.SolidFill.Alpha != 0.4 {
+					t.Errorf("expected outline alpha 0.4, got %+v", line)
+				}
+				if tt.wantLineRGB != (line.SolidFill.Color != nil && line.SolidFill.Color.RgbColor != nil) {
+					t.Errorf("unexpected outline color %+v", line.SolidFill.Color)
+				}
+			}
+		})
+	}
+
+	t.Run("zero opacity is sent explicitly", func(t *testing.T) {
+		request := withShapeOpacity(nil, "shape-1", float64Ptr(0))
+		fill := request.UpdateShapeProperties.ShapeProperties.ShapeBackgroundFill.SolidFill
+		if len(fill.ForceSendFields) != 1 || fill.ForceSendFields[0] != "Alpha" {
+			t.Errorf("expected Alpha to be force-sent, got %v", fill.ForceSendFields)
+		}
+	})
+
+	t.Run("nil opacity leaves the request unchanged", func(t *testing.T) {
+		if withShapeOpacity(nil, "shape-1", nil) != nil {
+			t.Error("expected no request")
+		}
+	})
+}
+
+func TestCreateShape_Opacity(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	input := CreateShapeInput{
+		PresentationID: "pres-1",
+		SlideIndex:     1,
+		ShapeType:      "RECTANGLE",
+		Size:           &SizeInput{Width: 100, Height: 50},
+		FillColor:      "#336699",
+		Opacity:        float64Ptr(0.25),
+	}
+	if _, err := tools.CreateShape(context.Background(), nil, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(capturedRequests) != 2 || capturedRequests[1].UpdateShapeProperties == nil {
+		t.Fatalf("expected CreateShape then UpdateShapeProperties, got %d requests", len(capturedRequests))
+	}
+	props := capturedRequests[1].UpdateShapeProperties.ShapeProperties
+	if props.ShapeBackgroundFill.SolidFill.Alpha != 0.25 || props.Outline.OutlineFill.SolidFill.Alpha != 0.25 {
+		t.Errorf("expected fill and outline alpha 0.25, got %g and %g",
+			props.ShapeBackgroundFill.SolidFill.Alpha, props.Outline.OutlineFill.SolidFill.Alpha)
+	}
+
+	for _, opacity := range []float64{-0.1, 1.5} {
+		input.Opacity = float64Ptr(opacity)
+		if _, err := tools.CreateShape(context.Background(), nil, input); !errors.Is(err, ErrInvalidOpacity) {
+			t.Errorf("opacity %g: expected ErrInvalidOpacity, got %v", opacity, err)
+		}
+	}
+}
+
+func TestBuildShapePropertiesRequest_OutlineState(t *testing.T) {
+	for _, state := range []string{"RENDERED", "NOT_RENDERED", "INHERIT"} {
+		t.Run(state, func(t *testing.T) {
+			req := buildShapePropertiesRequest("shape-1", CreateShapeInput{OutlineState: state})
+			if req == nil {
+				t.Fatal("expected a request for an outline state alone")
+			}
+			update := req.UpdateShapeProperties
+			if update.Fields != "outline.propertyState" {
+				t.Errorf("expected fields 'outline.propertyState', got %q", update.Fields)
+			}
+			if update.ShapeProperties.Outline.PropertyState != state {
+				t.Errorf("expected state %s, got %s", state, update.ShapeProperties.Outline.PropertyState)
+			}
+			if update.ShapeProperties.Outline.OutlineFill != nil {
+				t.Error("outline color must not change with an outline state alone")
+			}
+		})
+	}
+
+	t.Run("with color", func(t *testing.T) {
+		req := buildShapePropertiesRequest("shape-1", CreateShapeInput{OutlineColor: "#FF0000", OutlineState: "NOT_RENDERED"})
+		update := req.UpdateShapeProperties
+		if update.Fields != "outline.outlineFill.solidFill.color,outline.propertyState" {
+			t.Errorf("unexpected fields %q", update.Fields)
+		}
+		if update.ShapeProperties.Outline.PropertyState != "NOT_RENDERED" || update.ShapeProperties.Outline.OutlineFill == nil {
+			t.Errorf("expected a hidden outline keeping its new color, got %+v", update.ShapeProperties.Outline)
+		}
+	})
+
+	t.Run("with transparent color", func(t *testing.T) {
+		req := buildShapePropertiesRequest("shape-1", CreateShapeInput{OutlineColor: "transparent", OutlineState: "NOT_RENDERED"})
+		if got := req.UpdateShapeProperties.Fields; got != "outline.propertyState" {
+			t.Errorf("expected a single propertyState field, got %q", got)
+		}
+	})
+}
+
+func TestNormalizeOutlineState(t *testing.T) {
+	tests := []struct {
+		name         string
+		state        string
+		outlineColor string
+		want         string
+		wantErr      bool
+	}{
+		{"empty", "", "", "", false},
+		{"rendered", "RENDERED", "", "RENDERED", false},
+		{"lowercase", " not_rendered ", "", "NOT_RENDERED", false},
+		{"inherit with color", "inherit", "#FF0000", "INHERIT", false},
+		{"transparent and hidden", "NOT_RENDERED", "transparent", "NOT_RENDERED", false},
+		{"transparent but rendered", "RENDERED", "Transparent", "", true},
+		{"unknown state", "HIDDEN", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeOutlineState(tt.state, tt.outlineColor)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidOutlineState) {
+					t.Errorf("expected ErrInvalidOutlineState, got %v", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("got (%q, %v), want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateShape_OutlineState(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	input := CreateShapeInput{
+		PresentationID: "pres-1",
+		SlideIndex:     1,
+		ShapeType:      "RECTANGLE",
+		Size:           &SizeInput{Width: 100, Height: 50},
+		OutlineState:   "inherit",
+	}
+	if _, err := tools.CreateShape(context.Background(), nil, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(capturedRequests) != 2 || capturedRequests[1].UpdateShapeProperties == nil {
+		t.Fatalf("expected CreateShape then UpdateShapeProperties, got %d requests", len(capturedRequests))
+	}
+	if got := capturedRequests[1].UpdateShapeProperties.ShapeProperties.Outline.PropertyState; got != "INHERIT" {
+		t.Errorf("expected INHERIT, got %s", got)
+	}
+
+	input.OutlineState = "visible"
+	if _, err := tools.CreateShape(context.Background(), nil, input); !errors.Is(err, ErrInvalidOutlineState) {
+		t.Errorf("expected ErrInvalidOutlineState, got %v", err)
+	}
+}
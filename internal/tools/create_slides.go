@@ -0,0 +1,171 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for create_slides tool.
+var (
+	ErrCreateSlidesFailed = errors.New("failed to create slides")
+	ErrInvalidSlideSpecs  = errors.New("invalid slide specs")
+)
+
+// maxCreateSlides caps how many slides one create_slides call may add.
+const maxCreateSlides = 100
+
+// SlideSpec describes one slide to create.
+type SlideSpec struct {
+	Layout         string `json:"layout,omitempty"`           // Layout type (BLANK, TITLE, TITLE_AND_BODY, etc.)
+	LayoutObjectID string `json:"layout_object_id,omitempty"` // Specific layout in the deck (use this OR Layout)
+	Position       int    `json:"position,omitempty"`         // 1-based position at the time of insertion (0 or omitted = end)
+}
+
+// CreateSlidesInput represents the input for the create_slides tool.
+type CreateSlidesInput struct {
+	PresentationID string      `json:"presentation_id"`
+	Slides         []SlideSpec `json:"slides"` // Applied in order
+}
+
+// CreatedSlide describes one slide added by create_slides.
+type CreatedSlide struct {
+	SlideIndex int    `json:"slide_index"` // 1-based index once all slides are inserted
+	SlideID    string `json:"slide_id"`
+	LayoutID   string `json:"layout_id,omitempty"` // Empty when a predefined layout was used
+}
+
+// CreateSlidesOutput represents the output of the create_slides tool.
+type CreateSlidesOutput struct {
+	PresentationID string         `json:"presentation_id"`
+	SlideIDs       []string       `json:"slide_ids"` // In spec order
+	Slides         []CreatedSlide `json:"slides"`    // In spec order
+	TotalSlides    int            `json:"total_slides"`
+}
+
+// CreateSlides adds several slides in a single batch update. Specs are applied in order, so each
+// position refers to the deck as it is after the previous specs were inserted: creating two slides
+// at position 1 leaves the second spec's slide first.
+func (t *Tools) CreateSlides(ctx context.Context, tokenSource oauth2.TokenSource, input CreateSlidesInput) (*CreateSlidesOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if len(input.Slides) == 0 {
+		return nil, fmt.Errorf("%w: at least one slide is required", ErrInvalidSlideSpecs)
+	}
+	if len(input.Slides) > maxCreateSlides {
+		return nil, fmt.Errorf("%w: at most %d slides per call, got %d", ErrInvalidSlideSpecs, maxCreateSlides, len(input.Slides))
+	}
+	for i, spec := range input.Slides {
+		if err := validateAddSlideLayout(AddSlideInput{Layout: spec.Layout, LayoutObjectID: spec.LayoutObjectID}); err != nil {
+			return nil, fmt.Errorf("slides[%d]: %w", i, err)
+		}
+		if spec.Position < 0 {
+			return nil, fmt.Errorf("slides[%d]: %w: position must be 1 or greater", i, ErrInvalidPosition)
+		}
+	}
+
+	t.config.Logger.Info("creating slides in presentation",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("count", len(input.Slides)),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to determine number of slides and find layouts
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// order tracks the deck as the requests will leave it: -1 for existing slides, otherwise
+	// the index of the spec that created the slide.
+	order := make([]int, len(presentation.Slides))
+	for i := range order {
+		order[i] = -1
+	}
+
+	requests := make([]*slides.Request, 0, len(input.Slides))
+	layoutIDs := make([]string, 0, len(input.Slides))
+	for i, spec := range input.Slides {
+		layoutReference, err := t.resolveSlideLayout(presentation, spec.Layout, spec.LayoutObjectID)
+		if err != nil {
+			return nil, fmt.Errorf("slides[%d]: %w", i, err)
+		}
+
+		insertionIndex := createSlidesInsertionIndex(spec.Position, len(order))
+		order = append(order[:insertionIndex], append([]int{i}, order[insertionIndex:]...)...)
+
+		requests = append(requests, &slides.Request{
+			CreateSlide: &slides.CreateSlideRequest{
+				InsertionIndex:       int64(insertionIndex),
+				SlideLayoutReference: layoutReference,
+			},
+		})
+		layoutIDs = append(layoutIDs, layoutReference.LayoutId)
+	}
+
+	// Execute batch update
+	response, err := slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCreateSlidesFailed, err)
+	}
+
+	output := &CreateSlidesOutput{
+		PresentationID: input.PresentationID,
+		SlideIDs:       make([]string, len(input.Slides)),
+		Slides:         make([]CreatedSlide, len(input.Slides)),
+		TotalSlides:    len(order),
+	}
+	for i := range input.Slides {
+		var slideID string
+		if response != nil && i < len(response.Replies) && response.Replies[i].CreateSlide != nil {
+			slideID = response.Replies[i].CreateSlide.ObjectId
+		}
+		output.SlideIDs[i] = slideID
+		output.Slides[i] = CreatedSlide{SlideID: slideID, LayoutID: layoutIDs[i]}
+	}
+	for position, specIndex := range order {
+		if specIndex >= 0 {
+			output.Slides[specIndex].SlideIndex = position + 1
+		}
+	}
+
+	t.config.Logger.Info("slides created successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("count", len(output.SlideIDs)),
+		slog.Int("total_slides", output.TotalSlides),
+	)
+
+	return output, nil
+}
+
+// createSlidesInsertionIndex converts a 1-based position into a 0-based insertion index for a deck
+// of numSlides slides. Positions of 0 or past the end append the slide.
+func createSlidesInsertionIndex(position, numSlides int) int {
+	if position <= 0 || position > numSlides {
+		return numSlides
+	}
+	return position - 1
+}
@@ -0,0 +1,163 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newCreateSlidesTestTools(getErr, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides:         []*slides.Page{{ObjectId: "existing-1"}, {ObjectId: "existing-2"}},
+		Layouts: []*slides.Page{
+			{ObjectId: "layout-title", LayoutProperties: &slides.LayoutProperties{Name: "TITLE"}},
+			{ObjectId: "layout-body", LayoutProperties: &slides.LayoutProperties{Name: "TITLE_AND_BODY"}},
+			{ObjectId: "layout-custom", LayoutProperties: &slides.LayoutProperties{Name: "CUSTOM_1"}},
+		},
+	}
+
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*batchCalls++
+			*captured = requests
+			if batchErr != nil {
+				return nil, batchErr
+			}
+			response := &slides.BatchUpdatePresentationResponse{}
+			for i := range requests {
+				response.Replies = append(response.Replies, &slides.Response{
+					CreateSlide: &slides.CreateSlideResponse{ObjectId: fmt.Sprintf("new-%d", i+1)},
+				})
+			}
+			return response, nil
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestCreateSlides_ThreeLayoutsInOneBatch(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newCreateSlidesTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.CreateSlides(context.Background(), nil, CreateSlidesInput{
+		PresentationID: "pres-1",
+		Slides: []SlideSpec{
+			{Layout: "TITLE", Position: 1},
+			{Layout: "TITLE_AND_BODY"},
+			{LayoutObjectID: "layout-custom", Position: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 || len(captured) != 3 {
+		t.Fatalf("expected 3 requests in a single batch, got %d calls and %d requests", batchCalls, len(captured))
+	}
+
+	want := []struct {
+		insertionIndex int64
+		layoutID       string
+	}{
+		{0, "layout-title"},  // Before both existing slides
+		{3, "layout-body"},   // End of the 3-slide deck
+		{1, "layout-custom"}, // Between the first new slide and existing-1
+	}
+	for i, w := range want {
+		create := captured[i].CreateSlide
+		if create == nil || create.InsertionIndex != w.insertionIndex || create.SlideLayoutReference.LayoutId != w.layoutID {
+			t.Errorf("request %d = %+v, want index %d layout %s", i, create, w.insertionIndex, w.layoutID)
+		}
+	}
+
+	wantIDs := []string{"new-1", "new-2", "new-3"}
+	wantIndices := []int{1, 5, 2} // Final deck: new-1, new-3, existing-1, existing-2, new-2
+	for i := range wantIDs {
+		if output.SlideIDs[i] != wantIDs[i] || output.Slides[i].SlideID != wantIDs[i] {
+			t.Errorf("slide %d: expected ID %s, got %s / %s", i, wantIDs[i], output.SlideIDs[i], output.Slides[i].SlideID)
+		}
+		if output.Slides[i].SlideIndex != wantIndices[i] {
+			t.Errorf("slide %d: expected final index %d, got %d", i, wantIndices[i], output.Slides[i].SlideIndex)
+		}
+		if output.Slides[i].LayoutID != want[i].layoutID {
+			t.Errorf("slide %d: expected layout %s, got %s", i, want[i].layoutID, output.Slides[i].LayoutID)
+		}
+	}
+	if output.TotalSlides != 5 {
+		t.Errorf("expected 5 slides in total, got %d", output.TotalSlides)
+	}
+}
+
+func TestCreateSlides_Errors(t *testing.T) {
+	tooMany := make([]SlideSpec, maxCreateSlides+1)
+	for i := range tooMany {
+		tooMany[i] = SlideSpec{Layout: "BLANK"}
+	}
+
+	tests := []struct {
+		name     string
+		input    CreateSlidesInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", CreateSlidesInput{Slides: []SlideSpec{{Layout: "BLANK"}}}, nil, nil, ErrInvalidPresentationID},
+		{"no slides", CreateSlidesInput{PresentationID: "pres-1"}, nil, nil, ErrInvalidSlideSpecs},
+		{"too many slides", CreateSlidesInput{PresentationID: "pres-1", Slides: tooMany}, nil, nil, ErrInvalidSlideSpecs},
+		{"missing layout", CreateSlidesInput{PresentationID: "pres-1", Slides: []SlideSpec{{Layout: "BLANK"}, {}}}, nil, nil, ErrInvalidLayout},
+		{"unsupported layout", CreateSlidesInput{PresentationID: "pres-1", Slides: []SlideSpec{{Layout: "FANCY"}}}, nil, nil, ErrInvalidLayout},
+		{"both layouts", CreateSlidesInput{PresentationID: "pres-1", Slides: []SlideSpec{{Layout: "TITLE", LayoutObjectID: "layout-title"}}}, nil, nil, ErrInvalidLayout},
+		{"unknown layout object", CreateSlidesInput{PresentationID: "pres-1", Slides: []SlideSpec{{LayoutObjectID: "nope"}}}, nil, nil, ErrInvalidLayout},
+		{"negative position", CreateSlidesInput{PresentationID: "pres-1", Slides: []SlideSpec{{Layout: "BLANK", Position: -1}}}, nil, nil, ErrInvalidPosition},
+		{"presentation not found", CreateSlidesInput{PresentationID: "pres-1", Slides: []SlideSpec{{Layout: "BLANK"}}}, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", CreateSlidesInput{PresentationID: "pres-1", Slides: []SlideSpec{{Layout: "BLANK"}}}, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", CreateSlidesInput{PresentationID: "pres-1", Slides: []SlideSpec{{Layout: "BLANK"}}}, nil, errors.New("boom"), ErrCreateSlidesFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newCreateSlidesTestTools(tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.CreateSlides(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", batchCalls)
+			}
+		})
+	}
+}
+
+func TestCreateSlidesInsertionIndex(t *testing.T) {
+	tests := []struct {
+		position, numSlides, want int
+	}{
+		{0, 3, 3},
+		{1, 3, 0},
+		{3, 3, 2},
+		{4, 3, 3},
+		{10, 3, 3},
+		{1, 0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := createSlidesInsertionIndex(tt.position, tt.numSlides); got != tt.want {
+			t.Errorf("createSlidesInsertionIndex(%d, %d) = %d, want %d", tt.position, tt.numSlides, got, tt.want)
+		}
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"time"
+	"strings"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -13,35 +13,42 @@ import (
 
 // Sentinel errors for create_table tool.
 var (
-	ErrCreateTableFailed = errors.New("failed to create table")
-	ErrInvalidRowCount   = errors.New("rows must be at least 1")
-	ErrInvalidColCount   = errors.New("columns must be at least 1")
+	ErrCreateTableFailed  = errors.New("failed to create table")
+	ErrInvalidRowCount    = errors.New("rows must be at least 1")
+	ErrInvalidColCount    = errors.New("columns must be at least 1")
+	ErrInvalidHeaderStyle = errors.New("invalid header style")
 )
 
 // CreateTableInput represents the input for the create_table tool.
 type CreateTableInput struct {
-	PresentationID string         `json:"presentation_id"`
-	SlideIndex     int            `json:"slide_index,omitempty"` // 1-based index
-	SlideID        string         `json:"slide_id,omitempty"`    // Alternative to slide_index
-	Rows           int            `json:"rows"`                  // Number of rows (min 1)
-	Columns        int            `json:"columns"`               // Number of columns (min 1)
-	Position       *PositionInput `json:"position,omitempty"`    // Position in points
-	Size           *SizeInput     `json:"size,omitempty"`        // Size in points
+	PresentationID string            `json:"presentation_id"`
+	SlideIndex     int               `json:"slide_index,omitempty"`  // 1-based index
+	SlideID        string            `json:"slide_id,omitempty"`     // Alternative to slide_index
+	Rows           int               `json:"rows"`                   // Number of rows (min 1)
+	Columns        int               `json:"columns"`                // Number of columns (min 1)
+	Position       *PositionInput    `json:"position,omitempty"`     // Position in points
+	Size           *SizeInput        `json:"size,omitempty"`         // Size in points
+	HeaderStyle    *TableHeaderStyle `json:"header_style,omitempty"` // Optional styling for row 0
+}
+
+// TableHeaderStyle styles the first row of a new table.
+type TableHeaderStyle struct {
+	BackgroundColor string `json:"background_color,omitempty"` // Hex fill for header cells
+	TextColor       string `json:"text_color,omitempty"`       // Hex color for header text
+	Bold            *bool  `json:"bold,omitempty"`             // Default true
 }
 
 // CreateTableOutput represents the output of the create_table tool.
 type CreateTableOutput struct {
-	ObjectID string `json:"object_id"`
-	Rows     int    `json:"rows"`
-	Columns  int    `json:"columns"`
+	ObjectID     string   `json:"object_id"`
+	Rows         int      `json:"rows"`
+	Columns      int      `json:"columns"`
+	HeaderStyles []string `json:"header_styles,omitempty"` // Styles applied to row 0
 }
 
-// tableTimeNowFunc allows overriding the time function for tests.
-var tableTimeNowFunc = time.Now
-
 // generateTableObjectID generates a unique object ID for a new table element.
 func generateTableObjectID() string {
-	return fmt.Sprintf("table_%d", tableTimeNowFunc().UnixNano())
+	return newObjectID("table")
 }
 
 // CreateTable creates a new table on a slide.
@@ -68,6 +75,10 @@ func (t *Tools) CreateTable(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, ErrInvalidSize
 	}
 
+	if err := validateTableHeaderStyle(input.HeaderStyle); err != nil {
+		return nil, err
+	}
+
 	// Default position to (0, 0) if not provided
 	if input.Position == nil {
 		input.Position = &PositionInput{X: 0, Y: 0}
@@ -87,20 +98,8 @@ func (t *Tools) CreateTable(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
 	}
 
-	// Get the presentation to find the target slide
-	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
-	if err != nil {
-		if isNotFoundError(err) {
-			return nil, ErrPresentationNotFound
-		}
-		if isForbiddenError(err) {
-			return nil, ErrAccessDenied
-		}
-		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
-	}
-
 	// Find the target slide
-	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	slideID, _, err := t.resolveSlideID(ctx, slidesService, input.PresentationID, input.SlideIndex, input.SlideID)
 	if err != nil {
 		return nil, err
 	}
@@ -108,8 +107,10 @@ func (t *Tools) CreateTable(ctx context.Context, tokenSource oauth2.TokenSource,
 	// Generate a unique object ID for the table
 	objectID := generateTableObjectID()
 
-	// Build the requests for creating the table
+	// Build the requests for creating the table, then style its header row
 	requests := buildCreateTableRequests(objectID, slideID, input)
+	headerRequests, headerStyles := buildTableHeaderRequests(objectID, input.Columns, nil, input.HeaderStyle)
+	requests = append(requests, headerRequests...)
 
 	// Execute batch update
 	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
@@ -124,9 +125,10 @@ func (t *Tools) CreateTable(ctx context.Context, tokenSource oauth2.TokenSource,
 	}
 
 	output := &CreateTableOutput{
-		ObjectID: objectID,
-		Rows:     input.Rows,
-		Columns:  input.Columns,
+		ObjectID:     objectID,
+		Rows:         input.Rows,
+		Columns:      input.Columns,
+		HeaderStyles: headerStyles,
 	}
 
 	t.config.Logger.Info("table created successfully",
@@ -184,3 +186,94 @@ func buildCreateTableRequests(objectID, slideID string, input CreateTableInput)
 
 	return requests
 }
+
+// validateTableHeaderStyle checks the header colors parse as hex.
+func validateTableHeaderStyle(style *TableHeaderStyle) error {
+	if style == nil {
+		return nil
+	}
+	if style.BackgroundColor != "" && parseHexColor(style.BackgroundColor) == nil {
+		return fmt.Errorf("%w: background_color '%s' is not a hex color", ErrInvalidHeaderStyle, style.BackgroundColor)
+	}
+	if style.TextColor != "" && parseHexColor(style.TextColor) == nil {
+		return fmt.Errorf("%w: text_color '%s' is not a hex color", ErrInvalidHeaderStyle, style.TextColor)
+	}
+	return nil
+}
+
+// buildTableHeaderRequests styles every cell of row 0: one UpdateTableCellProperties for the
+// fill and one UpdateTextStyle per cell for bold and text color. headerRow holds the header
+// text when known; cells it leaves empty get no text style since they have no text to style.
+// It must be appended after any requests that insert the header text.
+func buildTableHeaderRequests(tableID string, columns int, headerRow []string, style *TableHeaderStyle) ([]*slides.Request, []string) {
+	if style == nil {
+		return nil, nil
+	}
+
+	var requests []*slides.Request
+	var appliedStyles []string
+
+	if color := parseHexColor(style.BackgroundColor); color != nil {
+		requests = append(requests, &slides.Request{
+			UpdateTableCellProperties: &slides.UpdateTableCellPropertiesRequest{
+				ObjectId: tableID,
+				TableRange: &slides.TableRange{
+					Location: &slides.TableCellLocation{
+						RowIndex:        0,
+						ColumnIndex:     0,
+						ForceSendFields: []string{"RowIndex", "ColumnIndex"},
+					},
+					RowSpan:    1,
+					ColumnSpan: int64(columns),
+				},
+				TableCellProperties: &slides.TableCellProperties{
+					TableCellBackgroundFill: &slides.TableCellBackgroundFill{
+						SolidFill: &slides.SolidFill{
+							Color: &slides.OpaqueColor{RgbColor: color},
+						},
+					},
+				},
+				Fields: "tableCellBackgroundFill.solidFill.color",
+			},
+		})
+		appliedStyles = append(appliedStyles, fmt.Sprintf("background_color=%s", style.BackgroundColor))
+	}
+
+	bold := true
+	if style.Bold != nil {
+		bold = *style.Bold
+	}
+	textStyle := &slides.TextStyle{Bold: bold, ForceSendFields: []string{"Bold"}}
+	fields := []string{"bold"}
+	if color := parseHexColor(style.TextColor); color != nil {
+		textStyle.ForegroundColor = &slides.OptionalColor{
+			OpaqueColor: &slides.OpaqueColor{RgbColor: color},
+		}
+		fields = append(fields, "foregroundColor")
+	}
+
+	for col := 0; col < columns; col++ {
+		if headerRow != nil && (col >= len(headerRow) || headerRow[col] == "") {
+			continue
+		}
+		requests = append(requests, &slides.Request{
+			UpdateTextStyle: &slides.UpdateTextStyleRequest{
+				ObjectId: tableID,
+				CellLocation: &slides.TableCellLocation{
+					RowIndex:        0,
+					ColumnIndex:     int64(col),
+					ForceSendFields: []string{"RowIndex", "ColumnIndex"},
+				},
+				TextRange: &slides.Range{Type: "ALL"},
+				Style:     textStyle,
+				Fields:    strings.Join(fields, ","),
+			},
+		})
+	}
+	appliedStyles = append(appliedStyles, fmt.Sprintf("bold=%t", bold))
+	if len(fields) > 1 {
+		appliedStyles = append(appliedStyles, fmt.Sprintf("text_color=%s", style.TextColor))
+	}
+
+	return requests, appliedStyles
+}
@@ -0,0 +1,201 @@
+package tools
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for create_table_from_csv tool.
+var (
+	ErrMalformedCSV = errors.New("malformed CSV")
+)
+
+// CreateTableFromCSVInput represents the input for the create_table_from_csv tool.
+type CreateTableFromCSVInput struct {
+	PresentationID string            `json:"presentation_id"`
+	SlideIndex     int               `json:"slide_index,omitempty"`  // 1-based index
+	SlideID        string            `json:"slide_id,omitempty"`     // Alternative to slide_index
+	CSV            string            `json:"csv"`                    // One table row per record
+	Delimiter      string            `json:"delimiter,omitempty"`    // Single character, default ","
+	Position       *PositionInput    `json:"position,omitempty"`     // Position in points
+	Size           *SizeInput        `json:"size,omitempty"`         // Size in points
+	HeaderStyle    *TableHeaderStyle `json:"header_style,omitempty"` // Optional styling for the first record
+}
+
+// CreateTableFromCSVOutput represents the output of the create_table_from_csv tool.
+type CreateTableFromCSVOutput struct {
+	ObjectID      string   `json:"object_id"`
+	Rows          int      `json:"rows"`
+	Columns       int      `json:"columns"`
+	CellsWithText int      `json:"cells_with_text"`
+	HeaderStyles  []string `json:"header_styles,omitempty"` // Styles applied to row 0
+}
+
+// CreateTableFromCSV creates a table on a slide and fills it from CSV data.
+func (t *Tools) CreateTableFromCSV(ctx context.Context, tokenSource oauth2.TokenSource, input CreateTableFromCSVInput) (*CreateTableFromCSVOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	if input.SlideIndex == 0 && input.SlideID == "" {
+		return nil, ErrInvalidSlideReference
+	}
+
+	if input.Size != nil && (input.Size.Width <= 0 || input.Size.Height <= 0) {
+		return nil, ErrInvalidSize
+	}
+
+	if err := validateTableHeaderStyle(input.HeaderStyle); err != nil {
+		return nil, err
+	}
+
+	records, err := parseTableCSV(input.CSV, input.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Position == nil {
+		input.Position = &PositionInput{X: 0, Y: 0}
+	}
+
+	tableInput := CreateTableInput{
+		Rows:     len(records),
+		Columns:  len(records[0]),
+		Position: input.Position,
+		Size:     input.Size,
+	}
+
+	t.config.Logger.Info("creating table from CSV",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+		slog.Int("rows", tableInput.Rows),
+		slog.Int("columns", tableInput.Columns),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to find the target slide
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the table and fill it in the same batch
+	objectID := generateTableObjectID()
+	requests := buildCreateTableRequests(objectID, slideID, tableInput)
+	cellRequests := buildTableCellTextRequests(objectID, records)
+	requests = append(requests, cellRequests...)
+	headerRequests, headerStyles := buildTableHeaderRequests(objectID, tableInput.Columns, records[0], input.HeaderStyle)
+	requests = append(requests, headerRequests...)
+
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCreateTableFailed, err)
+	}
+
+	output := &CreateTableFromCSVOutput{
+		ObjectID:      objectID,
+		Rows:          tableInput.Rows,
+		Columns:       tableInput.Columns,
+		CellsWithText: len(cellRequests),
+		HeaderStyles:  headerStyles,
+	}
+
+	t.config.Logger.Info("table created from CSV successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("object_id", output.ObjectID),
+		slog.Int("rows", output.Rows),
+		slog.Int("columns", output.Columns),
+	)
+
+	return output, nil
+}
+
+// parseTableCSV parses CSV data into rows of cells, requiring at least one row and
+// the same number of fields on every row.
+func parseTableCSV(data, delimiter string) ([][]string, error) {
+	if strings.TrimSpace(data) == "" {
+		return nil, fmt.Errorf("%w: csv is empty", ErrMalformedCSV)
+	}
+
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = 0 // All records must match the first one
+
+	if delimiter != "" {
+		comma, size := utf8.DecodeRuneInString(delimiter)
+		if size != len(delimiter) || comma == '"' || comma == '\r' || comma == '\n' || comma == utf8.RuneError {
+			return nil, fmt.Errorf("%w: delimiter must be a single character other than a quote or newline", ErrMalformedCSV)
+		}
+		reader.Comma = comma
+	}
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+			return nil, fmt.Errorf("%w: record on line %d has a different number of columns than the first row", ErrMalformedCSV, parseErr.StartLine)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrMalformedCSV, err)
+	}
+
+	if len(records) == 0 || len(records[0]) == 0 {
+		return nil, fmt.Errorf("%w: csv has no rows", ErrMalformedCSV)
+	}
+
+	return records, nil
+}
+
+// buildTableCellTextRequests creates one InsertText request per non-empty cell.
+func buildTableCellTextRequests(tableID string, records [][]string) []*slides.Request {
+	var requests []*slides.Request
+	for rowIdx, record := range records {
+		for colIdx, value := range record {
+			if value == "" {
+				continue
+			}
+			requests = append(requests, &slides.Request{
+				InsertText: &slides.InsertTextRequest{
+					ObjectId: tableID,
+					CellLocation: &slides.TableCellLocation{
+						RowIndex:    int64(rowIdx),
+						ColumnIndex: int64(colIdx),
+					},
+					Text:           value,
+					InsertionIndex: 0,
+				},
+			})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,225 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestCreateTableFromCSV(t *testing.T) {
+	stubObjectIDSuffix(t, "csv")
+
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.CreateTableFromCSV(context.Background(), &mockTokenSource{}, CreateTableFromCSVInput{
+		PresentationID: "pres",
+		SlideIndex:     1,
+		CSV:            "Region;Q1;Q2\nNorth;10;\n\"South; East\";7;9\n",
+		Delimiter:      ";",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.ObjectID != "table_csv" || output.Rows != 3 || output.Columns != 3 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+	if output.CellsWithText != 8 {
+		t.Errorf("CellsWithText = %d, want 8 (one empty cell)", output.CellsWithText)
+	}
+
+	createTable := capturedRequests[0].CreateTable
+	if createTable == nil || createTable.Rows != 3 || createTable.Columns != 3 || createTable.ElementProperties.PageObjectId != "slide-1" {
+		t.Fatalf("expected a 3x3 CreateTable on slide-1 first, got %+v", capturedRequests[0])
+	}
+
+	cells := make(map[[2]int64]string)
+	for _, req := range capturedRequests[1:] {
+		insert := req.InsertText
+		if insert == nil || insert.ObjectId != "table_csv" {
+			t.Fatalf("expected InsertText into the table, got %+v", req)
+		}
+		cells[[2]int64{insert.CellLocation.RowIndex, insert.CellLocation.ColumnIndex}] = insert.Text
+	}
+
+	want := map[[2]int64]string{
+		{0, 0}: "Region", {0, 1}: "Q1", {0, 2}: "Q2",
+		{1, 0}: "North", {1, 1}: "10",
+		{2, 0}: "South; East", {2, 1}: "7", {2, 2}: "9",
+	}
+	for location, text := range want {
+		if cells[location] != text {
+			t.Errorf("cell %v = %q, want %q", location, cells[location], text)
+		}
+	}
+	if _, ok := cells[[2]int64{1, 2}]; ok {
+		t.Error("expected no InsertText for the empty cell")
+	}
+}
+
+func TestParseTableCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		delimiter string
+		wantRows  int
+		wantCols  int
+		wantErr   error
+	}{
+		{name: "comma default", data: "a,b\nc,d", wantRows: 2, wantCols: 2},
+		{name: "tab delimiter", data: "a\tb\tc", delimiter: "\t", wantRows: 1, wantCols: 3},
+		{name: "quoted newline stays in cell", data: "\"a\nb\",c", wantRows: 1, wantCols: 2},
+		{name: "empty", data: "  \n", wantErr: ErrMalformedCSV},
+		{name: "ragged rows", data: "a,b\nc\n", wantErr: ErrMalformedCSV},
+		{name: "multi-character delimiter", data: "a::b", delimiter: "::", wantErr: ErrMalformedCSV},
+		{name: "quote delimiter", data: "a\"b", delimiter: "\"", wantErr: ErrMalformedCSV},
+		{name: "bare quote", data: "a,b\"c\n", wantErr: ErrMalformedCSV},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := parseTableCSV(tt.data, tt.delimiter)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(records) != tt.wantRows || len(records[0]) != tt.wantCols {
+				t.Errorf("got %dx%d, want %dx%d", len(records), len(records[0]), tt.wantRows, tt.wantCols)
+			}
+		})
+	}
+}
+
+func TestCreateTableFromCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CreateTableFromCSVInput
+		getErr  error
+		wantErr error
+	}{
+		{name: "missing presentation ID", input: CreateTableFromCSVInput{SlideIndex: 1, CSV: "a"}, wantErr: ErrInvalidPresentationID},
+		{name: "missing slide reference", input: CreateTableFromCSVInput{PresentationID: "pres", CSV: "a"}, wantErr: ErrInvalidSlideReference},
+		{name: "ragged CSV", input: CreateTableFromCSVInput{PresentationID: "pres", SlideIndex: 1, CSV: "a,b\nc"}, wantErr: ErrMalformedCSV},
+		{name: "slide not found", input: CreateTableFromCSVInput{PresentationID: "pres", SlideIndex: 5, CSV: "a"}, wantErr: ErrSlideNotFound},
+		{name: "presentation not found", input: CreateTableFromCSVInput{PresentationID: "pres", SlideIndex: 1, CSV: "a"}, getErr: errors.New("googleapi: Error 404: not found"), wantErr: ErrPresentationNotFound},
+		{name: "invalid header color", input: CreateTableFromCSVInput{PresentationID: "pres", SlideIndex: 1, CSV: "a", HeaderStyle: &TableHeaderStyle{BackgroundColor: "blue"}}, wantErr: ErrInvalidHeaderStyle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			_, err := tools.CreateTableFromCSV(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCreateTableFromCSV_HeaderStyle(t *testing.T) {
+	stubObjectIDSuffix(t, "csv")
+
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.CreateTableFromCSV(context.Background(), &mockTokenSource{}, CreateTableFromCSVInput{
+		PresentationID: "pres",
+		SlideIndex:     1,
+		CSV:            "Region,,Q2\nNorth,10,12\n",
+		HeaderStyle:    &TableHeaderStyle{BackgroundColor: "#1F4E79", TextColor: "#FFFFFF"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantStyles := []string{"background_color=#1F4E79", "bold=true", "text_color=#FFFFFF"}
+	if strings.Join(output.HeaderStyles, "|") != strings.Join(wantStyles, "|") {
+		t.Errorf("HeaderStyles = %v, want %v", output.HeaderStyles, wantStyles)
+	}
+
+	// Header styling comes after the text inserts so the styled text exists
+	lastInsert := -1
+	var fills, textStyles []*slides.Request
+	for i, req := range capturedRequests {
+		switch {
+		case req.InsertText != nil:
+			lastInsert = i
+		case req.UpdateTableCellProperties != nil:
+			fills = append(fills, req)
+			if i < lastInsert {
+				t.Errorf("fill request %d precedes a text insert", i)
+			}
+		case req.UpdateTextStyle != nil:
+			textStyles = append(textStyles, req)
+			if i < lastInsert {
+				t.Errorf("text style request %d precedes a text insert", i)
+			}
+		}
+	}
+
+	if len(fills) != 1 {
+		t.Fatalf("expected one header fill request, got %d", len(fills))
+	}
+	tableRange := fills[0].UpdateTableCellProperties.TableRange
+	if tableRange.Location.RowIndex != 0 || tableRange.Location.ColumnIndex != 0 || tableRange.RowSpan != 1 || tableRange.ColumnSpan != 3 {
+		t.Errorf("fill range = row %d col %d span %dx%d, want row 0 across 3 columns",
+			tableRange.Location.RowIndex, tableRange.Location.ColumnIndex, tableRange.RowSpan, tableRange.ColumnSpan)
+	}
+
+	// The empty header cell (column 1) has no text to style
+	if len(textStyles) != 2 {
+		t.Fatalf("expected 2 header text style requests, got %d", len(textStyles))
+	}
+	for i, wantCol := range []int64{0, 2} {
+		style := textStyles[i].UpdateTextStyle
+		if style.CellLocation.RowIndex != 0 || style.CellLocation.ColumnIndex != wantCol {
+			t.Errorf("text style %d targets row %d col %d, want row 0 col %d", i, style.CellLocation.RowIndex, style.CellLocation.ColumnIndex, wantCol)
+		}
+		if !style.Style.Bold || style.Fields != "bold,foregroundColor" {
+			t.Errorf("text style %d = %+v with fields %q", i, style.Style, style.Fields)
+		}
+	}
+}
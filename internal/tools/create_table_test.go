@@ -4,19 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
 )
 
 func TestCreateTable(t *testing.T) {
-	// Override time function for deterministic object IDs
-	originalTimeFunc := tableTimeNowFunc
-	tableTimeNowFunc = func() time.Time {
-		return time.Unix(1234567890, 123456789)
-	}
-	defer func() { tableTimeNowFunc = originalTimeFunc }()
+	// Fixed ID suffix for deterministic object IDs
+	stubObjectIDSuffix(t, "1234567890123456789")
 
 	expectedObjectID := "table_1234567890123456789"
 
@@ -476,12 +471,8 @@ func TestCreateTable(t *testing.T) {
 }
 
 func TestCreateTable_DefaultPosition(t *testing.T) {
-	// Override time function for deterministic object IDs
-	originalTimeFunc := tableTimeNowFunc
-	tableTimeNowFunc = func() time.Time {
-		return time.Unix(1234567890, 123456789)
-	}
-	defer func() { tableTimeNowFunc = originalTimeFunc }()
+	// Fixed ID suffix for deterministic object IDs
+	stubObjectIDSuffix(t, "1234567890123456789")
 
 	var capturedRequests []*slides.Request
 
@@ -535,12 +526,8 @@ func TestCreateTable_DefaultPosition(t *testing.T) {
 }
 
 func TestGenerateTableObjectID(t *testing.T) {
-	// Override time function
-	originalTimeFunc := tableTimeNowFunc
-	tableTimeNowFunc = func() time.Time {
-		return time.Unix(1234567890, 123456789)
-	}
-	defer func() { tableTimeNowFunc = originalTimeFunc }()
+	// Fixed ID suffix for deterministic object IDs
+	stubObjectIDSuffix(t, "1234567890123456789")
 
 	objectID := generateTableObjectID()
 	expected := "table_1234567890123456789"
@@ -697,3 +684,92 @@ func TestBuildCreateTableRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildTableHeaderRequests(t *testing.T) {
+	t.Run("nil style", func(t *testing.T) {
+		requests, styles := buildTableHeaderRequests("table-1", 3, nil, nil)
+		if requests != nil || styles != nil {
+			t.Errorf("expected no requests, got %d", len(requests))
+		}
+	})
+
+	t.Run("empty table styles every header cell", func(t *testing.T) {
+		requests, styles := buildTableHeaderRequests("table-1", 4, nil, &TableHeaderStyle{Bold: boolPtr(false)})
+		if len(requests) != 4 {
+			t.Fatalf("expected 4 text style requests, got %d", len(requests))
+		}
+		for i, req := range requests {
+			style := req.UpdateTextStyle
+			if style == nil || style.CellLocation.RowIndex != 0 || style.CellLocation.ColumnIndex != int64(i) {
+				t.Fatalf("request %d: expected UpdateTextStyle on row 0 col %d, got %+v", i, i, req)
+			}
+			if style.Style.Bold || style.Fields != "bold" {
+				t.Errorf("request %d: expected bold=false with fields 'bold', got %+v %q", i, style.Style, style.Fields)
+			}
+		}
+		if len(styles) != 1 || styles[0] != "bold=false" {
+			t.Errorf("unexpected styles: %v", styles)
+		}
+	})
+}
+
+func TestCreateTable_HeaderStyle(t *testing.T) {
+	stubObjectIDSuffix(t, "hdr")
+
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	_, err := tools.CreateTable(context.Background(), &mockTokenSource{}, CreateTableInput{
+		PresentationID: "pres",
+		SlideIndex:     1,
+		Rows:           3,
+		Columns:        2,
+		HeaderStyle:    &TableHeaderStyle{BackgroundColor: "#DDDDDD"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if capturedRequests[0].CreateTable == nil {
+		t.Fatal("expected CreateTable first")
+	}
+	for i, req := range capturedRequests[1:] {
+		switch {
+		case req.UpdateTableCellProperties != nil:
+			if loc := req.UpdateTableCellProperties.TableRange.Location; loc.RowIndex != 0 || req.UpdateTableCellProperties.TableRange.RowSpan != 1 {
+				t.Errorf("request %d: fill must cover only row 0", i+1)
+			}
+		case req.UpdateTextStyle != nil:
+			if req.UpdateTextStyle.CellLocation.RowIndex != 0 {
+				t.Errorf("request %d: text style must target row 0, got row %d", i+1, req.UpdateTextStyle.CellLocation.RowIndex)
+			}
+		default:
+			t.Errorf("request %d: unexpected request %+v", i+1, req)
+		}
+	}
+	if len(capturedRequests) != 1+1+2 {
+		t.Errorf("expected create, one fill and two text styles, got %d requests", len(capturedRequests))
+	}
+
+	_, err = tools.CreateTable(context.Background(), &mockTokenSource{}, CreateTableInput{
+		PresentationID: "pres",
+		SlideIndex:     1,
+		Rows:           1,
+		Columns:        1,
+		HeaderStyle:    &TableHeaderStyle{TextColor: "#12"},
+	})
+	if !errors.Is(err, ErrInvalidHeaderStyle) {
+		t.Errorf("expected ErrInvalidHeaderStyle, got %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for create_text_boxes tool.
+var (
+	ErrCreateTextBoxesFailed = errors.New("failed to create text boxes")
+	ErrNoTextBoxes           = errors.New("no text boxes provided")
+)
+
+// TextBoxEntry is one text box of a create_text_boxes call.
+type TextBoxEntry struct {
+	SlideIndex int             `json:"slide_index,omitempty"` // 1-based index
+	SlideID    string          `json:"slide_id,omitempty"`    // Alternative to slide_index
+	Text       string          `json:"text"`
+	Position   *PositionInput  `json:"position,omitempty"` // Position in points, default top-left corner
+	Size       *SizeInput      `json:"size"`               // Size in points
+	Style      *TextStyleInput `json:"style,omitempty"`
+}
+
+// CreateTextBoxesInput represents the input for the create_text_boxes tool.
+type CreateTextBoxesInput struct {
+	PresentationID string         `json:"presentation_id"`
+	TextBoxes      []TextBoxEntry `json:"text_boxes"`
+}
+
+// CreateTextBoxesOutput represents the output of the create_text_boxes tool.
+type CreateTextBoxesOutput struct {
+	ObjectIDs []string `json:"object_ids"` // In input order
+	Count     int      `json:"count"`
+}
+
+// CreateTextBoxes creates many text boxes, possibly on different slides, in a single batch update.
+// Each entry is built exactly like a batch_update add_text_box operation.
+func (t *Tools) CreateTextBoxes(ctx context.Context, tokenSource oauth2.TokenSource, input CreateTextBoxesInput) (*CreateTextBoxesOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if len(input.TextBoxes) == 0 {
+		return nil, ErrNoTextBoxes
+	}
+	for i, entry := range input.TextBoxes {
+		if err := validateTextBoxEntry(entry); err != nil {
+			return nil, fmt.Errorf("text_boxes[%d]: %w", i, err)
+		}
+	}
+
+	t.config.Logger.Info("creating text boxes",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("count", len(input.TextBoxes)),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to resolve the target slides
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	output := &CreateTextBoxesOutput{ObjectIDs: make([]string, 0, len(input.TextBoxes))}
+	var requests []*slides.Request
+	for i, entry := range input.TextBoxes {
+		slideID, _, err := findSlide(presentation, entry.SlideIndex, entry.SlideID)
+		if err != nil {
+			return nil, fmt.Errorf("text_boxes[%d]: %w", i, err)
+		}
+
+		params, err := json.Marshal(AddTextBoxInput{
+			SlideID:  slideID,
+			Text:     entry.Text,
+			Position: entry.Position,
+			Size:     entry.Size,
+			Style:    entry.Style,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCreateTextBoxesFailed, err)
+		}
+		entryRequests, _, err := t.addTextBoxToRequests(params, input.PresentationID)
+		if err != nil {
+			return nil, fmt.Errorf("text_boxes[%d]: %w", i, err)
+		}
+
+		requests = append(requests, entryRequests...)
+		output.ObjectIDs = append(output.ObjectIDs, entryRequests[0].CreateShape.ObjectId)
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCreateTextBoxesFailed, err)
+	}
+
+	output.Count = len(output.ObjectIDs)
+
+	t.config.Logger.Info("text boxes created successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("count", output.Count),
+		slog.Int("requests", len(requests)),
+	)
+
+	return output, nil
+}
+
+// validateTextBoxEntry checks an entry before anything is fetched, so a bad entry fails the
+// whole call without creating any boxes.
+func validateTextBoxEntry(entry TextBoxEntry) error {
+	if entry.SlideIndex <= 0 && entry.SlideID == "" {
+		return fmt.Errorf("%w: either slide_index or slide_id is required", ErrInvalidSlideReference)
+	}
+	if entry.Text == "" {
+		return fmt.Errorf("%w: text is required", ErrInvalidText)
+	}
+	if entry.Size == nil || entry.Size.Width <= 0 || entry.Size.Height <= 0 {
+		return fmt.Errorf("%w: size with positive width and height is required", ErrInvalidSize)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/slides/v1"
+)
+
+func newCreateTextBoxesTestTools(batchCalls *int, captured *[]*slides.Request, batchErr error) *Tools {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides:         []*slides.Page{{ObjectId: "slide-1"}, {ObjectId: "slide-2"}},
+	}
+	return newBatchCaptureTestTools(presentation, nil, batchErr, batchCalls, captured)
+}
+
+func TestCreateTextBoxes(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newCreateTextBoxesTestTools(&batchCalls, &captured, nil)
+
+	output, err := tools.CreateTextBoxes(context.Background(), nil, CreateTextBoxesInput{
+		PresentationID: "pres-1",
+		TextBoxes: []TextBoxEntry{
+			{SlideIndex: 1, Text: "North", Position: &PositionInput{X: 100, Y: 20}, Size: &SizeInput{Width: 80, Height: 30}},
+			{SlideIndex: 1, Text: "South", Position: &PositionInput{X: 100, Y: 300}, Size: &SizeInput{Width: 80, Height: 30},
+				Style: &TextStyleInput{Bold: true}},
+			{SlideID: "slide-2", Text: "Legend", Size: &SizeInput{Width: 120, Height: 40}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 {
+		t.Errorf("expected a single batch update, got %d", batchCalls)
+	}
+	if output.Count != 3 || len(output.ObjectIDs) != 3 {
+		t.Fatalf("expected 3 object IDs, got %+v", output)
+	}
+
+	// CreateShape + InsertText per box, plus one style request for the styled box
+	if len(captured) != 7 {
+		t.Fatalf("expected 7 requests, got %d", len(captured))
+	}
+
+	var shapes []*slides.CreateShapeRequest
+	inserted := map[string]string{}
+	for _, req := range captured {
+		if req.CreateShape != nil {
+			shapes = append(shapes, req.CreateShape)
+		}
+		if req.InsertText != nil {
+			inserted[req.InsertText.ObjectId] = req.InsertText.Text
+		}
+	}
+	if len(shapes) != 3 {
+		t.Fatalf("expected 3 CreateShape requests, got %d", len(shapes))
+	}
+
+	wantSlides := []string{"slide-1", "slide-1", "slide-2"}
+	wantTexts := []string{"North", "South", "Legend"}
+	seen := map[string]bool{}
+	for i, shape := range shapes {
+		if shape.ObjectId != output.ObjectIDs[i] {
+			t.Errorf("shape %d: ID %s, output has %s", i, shape.ObjectId, output.ObjectIDs[i])
+		}
+		if shape.ElementProperties.PageObjectId != wantSlides[i] {
+			t.Errorf("shape %d: on %s, want %s", i, shape.ElementProperties.PageObjectId, wantSlides[i])
+		}
+		if inserted[shape.ObjectId] != wantTexts[i] {
+			t.Errorf("shape %d: text %q, want %q", i, inserted[shape.ObjectId], wantTexts[i])
+		}
+		if seen[shape.ObjectId] {
+			t.Errorf("duplicate object ID %s", shape.ObjectId)
+		}
+		seen[shape.ObjectId] = true
+	}
+	if got := shapes[1].ElementProperties.Transform.TranslateY; got != pointsToEMU(300) {
+		t.Errorf("expected second box at y=300pt, got %v EMU", got)
+	}
+}
+
+func TestCreateTextBoxes_Errors(t *testing.T) {
+	size := &SizeInput{Width: 80, Height: 30}
+	tests := []struct {
+		name      string
+		input     CreateTextBoxesInput
+		batchErr  error
+		wantErr   error
+		wantBatch bool
+	}{
+		{"missing presentation", CreateTextBoxesInput{TextBoxes: []TextBoxEntry{{SlideIndex: 1, Text: "A", Size: size}}}, nil, ErrInvalidPresentationID, false},
+		{"no entries", CreateTextBoxesInput{PresentationID: "pres-1"}, nil, ErrNoTextBoxes, false},
+		{"entry without text", CreateTextBoxesInput{PresentationID: "pres-1", TextBoxes: []TextBoxEntry{
+			{SlideIndex: 1, Text: "A", Size: size}, {SlideIndex: 1, Size: size},
+		}}, nil, ErrInvalidText, false},
+		{"entry without size", CreateTextBoxesInput{PresentationID: "pres-1", TextBoxes: []TextBoxEntry{{SlideIndex: 1, Text: "A"}}}, nil, ErrInvalidSize, false},
+		{"entry without slide", CreateTextBoxesInput{PresentationID: "pres-1", TextBoxes: []TextBoxEntry{{Text: "A", Size: size}}}, nil, ErrInvalidSlideReference, false},
+		{"unknown slide", CreateTextBoxesInput{PresentationID: "pres-1", TextBoxes: []TextBoxEntry{{SlideIndex: 3, Text: "A", Size: size}}}, nil, ErrSlideNotFound, false},
+		{"batch update failure", CreateTextBoxesInput{PresentationID: "pres-1", TextBoxes: []TextBoxEntry{{SlideIndex: 1, Text: "A", Size: size}}}, errors.New("boom"), ErrCreateTextBoxesFailed, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newCreateTextBoxesTestTools(&batchCalls, &captured, tt.batchErr)
+
+			_, err := tools.CreateTextBoxes(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if (batchCalls > 0) != tt.wantBatch {
+				t.Errorf("batch update called %d times", batchCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+)
+
+// Sentinel errors for delete_presentation tool.
+var (
+	ErrDeletePresentationFailed = errors.New("failed to delete presentation")
+	ErrDeleteNotConfirmed       = errors.New("deletion not confirmed: set confirm to true")
+	ErrNotAPresentation         = errors.New("file is not a Google Slides presentation")
+)
+
+// DeletePresentationInput represents the input for the delete_presentation tool.
+type DeletePresentationInput struct {
+	PresentationID string `json:"presentation_id"`
+	Permanent      bool   `json:"permanent,omitempty"` // Permanently delete instead of moving to trash
+	Confirm        bool   `json:"confirm"`             // Must be true to proceed
+}
+
+// DeletePresentationOutput represents the output of the delete_presentation tool.
+type DeletePresentationOutput struct {
+	PresentationID string `json:"presentation_id"`
+	Permanent      bool   `json:"permanent"`
+	Message        string `json:"message"`
+}
+
+// DeletePresentation moves a presentation to the Drive trash, or permanently deletes it.
+func (t *Tools) DeletePresentation(ctx context.Context, tokenSource oauth2.TokenSource, input DeletePresentationInput) (*DeletePresentationOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	if !input.Confirm {
+		return nil, ErrDeleteNotConfirmed
+	}
+
+	t.config.Logger.Info("deleting presentation",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Bool("permanent", input.Permanent),
+	)
+
+	// Create Drive service
+	driveService, err := t.driveServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+	}
+
+	// Any Drive file ID is accepted by the Drive API, so make sure this one is a presentation
+	file, err := driveService.GetFile(ctx, input.PresentationID, "id,mimeType")
+	if err != nil {
+		return nil, mapDeletePresentationError(err)
+	}
+	if file.MimeType != presentationMimeType {
+		return nil, fmt.Errorf("%w: %s has MIME type %s", ErrNotAPresentation, input.PresentationID, file.MimeType)
+	}
+
+	if input.Permanent {
+		err = driveService.DeleteFile(ctx, input.PresentationID)
+	} else {
+		err = driveService.TrashFile(ctx, input.PresentationID)
+	}
+	if err != nil {
+		return nil, mapDeletePresentationError(err)
+	}
+
+	message := "Presentation moved to trash"
+	if input.Permanent {
+		message = "Presentation permanently deleted"
+	}
+
+	output := &DeletePresentationOutput{
+		PresentationID: input.PresentationID,
+		Permanent:      input.Permanent,
+		Message:        message,
+	}
+
+	t.config.Logger.Info("presentation deleted successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Bool("permanent", input.Permanent),
+	)
+
+	return output, nil
+}
+
+// mapDeletePresentationError converts a Drive API error into a sentinel error.
+func mapDeletePresentationError(err error) error {
+	if isNotFoundError(err) {
+		return fmt.Errorf("%w: presentation not found", ErrPresentationNotFound)
+	}
+	if isForbiddenError(err) {
+		return fmt.Errorf("%w: access denied to presentation", ErrAccessDenied)
+	}
+	return fmt.Errorf("%w: %v", ErrDeletePresentationFailed, err)
+}
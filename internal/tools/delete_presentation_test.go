@@ -0,0 +1,261 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// getPresentationFile returns Drive metadata describing a Google Slides presentation.
+func getPresentationFile(ctx context.Context, fileID string, fields googleapi.Field) (*drive.File, error) {
+	return &drive.File{Id: fileID, MimeType: presentationMimeType}, nil
+}
+
+func TestDeletePresentation_TrashByDefault(t *testing.T) {
+	trashCalled := false
+	deleteCalled := false
+	mockService := &mockDriveService{
+		GetFileFunc: getPresentationFile,
+		TrashFileFunc: func(ctx context.Context, fileID string) error {
+			trashCalled = true
+			if fileID != "pres-123" {
+				t.Errorf("expected file ID 'pres-123', got '%s'", fileID)
+			}
+			return nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			deleteCalled = true
+			return nil
+		},
+	}
+
+	driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return mockService, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), nil, driveFactory)
+
+	output, err := tools.DeletePresentation(context.Background(), &mockTokenSource{}, DeletePresentationInput{
+		PresentationID: "pres-123",
+		Confirm:        true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !trashCalled {
+		t.Error("expected TrashFile to be called")
+	}
+	if deleteCalled {
+		t.Error("expected DeleteFile not to be called")
+	}
+	if output.Permanent {
+		t.Error("expected permanent to be false")
+	}
+	if output.Message != "Presentation moved to trash" {
+		t.Errorf("unexpected message: %s", output.Message)
+	}
+}
+
+func TestDeletePresentation_Permanent(t *testing.T) {
+	trashCalled := false
+	deleteCalled := false
+	mockService := &mockDriveService{
+		GetFileFunc: getPresentationFile,
+		TrashFileFunc: func(ctx context.Context, fileID string) error {
+			trashCalled = true
+			return nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			deleteCalled = true
+			if fileID != "pres-123" {
+				t.Errorf("expected file ID 'pres-123', got '%s'", fileID)
+			}
+			return nil
+		},
+	}
+
+	driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return mockService, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), nil, driveFactory)
+
+	output, err := tools.DeletePresentation(context.Background(), &mockTokenSource{}, DeletePresentationInput{
+		PresentationID: "pres-123",
+		Permanent:      true,
+		Confirm:        true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !deleteCalled {
+		t.Error("expected DeleteFile to be called")
+	}
+	if trashCalled {
+		t.Error("expected TrashFile not to be called")
+	}
+	if !output.Permanent {
+		t.Error("expected permanent to be true")
+	}
+}
+
+func TestDeletePresentation_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       DeletePresentationInput
+		expectedErr error
+	}{
+		{
+			name:        "missing presentation ID",
+			input:       DeletePresentationInput{Confirm: true},
+			expectedErr: ErrInvalidPresentationID,
+		},
+		{
+			name:        "not confirmed",
+			input:       DeletePresentationInput{PresentationID: "pres-123"},
+			expectedErr: ErrDeleteNotConfirmed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+				t.Fatal("drive service should not be created")
+				return nil, nil
+			}
+			tools := NewToolsWithDrive(DefaultToolsConfig(), nil, driveFactory)
+
+			_, err := tools.DeletePresentation(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestDeletePresentation_APIErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		apiErr      error
+		expectedErr error
+	}{
+		{
+			name:        "not found",
+			apiErr:      errors.New("googleapi: Error 404: File not found: pres-123"),
+			expectedErr: ErrPresentationNotFound,
+		},
+		{
+			name:        "forbidden",
+			apiErr:      errors.New("googleapi: Error 403: The user does not have sufficient permissions"),
+			expectedErr: ErrAccessDenied,
+		},
+		{
+			name:        "other error",
+			apiErr:      errors.New("internal error"),
+			expectedErr: ErrDeletePresentationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockDriveService{
+				GetFileFunc: getPresentationFile,
+				TrashFileFunc: func(ctx context.Context, fileID string) error {
+					return tt.apiErr
+				},
+			}
+			driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+				return mockService, nil
+			}
+			tools := NewToolsWithDrive(DefaultToolsConfig(), nil, driveFactory)
+
+			_, err := tools.DeletePresentation(context.Background(), &mockTokenSource{}, DeletePresentationInput{
+				PresentationID: "pres-123",
+				Confirm:        true,
+			})
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestDeletePresentation_RejectsNonPresentation(t *testing.T) {
+	tests := []struct {
+		name        string
+		getFile     func(ctx context.Context, fileID string, fields googleapi.Field) (*drive.File, error)
+		expectedErr error
+	}{
+		{
+			name: "spreadsheet",
+			getFile: func(ctx context.Context, fileID string, fields googleapi.Field) (*drive.File, error) {
+				return &drive.File{Id: fileID, MimeType: "application/vnd.google-apps.spreadsheet"}, nil
+			},
+			expectedErr: ErrNotAPresentation,
+		},
+		{
+			name: "uploaded pptx",
+			getFile: func(ctx context.Context, fileID string, fields googleapi.Field) (*drive.File, error) {
+				return &drive.File{Id: fileID, MimeType: "application/vnd.openxmlformats-officedocument.presentationml.presentation"}, nil
+			},
+			expectedErr: ErrNotAPresentation,
+		},
+		{
+			name: "metadata not found",
+			getFile: func(ctx context.Context, fileID string, fields googleapi.Field) (*drive.File, error) {
+				return nil, errors.New("googleapi: Error 404: File not found: pres-123")
+			},
+			expectedErr: ErrPresentationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockDriveService{
+				GetFileFunc: tt.getFile,
+				TrashFileFunc: func(ctx context.Context, fileID string) error {
+					t.Error("expected TrashFile not to be called")
+					return nil
+				},
+				DeleteFileFunc: func(ctx context.Context, fileID string) error {
+					t.Error("expected DeleteFile not to be called")
+					return nil
+				},
+			}
+			driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+				return mockService, nil
+			}
+			tools := NewToolsWithDrive(DefaultToolsConfig(), nil, driveFactory)
+
+			_, err := tools.DeletePresentation(context.Background(), &mockTokenSource{}, DeletePresentationInput{
+				PresentationID: "pres-123",
+				Permanent:      true,
+				Confirm:        true,
+			})
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestDeletePresentation_DriveServiceFactoryError(t *testing.T) {
+	driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return nil, errors.New("factory error")
+	}
+	tools := NewToolsWithDrive(DefaultToolsConfig(), nil, driveFactory)
+
+	_, err := tools.DeletePresentation(context.Background(), &mockTokenSource{}, DeletePresentationInput{
+		PresentationID: "pres-123",
+		Confirm:        true,
+	})
+	if !errors.Is(err, ErrDriveAPIError) {
+		t.Errorf("expected ErrDriveAPIError, got %v", err)
+	}
+}
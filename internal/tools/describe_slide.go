@@ -313,30 +313,6 @@ func truncateText(text string, maxLen int) string {
 	return text[:maxLen-3] + "..."
 }
 
-// emuToPoints converts EMU (English Metric Units) to points.
-// 1 point = 12700 EMU
-const emuPerPoint = 12700.0
-
-func emuToPoints(emu float64) float64 {
-	return emu / emuPerPoint
-}
-
-// convertToPoints converts a Dimension to points.
-func convertToPoints(dim *slides.Dimension) float64 {
-	if dim == nil {
-		return 0
-	}
-
-	switch dim.Unit {
-	case "PT":
-		return dim.Magnitude
-	case "EMU":
-		return dim.Magnitude / emuPerPoint
-	default:
-		return dim.Magnitude
-	}
-}
-
 // generateLayoutDescription creates a human-readable description of the slide layout.
 func generateLayoutDescription(objects []ObjectDescription, pageSize *PageSize, title string) string {
 	if len(objects) == 0 {
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// DuplicatePresentationInput represents the input for the duplicate_presentation tool.
+type DuplicatePresentationInput struct {
+	SourcePresentationID string `json:"source_presentation_id"`
+	NewTitle             string `json:"new_title"`
+}
+
+// DuplicatePresentationOutput represents the output of the duplicate_presentation tool.
+type DuplicatePresentationOutput struct {
+	PresentationID       string `json:"presentation_id"`
+	Title                string `json:"title"`
+	URL                  string `json:"url"`
+	SourcePresentationID string `json:"source_presentation_id"`
+}
+
+// DuplicatePresentation makes a renamed working copy of a presentation next to the original.
+// It is copy_presentation without a destination folder, with a stricter title check. Drive
+// applies the new name as part of the copy, so the duplicate never exists under the source's
+// title.
+func (t *Tools) DuplicatePresentation(ctx context.Context, tokenSource oauth2.TokenSource, input DuplicatePresentationInput) (*DuplicatePresentationOutput, error) {
+	// Validate input
+	if input.SourcePresentationID == "" {
+		return nil, fmt.Errorf("%w: source_presentation_id is required", ErrInvalidSourceID)
+	}
+
+	title := strings.TrimSpace(input.NewTitle)
+	if title == "" {
+		return nil, fmt.Errorf("%w: new_title is required", ErrInvalidTitle)
+	}
+	if strings.ContainsAny(title, "\r\n") {
+		return nil, fmt.Errorf("%w: new_title must be a single line", ErrInvalidTitle)
+	}
+
+	// Without a destination folder the copy stays beside the source
+	copied, err := t.CopyPresentation(ctx, tokenSource, CopyPresentationInput{
+		SourceID: input.SourcePresentationID,
+		NewTitle: title,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &DuplicatePresentationOutput{
+		PresentationID:       copied.PresentationID,
+		Title:                copied.Title,
+		URL:                  copied.URL,
+		SourcePresentationID: copied.SourceID,
+	}
+	if output.Title == "" {
+		output.Title = title
+	}
+
+	return output, nil
+}
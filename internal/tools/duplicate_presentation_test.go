@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+)
+
+func TestDuplicatePresentation_CopiesWithNewTitle(t *testing.T) {
+	copyCalls := 0
+	mockService := &mockDriveService{
+		CopyFileFunc: func(ctx context.Context, fileID string, file *drive.File) (*drive.File, error) {
+			copyCalls++
+			if fileID != "source-id" {
+				t.Errorf("expected source ID 'source-id', got: %s", fileID)
+			}
+			if file.Name != "Q3 Review (working copy)" {
+				t.Errorf("expected copy to be renamed to 'Q3 Review (working copy)', got: %q", file.Name)
+			}
+			if len(file.Parents) != 0 {
+				t.Errorf("expected the copy to keep the source's folder, got parents: %v", file.Parents)
+			}
+			return &drive.File{Id: "copy-id", Name: file.Name}, nil
+		},
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), nil, func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.DuplicatePresentation(context.Background(), &mockTokenSource{}, DuplicatePresentationInput{
+		SourcePresentationID: "source-id",
+		NewTitle:             "  Q3 Review (working copy) ",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if copyCalls != 1 {
+		t.Errorf("expected exactly 1 copy call, got %d", copyCalls)
+	}
+	if output.PresentationID != "copy-id" {
+		t.Errorf("expected presentation ID 'copy-id', got '%s'", output.PresentationID)
+	}
+	if output.Title != "Q3 Review (working copy)" {
+		t.Errorf("expected title 'Q3 Review (working copy)', got '%s'", output.Title)
+	}
+	if output.SourcePresentationID != "source-id" {
+		t.Errorf("expected source presentation ID 'source-id', got '%s'", output.SourcePresentationID)
+	}
+	if output.URL != "https://docs.google.com/presentation/d/copy-id/edit" {
+		t.Errorf("unexpected URL '%s'", output.URL)
+	}
+}
+
+func TestDuplicatePresentation_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   DuplicatePresentationInput
+		wantErr error
+	}{
+		{
+			name:    "missing source ID",
+			input:   DuplicatePresentationInput{NewTitle: "Copy"},
+			wantErr: ErrInvalidSourceID,
+		},
+		{
+			name:    "blank title",
+			input:   DuplicatePresentationInput{SourcePresentationID: "source-id", NewTitle: "   "},
+			wantErr: ErrInvalidTitle,
+		},
+		{
+			name:    "multi-line title",
+			input:   DuplicatePresentationInput{SourcePresentationID: "source-id", NewTitle: "Copy\nof deck"},
+			wantErr: ErrInvalidTitle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := NewToolsWithDrive(DefaultToolsConfig(), nil, func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+				t.Fatal("drive service should not be created")
+				return nil, nil
+			})
+
+			_, err := tools.DuplicatePresentation(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for equalize_sizes tool.
+var (
+	ErrInvalidEqualizeMode  = errors.New("invalid equalize mode")
+	ErrInvalidSizeReference = errors.New("invalid size reference")
+	ErrUnknownObjectSize    = errors.New("object size is unknown")
+	ErrEqualizeSizesFailed  = errors.New("failed to equalize object sizes")
+)
+
+// Equalize modes: the dimensions that are made equal.
+const (
+	equalizeModeWidth  = "width"
+	equalizeModeHeight = "height"
+	equalizeModeBoth   = "both"
+)
+
+// Size references: where the target size comes from.
+const (
+	sizeReferenceFirst    = "first"
+	sizeReferenceLargest  = "largest"
+	sizeReferenceSmallest = "smallest"
+)
+
+// EqualizeSizesInput represents the input for the equalize_sizes tool.
+type EqualizeSizesInput struct {
+	PresentationID string   `json:"presentation_id"`
+	ObjectIDs      []string `json:"object_ids"`          // At least two, all on the same slide
+	Mode           string   `json:"mode"`                // "width", "height" or "both"
+	Reference      string   `json:"reference,omitempty"` // "first" (default), "largest" or "smallest"
+}
+
+// EqualizedObject describes one element's displayed size after equalizing.
+type EqualizedObject struct {
+	ObjectID string `json:"object_id"`
+	Size     *Size  `json:"size"`    // Points
+	Changed  bool   `json:"changed"` // False when the element already had the reference size
+}
+
+// EqualizeSizesOutput represents the output of the equalize_sizes tool.
+type EqualizeSizesOutput struct {
+	PresentationID  string            `json:"presentation_id"`
+	SlideID         string            `json:"slide_id"`
+	Mode            string            `json:"mode"`
+	Reference       string            `json:"reference"`
+	ReferenceWidth  float64           `json:"reference_width,omitempty"`  // Points; set for width and both
+	ReferenceHeight float64           `json:"reference_height,omitempty"` // Points; set for height and both
+	Objects         []EqualizedObject `json:"objects"`
+	ChangedCount    int               `json:"changed_count"`
+}
+
+// EqualizeSizes resizes objects on one slide to a common displayed width, height or both. The
+// reference size is the first object's, or the largest or smallest per dimension. Sizes change
+// through the transform's scale, so each object keeps its top-left corner, rotation and flips.
+func (t *Tools) EqualizeSizes(ctx context.Context, tokenSource oauth2.TokenSource, input EqualizeSizesInput) (*EqualizeSizesOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if len(input.ObjectIDs) < 2 {
+		return nil, fmt.Errorf("%w: at least two object_ids are required", ErrInvalidObjectID)
+	}
+	seen := make(map[string]bool, len(input.ObjectIDs))
+	for _, objectID := range input.ObjectIDs {
+		if objectID == "" {
+			return nil, fmt.Errorf("%w: object_ids cannot contain empty IDs", ErrInvalidObjectID)
+		}
+		if seen[objectID] {
+			return nil, fmt.Errorf("%w: object '%s' is listed more than once", ErrInvalidObjectID, objectID)
+		}
+		seen[objectID] = true
+	}
+	mode := strings.ToLower(strings.TrimSpace(input.Mode))
+	switch mode {
+	case equalizeModeWidth, equalizeModeHeight, equalizeModeBoth:
+	default:
+		return nil, fmt.Errorf("%w: '%s' (use width, height or both)", ErrInvalidEqualizeMode, input.Mode)
+	}
+	reference := strings.ToLower(strings.TrimSpace(input.Reference))
+	if reference == "" {
+		reference = sizeReferenceFirst
+	}
+	switch reference {
+	case sizeReferenceFirst, sizeReferenceLargest, sizeReferenceSmallest:
+	default:
+		return nil, fmt.Errorf("%w: '%s' (use first, largest or smallest)", ErrInvalidSizeReference, input.Reference)
+	}
+
+	t.config.Logger.Info("equalizing object sizes",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("object_count", len(input.ObjectIDs)),
+		slog.String("mode", mode),
+		slog.String("reference", reference),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation for the current sizes
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// Resolve every object on a single slide and measure it
+	var slideID string
+	elements := make([]*slides.PageElement, 0, len(input.ObjectIDs))
+	widths := make([]float64, 0, len(input.ObjectIDs))
+	heights := make([]float64, 0, len(input.ObjectIDs))
+	for _, objectID := range input.ObjectIDs {
+		slide := findSlideContainingObject(presentation.Slides, objectID)
+		if slide == nil {
+			return nil, fmt.Errorf("%w: object '%s' not found", ErrObjectNotFound, objectID)
+		}
+		if slideID == "" {
+			slideID = slide.ObjectId
+		} else if slide.ObjectId != slideID {
+			return nil, fmt.Errorf("%w: object '%s' is on slide '%s', not '%s'", ErrObjectsOnDifferentPages, objectID, slide.ObjectId, slideID)
+		}
+
+		element := findElementByID(slide.PageElements, objectID)
+		width, height, ok := displayedSizeInPoints(element)
+		if !ok || element.Transform == nil {
+			return nil, fmt.Errorf("%w: object '%s' has no size or transform (groups cannot be resized)", ErrUnknownObjectSize, objectID)
+		}
+		elements = append(elements, element)
+		widths = append(widths, width)
+		heights = append(heights, height)
+	}
+
+	output := &EqualizeSizesOutput{
+		PresentationID: input.PresentationID,
+		SlideID:        slideID,
+		Mode:           mode,
+		Reference:      reference,
+		Objects:        make([]EqualizedObject, 0, len(elements)),
+	}
+	targetWidth, targetHeight := -1.0, -1.0 // Negative keeps the dimension as is
+	if mode != equalizeModeHeight {
+		targetWidth = referenceDimension(widths, reference)
+		output.ReferenceWidth = math.Max(math.Round(targetWidth*100)/100, 0)
+	}
+	if mode != equalizeModeWidth {
+		targetHeight = referenceDimension(heights, reference)
+		output.ReferenceHeight = math.Max(math.Round(targetHeight*100)/100, 0)
+	}
+
+	var requests []*slides.Request
+	for i, element := range elements {
+		transform, changed := resizeTransform(element.Transform, widths[i], heights[i], targetWidth, targetHeight)
+		output.Objects = append(output.Objects, EqualizedObject{
+			ObjectID: element.ObjectId,
+			Size:     displayedSizeWithTransform(element, transform),
+			Changed:  changed,
+		})
+		if !changed {
+			continue
+		}
+		output.ChangedCount++
+		requests = append(requests, &slides.Request{
+			UpdatePageElementTransform: &slides.UpdatePageElementTransformRequest{
+				ObjectId:  element.ObjectId,
+				Transform: transform,
+				ApplyMode: "ABSOLUTE",
+			},
+		})
+	}
+
+	// Execute batch update only when a size changes
+	if len(requests) > 0 {
+		_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, ErrPresentationNotFound
+			}
+			if isForbiddenError(err) {
+				return nil, ErrAccessDenied
+			}
+			return nil, fmt.Errorf("%w: %v", ErrEqualizeSizesFailed, err)
+		}
+	}
+
+	t.config.Logger.Info("object sizes equalized",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("slide_id", slideID),
+		slog.Int("changed_count", output.ChangedCount),
+	)
+
+	return output, nil
+}
+
+// referenceDimension picks the target value from sizes: the first, the largest or the smallest.
+// Zero sizes, such as a line's missing thickness, never become the reference; -1 is returned
+// when every size is zero so the dimension is left alone.
+func referenceDimension(sizes []float64, reference string) float64 {
+	target := -1.0
+	for _, size := range sizes {
+		if size <= 0 {
+			continue
+		}
+		switch {
+		case target < 0:
+			target = size
+		case reference == sizeReferenceLargest:
+			target = math.Max(target, size)
+		case reference == sizeReferenceSmallest:
+			target = math.Min(target, size)
+		}
+		if reference == sizeReferenceFirst {
+			break
+		}
+	}
+	return target
+}
+
+// resizeTransform returns a copy of current whose columns are scaled so a displayed width x
+// height becomes targetWidth x targetHeight. A negative target, or a zero current dimension such
+// as a horizontal line's height, leaves that dimension unchanged. The translation is kept.
+func resizeTransform(current *slides.AffineTransform, width, height, targetWidth, targetHeight float64) (*slides.AffineTransform, bool) {
+	resized := &slides.AffineTransform{
+		ScaleX:     current.ScaleX,
+		ScaleY:     current.ScaleY,
+		ShearX:     current.ShearX,
+		ShearY:     current.ShearY,
+		TranslateX: current.TranslateX,
+		TranslateY: current.TranslateY,
+		Unit:       "EMU",
+	}
+
+	changed := false
+	ratioX, ratioY := 1.0, 1.0
+	if targetWidth >= 0 && width > 0 && math.Abs(targetWidth-width) > 1e-6 {
+		ratioX = targetWidth / width
+		changed = true
+	}
+	if targetHeight >= 0 && height > 0 && math.Abs(targetHeight-height) > 1e-6 {
+		ratioY = targetHeight / height
+		changed = true
+	}
+	scaleTransformColumns(resized, ratioX, ratioY)
+	return resized, changed
+}
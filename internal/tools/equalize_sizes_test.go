@@ -0,0 +1,201 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newEqualizeSizesTestTools(getErr, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	scaled := treeTestElement("scaled", 300, 0, 50, 40)
+	scaled.Transform.ScaleX = 2 // Displayed 100 wide
+	vertical := treeTestElement("vertical-line", 400, 0, 0, 80)
+
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					treeTestElement("small", 0, 0, 60, 30),
+					treeTestElement("large", 100, 0, 150, 90),
+					scaled,
+					vertical,
+					{ObjectId: "group-1", ElementGroup: &slides.Group{}},
+				},
+			},
+			{
+				ObjectId:     "slide-2",
+				PageElements: []*slides.PageElement{treeTestElement("elsewhere", 0, 0, 10, 10)},
+			},
+		},
+	}
+
+	return newBatchCaptureTestTools(presentation, getErr, batchErr, batchCalls, captured)
+}
+
+func TestEqualizeSizes_Widths(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      float64
+	}{
+		{"", 60}, // Defaults to the first object
+		{"first", 60},
+		{"largest", 150},
+		{"smallest", 60},
+	}
+
+	for _, tt := range tests {
+		t.Run("reference "+tt.reference, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newEqualizeSizesTestTools(nil, nil, &batchCalls, &captured)
+
+			output, err := tools.EqualizeSizes(context.Background(), nil, EqualizeSizesInput{
+				PresentationID: "pres-1",
+				ObjectIDs:      []string{"small", "large", "scaled"},
+				Mode:           "width",
+				Reference:      tt.reference,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.ReferenceWidth != tt.want || output.ReferenceHeight != 0 {
+				t.Errorf("expected reference width %v only, got %v x %v", tt.want, output.ReferenceWidth, output.ReferenceHeight)
+			}
+			originalHeights := map[string]float64{"small": 30, "large": 90, "scaled": 40}
+			for _, object := range output.Objects {
+				if object.Size.Width != tt.want {
+					t.Errorf("%s: expected width %v, got %v", object.ObjectID, tt.want, object.Size.Width)
+				}
+				if object.Size.Height != originalHeights[object.ObjectID] {
+					t.Errorf("%s: expected height %v to be kept, got %v", object.ObjectID, originalHeights[object.ObjectID], object.Size.Height)
+				}
+			}
+
+			if batchCalls != 1 || len(captured) != output.ChangedCount {
+				t.Fatalf("expected one batch with %d requests, got %d calls and %d requests", output.ChangedCount, batchCalls, len(captured))
+			}
+			for _, request := range captured {
+				update := request.UpdatePageElementTransform
+				if update.ApplyMode != "ABSOLUTE" || update.Transform.ScaleY != 1 {
+					t.Errorf("%s: unexpected request %+v", update.ObjectId, update.Transform)
+				}
+			}
+		})
+	}
+}
+
+func TestEqualizeSizes_Both(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newEqualizeSizesTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.EqualizeSizes(context.Background(), nil, EqualizeSizesInput{
+		PresentationID: "pres-1",
+		ObjectIDs:      []string{"large", "scaled", "vertical-line"},
+		Mode:           "both",
+		Reference:      "smallest",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The line's zero width is ignored when picking the smallest width
+	if output.ReferenceWidth != 100 || output.ReferenceHeight != 40 {
+		t.Fatalf("expected a 100x40 reference, got %v x %v", output.ReferenceWidth, output.ReferenceHeight)
+	}
+	if output.ChangedCount != 2 || len(captured) != 2 {
+		t.Fatalf("expected large and the line to change, got %d changes", output.ChangedCount)
+	}
+
+	large := captured[0].UpdatePageElementTransform
+	if large.ObjectId != "large" || math.Abs(large.Transform.ScaleX-100.0/150) > 1e-9 || math.Abs(large.Transform.ScaleY-40.0/90) > 1e-9 {
+		t.Errorf("unexpected transform for large: %+v", large.Transform)
+	}
+	if large.Transform.TranslateX != pointsToEMU(100) || large.Transform.TranslateY != 0 {
+		t.Errorf("expected large to keep its position, got %+v", large.Transform)
+	}
+	if output.Objects[1].ObjectID != "scaled" || output.Objects[1].Changed {
+		t.Errorf("expected scaled to already match, got %+v", output.Objects[1])
+	}
+	if line := output.Objects[2]; line.Size.Width != 0 || line.Size.Height != 40 {
+		t.Errorf("expected the line to keep zero width and get height 40, got %+v", line.Size)
+	}
+}
+
+func TestEqualizeSizes_Errors(t *testing.T) {
+	valid := EqualizeSizesInput{PresentationID: "pres-1", ObjectIDs: []string{"small", "large"}, Mode: "both"}
+	with := func(modify func(*EqualizeSizesInput)) EqualizeSizesInput {
+		input := valid
+		modify(&input)
+		return input
+	}
+
+	tests := []struct {
+		name     string
+		input    EqualizeSizesInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", with(func(i *EqualizeSizesInput) { i.PresentationID = "" }), nil, nil, ErrInvalidPresentationID},
+		{"single object", with(func(i *EqualizeSizesInput) { i.ObjectIDs = []string{"small"} }), nil, nil, ErrInvalidObjectID},
+		{"empty object ID", with(func(i *EqualizeSizesInput) { i.ObjectIDs = []string{"small", ""} }), nil, nil, ErrInvalidObjectID},
+		{"duplicate object ID", with(func(i *EqualizeSizesInput) { i.ObjectIDs = []string{"small", "small"} }), nil, nil, ErrInvalidObjectID},
+		{"missing mode", with(func(i *EqualizeSizesInput) { i.Mode = "" }), nil, nil, ErrInvalidEqualizeMode},
+		{"invalid mode", with(func(i *EqualizeSizesInput) { i.Mode = "area" }), nil, nil, ErrInvalidEqualizeMode},
+		{"invalid reference", with(func(i *EqualizeSizesInput) { i.Reference = "average" }), nil, nil, ErrInvalidSizeReference},
+		{"unknown object", with(func(i *EqualizeSizesInput) { i.ObjectIDs = []string{"small", "nope"} }), nil, nil, ErrObjectNotFound},
+		{"different slides", with(func(i *EqualizeSizesInput) { i.ObjectIDs = []string{"small", "elsewhere"} }), nil, nil, ErrObjectsOnDifferentPages},
+		{"group", with(func(i *EqualizeSizesInput) { i.ObjectIDs = []string{"small", "group-1"} }), nil, nil, ErrUnknownObjectSize},
+		{"presentation not found", valid, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", valid, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", valid, nil, errors.New("boom"), ErrEqualizeSizesFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newEqualizeSizesTestTools(tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.EqualizeSizes(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", batchCalls)
+			}
+		})
+	}
+}
+
+func TestReferenceDimension(t *testing.T) {
+	tests := []struct {
+		name      string
+		sizes     []float64
+		reference string
+		want      float64
+	}{
+		{"first", []float64{40, 10, 90}, sizeReferenceFirst, 40},
+		{"largest", []float64{40, 10, 90}, sizeReferenceLargest, 90},
+		{"smallest", []float64{40, 10, 90}, sizeReferenceSmallest, 10},
+		{"first skips zero", []float64{0, 25, 90}, sizeReferenceFirst, 25},
+		{"smallest skips zero", []float64{40, 0, 90}, sizeReferenceSmallest, 40},
+		{"all zero", []float64{0, 0}, sizeReferenceLargest, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := referenceDimension(tt.sizes, tt.reference); got != tt.want {
+				t.Errorf("referenceDimension(%v, %s) = %v, want %v", tt.sizes, tt.reference, got, tt.want)
+			}
+		})
+	}
+}
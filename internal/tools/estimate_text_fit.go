@@ -0,0 +1,192 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"unicode"
+
+	"golang.org/x/oauth2"
+)
+
+// Sentinel errors for estimate_text_fit tool.
+var (
+	ErrInvalidTextFit = errors.New("invalid text fit request")
+)
+
+// Text fit heuristics. True glyph metrics need rendering, so widths are averages in ems.
+const (
+	defaultFitFontSize   = 18.0 // Slides' default text box font size
+	defaultFitFontFamily = "Arial"
+	fitBoxInset          = 7.2 // Default text box padding on each side, in points
+	fitLineHeight        = 1.2 // Line height per point of font size at 100% line spacing
+	fitMonospaceWidth    = 0.6 // Every character in a monospace font
+	fitCondensedFactor   = 0.85
+	fitApproximationNote = "approximate: based on average character widths, not real font metrics; leave some margin"
+)
+
+// monospaceFontFamilies are families whose characters all share the same width.
+var monospaceFontFamilies = map[string]bool{
+	"courier":         true,
+	"courier new":     true,
+	"consolas":        true,
+	"roboto mono":     true,
+	"source code pro": true,
+	"inconsolata":     true,
+	"space mono":      true,
+	"ubuntu mono":     true,
+	"fira code":       true,
+	"fira mono":       true,
+}
+
+// condensedFontFamilies are narrow proportional families.
+var condensedFontFamilies = map[string]bool{
+	"arial narrow":        true,
+	"roboto condensed":    true,
+	"oswald":              true,
+	"pt sans narrow":      true,
+	"open sans condensed": true,
+}
+
+// EstimateTextFitInput represents the input for the estimate_text_fit tool.
+type EstimateTextFitInput struct {
+	Text       string     `json:"text"`
+	FontSize   float64    `json:"font_size,omitempty"`   // Points, default 18
+	FontFamily string     `json:"font_family,omitempty"` // Default Arial
+	Size       *SizeInput `json:"size"`                  // Box size in points
+}
+
+// EstimateTextFitOutput represents the output of the estimate_text_fit tool.
+type EstimateTextFitOutput struct {
+	EstimatedLines  int     `json:"estimated_lines"`
+	MaxLines        int     `json:"max_lines"`        // Lines that fit in the box height
+	EstimatedHeight float64 `json:"estimated_height"` // Text height in points, including padding
+	Overflows       bool    `json:"overflows"`
+	Approximate     bool    `json:"approximate"` // Always true
+	Note            string  `json:"note"`
+}
+
+// EstimateTextFit estimates how many lines text wraps to in a box and whether it overflows,
+// without calling the API. Results are heuristic: real widths depend on the font's metrics.
+func (t *Tools) EstimateTextFit(ctx context.Context, _ oauth2.TokenSource, input EstimateTextFitInput) (*EstimateTextFitOutput, error) {
+	// Validate input
+	if input.Text == "" {
+		return nil, fmt.Errorf("%w: text is required", ErrInvalidText)
+	}
+	if input.Size == nil || input.Size.Width <= 0 || input.Size.Height <= 0 {
+		return nil, fmt.Errorf("%w: size with positive width and height is required", ErrInvalidTextFit)
+	}
+	if input.FontSize < 0 || math.IsNaN(input.FontSize) || math.IsInf(input.FontSize, 0) {
+		return nil, fmt.Errorf("%w: font_size must be a positive number", ErrInvalidTextFit)
+	}
+
+	fontSize := input.FontSize
+	if fontSize == 0 {
+		fontSize = defaultFitFontSize
+	}
+	fontFamily := input.FontFamily
+	if fontFamily == "" {
+		fontFamily = defaultFitFontFamily
+	}
+
+	availableWidth := input.Size.Width - 2*fitBoxInset
+	availableHeight := input.Size.Height - 2*fitBoxInset
+	if availableWidth <= 0 {
+		return nil, fmt.Errorf("%w: box is narrower than its %.1fpt padding", ErrInvalidTextFit, 2*fitBoxInset)
+	}
+
+	lineHeight := fontSize * fitLineHeight
+	lines := estimateWrappedLines(input.Text, availableWidth/fontSize, fontFamily)
+
+	output := &EstimateTextFitOutput{
+		EstimatedLines:  lines,
+		MaxLines:        int(math.Max(0, math.Floor(availableHeight/lineHeight))),
+		EstimatedHeight: math.Round((float64(lines)*lineHeight+2*fitBoxInset)*10) / 10,
+		Approximate:     true,
+		Note:            fitApproximationNote,
+	}
+	output.Overflows = output.EstimatedLines > output.MaxLines
+
+	t.config.Logger.Info("estimated text fit",
+		slog.Int("estimated_lines", output.EstimatedLines),
+		slog.Int("max_lines", output.MaxLines),
+		slog.Bool("overflows", output.Overflows),
+	)
+
+	return output, nil
+}
+
+// estimateWrappedLines greedily wraps each paragraph at word boundaries into lines of lineEms
+// width, breaking words that are longer than a line.
+func estimateWrappedLines(text string, lineEms float64, fontFamily string) int {
+	lines := 0
+	for _, paragraph := range strings.Split(normalizeLineBreaks(text, false), "\n") {
+		paragraphLines := 1
+		used := 0.0
+		spaceWidth := fitCharWidth(' ', fontFamily)
+		for _, word := range strings.Fields(paragraph) {
+			wordWidth := 0.0
+			for _, r := range word {
+				wordWidth += fitCharWidth(r, fontFamily)
+			}
+
+			switch {
+			case used == 0:
+				used = wordWidth
+			case used+spaceWidth+wordWidth <= lineEms:
+				used += spaceWidth + wordWidth
+			default:
+				paragraphLines++
+				used = wordWidth
+			}
+
+			// A word wider than the line spills over onto extra lines
+			for used > lineEms {
+				paragraphLines++
+				used -= lineEms
+			}
+		}
+		lines += paragraphLines
+	}
+	return lines
+}
+
+// fitCharWidth returns the approximate advance width of r in ems for the font family.
+func fitCharWidth(r rune, fontFamily string) float64 {
+	family := strings.ToLower(strings.TrimSpace(fontFamily))
+	if isWideRune(r) {
+		return 1.0
+	}
+	if monospaceFontFamilies[family] {
+		return fitMonospaceWidth
+	}
+
+	var width float64
+	switch {
+	case r == ' ':
+		width = 0.28
+	case strings.ContainsRune("iljtfrI.,;:'!|()[]", r):
+		width = 0.3
+	case strings.ContainsRune("mwMW@%", r):
+		width = 0.85
+	case unicode.IsUpper(r):
+		width = 0.65
+	default:
+		width = 0.52
+	}
+	if condensedFontFamilies[family] {
+		width *= fitCondensedFactor
+	}
+	return width
+}
+
+// isWideRune reports whether r is typically drawn at full em width: CJK, Hangul, fullwidth
+// forms and emoji.
+func isWideRune(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hangul, unicode.Hiragana, unicode.Katakana) ||
+		(r >= 0xFF00 && r <= 0xFF60) ||
+		r >= 0x1F300
+}
@@ -0,0 +1,72 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEstimateTextFit(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	// 200x60pt box at 18pt: ~185pt of line width and 2 lines of height after padding
+	box := &SizeInput{Width: 200, Height: 60}
+
+	tests := []struct {
+		name          string
+		input         EstimateTextFitInput
+		wantLines     int
+		wantMaxLines  int
+		wantOverflows bool
+	}{
+		{"short string fits", EstimateTextFitInput{Text: "Q3 results", Size: box}, 1, 2, false},
+		{"long string overflows", EstimateTextFitInput{Text: strings.Repeat("quarterly revenue grew ", 8), Size: box}, 8, 2, true},
+		{"explicit line breaks count", EstimateTextFitInput{Text: "One\nTwo\nThree", Size: box}, 3, 2, true},
+		{"long word is broken", EstimateTextFitInput{Text: strings.Repeat("x", 30), Size: box}, 2, 2, false},
+		{"smaller font fits more", EstimateTextFitInput{Text: strings.Repeat("quarterly revenue grew ", 2), FontSize: 9, Size: box}, 1, 4, false},
+		{"monospace is wider", EstimateTextFitInput{Text: strings.Repeat("a", 20), FontFamily: "Courier New", Size: box}, 2, 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := tools.EstimateTextFit(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.EstimatedLines != tt.wantLines || output.MaxLines != tt.wantMaxLines || output.Overflows != tt.wantOverflows {
+				t.Errorf("got %d lines of %d (overflows=%v), want %d of %d (overflows=%v)",
+					output.EstimatedLines, output.MaxLines, output.Overflows, tt.wantLines, tt.wantMaxLines, tt.wantOverflows)
+			}
+			if !output.Approximate || output.Note == "" {
+				t.Errorf("expected the approximation caveat, got %+v", output)
+			}
+		})
+	}
+}
+
+func TestEstimateTextFit_Errors(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+	box := &SizeInput{Width: 200, Height: 60}
+
+	tests := []struct {
+		name    string
+		input   EstimateTextFitInput
+		wantErr error
+	}{
+		{"missing text", EstimateTextFitInput{Size: box}, ErrInvalidText},
+		{"missing size", EstimateTextFitInput{Text: "Hello"}, ErrInvalidTextFit},
+		{"zero height", EstimateTextFitInput{Text: "Hello", Size: &SizeInput{Width: 200}}, ErrInvalidTextFit},
+		{"negative font size", EstimateTextFitInput{Text: "Hello", FontSize: -1, Size: box}, ErrInvalidTextFit},
+		{"narrower than padding", EstimateTextFitInput{Text: "Hello", Size: &SizeInput{Width: 10, Height: 60}}, ErrInvalidTextFit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tools.EstimateTextFit(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
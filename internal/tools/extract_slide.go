@@ -0,0 +1,159 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for extract_slide tool.
+var (
+	ErrExtractSlideFailed = errors.New("failed to extract slide")
+)
+
+// ExtractSlideInput represents the input for the extract_slide tool.
+type ExtractSlideInput struct {
+	PresentationID      string `json:"presentation_id"`
+	SlideIndex          int    `json:"slide_index,omitempty"` // 1-based index
+	SlideID             string `json:"slide_id,omitempty"`    // Alternative to slide_index
+	NewTitle            string `json:"new_title,omitempty"`   // Default: "<source title> - Slide N"
+	DestinationFolderID string `json:"destination_folder_id,omitempty"`
+}
+
+// ExtractSlideOutput represents the output of the extract_slide tool.
+type ExtractSlideOutput struct {
+	PresentationID string `json:"presentation_id"` // ID of the new single-slide presentation
+	Title          string `json:"title"`
+	URL            string `json:"url"`
+	SourceID       string `json:"source_id"`
+	SlideID        string `json:"slide_id"`       // Object ID of the kept slide (unchanged by the copy)
+	SlideIndex     int    `json:"slide_index"`    // 1-based index in the source presentation
+	RemovedSlides  int    `json:"removed_slides"` // Slides deleted from the copy
+}
+
+// ExtractSlide creates a new presentation containing only one slide of the source.
+// The whole deck is copied through Drive, then every other slide is deleted from the copy,
+// so the kept slide retains its theme, layout, master and notes exactly.
+func (t *Tools) ExtractSlide(ctx context.Context, tokenSource oauth2.TokenSource, input ExtractSlideInput) (*ExtractSlideOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	if input.SlideIndex == 0 && input.SlideID == "" {
+		return nil, ErrInvalidSlideReference
+	}
+
+	t.config.Logger.Info("extracting slide",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+	)
+
+	// Create services
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	driveService, err := t.driveServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+	}
+
+	// Get the source presentation to find the slide and the ones to prune
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slideID, slideIndex, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	if err != nil {
+		return nil, err
+	}
+
+	title := input.NewTitle
+	if title == "" {
+		title = fmt.Sprintf("%s - Slide %d", presentation.Title, slideIndex)
+	}
+
+	// Copy the whole deck; Drive copies keep slide object IDs
+	copyFile := &drive.File{Name: title}
+	if input.DestinationFolderID != "" {
+		copyFile.Parents = []string{input.DestinationFolderID}
+	}
+
+	copiedFile, err := driveService.CopyFile(ctx, input.PresentationID, copyFile)
+	if err != nil {
+		if isParentNotFoundError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrDestinationInvalid, err)
+		}
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCopyFailed, err)
+	}
+
+	// Prune every other slide from the copy
+	requests := buildExtractSlideRequests(presentation.Slides, slideID)
+	if len(requests) > 0 {
+		_, err = slidesService.BatchUpdate(ctx, copiedFile.Id, requests)
+		if err != nil {
+			// Don't leave a full duplicate of the deck behind
+			if deleteErr := driveService.DeleteFile(ctx, copiedFile.Id); deleteErr != nil {
+				t.config.Logger.Warn("failed to delete partial slide extraction copy",
+					slog.String("presentation_id", copiedFile.Id),
+					slog.String("error", deleteErr.Error()),
+				)
+			}
+			return nil, fmt.Errorf("%w: %v", ErrExtractSlideFailed, err)
+		}
+	}
+
+	output := &ExtractSlideOutput{
+		PresentationID: copiedFile.Id,
+		Title:          title,
+		URL:            fmt.Sprintf("https://docs.google.com/presentation/d/%s/edit", copiedFile.Id),
+		SourceID:       input.PresentationID,
+		SlideID:        slideID,
+		SlideIndex:     slideIndex,
+		RemovedSlides:  len(requests),
+	}
+
+	t.config.Logger.Info("slide extracted successfully",
+		slog.String("source_id", input.PresentationID),
+		slog.String("new_id", output.PresentationID),
+		slog.String("slide_id", slideID),
+		slog.Int("removed_slides", output.RemovedSlides),
+	)
+
+	return output, nil
+}
+
+// buildExtractSlideRequests creates DeleteObject requests for every slide except keepSlideID.
+func buildExtractSlideRequests(pages []*slides.Page, keepSlideID string) []*slides.Request {
+	var requests []*slides.Request
+	for _, slide := range pages {
+		if slide == nil || slide.ObjectId == keepSlideID {
+			continue
+		}
+		requests = append(requests, &slides.Request{
+			DeleteObject: &slides.DeleteObjectRequest{ObjectId: slide.ObjectId},
+		})
+	}
+	return requests
+}
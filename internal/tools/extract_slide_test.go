@@ -0,0 +1,209 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+func newExtractSlideTestPresentation() *slides.Presentation {
+	return &slides.Presentation{
+		PresentationId: "source-pres",
+		Title:          "Quarterly Review",
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1"},
+			{ObjectId: "slide-2"},
+			{ObjectId: "slide-3"},
+		},
+	}
+}
+
+func newExtractSlideTestTools(mockSlides *mockSlidesService, mockDrive *mockDriveService) *Tools {
+	return NewToolsWithDrive(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	}, func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return mockDrive, nil
+	})
+}
+
+func TestExtractSlide_CopyThenPrune(t *testing.T) {
+	var copiedSource string
+	var copiedFile *drive.File
+	var batchPresentationID string
+	var batchRequests []*slides.Request
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return newExtractSlideTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			batchPresentationID = presentationID
+			batchRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	mockDrive := &mockDriveService{
+		CopyFileFunc: func(ctx context.Context, fileID string, file *drive.File) (*drive.File, error) {
+			copiedSource = fileID
+			copiedFile = file
+			return &drive.File{Id: "new-pres", Name: file.Name}, nil
+		},
+	}
+
+	tools := newExtractSlideTestTools(mockSlides, mockDrive)
+	output, err := tools.ExtractSlide(context.Background(), &mockTokenSource{}, ExtractSlideInput{
+		PresentationID:      "source-pres",
+		SlideIndex:          2,
+		DestinationFolderID: "folder-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if copiedSource != "source-pres" {
+		t.Errorf("copied %q, want source-pres", copiedSource)
+	}
+	if copiedFile.Name != "Quarterly Review - Slide 2" {
+		t.Errorf("copy name = %q, want default title", copiedFile.Name)
+	}
+	if len(copiedFile.Parents) != 1 || copiedFile.Parents[0] != "folder-1" {
+		t.Errorf("copy parents = %v, want [folder-1]", copiedFile.Parents)
+	}
+
+	if batchPresentationID != "new-pres" {
+		t.Errorf("batch update sent to %q, want the copy", batchPresentationID)
+	}
+	var deleted []string
+	for _, req := range batchRequests {
+		if req.DeleteObject == nil {
+			t.Fatalf("expected only DeleteObject requests, got %+v", req)
+		}
+		deleted = append(deleted, req.DeleteObject.ObjectId)
+	}
+	if len(deleted) != 2 || deleted[0] != "slide-1" || deleted[1] != "slide-3" {
+		t.Errorf("deleted slides = %v, want [slide-1 slide-3]", deleted)
+	}
+
+	if output.PresentationID != "new-pres" || output.SlideID != "slide-2" || output.SlideIndex != 2 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+	if output.RemovedSlides != 2 {
+		t.Errorf("RemovedSlides = %d, want 2", output.RemovedSlides)
+	}
+	if output.URL != "https://docs.google.com/presentation/d/new-pres/edit" {
+		t.Errorf("URL = %q", output.URL)
+	}
+}
+
+func TestExtractSlide_SingleSlideDeckSkipsBatch(t *testing.T) {
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Title: "Solo", Slides: []*slides.Page{{ObjectId: "only"}}}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			t.Error("BatchUpdate should not be called when there is nothing to prune")
+			return nil, errors.New("unexpected call")
+		},
+	}
+	mockDrive := &mockDriveService{
+		CopyFileFunc: func(ctx context.Context, fileID string, file *drive.File) (*drive.File, error) {
+			return &drive.File{Id: "new-pres"}, nil
+		},
+	}
+
+	tools := newExtractSlideTestTools(mockSlides, mockDrive)
+	output, err := tools.ExtractSlide(context.Background(), &mockTokenSource{}, ExtractSlideInput{
+		PresentationID: "source-pres",
+		SlideID:        "only",
+		NewTitle:       "Custom",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Title != "Custom" || output.RemovedSlides != 0 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+}
+
+func TestExtractSlide_PruneFailureDeletesCopy(t *testing.T) {
+	var deletedFile string
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return newExtractSlideTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return nil, errors.New("internal error")
+		},
+	}
+	mockDrive := &mockDriveService{
+		CopyFileFunc: func(ctx context.Context, fileID string, file *drive.File) (*drive.File, error) {
+			return &drive.File{Id: "new-pres"}, nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			deletedFile = fileID
+			return nil
+		},
+	}
+
+	tools := newExtractSlideTestTools(mockSlides, mockDrive)
+	_, err := tools.ExtractSlide(context.Background(), &mockTokenSource{}, ExtractSlideInput{
+		PresentationID: "source-pres",
+		SlideIndex:     1,
+	})
+	if !errors.Is(err, ErrExtractSlideFailed) {
+		t.Errorf("expected ErrExtractSlideFailed, got %v", err)
+	}
+	if deletedFile != "new-pres" {
+		t.Errorf("expected copy to be deleted, deleted %q", deletedFile)
+	}
+}
+
+func TestExtractSlide_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   ExtractSlideInput
+		getErr  error
+		copyErr error
+		wantErr error
+	}{
+		{name: "missing presentation ID", input: ExtractSlideInput{SlideIndex: 1}, wantErr: ErrInvalidPresentationID},
+		{name: "missing slide reference", input: ExtractSlideInput{PresentationID: "source-pres"}, wantErr: ErrInvalidSlideReference},
+		{name: "slide not found", input: ExtractSlideInput{PresentationID: "source-pres", SlideIndex: 9}, wantErr: ErrSlideNotFound},
+		{name: "presentation not found", input: ExtractSlideInput{PresentationID: "source-pres", SlideIndex: 1}, getErr: errors.New("googleapi: Error 404: not found"), wantErr: ErrPresentationNotFound},
+		{name: "access denied", input: ExtractSlideInput{PresentationID: "source-pres", SlideIndex: 1}, getErr: errors.New("googleapi: Error 403: forbidden"), wantErr: ErrAccessDenied},
+		{name: "copy failed", input: ExtractSlideInput{PresentationID: "source-pres", SlideIndex: 1}, copyErr: errors.New("quota exceeded"), wantErr: ErrCopyFailed},
+		{name: "invalid destination", input: ExtractSlideInput{PresentationID: "source-pres", SlideIndex: 1, DestinationFolderID: "bad"}, copyErr: errors.New("invalid parent"), wantErr: ErrDestinationInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSlides := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return newExtractSlideTestPresentation(), nil
+				},
+			}
+			mockDrive := &mockDriveService{
+				CopyFileFunc: func(ctx context.Context, fileID string, file *drive.File) (*drive.File, error) {
+					if tt.copyErr != nil {
+						return nil, tt.copyErr
+					}
+					return &drive.File{Id: "new-pres"}, nil
+				},
+			}
+
+			tools := newExtractSlideTestTools(mockSlides, mockDrive)
+			_, err := tools.ExtractSlide(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrFeatureDisabled is returned for tools an operator switched off in ToolsConfig.FeatureFlags.
+var ErrFeatureDisabled = errors.New("tool is disabled by server configuration")
+
+// FeatureFlags switches individual tools on or off, keyed by MCP tool name
+// (e.g. "delete_presentation"). Tools without an entry are enabled, so an empty or nil map
+// keeps every tool available; set a tool to false to disable it.
+type FeatureFlags map[string]bool
+
+// Enabled reports whether the named tool may be dispatched.
+func (f FeatureFlags) Enabled(tool string) bool {
+	enabled, ok := f[tool]
+	return !ok || enabled
+}
+
+// CheckToolEnabled returns ErrFeatureDisabled when the named tool is switched off by the
+// config's FeatureFlags. The transport calls it before dispatching a tool call, and
+// batch_update calls it for each wrapped operation.
+func (t *Tools) CheckToolEnabled(name string) error {
+	if !t.config.FeatureFlags.Enabled(name) {
+		return fmt.Errorf("%w: %s", ErrFeatureDisabled, name)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestFeatureFlags_Enabled(t *testing.T) {
+	flags := FeatureFlags{"delete_presentation": false, "batch_update": true}
+
+	tests := []struct {
+		name  string
+		flags FeatureFlags
+		tool  string
+		want  bool
+	}{
+		{"disabled tool", flags, "delete_presentation", false},
+		{"explicitly enabled tool", flags, "batch_update", true},
+		{"tool without entry", flags, "add_slide", true},
+		{"nil flags", nil, "delete_presentation", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.flags.Enabled(tt.tool); got != tt.want {
+				t.Errorf("Enabled(%q) = %v, want %v", tt.tool, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckToolEnabled(t *testing.T) {
+	var factoryCalls int
+	config := DefaultToolsConfig()
+	config.FeatureFlags = FeatureFlags{"delete_presentation": false}
+	tools := NewTools(config, func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		factoryCalls++
+		return &mockSlidesService{}, nil
+	})
+
+	err := tools.CheckToolEnabled("delete_presentation")
+	if !errors.Is(err, ErrFeatureDisabled) {
+		t.Errorf("expected ErrFeatureDisabled, got %v", err)
+	}
+	if err := tools.CheckToolEnabled("add_slide"); err != nil {
+		t.Errorf("expected add_slide to be enabled, got %v", err)
+	}
+	if factoryCalls != 0 {
+		t.Errorf("expected no service to be created, got %d", factoryCalls)
+	}
+}
+
+func TestBatchUpdate_DisabledToolInBatch(t *testing.T) {
+	var factoryCalls int
+	config := DefaultToolsConfig()
+	config.FeatureFlags = FeatureFlags{"delete_slide": false}
+	tools := NewTools(config, func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		factoryCalls++
+		return &mockSlidesService{}, nil
+	})
+
+	for _, estimateOnly := range []bool{false, true} {
+		_, err := tools.BatchUpdate(context.Background(), &mockTokenSource{}, BatchUpdateInput{
+			PresentationID: "pres-1",
+			Operations: []BatchOperation{
+				{ToolName: "add_slide", Parameters: json.RawMessage(`{}`)},
+				{ToolName: "DELETE_SLIDE", Parameters: json.RawMessage(`{"slide_index": 1}`)},
+			},
+			EstimateOnly: estimateOnly,
+		})
+		if !errors.Is(err, ErrFeatureDisabled) {
+			t.Errorf("estimate_only=%v: expected ErrFeatureDisabled, got %v", estimateOnly, err)
+		}
+	}
+	if factoryCalls != 0 {
+		t.Errorf("expected the batch to be refused before any service is created, got %d", factoryCalls)
+	}
+}
@@ -0,0 +1,224 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for fill_placeholders tool.
+var (
+	ErrFillPlaceholdersFailed = errors.New("failed to fill placeholders")
+	ErrNoPlaceholderFills     = errors.New("no placeholder fills provided")
+	ErrInvalidPlaceholderType = errors.New("invalid placeholder type")
+)
+
+// PlaceholderFill is the text to put in one slide's placeholder.
+type PlaceholderFill struct {
+	SlideID string `json:"slide_id"`
+	Text    string `json:"text"` // Replaces the placeholder's text; empty clears it
+}
+
+// FillPlaceholdersInput represents the input for the fill_placeholders tool.
+type FillPlaceholdersInput struct {
+	PresentationID  string            `json:"presentation_id"`
+	PlaceholderType string            `json:"placeholder_type"` // TITLE, SUBTITLE, BODY, etc.
+	Fills           []PlaceholderFill `json:"fills"`
+}
+
+// FillPlaceholderResult reports what happened on one slide.
+type FillPlaceholderResult struct {
+	SlideID  string `json:"slide_id"`
+	ObjectID string `json:"object_id,omitempty"` // The placeholder that was filled
+	Status   string `json:"status"`              // "filled", "slide_not_found", or "placeholder_not_found"
+}
+
+// FillPlaceholdersOutput represents the output of the fill_placeholders tool.
+type FillPlaceholdersOutput struct {
+	PresentationID  string                  `json:"presentation_id"`
+	PlaceholderType string                  `json:"placeholder_type"`
+	Results         []FillPlaceholderResult `json:"results"` // One entry per fill, in input order
+	Filled          int                     `json:"filled"`
+	Missing         int                     `json:"missing"`
+}
+
+// FillPlaceholders replaces the text of one placeholder type on several slides in a single batch.
+// Slides without the placeholder are reported in the results instead of failing the call.
+func (t *Tools) FillPlaceholders(ctx context.Context, tokenSource oauth2.TokenSource, input FillPlaceholdersInput) (*FillPlaceholdersOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	placeholderType := strings.ToUpper(strings.TrimSpace(input.PlaceholderType))
+	if placeholderType == "" {
+		return nil, fmt.Errorf("%w: placeholder_type is required", ErrInvalidPlaceholderType)
+	}
+
+	if len(input.Fills) == 0 {
+		return nil, ErrNoPlaceholderFills
+	}
+
+	seen := make(map[string]bool, len(input.Fills))
+	for _, fill := range input.Fills {
+		if fill.SlideID == "" {
+			return nil, fmt.Errorf("%w: slide_id is required for every fill", ErrInvalidSlideReference)
+		}
+		if seen[fill.SlideID] {
+			return nil, fmt.Errorf("%w: slide '%s' is listed more than once", ErrInvalidSlideReference, fill.SlideID)
+		}
+		seen[fill.SlideID] = true
+	}
+
+	t.config.Logger.Info("filling placeholders",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("placeholder_type", placeholderType),
+		slog.Int("fills", len(input.Fills)),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to locate the placeholders
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slidesByID := make(map[string]*slides.Page, len(presentation.Slides))
+	for _, slide := range presentation.Slides {
+		slidesByID[slide.ObjectId] = slide
+	}
+
+	output := &FillPlaceholdersOutput{
+		PresentationID:  input.PresentationID,
+		PlaceholderType: placeholderType,
+		Results:         make([]FillPlaceholderResult, 0, len(input.Fills)),
+	}
+
+	var requests []*slides.Request
+	for _, fill := range input.Fills {
+		result := FillPlaceholderResult{SlideID: fill.SlideID}
+
+		slide, ok := slidesByID[fill.SlideID]
+		if !ok {
+			result.Status = "slide_not_found"
+			output.Results = append(output.Results, result)
+			output.Missing++
+			continue
+		}
+
+		placeholder := findPlaceholderByType(slide.PageElements, placeholderType)
+		if placeholder == nil {
+			result.Status = "placeholder_not_found"
+			output.Results = append(output.Results, result)
+			output.Missing++
+			continue
+		}
+
+		requests = append(requests, buildReplaceShapeTextRequests(placeholder, normalizeLineBreaks(fill.Text, false))...)
+		result.ObjectID = placeholder.ObjectId
+		result.Status = "filled"
+		output.Results = append(output.Results, result)
+		output.Filled++
+	}
+
+	if output.Filled == 0 {
+		t.config.Logger.Info("no placeholders to fill",
+			slog.String("presentation_id", input.PresentationID),
+			slog.String("placeholder_type", placeholderType),
+		)
+		return output, nil
+	}
+
+	// Execute batch update; clearing already-empty placeholders needs no requests
+	if len(requests) > 0 {
+		_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, ErrPresentationNotFound
+			}
+			if isForbiddenError(err) {
+				return nil, ErrAccessDenied
+			}
+			return nil, fmt.Errorf("%w: %v", ErrFillPlaceholdersFailed, err)
+		}
+	}
+
+	t.config.Logger.Info("placeholders filled successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("filled", output.Filled),
+		slog.Int("missing", output.Missing),
+	)
+
+	return output, nil
+}
+
+// findPlaceholderByType returns the first placeholder shape of the given type, looking inside
+// groups. A TITLE lookup falls back to CENTERED_TITLE, which title slides use instead.
+func findPlaceholderByType(elements []*slides.PageElement, placeholderType string) *slides.PageElement {
+	var find func(elements []*slides.PageElement, wanted string) *slides.PageElement
+	find = func(elements []*slides.PageElement, wanted string) *slides.PageElement {
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+			if element.ElementGroup != nil {
+				if found := find(element.ElementGroup.Children, wanted); found != nil {
+					return found
+				}
+				continue
+			}
+			if element.Shape != nil && element.Shape.Placeholder != nil && element.Shape.Placeholder.Type == wanted {
+				return element
+			}
+		}
+		return nil
+	}
+
+	if found := find(elements, placeholderType); found != nil {
+		return found
+	}
+	if placeholderType == "TITLE" {
+		return find(elements, "CENTERED_TITLE")
+	}
+	return nil
+}
+
+// buildReplaceShapeTextRequests replaces all text in a shape. The delete is only sent when the
+// shape already holds text, and the insert only when there is text to add.
+func buildReplaceShapeTextRequests(element *slides.PageElement, text string) []*slides.Request {
+	var requests []*slides.Request
+	if element.Shape != nil && element.Shape.Text != nil && extractTextFromTextContent(element.Shape.Text) != "" {
+		requests = append(requests, &slides.Request{
+			DeleteText: &slides.DeleteTextRequest{
+				ObjectId:  element.ObjectId,
+				TextRange: &slides.Range{Type: "ALL"},
+			},
+		})
+	}
+	if text != "" {
+		requests = append(requests, &slides.Request{
+			InsertText: &slides.InsertTextRequest{
+				ObjectId:       element.ObjectId,
+				InsertionIndex: 0,
+				Text:           text,
+			},
+		})
+	}
+	return requests
+}
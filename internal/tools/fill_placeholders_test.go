@@ -0,0 +1,175 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func newFillPlaceholdersTestPresentation() *slides.Presentation {
+	placeholder := func(id, placeholderType, text string) *slides.PageElement {
+		shape := &slides.Shape{
+			ShapeType:   "TEXT_BOX",
+			Placeholder: &slides.Placeholder{Type: placeholderType},
+		}
+		if text != "" {
+			shape.Text = &slides.TextContent{TextElements: createTextElementsNoLink(text)}
+		}
+		return &slides.PageElement{ObjectId: id, Shape: shape}
+	}
+
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1", PageElements: []*slides.PageElement{placeholder("title-1", "CENTERED_TITLE", "Old title")}},
+			{ObjectId: "slide-2", PageElements: []*slides.PageElement{placeholder("title-2", "TITLE", ""), placeholder("body-2", "BODY", "")}},
+			{ObjectId: "slide-3", PageElements: []*slides.PageElement{placeholder("body-3", "BODY", "")}},
+		},
+	}
+}
+
+func newFillPlaceholdersTestTools(captured *[]*slides.Request, batchErr error) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return newFillPlaceholdersTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*captured = requests
+			return &slides.BatchUpdatePresentationResponse{}, batchErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestFillPlaceholders_TitlesAcrossSlides(t *testing.T) {
+	var captured []*slides.Request
+	tools := newFillPlaceholdersTestTools(&captured, nil)
+
+	output, err := tools.FillPlaceholders(context.Background(), nil, FillPlaceholdersInput{
+		PresentationID:  "pres-1",
+		PlaceholderType: "title",
+		Fills: []PlaceholderFill{
+			{SlideID: "slide-1", Text: "Introduction"},
+			{SlideID: "slide-2", Text: "Results"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantResults := []FillPlaceholderResult{
+		{SlideID: "slide-1", ObjectID: "title-1", Status: "filled"},
+		{SlideID: "slide-2", ObjectID: "title-2", Status: "filled"},
+	}
+	if !reflect.DeepEqual(output.Results, wantResults) {
+		t.Errorf("Results = %+v, want %+v", output.Results, wantResults)
+	}
+	if output.Filled != 2 || output.Missing != 0 {
+		t.Errorf("expected 2 filled and 0 missing, got %d and %d", output.Filled, output.Missing)
+	}
+	if output.PlaceholderType != "TITLE" {
+		t.Errorf("PlaceholderType = %q, want TITLE", output.PlaceholderType)
+	}
+
+	// slide-1 has existing text to delete; slide-2's title is empty so only an insert is sent
+	if len(captured) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(captured))
+	}
+	if captured[0].DeleteText == nil || captured[0].DeleteText.ObjectId != "title-1" {
+		t.Errorf("request 0: expected DeleteText on title-1, got %+v", captured[0])
+	}
+	if captured[1].InsertText == nil || captured[1].InsertText.ObjectId != "title-1" || captured[1].InsertText.Text != "Introduction" {
+		t.Errorf("request 1: expected InsertText 'Introduction' on title-1, got %+v", captured[1])
+	}
+	if captured[2].InsertText == nil || captured[2].InsertText.ObjectId != "title-2" || captured[2].InsertText.Text != "Results" {
+		t.Errorf("request 2: expected InsertText 'Results' on title-2, got %+v", captured[2])
+	}
+}
+
+func TestFillPlaceholders_ReportsMissing(t *testing.T) {
+	var captured []*slides.Request
+	tools := newFillPlaceholdersTestTools(&captured, nil)
+
+	output, err := tools.FillPlaceholders(context.Background(), nil, FillPlaceholdersInput{
+		PresentationID:  "pres-1",
+		PlaceholderType: "TITLE",
+		Fills: []PlaceholderFill{
+			{SlideID: "slide-2", Text: "Results"},
+			{SlideID: "slide-3", Text: "No title here"},
+			{SlideID: "slide-9", Text: "Unknown slide"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantResults := []FillPlaceholderResult{
+		{SlideID: "slide-2", ObjectID: "title-2", Status: "filled"},
+		{SlideID: "slide-3", Status: "placeholder_not_found"},
+		{SlideID: "slide-9", Status: "slide_not_found"},
+	}
+	if !reflect.DeepEqual(output.Results, wantResults) {
+		t.Errorf("Results = %+v, want %+v", output.Results, wantResults)
+	}
+	if output.Filled != 1 || output.Missing != 2 {
+		t.Errorf("expected 1 filled and 2 missing, got %d and %d", output.Filled, output.Missing)
+	}
+	if len(captured) != 1 {
+		t.Errorf("expected 1 request, got %d", len(captured))
+	}
+}
+
+func TestFillPlaceholders_NothingToFill(t *testing.T) {
+	var captured []*slides.Request
+	tools := newFillPlaceholdersTestTools(&captured, nil)
+
+	output, err := tools.FillPlaceholders(context.Background(), nil, FillPlaceholdersInput{
+		PresentationID:  "pres-1",
+		PlaceholderType: "SUBTITLE",
+		Fills:           []PlaceholderFill{{SlideID: "slide-1", Text: "Subtitle"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Filled != 0 || output.Missing != 1 {
+		t.Errorf("expected 0 filled and 1 missing, got %d and %d", output.Filled, output.Missing)
+	}
+	if captured != nil {
+		t.Error("expected no batch update when nothing is filled")
+	}
+}
+
+func TestFillPlaceholders_Errors(t *testing.T) {
+	fills := []PlaceholderFill{{SlideID: "slide-1", Text: "Title"}}
+	tests := []struct {
+		name     string
+		input    FillPlaceholdersInput
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", FillPlaceholdersInput{PlaceholderType: "TITLE", Fills: fills}, nil, ErrInvalidPresentationID},
+		{"missing placeholder type", FillPlaceholdersInput{PresentationID: "pres-1", Fills: fills}, nil, ErrInvalidPlaceholderType},
+		{"no fills", FillPlaceholdersInput{PresentationID: "pres-1", PlaceholderType: "TITLE"}, nil, ErrNoPlaceholderFills},
+		{"empty slide ID", FillPlaceholdersInput{PresentationID: "pres-1", PlaceholderType: "TITLE", Fills: []PlaceholderFill{{Text: "Title"}}}, nil, ErrInvalidSlideReference},
+		{"duplicate slide", FillPlaceholdersInput{PresentationID: "pres-1", PlaceholderType: "TITLE", Fills: append(fills, fills[0])}, nil, ErrInvalidSlideReference},
+		{"batch update failure", FillPlaceholdersInput{PresentationID: "pres-1", PlaceholderType: "TITLE", Fills: fills}, errors.New("boom"), ErrFillPlaceholdersFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []*slides.Request
+			tools := newFillPlaceholdersTestTools(&captured, tt.batchErr)
+
+			_, err := tools.FillPlaceholders(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
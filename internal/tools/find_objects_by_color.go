@@ -0,0 +1,271 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for find_objects_by_color tool.
+var (
+	ErrInvalidSearchColor    = errors.New("invalid search color")
+	ErrInvalidColorTolerance = errors.New("invalid color tolerance")
+)
+
+// DefaultColorTolerance is the default RGB distance (0-255 scale) within which colors match.
+const DefaultColorTolerance = 10.0
+
+// FindObjectsByColorInput represents the input for the find_objects_by_color tool.
+type FindObjectsByColorInput struct {
+	PresentationID string  `json:"presentation_id"`
+	Color          string  `json:"color"`               // Hex (#RRGGBB) or theme color name (ACCENT1, theme:DARK1, ...)
+	Tolerance      float64 `json:"tolerance,omitempty"` // Max RGB distance (0-255 scale), default 10
+}
+
+// ColorMatch describes one place an object uses a color matching the search color.
+type ColorMatch struct {
+	SlideIndex int     `json:"slide_index"` // 1-based
+	SlideID    string  `json:"slide_id"`
+	ObjectID   string  `json:"object_id"`
+	ObjectType string  `json:"object_type"`
+	Location   string  `json:"location"`              // "fill", "outline", "line" or "text"
+	Color      string  `json:"color,omitempty"`       // Resolved hex color, empty if the theme has no value for it
+	ThemeColor string  `json:"theme_color,omitempty"` // Theme color the object references, if any
+	Distance   float64 `json:"distance"`              // RGB distance to the search color, 0-255 scale
+}
+
+// FindObjectsByColorOutput represents the output of the find_objects_by_color tool.
+type FindObjectsByColorOutput struct {
+	PresentationID string       `json:"presentation_id"`
+	Color          string       `json:"color"` // Normalized search color
+	Tolerance      float64      `json:"tolerance"`
+	MatchCount     int          `json:"match_count"`
+	Matches        []ColorMatch `json:"matches"`
+	ObjectIDs      []string     `json:"object_ids"` // Unique matching objects, in slide order
+}
+
+// FindObjectsByColor reports objects whose solid fill, outline, line or text color lies within
+// the tolerance of the search color. Theme color references on either side are resolved with
+// the color scheme of each slide's master, so a hex search also finds theme-colored objects.
+func (t *Tools) FindObjectsByColor(ctx context.Context, tokenSource oauth2.TokenSource, input FindObjectsByColorInput) (*FindObjectsByColorOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	target := parseColor(input.Color)
+	if target == nil {
+		return nil, fmt.Errorf("%w: '%s' is not a hex color (#RRGGBB) or one of %s", ErrInvalidSearchColor, input.Color, strings.Join(themeColorTypes, ", "))
+	}
+	tolerance := input.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultColorTolerance
+	}
+	if math.IsNaN(tolerance) || tolerance < 0 || tolerance > maxSnapThreshold {
+		return nil, fmt.Errorf("%w: tolerance must be between 0 and %.1f", ErrInvalidColorTolerance, maxSnapThreshold)
+	}
+
+	searchColor := target.ThemeColor
+	if target.RgbColor != nil {
+		searchColor = rgbHex(target.RgbColor)
+	}
+
+	t.config.Logger.Info("finding objects by color",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("color", searchColor),
+		slog.Float64("tolerance", tolerance),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	mastersByID := make(map[string]*slides.Page)
+	for _, master := range presentation.Masters {
+		mastersByID[master.ObjectId] = master
+	}
+	var defaultMaster *slides.Page
+	if len(presentation.Masters) > 0 {
+		defaultMaster = presentation.Masters[0]
+	}
+
+	output := &FindObjectsByColorOutput{
+		PresentationID: input.PresentationID,
+		Color:          searchColor,
+		Tolerance:      tolerance,
+		Matches:        []ColorMatch{},
+		ObjectIDs:      []string{},
+	}
+
+	for i, slide := range presentation.Slides {
+		master := defaultMaster
+		if slide.SlideProperties != nil && mastersByID[slide.SlideProperties.MasterObjectId] != nil {
+			master = mastersByID[slide.SlideProperties.MasterObjectId]
+		}
+
+		matches := findColorMatches(slide.PageElements, target, themeColorsByType(master), tolerance)
+		for j := range matches {
+			matches[j].SlideIndex = i + 1
+			matches[j].SlideID = slide.ObjectId
+		}
+		output.Matches = append(output.Matches, matches...)
+	}
+
+	output.MatchCount = len(output.Matches)
+	seen := make(map[string]bool)
+	for _, match := range output.Matches {
+		if !seen[match.ObjectID] {
+			seen[match.ObjectID] = true
+			output.ObjectIDs = append(output.ObjectIDs, match.ObjectID)
+		}
+	}
+
+	t.config.Logger.Info("objects found by color",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("match_count", output.MatchCount),
+		slog.Int("object_count", len(output.ObjectIDs)),
+	)
+
+	return output, nil
+}
+
+// findColorMatches walks elements, including group children and table cells, and returns one
+// match per object and location whose color is within tolerance of target. Text runs of the
+// same object collapse into a single "text" match carrying the closest color.
+func findColorMatches(elements []*slides.PageElement, target *slides.OpaqueColor, themeColors map[string]*slides.RgbColor, tolerance float64) []ColorMatch {
+	var matches []ColorMatch
+
+	check := func(element *slides.PageElement, location string, color *slides.OpaqueColor) {
+		match, ok := matchColor(color, target, themeColors, tolerance)
+		if !ok {
+			return
+		}
+		match.ObjectID = element.ObjectId
+		match.ObjectType = determineObjectType(element)
+		match.Location = location
+
+		for i := range matches {
+			if matches[i].ObjectID == match.ObjectID && matches[i].Location == location {
+				if match.Distance < matches[i].Distance {
+					matches[i] = match
+				}
+				return
+			}
+		}
+		matches = append(matches, match)
+	}
+
+	checkText := func(element *slides.PageElement, text *slides.TextContent) {
+		if text == nil {
+			return
+		}
+		for _, textElement := range text.TextElements {
+			run := textElement.TextRun
+			if run == nil || run.Style == nil || textElement.EndIndex <= textElement.StartIndex {
+				continue
+			}
+			if foreground := run.Style.ForegroundColor; foreground != nil {
+				check(element, "text", foreground.OpaqueColor)
+			}
+		}
+	}
+
+	var visit func(elements []*slides.PageElement)
+	visit = func(elements []*slides.PageElement) {
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+
+			switch {
+			case element.ElementGroup != nil:
+				visit(element.ElementGroup.Children)
+
+			case element.Shape != nil:
+				if props := element.Shape.ShapeProperties; props != nil {
+					if fill := props.ShapeBackgroundFill; fill != nil && fill.SolidFill != nil {
+						check(element, "fill", fill.SolidFill.Color)
+					}
+					if outline := props.Outline; outline != nil && outline.OutlineFill != nil && outline.OutlineFill.SolidFill != nil {
+						check(element, "outline", outline.OutlineFill.SolidFill.Color)
+					}
+				}
+				checkText(element, element.Shape.Text)
+
+			case element.Line != nil:
+				if props := element.Line.LineProperties; props != nil && props.LineFill != nil && props.LineFill.SolidFill != nil {
+					check(element, "line", props.LineFill.SolidFill.Color)
+				}
+
+			case element.Table != nil:
+				for _, row := range element.Table.TableRows {
+					for _, cell := range row.TableCells {
+						if props := cell.TableCellProperties; props != nil && props.TableCellBackgroundFill != nil && props.TableCellBackgroundFill.SolidFill != nil {
+							check(element, "fill", props.TableCellBackgroundFill.SolidFill.Color)
+						}
+						checkText(element, cell.Text)
+					}
+				}
+			}
+		}
+	}
+
+	visit(elements)
+	return matches
+}
+
+// matchColor compares an object color with the search color. A reference to the same theme
+// color always matches; otherwise both sides are resolved to RGB through themeColors and must
+// lie within tolerance.
+func matchColor(color, target *slides.OpaqueColor, themeColors map[string]*slides.RgbColor, tolerance float64) (ColorMatch, bool) {
+	if color == nil {
+		return ColorMatch{}, false
+	}
+
+	resolve := func(c *slides.OpaqueColor) *slides.RgbColor {
+		if c.RgbColor != nil {
+			return c.RgbColor
+		}
+		return themeColors[c.ThemeColor]
+	}
+
+	match := ColorMatch{ThemeColor: color.ThemeColor}
+	rgb := resolve(color)
+	if rgb != nil {
+		match.Color = rgbHex(rgb)
+	}
+
+	if target.ThemeColor != "" && color.ThemeColor == target.ThemeColor {
+		return match, true
+	}
+
+	targetRGB := resolve(target)
+	if rgb == nil || targetRGB == nil {
+		return ColorMatch{}, false
+	}
+	distance := rgbDistance(rgb, targetRGB)
+	if distance > tolerance {
+		return ColorMatch{}, false
+	}
+	match.Distance = math.Round(distance*10) / 10
+	return match, true
+}
@@ -0,0 +1,236 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func colorTestFill(id string, color *slides.OpaqueColor) *slides.PageElement {
+	return &slides.PageElement{ObjectId: id, Shape: &slides.Shape{
+		ShapeType: "RECTANGLE",
+		ShapeProperties: &slides.ShapeProperties{
+			ShapeBackgroundFill: &slides.ShapeBackgroundFill{SolidFill: &slides.SolidFill{Color: color}},
+		},
+	}}
+}
+
+func colorTestRGB(hex string) *slides.OpaqueColor {
+	return &slides.OpaqueColor{RgbColor: parseHexColor(hex)}
+}
+
+func newFindObjectsByColorTestTools(getErr error) *Tools {
+	red := colorTestRGB("#FF0000")
+	textBox := &slides.PageElement{ObjectId: "text", Shape: &slides.Shape{
+		ShapeType: "TEXT_BOX",
+		Text: &slides.TextContent{TextElements: []*slides.TextElement{
+			{StartIndex: 0, EndIndex: 5, TextRun: &slides.TextRun{Content: "Black", Style: &slides.TextStyle{
+				ForegroundColor: &slides.OptionalColor{OpaqueColor: colorTestRGB("#000000")},
+			}}},
+			{StartIndex: 5, EndIndex: 9, TextRun: &slides.TextRun{Content: "Red\n", Style: &slides.TextStyle{
+				ForegroundColor: &slides.OptionalColor{OpaqueColor: colorTestRGB("#FA0000")},
+			}}},
+			{StartIndex: 9, EndIndex: 13, TextRun: &slides.TextRun{Content: "Red\n", Style: &slides.TextStyle{
+				ForegroundColor: &slides.OptionalColor{OpaqueColor: red},
+			}}},
+		}},
+	}}
+	outlined := &slides.PageElement{ObjectId: "outlined", Shape: &slides.Shape{
+		ShapeType: "ELLIPSE",
+		ShapeProperties: &slides.ShapeProperties{
+			ShapeBackgroundFill: &slides.ShapeBackgroundFill{SolidFill: &slides.SolidFill{Color: colorTestRGB("#FFFFFF")}},
+			Outline:             &slides.Outline{OutlineFill: &slides.OutlineFill{SolidFill: &slides.SolidFill{Color: red}}},
+		},
+	}}
+	line := &slides.PageElement{ObjectId: "line", Line: &slides.Line{
+		LineProperties: &slides.LineProperties{LineFill: &slides.LineFill{SolidFill: &slides.SolidFill{Color: red}}},
+	}}
+	table := &slides.PageElement{ObjectId: "table", Table: &slides.Table{TableRows: []*slides.TableRow{
+		{TableCells: []*slides.TableCell{
+			{TableCellProperties: &slides.TableCellProperties{
+				TableCellBackgroundFill: &slides.TableCellBackgroundFill{SolidFill: &slides.SolidFill{Color: red}},
+			}},
+		}},
+	}}}
+
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Masters: []*slides.Page{{
+			ObjectId: "master-1",
+			PageProperties: &slides.PageProperties{ColorScheme: &slides.ColorScheme{Colors: []*slides.ThemeColorPair{
+				{Type: "ACCENT1", Color: &slides.RgbColor{Blue: 1}},
+				{Type: "ACCENT2", Color: &slides.RgbColor{Red: 1}},
+			}}},
+		}},
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1", PageElements: []*slides.PageElement{
+				colorTestFill("red", red),
+				colorTestFill("green", colorTestRGB("#00FF00")),
+				colorTestFill("near-red", colorTestRGB("#F80000")),
+				colorTestFill("far-red", colorTestRGB("#E00000")),
+				textBox,
+			}},
+			{ObjectId: "slide-2", PageElements: []*slides.PageElement{
+				colorTestFill("accent2", &slides.OpaqueColor{ThemeColor: "ACCENT2"}),
+				colorTestFill("accent1", &slides.OpaqueColor{ThemeColor: "ACCENT1"}),
+				{ObjectId: "group", ElementGroup: &slides.Group{Children: []*slides.PageElement{colorTestFill("grouped", red)}}},
+				outlined,
+				line,
+				table,
+			}},
+		},
+	}
+
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestFindObjectsByColor(t *testing.T) {
+	tools := newFindObjectsByColorTestTools(nil)
+
+	output, err := tools.FindObjectsByColor(context.Background(), nil, FindObjectsByColorInput{
+		PresentationID: "pres-1",
+		Color:          "#ff0000",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct {
+		slideIndex int
+		objectID   string
+		location   string
+		distance   float64
+	}{
+		{1, "red", "fill", 0},
+		{1, "near-red", "fill", 7},
+		{1, "text", "text", 0},
+		{2, "accent2", "fill", 0},
+		{2, "grouped", "fill", 0},
+		{2, "outlined", "outline", 0},
+		{2, "line", "line", 0},
+		{2, "table", "fill", 0},
+	}
+	if output.Color != "#FF0000" || output.Tolerance != DefaultColorTolerance {
+		t.Errorf("unexpected search echo: %s within %v", output.Color, output.Tolerance)
+	}
+	if output.MatchCount != len(want) || len(output.Matches) != len(want) {
+		t.Fatalf("expected %d matches, got %+v", len(want), output.Matches)
+	}
+	for i, w := range want {
+		got := output.Matches[i]
+		if got.SlideIndex != w.slideIndex || got.ObjectID != w.objectID || got.Location != w.location || math.Abs(got.Distance-w.distance) > 0.1 {
+			t.Errorf("match %d = %+v, want %+v", i, got, w)
+		}
+	}
+
+	accent := output.Matches[3]
+	if accent.ThemeColor != "ACCENT2" || accent.Color != "#FF0000" || accent.SlideID != "slide-2" {
+		t.Errorf("expected the theme reference resolved to red, got %+v", accent)
+	}
+	if len(output.ObjectIDs) != len(want) {
+		t.Errorf("expected %d unique objects, got %v", len(want), output.ObjectIDs)
+	}
+	for _, match := range output.Matches {
+		if match.ObjectID == "green" || match.ObjectID == "far-red" || match.ObjectID == "accent1" {
+			t.Errorf("expected %s to be ignored", match.ObjectID)
+		}
+	}
+}
+
+func TestFindObjectsByColor_Tolerance(t *testing.T) {
+	tools := newFindObjectsByColorTestTools(nil)
+
+	output, err := tools.FindObjectsByColor(context.Background(), nil, FindObjectsByColorInput{
+		PresentationID: "pres-1",
+		Color:          "#FF0000",
+		Tolerance:      40,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	found := make(map[string]bool)
+	for _, match := range output.Matches {
+		found[match.ObjectID] = true
+	}
+	if !found["far-red"] || found["green"] {
+		t.Errorf("expected a wider tolerance to include far-red only, got %v", output.ObjectIDs)
+	}
+}
+
+func TestFindObjectsByColor_ThemeColor(t *testing.T) {
+	tools := newFindObjectsByColorTestTools(nil)
+
+	output, err := tools.FindObjectsByColor(context.Background(), nil, FindObjectsByColorInput{
+		PresentationID: "pres-1",
+		Color:          "theme:accent1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Color != "ACCENT1" || len(output.Matches) != 1 || output.Matches[0].ObjectID != "accent1" {
+		t.Fatalf("expected only the ACCENT1 reference, got %+v", output)
+	}
+	if output.Matches[0].Color != "#0000FF" {
+		t.Errorf("expected the resolved color #0000FF, got %s", output.Matches[0].Color)
+	}
+}
+
+func TestMatchColor_UnresolvedThemeColor(t *testing.T) {
+	target := &slides.OpaqueColor{ThemeColor: "DARK1"}
+
+	match, ok := matchColor(&slides.OpaqueColor{ThemeColor: "DARK1"}, target, nil, DefaultColorTolerance)
+	if !ok || match.Color != "" || match.ThemeColor != "DARK1" {
+		t.Errorf("expected the same theme reference to match without a scheme, got %+v, %v", match, ok)
+	}
+	if _, ok := matchColor(colorTestRGB("#000000"), target, nil, DefaultColorTolerance); ok {
+		t.Error("expected an RGB color not to match an unresolved theme color")
+	}
+	if _, ok := matchColor(nil, target, nil, DefaultColorTolerance); ok {
+		t.Error("expected a nil color not to match")
+	}
+}
+
+func TestFindObjectsByColor_Errors(t *testing.T) {
+	valid := FindObjectsByColorInput{PresentationID: "pres-1", Color: "#FF0000"}
+
+	tests := []struct {
+		name    string
+		input   FindObjectsByColorInput
+		getErr  error
+		wantErr error
+	}{
+		{"missing presentation", FindObjectsByColorInput{Color: "#FF0000"}, nil, ErrInvalidPresentationID},
+		{"missing color", FindObjectsByColorInput{PresentationID: "pres-1"}, nil, ErrInvalidSearchColor},
+		{"unknown color", FindObjectsByColorInput{PresentationID: "pres-1", Color: "crimson"}, nil, ErrInvalidSearchColor},
+		{"negative tolerance", FindObjectsByColorInput{PresentationID: "pres-1", Color: "#FF0000", Tolerance: -1}, nil, ErrInvalidColorTolerance},
+		{"tolerance too large", FindObjectsByColorInput{PresentationID: "pres-1", Color: "#FF0000", Tolerance: 500}, nil, ErrInvalidColorTolerance},
+		{"presentation not found", valid, &googleapi.Error{Code: 404}, ErrPresentationNotFound},
+		{"access denied", valid, &googleapi.Error{Code: 403}, ErrAccessDenied},
+		{"api failure", valid, errors.New("boom"), ErrSlidesAPIError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newFindObjectsByColorTestTools(tt.getErr)
+
+			_, err := tools.FindObjectsByColor(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Off-slide statuses reported by find_off_slide_objects.
+const (
+	OffSlidePartially = "partially_off"
+	OffSlideFully     = "fully_off"
+)
+
+// offSlideTolerance absorbs EMU rounding so elements flush with a page edge are not flagged.
+const offSlideTolerance = 0.01 // Points
+
+// FindOffSlideObjectsInput represents the input for the find_off_slide_objects tool.
+type FindOffSlideObjectsInput struct {
+	PresentationID string `json:"presentation_id"`
+	SlideIndex     int    `json:"slide_index,omitempty"` // 1-based; omit both slide fields to check every slide
+	SlideID        string `json:"slide_id,omitempty"`    // Alternative to slide_index
+}
+
+// OffSlideObject describes a top-level element whose bounding box crosses the page bounds.
+type OffSlideObject struct {
+	SlideIndex int       `json:"slide_index"` // 1-based
+	SlideID    string    `json:"slide_id"`
+	ObjectID   string    `json:"object_id"`
+	ObjectType string    `json:"object_type"`
+	Status     string    `json:"status"`   // "partially_off" or "fully_off"
+	Edges      []string  `json:"edges"`    // Page edges crossed: "left", "top", "right", "bottom"
+	Position   *Position `json:"position"` // Top-left corner in points
+	Size       *Size     `json:"size"`     // Points
+}
+
+// FindOffSlideObjectsOutput represents the output of the find_off_slide_objects tool.
+type FindOffSlideObjectsOutput struct {
+	PresentationID    string           `json:"presentation_id"`
+	PageWidth         float64          `json:"page_width"`  // Points
+	PageHeight        float64          `json:"page_height"` // Points
+	SlidesChecked     int              `json:"slides_checked"`
+	PartiallyOffCount int              `json:"partially_off_count"`
+	FullyOffCount     int              `json:"fully_off_count"`
+	Objects           []OffSlideObject `json:"objects"`
+}
+
+// FindOffSlideObjects reports top-level elements whose bounding box lies partly or fully outside
+// the page. Boxes are computed as in find_overlaps, so a group is bounded by its children and
+// rotation is ignored. It only reads the presentation.
+func (t *Tools) FindOffSlideObjects(ctx context.Context, tokenSource oauth2.TokenSource, input FindOffSlideObjectsInput) (*FindOffSlideObjectsOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	t.config.Logger.Info("finding off-slide objects",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// Check one slide when given, otherwise all of them
+	slideIndices := make([]int, 0, len(presentation.Slides))
+	if input.SlideIndex != 0 || input.SlideID != "" {
+		_, slideIndex, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+		if err != nil {
+			return nil, err
+		}
+		slideIndices = append(slideIndices, slideIndex)
+	} else {
+		for i := range presentation.Slides {
+			slideIndices = append(slideIndices, i+1)
+		}
+	}
+
+	pageWidth, pageHeight := pageSizeInPoints(presentation.PageSize)
+	output := &FindOffSlideObjectsOutput{
+		PresentationID: input.PresentationID,
+		PageWidth:      pageWidth,
+		PageHeight:     pageHeight,
+		SlidesChecked:  len(slideIndices),
+		Objects:        []OffSlideObject{},
+	}
+
+	for _, slideIndex := range slideIndices {
+		slide := presentation.Slides[slideIndex-1]
+		for _, object := range findOffSlideElements(slide.PageElements, pageWidth, pageHeight) {
+			object.SlideIndex = slideIndex
+			object.SlideID = slide.ObjectId
+			output.Objects = append(output.Objects, object)
+			if object.Status == OffSlideFully {
+				output.FullyOffCount++
+			} else {
+				output.PartiallyOffCount++
+			}
+		}
+	}
+
+	t.config.Logger.Info("off-slide objects found",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slides_checked", output.SlidesChecked),
+		slog.Int("partially_off", output.PartiallyOffCount),
+		slog.Int("fully_off", output.FullyOffCount),
+	)
+
+	return output, nil
+}
+
+// findOffSlideElements returns the top-level elements whose bounding box crosses the page
+// bounds. An element is fully off when its box shares no area with the page. Elements without
+// bounds are skipped.
+func findOffSlideElements(elements []*slides.PageElement, pageWidth, pageHeight float64) []OffSlideObject {
+	var objects []OffSlideObject
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+		box, ok := elementBoundingBox(element)
+		if !ok {
+			continue
+		}
+
+		var edges []string
+		if box.Left < -offSlideTolerance {
+			edges = append(edges, "left")
+		}
+		if box.Top < -offSlideTolerance {
+			edges = append(edges, "top")
+		}
+		if box.Right > pageWidth+offSlideTolerance {
+			edges = append(edges, "right")
+		}
+		if box.Bottom > pageHeight+offSlideTolerance {
+			edges = append(edges, "bottom")
+		}
+		if len(edges) == 0 {
+			continue
+		}
+
+		status := OffSlidePartially
+		if box.Right <= offSlideTolerance || box.Bottom <= offSlideTolerance ||
+			box.Left >= pageWidth-offSlideTolerance || box.Top >= pageHeight-offSlideTolerance {
+			status = OffSlideFully
+		}
+
+		objects = append(objects, OffSlideObject{
+			ObjectID:   element.ObjectId,
+			ObjectType: determineObjectType(element),
+			Status:     status,
+			Edges:      edges,
+			Position:   &Position{X: math.Round(box.Left*100) / 100, Y: math.Round(box.Top*100) / 100},
+			Size:       &Size{Width: math.Round((box.Right-box.Left)*100) / 100, Height: math.Round((box.Bottom-box.Top)*100) / 100},
+		})
+	}
+	return objects
+}
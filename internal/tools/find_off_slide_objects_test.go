@@ -0,0 +1,146 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newFindOffSlideObjectsTestTools(getErr error) *Tools {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		PageSize: &slides.Size{
+			Width:  &slides.Dimension{Magnitude: pointsToEMU(720), Unit: "EMU"},
+			Height: &slides.Dimension{Magnitude: pointsToEMU(405), Unit: "EMU"},
+		},
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					treeTestElement("inside", 10, 10, 100, 100),
+					treeTestElement("flush", 620, 305, 100, 100), // Touches the bottom-right corner exactly
+					treeTestElement("past-width", 680, 50, 100, 50),
+					treeTestElement("beyond-width", 750, 50, 100, 50),
+					treeTestElement("above", -20, -30, 50, 50),
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "group-1",
+						ElementGroup: &slides.Group{Children: []*slides.PageElement{
+							treeTestElement("child-1", 300, 300, 20, 20),
+							treeTestElement("child-2", 380, 400, 20, 20), // Pushes the group past the bottom
+						}},
+					},
+					{ObjectId: "no-transform", Shape: &slides.Shape{ShapeType: "RECTANGLE"}},
+					{
+						// Children sit inside the page, but the group moves them past the right edge
+						ObjectId:  "moved-group",
+						Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: pointsToEMU(720)},
+						ElementGroup: &slides.Group{Children: []*slides.PageElement{
+							treeTestElement("moved-child", 10, 10, 50, 50),
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestFindOffSlideObjects(t *testing.T) {
+	tools := newFindOffSlideObjectsTestTools(nil)
+
+	output, err := tools.FindOffSlideObjects(context.Background(), nil, FindOffSlideObjectsInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.SlidesChecked != 2 || output.PageWidth != 720 || output.PageHeight != 405 {
+		t.Errorf("unexpected summary: %+v", output)
+	}
+
+	want := []struct {
+		objectID string
+		slideID  string
+		status   string
+		edges    string
+	}{
+		{"past-width", "slide-1", OffSlidePartially, "right"},
+		{"beyond-width", "slide-1", OffSlideFully, "right"},
+		{"above", "slide-1", OffSlidePartially, "left,top"},
+		{"group-1", "slide-2", OffSlidePartially, "bottom"},
+		{"moved-group", "slide-2", OffSlideFully, "right"},
+	}
+	if len(output.Objects) != len(want) {
+		t.Fatalf("expected %d flagged objects, got %+v", len(want), output.Objects)
+	}
+	for i, w := range want {
+		got := output.Objects[i]
+		if got.ObjectID != w.objectID || got.SlideID != w.slideID || got.Status != w.status || strings.Join(got.Edges, ",") != w.edges {
+			t.Errorf("object %d: expected %s on %s %s (%s), got %+v", i, w.objectID, w.slideID, w.status, w.edges, got)
+		}
+	}
+
+	beyond := output.Objects[1]
+	if beyond.Position.X != 750 || beyond.Size.Width != 100 || beyond.ObjectType != "RECTANGLE" {
+		t.Errorf("unexpected geometry for the element beyond the page width: %+v %+v %s", beyond.Position, beyond.Size, beyond.ObjectType)
+	}
+	if output.PartiallyOffCount != 3 || output.FullyOffCount != 2 {
+		t.Errorf("expected 3 partially and 2 fully off, got %d and %d", output.PartiallyOffCount, output.FullyOffCount)
+	}
+	if moved := output.Objects[4]; moved.Position.X != 730 || moved.Size.Width != 50 {
+		t.Errorf("expected the group transform applied to its child, got %+v %+v", moved.Position, moved.Size)
+	}
+}
+
+func TestFindOffSlideObjects_SingleSlide(t *testing.T) {
+	tools := newFindOffSlideObjectsTestTools(nil)
+
+	output, err := tools.FindOffSlideObjects(context.Background(), nil, FindOffSlideObjectsInput{PresentationID: "pres-1", SlideID: "slide-2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.SlidesChecked != 1 || len(output.Objects) != 2 || output.Objects[0].ObjectID != "group-1" || output.Objects[0].SlideIndex != 2 {
+		t.Errorf("expected only the groups on slide 2, got %+v", output)
+	}
+}
+
+func TestFindOffSlideObjects_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   FindOffSlideObjectsInput
+		getErr  error
+		wantErr error
+	}{
+		{"missing presentation", FindOffSlideObjectsInput{}, nil, ErrInvalidPresentationID},
+		{"slide not found", FindOffSlideObjectsInput{PresentationID: "pres-1", SlideIndex: 9}, nil, ErrSlideNotFound},
+		{"presentation not found", FindOffSlideObjectsInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 404}, ErrPresentationNotFound},
+		{"access denied", FindOffSlideObjectsInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 403}, ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newFindOffSlideObjectsTestTools(tt.getErr)
+			_, err := tools.FindOffSlideObjects(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for find_overlaps tool.
+var (
+	ErrInvalidOverlapThreshold = errors.New("invalid overlap threshold")
+)
+
+// FindOverlapsInput represents the input for the find_overlaps tool.
+type FindOverlapsInput struct {
+	PresentationID string  `json:"presentation_id"`
+	SlideIndex     int     `json:"slide_index,omitempty"` // 1-based; omit both slide fields to check every slide
+	SlideID        string  `json:"slide_id,omitempty"`    // Alternative to slide_index
+	Threshold      float64 `json:"threshold,omitempty"`   // Minimum overlap as a fraction (0-1) of the smaller box's area, default 0
+}
+
+// ObjectOverlap describes two top-level elements whose bounding boxes overlap.
+type ObjectOverlap struct {
+	SlideIndex    int     `json:"slide_index"` // 1-based
+	SlideID       string  `json:"slide_id"`
+	ObjectIDA     string  `json:"object_id_a"` // Lower in z-order
+	ObjectIDB     string  `json:"object_id_b"`
+	OverlapWidth  float64 `json:"overlap_width"`  // Points
+	OverlapHeight float64 `json:"overlap_height"` // Points
+	OverlapRatio  float64 `json:"overlap_ratio"`  // Overlap area / smaller box's area; 1 when one box contains the other
+}
+
+// FindOverlapsOutput represents the output of the find_overlaps tool.
+type FindOverlapsOutput struct {
+	PresentationID string          `json:"presentation_id"`
+	Threshold      float64         `json:"threshold"`
+	SlidesChecked  int             `json:"slides_checked"`
+	OverlapCount   int             `json:"overlap_count"`
+	Overlaps       []ObjectOverlap `json:"overlaps"`
+}
+
+// boundingBox is an axis-aligned rectangle in points.
+type boundingBox struct {
+	Left, Top, Right, Bottom float64
+}
+
+// FindOverlaps reports pairs of top-level elements on each slide whose bounding boxes overlap by
+// more than the threshold. A group counts as one element bounded by its children. Boxes come
+// from each element's transform and size, ignoring rotation. It only reads the presentation.
+func (t *Tools) FindOverlaps(ctx context.Context, tokenSource oauth2.TokenSource, input FindOverlapsInput) (*FindOverlapsOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.Threshold < 0 || input.Threshold >= 1 || math.IsNaN(input.Threshold) {
+		return nil, fmt.Errorf("%w: threshold must be at least 0 and below 1", ErrInvalidOverlapThreshold)
+	}
+
+	t.config.Logger.Info("finding overlapping objects",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+		slog.Float64("threshold", input.Threshold),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// Check one slide when given, otherwise all of them
+	slideIndices := make([]int, 0, len(presentation.Slides))
+	if input.SlideIndex != 0 || input.SlideID != "" {
+		_, slideIndex, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+		if err != nil {
+			return nil, err
+		}
+		slideIndices = append(slideIndices, slideIndex)
+	} else {
+		for i := range presentation.Slides {
+			slideIndices = append(slideIndices, i+1)
+		}
+	}
+
+	output := &FindOverlapsOutput{
+		PresentationID: input.PresentationID,
+		Threshold:      input.Threshold,
+		SlidesChecked:  len(slideIndices),
+		Overlaps:       []ObjectOverlap{},
+	}
+
+	for _, slideIndex := range slideIndices {
+		slide := presentation.Slides[slideIndex-1]
+		for _, overlap := range findSlideOverlaps(slide.PageElements, input.Threshold) {
+			overlap.SlideIndex = slideIndex
+			overlap.SlideID = slide.ObjectId
+			output.Overlaps = append(output.Overlaps, overlap)
+		}
+	}
+	output.OverlapCount = len(output.Overlaps)
+
+	t.config.Logger.Info("overlapping objects found",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slides_checked", output.SlidesChecked),
+		slog.Int("overlap_count", output.OverlapCount),
+	)
+
+	return output, nil
+}
+
+// findSlideOverlaps compares every pair of top-level elements, in z-order, and returns the pairs
+// whose overlap ratio exceeds threshold. Elements without bounds are skipped.
+func findSlideOverlaps(elements []*slides.PageElement, threshold float64) []ObjectOverlap {
+	type boxedElement struct {
+		objectID string
+		box      boundingBox
+	}
+	var boxed []boxedElement
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+		if box, ok := elementBoundingBox(element); ok {
+			boxed = append(boxed, boxedElement{objectID: element.ObjectId, box: box})
+		}
+	}
+
+	var overlaps []ObjectOverlap
+	for i := 0; i < len(boxed); i++ {
+		for j := i + 1; j < len(boxed); j++ {
+			a, b := boxed[i].box, boxed[j].box
+			width := math.Min(a.Right, b.Right) - math.Max(a.Left, b.Left)
+			height := math.Min(a.Bottom, b.Bottom) - math.Max(a.Top, b.Top)
+			if width <= 0 || height <= 0 {
+				continue
+			}
+
+			smallerArea := math.Min(a.area(), b.area())
+			ratio := 1.0
+			if smallerArea > 0 {
+				ratio = math.Min(width*height/smallerArea, 1)
+			}
+			if ratio <= threshold {
+				continue
+			}
+
+			overlaps = append(overlaps, ObjectOverlap{
+				ObjectIDA:     boxed[i].objectID,
+				ObjectIDB:     boxed[j].objectID,
+				OverlapWidth:  math.Round(width*100) / 100,
+				OverlapHeight: math.Round(height*100) / 100,
+				OverlapRatio:  math.Round(ratio*1000) / 1000,
+			})
+		}
+	}
+	return overlaps
+}
+
+// elementBoundingBox returns an element's rendered bounds in points, ignoring rotation. Groups
+// are bounded by the union of their children's boxes; other elements need a transform and a size.
+func elementBoundingBox(element *slides.PageElement) (boundingBox, bool) {
+	return elementBoundingBoxWithin(element, nil)
+}
+
+// elementBoundingBoxWithin bounds an element nested in groups whose combined transform is parent
+// (nil at the top level), placing it with pageTransform.
+func elementBoundingBoxWithin(element *slides.PageElement, parent *slides.AffineTransform) (boundingBox, bool) {
+	transform := pageTransform(element, parent)
+
+	if element.ElementGroup != nil {
+		var union boundingBox
+		found := false
+		for _, child := range element.ElementGroup.Children {
+			if child == nil {
+				continue
+			}
+			box, ok := elementBoundingBoxWithin(child, transform)
+			if !ok {
+				continue
+			}
+			if !found {
+				union, found = box, true
+				continue
+			}
+			union = boundingBox{
+				Left:   math.Min(union.Left, box.Left),
+				Top:    math.Min(union.Top, box.Top),
+				Right:  math.Max(union.Right, box.Right),
+				Bottom: math.Max(union.Bottom, box.Bottom),
+			}
+		}
+		return union, found
+	}
+
+	if transform == nil {
+		return boundingBox{}, false
+	}
+	width, height, ok := displayedSizeInPoints(&slides.PageElement{Size: element.Size, Transform: transform})
+	if !ok {
+		return boundingBox{}, false
+	}
+	left := emuToPoints(transform.TranslateX)
+	top := emuToPoints(transform.TranslateY)
+	return boundingBox{Left: left, Top: top, Right: left + width, Bottom: top + height}, true
+}
+
+// area returns the box's area in square points.
+func (b boundingBox) area() float64 {
+	return (b.Right - b.Left) * (b.Bottom - b.Top)
+}
@@ -0,0 +1,176 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newFindOverlapsTestTools(presentation *slides.Presentation, getErr error) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func findOverlapsTestPresentation() *slides.Presentation {
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					treeTestElement("rect-a", 0, 0, 100, 100),
+					treeTestElement("rect-b", 50, 50, 100, 100), // Overlaps rect-a by 50x50
+					treeTestElement("far", 400, 0, 50, 50),      // Disjoint from everything
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					treeTestElement("left", 0, 0, 100, 100),
+					treeTestElement("right", 100, 0, 100, 100), // Touching edges only
+					{
+						ObjectId: "group-1",
+						ElementGroup: &slides.Group{Children: []*slides.PageElement{
+							treeTestElement("child-1", 300, 0, 20, 20),
+							treeTestElement("child-2", 380, 80, 20, 20),
+						}},
+					},
+					treeTestElement("inside-group", 340, 40, 10, 10), // Between the children, inside the group's bounds
+				},
+			},
+		},
+	}
+}
+
+func TestFindOverlaps(t *testing.T) {
+	tools := newFindOverlapsTestTools(findOverlapsTestPresentation(), nil)
+
+	output, err := tools.FindOverlaps(context.Background(), nil, FindOverlapsInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.SlidesChecked != 2 || output.OverlapCount != 2 {
+		t.Fatalf("expected 2 overlaps on 2 slides, got %d on %d: %+v", output.OverlapCount, output.SlidesChecked, output.Overlaps)
+	}
+
+	pair := output.Overlaps[0]
+	if pair.ObjectIDA != "rect-a" || pair.ObjectIDB != "rect-b" || pair.SlideIndex != 1 || pair.SlideID != "slide-1" {
+		t.Errorf("expected rect-a/rect-b on slide 1, got %+v", pair)
+	}
+	if pair.OverlapWidth != 50 || pair.OverlapHeight != 50 || pair.OverlapRatio != 0.25 {
+		t.Errorf("expected a 50x50 overlap (ratio 0.25), got %+v", pair)
+	}
+
+	group := output.Overlaps[1]
+	if group.ObjectIDA != "group-1" || group.ObjectIDB != "inside-group" || group.OverlapRatio != 1 {
+		t.Errorf("expected inside-group to be contained in group-1's bounds, got %+v", group)
+	}
+}
+
+func TestFindOverlaps_DisjointOnly(t *testing.T) {
+	presentation := findOverlapsTestPresentation()
+	presentation.Slides[0].PageElements = []*slides.PageElement{
+		treeTestElement("rect-a", 0, 0, 100, 100),
+		treeTestElement("rect-b", 200, 200, 100, 100),
+	}
+	tools := newFindOverlapsTestTools(presentation, nil)
+
+	output, err := tools.FindOverlaps(context.Background(), nil, FindOverlapsInput{PresentationID: "pres-1", SlideIndex: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.SlidesChecked != 1 || output.OverlapCount != 0 || len(output.Overlaps) != 0 {
+		t.Errorf("expected no overlaps on one slide, got %+v", output)
+	}
+}
+
+func TestFindOverlaps_Threshold(t *testing.T) {
+	tools := newFindOverlapsTestTools(findOverlapsTestPresentation(), nil)
+
+	output, err := tools.FindOverlaps(context.Background(), nil, FindOverlapsInput{PresentationID: "pres-1", Threshold: 0.3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.OverlapCount != 1 || output.Overlaps[0].ObjectIDA != "group-1" {
+		t.Errorf("expected only the contained element above a 0.3 threshold, got %+v", output.Overlaps)
+	}
+}
+
+func TestFindOverlaps_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   FindOverlapsInput
+		getErr  error
+		wantErr error
+	}{
+		{"missing presentation", FindOverlapsInput{}, nil, ErrInvalidPresentationID},
+		{"negative threshold", FindOverlapsInput{PresentationID: "pres-1", Threshold: -0.1}, nil, ErrInvalidOverlapThreshold},
+		{"threshold of 1", FindOverlapsInput{PresentationID: "pres-1", Threshold: 1}, nil, ErrInvalidOverlapThreshold},
+		{"unknown slide", FindOverlapsInput{PresentationID: "pres-1", SlideID: "nope"}, nil, ErrSlideNotFound},
+		{"presentation not found", FindOverlapsInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 404}, ErrPresentationNotFound},
+		{"access denied", FindOverlapsInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 403}, ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newFindOverlapsTestTools(findOverlapsTestPresentation(), tt.getErr)
+
+			_, err := tools.FindOverlaps(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestElementBoundingBox(t *testing.T) {
+	scaled := treeTestElement("scaled", 10, 20, 100, 50)
+	scaled.Transform.ScaleX = 2
+
+	// Child transforms are relative to their group
+	group := func(x, y, scaleX float64, children ...*slides.PageElement) *slides.PageElement {
+		return &slides.PageElement{
+			ObjectId:     "group",
+			Transform:    &slides.AffineTransform{ScaleX: scaleX, ScaleY: 1, TranslateX: pointsToEMU(x), TranslateY: pointsToEMU(y)},
+			ElementGroup: &slides.Group{Children: children},
+		}
+	}
+	moved := group(100, 50, 1, treeTestElement("a", 0, 0, 20, 20), treeTestElement("b", 30, 10, 20, 20))
+	scaledGroup := group(10, 0, 2, treeTestElement("a", 5, 5, 10, 10))
+	nested := group(100, 0, 1, group(0, 50, 1, treeTestElement("a", 10, 10, 20, 20)))
+
+	tests := []struct {
+		name    string
+		element *slides.PageElement
+		want    boundingBox
+		wantOK  bool
+	}{
+		{"plain", treeTestElement("plain", 10, 20, 100, 50), boundingBox{10, 20, 110, 70}, true},
+		{"scaled", scaled, boundingBox{10, 20, 210, 70}, true},
+		{"no size", &slides.PageElement{ObjectId: "x", Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1}}, boundingBox{}, false},
+		{"empty group", &slides.PageElement{ObjectId: "g", ElementGroup: &slides.Group{}}, boundingBox{}, false},
+		{"moved group", moved, boundingBox{100, 50, 150, 80}, true},
+		{"scaled group", scaledGroup, boundingBox{20, 5, 40, 15}, true},
+		{"nested groups", nested, boundingBox{110, 60, 130, 80}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := elementBoundingBox(tt.element)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("elementBoundingBox = (%+v, %v), want (%+v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ var (
 	ErrNoFormattingProvided  = errors.New("no formatting properties provided")
 	ErrInvalidAlignment      = errors.New("invalid alignment value")
 	ErrInvalidParagraphIndex = errors.New("invalid paragraph index")
+	ErrInvalidIndent         = errors.New("invalid indent")
 )
 
 // Valid alignment values for paragraph formatting.
@@ -76,6 +77,10 @@ func (t *Tools) FormatParagraph(ctx context.Context, tokenSource oauth2.TokenSou
 		input.Formatting.Alignment = alignmentUpper
 	}
 
+	if err := validateParagraphIndents(input.Formatting); err != nil {
+		return nil, err
+	}
+
 	// Validate paragraph index if provided
 	if input.ParagraphIndex != nil && *input.ParagraphIndex < 0 {
 		return nil, fmt.Errorf("%w: paragraph_index cannot be negative", ErrInvalidParagraphIndex)
@@ -173,6 +178,25 @@ func (t *Tools) FormatParagraph(ctx context.Context, tokenSource oauth2.TokenSou
 	return output, nil
 }
 
+// validateParagraphIndents rejects negative indents. Slides measures IndentFirstLine from the
+// same edge as IndentStart, so a hanging indent is a first-line indent smaller than the start.
+func validateParagraphIndents(formatting *ParagraphFormattingOptions) error {
+	indents := []struct {
+		name  string
+		value *float64
+	}{
+		{"indent_first_line", formatting.IndentFirstLine},
+		{"indent_start", formatting.IndentStart},
+		{"indent_end", formatting.IndentEnd},
+	}
+	for _, indent := range indents {
+		if indent.value != nil && *indent.value < 0 {
+			return fmt.Errorf("%w: %s cannot be negative, got %g", ErrInvalidIndent, indent.name, *indent.value)
+		}
+	}
+	return nil
+}
+
 // countParagraphs counts the number of paragraphs in text content.
 func countParagraphs(text *slides.TextContent) int {
 	if text == nil || len(text.TextElements) == 0 {
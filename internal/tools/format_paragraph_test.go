@@ -870,3 +870,116 @@ func TestBuildFormatParagraphRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildFormatParagraphRequest_IndentFields(t *testing.T) {
+	floatPtr := func(f float64) *float64 { return &f }
+
+	tests := []struct {
+		name       string
+		formatting *ParagraphFormattingOptions
+		wantField  string
+		dimension  func(style *slides.ParagraphStyle) *slides.Dimension
+		wantPoints float64
+	}{
+		{
+			name:       "indent_first_line",
+			formatting: &ParagraphFormattingOptions{IndentFirstLine: floatPtr(0)},
+			wantField:  "indentFirstLine",
+			dimension:  func(style *slides.ParagraphStyle) *slides.Dimension { return style.IndentFirstLine },
+			wantPoints: 0,
+		},
+		{
+			name:       "indent_start",
+			formatting: &ParagraphFormattingOptions{IndentStart: floatPtr(36)},
+			wantField:  "indentStart",
+			dimension:  func(style *slides.ParagraphStyle) *slides.Dimension { return style.IndentStart },
+			wantPoints: 36,
+		},
+		{
+			name:       "indent_end",
+			formatting: &ParagraphFormattingOptions{IndentEnd: floatPtr(12.5)},
+			wantField:  "indentEnd",
+			dimension:  func(style *slides.ParagraphStyle) *slides.Dimension { return style.IndentEnd },
+			wantPoints: 12.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, applied := buildFormatParagraphRequest(FormatParagraphInput{
+				ObjectID:   "obj-1",
+				Formatting: tt.formatting,
+			}, &slides.TextContent{})
+			if request == nil {
+				t.Fatal("expected request, got nil")
+			}
+
+			req := request.UpdateParagraphStyle
+			if req.Fields != tt.wantField {
+				t.Errorf("expected field mask %q, got %q", tt.wantField, req.Fields)
+			}
+			dim := tt.dimension(req.Style)
+			if dim == nil || dim.Magnitude != tt.wantPoints || dim.Unit != "PT" {
+				t.Errorf("expected %gpt, got %+v", tt.wantPoints, dim)
+			}
+			if len(applied) != 1 || !strings.HasPrefix(applied[0], tt.name+"=") {
+				t.Errorf("expected applied formatting for %s, got %v", tt.name, applied)
+			}
+		})
+	}
+}
+
+func TestBuildFormatParagraphRequest_HangingIndent(t *testing.T) {
+	floatPtr := func(f float64) *float64 { return &f }
+
+	request, _ := buildFormatParagraphRequest(FormatParagraphInput{
+		ObjectID: "obj-1",
+		Formatting: &ParagraphFormattingOptions{
+			IndentFirstLine: floatPtr(0),
+			IndentStart:     floatPtr(36),
+		},
+	}, &slides.TextContent{})
+	if request == nil {
+		t.Fatal("expected request, got nil")
+	}
+
+	req := request.UpdateParagraphStyle
+	if req.Fields != "indentFirstLine,indentStart" {
+		t.Errorf("expected field mask 'indentFirstLine,indentStart', got %q", req.Fields)
+	}
+	if req.Style.IndentFirstLine.Magnitude >= req.Style.IndentStart.Magnitude {
+		t.Errorf("expected first line (%g) to hang left of the start indent (%g)",
+			req.Style.IndentFirstLine.Magnitude, req.Style.IndentStart.Magnitude)
+	}
+}
+
+func TestFormatParagraph_NegativeIndent(t *testing.T) {
+	floatPtr := func(f float64) *float64 { return &f }
+
+	tests := []struct {
+		name       string
+		formatting *ParagraphFormattingOptions
+	}{
+		{name: "negative first line", formatting: &ParagraphFormattingOptions{IndentFirstLine: floatPtr(-1)}},
+		{name: "negative start", formatting: &ParagraphFormattingOptions{IndentStart: floatPtr(-18)}},
+		{name: "negative end", formatting: &ParagraphFormattingOptions{IndentEnd: floatPtr(-0.5)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				t.Fatal("service must not be created for invalid input")
+				return nil, nil
+			})
+
+			_, err := tools.FormatParagraph(context.Background(), &mockTokenSource{}, FormatParagraphInput{
+				PresentationID: "pres-1",
+				ObjectID:       "obj-1",
+				Formatting:     tt.formatting,
+			})
+			if !errors.Is(err, ErrInvalidIndent) {
+				t.Errorf("expected ErrInvalidIndent, got %v", err)
+			}
+		})
+	}
+}
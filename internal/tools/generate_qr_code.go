@@ -0,0 +1,176 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+)
+
+// Sentinel errors for generate_qr_code tool.
+var (
+	ErrInvalidQRCodeData      = errors.New("invalid QR code data")
+	ErrQRCodeDataTooLong      = errors.New("QR code data too long")
+	ErrInvalidQRCodeSize      = errors.New("invalid QR code size")
+	ErrInvalidErrorCorrection = errors.New("invalid error correction level")
+	ErrGenerateQRCodeFailed   = errors.New("failed to generate QR code")
+)
+
+// QR code sizes are the side of the square image in points, quiet zone included.
+const (
+	DefaultQRCodeSize = 144.0 // 2 inches
+	minQRCodeSize     = 36.0  // Half an inch, about the smallest that scans reliably
+	maxQRCodeSize     = 540.0 // Height of a 4:3 slide
+)
+
+// GenerateQRCodeInput represents the input for the generate_qr_code tool.
+type GenerateQRCodeInput struct {
+	PresentationID  string         `json:"presentation_id"`
+	SlideIndex      int            `json:"slide_index,omitempty"`      // 1-based index
+	SlideID         string         `json:"slide_id,omitempty"`         // Alternative to slide_index
+	Data            string         `json:"data"`                       // URL or text to encode
+	ErrorCorrection string         `json:"error_correction,omitempty"` // L, M, Q or H (default M)
+	Size            float64        `json:"size,omitempty"`             // Side in points, default 144
+	Position        *PositionInput `json:"position,omitempty"`         // Position in points (default: 0, 0)
+}
+
+// GenerateQRCodeOutput represents the output of the generate_qr_code tool.
+type GenerateQRCodeOutput struct {
+	ObjectID        string  `json:"object_id"`
+	SlideID         string  `json:"slide_id"`
+	Version         int     `json:"version"` // QR version, 1-40
+	Modules         int     `json:"modules"` // Modules per side, quiet zone excluded
+	ErrorCorrection string  `json:"error_correction"`
+	Size            float64 `json:"size"` // Side in points
+}
+
+// GenerateQRCode renders a QR code for the data as a PNG, uploads it to Drive and places it
+// on a slide the same way add_image does. The image includes the standard 4-module quiet zone.
+func (t *Tools) GenerateQRCode(ctx context.Context, tokenSource oauth2.TokenSource, input GenerateQRCodeInput) (*GenerateQRCodeOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.SlideIndex == 0 && input.SlideID == "" {
+		return nil, ErrInvalidSlideReference
+	}
+	if input.Data == "" {
+		return nil, fmt.Errorf("%w: data is required", ErrInvalidQRCodeData)
+	}
+	level, ok := parseQRErrorCorrectionLevel(input.ErrorCorrection)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s' (valid levels: L, M, Q, H)", ErrInvalidErrorCorrection, input.ErrorCorrection)
+	}
+	if maxBytes := qrMaxPayloadBytes(level); len(input.Data) > maxBytes {
+		return nil, fmt.Errorf("%w: %d bytes, at most %d at error correction level %s", ErrQRCodeDataTooLong, len(input.Data), maxBytes, level.name)
+	}
+	size := input.Size
+	if size == 0 {
+		size = DefaultQRCodeSize
+	}
+	if !(size >= minQRCodeSize && size <= maxQRCodeSize) { // Also rejects NaN
+		return nil, fmt.Errorf("%w: size must be between %.0f and %.0f points", ErrInvalidQRCodeSize, minQRCodeSize, maxQRCodeSize)
+	}
+	if input.Position != nil && (input.Position.X < 0 || input.Position.Y < 0) {
+		return nil, ErrInvalidImagePosition
+	}
+	if err := t.checkImageFormatAllowed("image/png"); err != nil {
+		return nil, err
+	}
+
+	t.config.Logger.Info("generating QR code",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+		slog.Int("data_length", len(input.Data)),
+		slog.String("error_correction", level.name),
+	)
+
+	// Render the QR code before touching the presentation
+	code, err := encodeQRCode([]byte(input.Data), level)
+	if err != nil {
+		return nil, err
+	}
+	imageData, err := code.renderPNG()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGenerateQRCodeFailed, err)
+	}
+
+	// Create services
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	driveService, err := t.driveServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+	}
+
+	// Get the presentation to find the target slide
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Upload the PNG to Drive
+	driveFileID, err := t.uploadSlideImage(ctx, driveService, generateQRCodeFileName(), "image/png", imageData)
+	if err != nil {
+		return nil, err
+	}
+
+	objectID := newObjectID("qrcode")
+	requests := buildImageRequests(objectID, slideID, driveFileID, AddImageInput{
+		Position: input.Position,
+		Size:     &ImageSizeInput{Width: &size, Height: &size},
+	})
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		// The image never made it onto the slide, so don't leave the upload behind
+		t.deleteOrphanedUploads(ctx, driveService, []string{driveFileID})
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrGenerateQRCodeFailed, err)
+	}
+
+	output := &GenerateQRCodeOutput{
+		ObjectID:        objectID,
+		SlideID:         slideID,
+		Version:         code.version,
+		Modules:         code.size,
+		ErrorCorrection: level.name,
+		Size:            size,
+	}
+
+	t.config.Logger.Info("QR code added successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("object_id", output.ObjectID),
+		slog.Int("version", output.Version),
+	)
+
+	return output, nil
+}
+
+// generateQRCodeFileName generates a unique file name for the uploaded QR code image.
+func generateQRCodeFileName() string {
+	return newObjectID("slides_qrcode") + ".png"
+}
@@ -0,0 +1,198 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/png"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+type qrCodeTestCapture struct {
+	uploaded   []byte
+	mimeType   string
+	requests   []*slides.Request
+	batchCalls int
+	deleted    []string
+}
+
+func newGenerateQRCodeTestTools(getErr, batchErr error, capture *qrCodeTestCapture) *Tools {
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "pres-1",
+				Slides:         []*slides.Page{{ObjectId: "slide-1"}, {ObjectId: "slide-2"}},
+			}, getErr
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capture.batchCalls++
+			capture.requests = requests
+			return &slides.BatchUpdatePresentationResponse{}, batchErr
+		},
+	}
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			capture.mimeType = mimeType
+			capture.uploaded, _ = io.ReadAll(content)
+			return &drive.File{Id: "qr-file"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			capture.deleted = append(capture.deleted, fileID)
+			return nil
+		},
+	}
+
+	return NewToolsWithDrive(DefaultToolsConfig(),
+		func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) { return mockSlides, nil },
+		func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) { return mockDrive, nil },
+	)
+}
+
+func TestGenerateQRCode(t *testing.T) {
+	stubObjectIDSuffix(t, "1705312800000000000")
+	capture := &qrCodeTestCapture{}
+	tools := newGenerateQRCodeTestTools(nil, nil, capture)
+
+	output, err := tools.GenerateQRCode(context.Background(), &mockTokenSource{}, GenerateQRCodeInput{
+		PresentationID: "pres-1",
+		SlideIndex:     2,
+		Data:           "https://example.com/signup",
+		Position:       &PositionInput{X: 500, Y: 300},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if capture.mimeType != "image/png" {
+		t.Errorf("expected a PNG upload, got %s", capture.mimeType)
+	}
+	img, err := png.Decode(bytes.NewReader(capture.uploaded))
+	if err != nil {
+		t.Fatalf("uploaded image is not a valid PNG: %v", err)
+	}
+	if bounds := img.Bounds(); bounds.Dx() != bounds.Dy() || bounds.Dx() < qrTargetPixels/2 {
+		t.Errorf("expected a large square image, got %dx%d", bounds.Dx(), bounds.Dy())
+	}
+
+	if len(capture.requests) != 1 || capture.requests[0].CreateImage == nil {
+		t.Fatalf("expected a single CreateImage request, got %+v", capture.requests)
+	}
+	create := capture.requests[0].CreateImage
+	if create.ObjectId != output.ObjectID || !strings.HasPrefix(create.ObjectId, "qrcode") {
+		t.Errorf("unexpected object ID %s (output %s)", create.ObjectId, output.ObjectID)
+	}
+	if create.Url != driveImageContentURL("qr-file") || create.ElementProperties.PageObjectId != "slide-2" {
+		t.Errorf("expected the uploaded file on slide-2, got %s on %s", create.Url, create.ElementProperties.PageObjectId)
+	}
+	size := create.ElementProperties.Size
+	if size.Width.Magnitude != pointsToEMU(DefaultQRCodeSize) || size.Height.Magnitude != pointsToEMU(DefaultQRCodeSize) {
+		t.Errorf("expected a %v pt square, got %+v x %+v", DefaultQRCodeSize, size.Width, size.Height)
+	}
+	transform := create.ElementProperties.Transform
+	if transform.TranslateX != pointsToEMU(500) || transform.TranslateY != pointsToEMU(300) {
+		t.Errorf("unexpected position: %+v", transform)
+	}
+
+	if output.SlideID != "slide-2" || output.Version != 2 || output.Modules != 25 || output.ErrorCorrection != "M" || output.Size != DefaultQRCodeSize {
+		t.Errorf("unexpected output: %+v", output)
+	}
+}
+
+func TestGenerateQRCode_ErrorCorrectionAndSize(t *testing.T) {
+	capture := &qrCodeTestCapture{}
+	tools := newGenerateQRCodeTestTools(nil, nil, capture)
+
+	output, err := tools.GenerateQRCode(context.Background(), &mockTokenSource{}, GenerateQRCodeInput{
+		PresentationID:  "pres-1",
+		SlideID:         "slide-1",
+		Data:            "https://example.com/signup",
+		ErrorCorrection: "h",
+		Size:            72,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.ErrorCorrection != "H" || output.Version != 4 || output.Size != 72 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+	width := capture.requests[0].CreateImage.ElementProperties.Size.Width.Magnitude
+	if math.Abs(width-pointsToEMU(72)) > 0.001 {
+		t.Errorf("expected a 72 pt image, got %v EMU", width)
+	}
+}
+
+func TestGenerateQRCode_Errors(t *testing.T) {
+	valid := GenerateQRCodeInput{PresentationID: "pres-1", SlideIndex: 1, Data: "https://example.com"}
+	with := func(modify func(*GenerateQRCodeInput)) GenerateQRCodeInput {
+		input := valid
+		modify(&input)
+		return input
+	}
+
+	tests := []struct {
+		name     string
+		input    GenerateQRCodeInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", with(func(i *GenerateQRCodeInput) { i.PresentationID = "" }), nil, nil, ErrInvalidPresentationID},
+		{"missing slide", with(func(i *GenerateQRCodeInput) { i.SlideIndex = 0 }), nil, nil, ErrInvalidSlideReference},
+		{"missing data", with(func(i *GenerateQRCodeInput) { i.Data = "" }), nil, nil, ErrInvalidQRCodeData},
+		{"data too long", with(func(i *GenerateQRCodeInput) { i.Data = strings.Repeat("a", 2332) }), nil, nil, ErrQRCodeDataTooLong},
+		{"unknown level", with(func(i *GenerateQRCodeInput) { i.ErrorCorrection = "X" }), nil, nil, ErrInvalidErrorCorrection},
+		{"too small", with(func(i *GenerateQRCodeInput) { i.Size = 20 }), nil, nil, ErrInvalidQRCodeSize},
+		{"too large", with(func(i *GenerateQRCodeInput) { i.Size = 1000 }), nil, nil, ErrInvalidQRCodeSize},
+		{"not a number", with(func(i *GenerateQRCodeInput) { i.Size = math.NaN() }), nil, nil, ErrInvalidQRCodeSize},
+		{"negative position", with(func(i *GenerateQRCodeInput) { i.Position = &PositionInput{X: -1} }), nil, nil, ErrInvalidImagePosition},
+		{"slide not found", with(func(i *GenerateQRCodeInput) { i.SlideIndex = 5 }), nil, nil, ErrSlideNotFound},
+		{"presentation not found", valid, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", valid, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", valid, nil, errors.New("boom"), ErrGenerateQRCodeFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			capture := &qrCodeTestCapture{}
+			tools := newGenerateQRCodeTestTools(tt.getErr, tt.batchErr, capture)
+
+			_, err := tools.GenerateQRCode(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && (capture.batchCalls != 0 || capture.uploaded != nil) {
+				t.Errorf("expected no upload or batch update, got %d calls", capture.batchCalls)
+			}
+			if tt.batchErr != nil && (len(capture.deleted) != 1 || capture.deleted[0] != "qr-file") {
+				t.Errorf("expected the orphaned upload to be deleted, got %v", capture.deleted)
+			}
+		})
+	}
+}
+
+func TestGenerateQRCode_DisallowedPNG(t *testing.T) {
+	config := DefaultToolsConfig()
+	config.AllowedImageFormats = []string{"jpeg"}
+	tools := NewToolsWithDrive(config, nil, nil)
+
+	_, err := tools.GenerateQRCode(context.Background(), &mockTokenSource{}, GenerateQRCodeInput{
+		PresentationID: "pres-1",
+		SlideIndex:     1,
+		Data:           "https://example.com",
+	})
+	if !errors.Is(err, ErrDisallowedImageFormat) {
+		t.Errorf("expected ErrDisallowedImageFormat, got %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for generate_toc tool.
+var (
+	ErrNoTOCEntries      = errors.New("no titled slides to list in table of contents")
+	ErrGenerateTOCFailed = errors.New("failed to generate table of contents")
+)
+
+// tocLayout is the predefined layout used for a new table of contents slide.
+const tocLayout = "TITLE_AND_BODY"
+
+// defaultTOCTitle is the title of the table of contents slide when none is given.
+const defaultTOCTitle = "Contents"
+
+// tocBulletPreset is the bullet preset applied to the entries.
+const tocBulletPreset = "BULLET_DISC_CIRCLE_SQUARE"
+
+// defaultTOCPosition places a new table of contents right after the cover slide.
+const defaultTOCPosition = 2
+
+// GenerateTOCInput represents the input for the generate_toc tool.
+type GenerateTOCInput struct {
+	PresentationID string `json:"presentation_id"`
+	Title          string `json:"title,omitempty"`        // Default "Contents"
+	Position       int    `json:"position,omitempty"`     // 1-based position of a new TOC slide (0 or omitted = 2, after the cover)
+	TOCSlideID     string `json:"toc_slide_id,omitempty"` // Existing TOC slide to update in place instead of creating one
+}
+
+// TOCEntry is one line of the table of contents.
+type TOCEntry struct {
+	SlideID    string `json:"slide_id"`
+	SlideIndex int    `json:"slide_index"` // 1-based, once the TOC slide is in place
+	Title      string `json:"title"`
+}
+
+// GenerateTOCOutput represents the output of the generate_toc tool.
+type GenerateTOCOutput struct {
+	SlideID       string     `json:"slide_id"`
+	SlideIndex    int        `json:"slide_index"` // 1-based index of the TOC slide
+	Created       bool       `json:"created"`     // False when an existing TOC slide was updated
+	Entries       []TOCEntry `json:"entries"`
+	SkippedSlides int        `json:"skipped_slides"` // Slides left out because their title is empty
+}
+
+// GenerateTOC builds a table of contents from the slide titles: one bullet per titled slide,
+// each linked to its slide. It creates a TITLE_AND_BODY slide at Position, or rewrites the
+// title and body of TOCSlideID so the TOC can be refreshed after the deck changes.
+func (t *Tools) GenerateTOC(ctx context.Context, tokenSource oauth2.TokenSource, input GenerateTOCInput) (*GenerateTOCOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	title := input.Title
+	if title == "" {
+		title = defaultTOCTitle
+	}
+	position := input.Position
+	if position <= 0 {
+		position = defaultTOCPosition
+	}
+
+	t.config.Logger.Info("generating table of contents",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("toc_slide_id", input.TOCSlideID),
+		slog.Int("position", position),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to read the slide titles
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	output := &GenerateTOCOutput{}
+	var requests []*slides.Request
+	var bodyObjectID string
+
+	if input.TOCSlideID != "" {
+		// Update in place: the slide keeps its position
+		slideID, slideIndex, err := findSlide(presentation, 0, input.TOCSlideID)
+		if err != nil {
+			return nil, err
+		}
+		tocSlide := presentation.Slides[slideIndex-1]
+		body := findPlaceholderByType(tocSlide.PageElements, "BODY")
+		if body == nil {
+			return nil, fmt.Errorf("%w: slide '%s' has no body placeholder for the table of contents", ErrInvalidLayout, slideID)
+		}
+
+		output.SlideID = slideID
+		output.SlideIndex = slideIndex
+		output.Entries, output.SkippedSlides = collectTOCEntries(presentation.Slides, slideID, -1)
+
+		if titleElement := findPlaceholderByType(tocSlide.PageElements, "TITLE"); titleElement != nil {
+			requests = append(requests, buildReplaceShapeTextRequests(titleElement, normalizeLineBreaks(title, false))...)
+		}
+		requests = append(requests, buildReplaceShapeTextRequests(body, buildTOCText(output.Entries))...)
+		bodyObjectID = body.ObjectId
+	} else {
+		layout := findLayoutPageByType(presentation.Layouts, tocLayout)
+		if layout == nil {
+			return nil, fmt.Errorf("%w: presentation has no %s layout", ErrInvalidLayout, tocLayout)
+		}
+		titlePlaceholder := findPlaceholderByType(layout.PageElements, "TITLE")
+		bodyPlaceholder := findPlaceholderByType(layout.PageElements, "BODY")
+		if titlePlaceholder == nil || bodyPlaceholder == nil {
+			return nil, fmt.Errorf("%w: %s layout needs a title and a body placeholder", ErrInvalidLayout, tocLayout)
+		}
+
+		insertionIndex := min(position-1, len(presentation.Slides))
+		output.SlideID = newObjectID("toc")
+		output.SlideIndex = insertionIndex + 1
+		output.Created = true
+		output.Entries, output.SkippedSlides = collectTOCEntries(presentation.Slides, "", insertionIndex)
+
+		titleObjectID := newObjectID("toc_title")
+		bodyObjectID = newObjectID("toc_body")
+		requests = append(requests,
+			&slides.Request{CreateSlide: &slides.CreateSlideRequest{
+				ObjectId:             output.SlideID,
+				InsertionIndex:       int64(insertionIndex),
+				SlideLayoutReference: &slides.LayoutReference{LayoutId: layout.ObjectId},
+				PlaceholderIdMappings: []*slides.LayoutPlaceholderIdMapping{
+					{LayoutPlaceholder: layoutPlaceholderRef(titlePlaceholder), ObjectId: titleObjectID},
+					{LayoutPlaceholder: layoutPlaceholderRef(bodyPlaceholder), ObjectId: bodyObjectID},
+				},
+			}},
+			&slides.Request{InsertText: &slides.InsertTextRequest{ObjectId: titleObjectID, Text: normalizeLineBreaks(title, false)}},
+			&slides.Request{InsertText: &slides.InsertTextRequest{ObjectId: bodyObjectID, Text: buildTOCText(output.Entries)}},
+		)
+	}
+
+	if len(output.Entries) == 0 {
+		return nil, fmt.Errorf("%w: %d slide(s) without a title", ErrNoTOCEntries, output.SkippedSlides)
+	}
+	requests = append(requests, buildTOCListRequests(bodyObjectID, output.Entries)...)
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrGenerateTOCFailed, err)
+	}
+
+	t.config.Logger.Info("table of contents generated successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("slide_id", output.SlideID),
+		slog.Int("entries", len(output.Entries)),
+		slog.Bool("created", output.Created),
+	)
+
+	return output, nil
+}
+
+// collectTOCEntries lists the titled slides, skipping the TOC slide itself. insertionIndex is
+// the 0-based index a new TOC slide is inserted at, so the reported indices account for it;
+// -1 when the TOC slide already exists. The second result counts the untitled slides.
+func collectTOCEntries(presentationSlides []*slides.Page, tocSlideID string, insertionIndex int) ([]TOCEntry, int) {
+	var entries []TOCEntry
+	skipped := 0
+	for i, slide := range presentationSlides {
+		if slide.ObjectId == tocSlideID {
+			continue
+		}
+		title := extractSlideTitle(slide)
+		if title == "" {
+			skipped++
+			continue
+		}
+
+		slideIndex := i + 1
+		if insertionIndex >= 0 && i >= insertionIndex {
+			slideIndex++
+		}
+		entries = append(entries, TOCEntry{SlideID: slide.ObjectId, SlideIndex: slideIndex, Title: title})
+	}
+	return entries, skipped
+}
+
+// buildTOCText joins the entry titles into one paragraph per entry. Line breaks inside a
+// title become soft breaks so a multi-line title stays a single entry.
+func buildTOCText(entries []TOCEntry) string {
+	lines := make([]string, len(entries))
+	for i, entry := range entries {
+		lines[i] = normalizeLineBreaks(entry.Title, true)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// buildTOCListRequests bullets the TOC body and links each entry to its slide, as
+// manage_hyperlinks does for "#slideId=" URLs. Ranges follow buildTOCText in UTF-16 units.
+func buildTOCListRequests(objectID string, entries []TOCEntry) []*slides.Request {
+	requests := []*slides.Request{
+		{CreateParagraphBullets: &slides.CreateParagraphBulletsRequest{
+			ObjectId:     objectID,
+			TextRange:    &slides.Range{Type: "ALL"},
+			BulletPreset: tocBulletPreset,
+		}},
+	}
+
+	start := 0
+	for _, entry := range entries {
+		end := start + utf16Len(normalizeLineBreaks(entry.Title, true))
+		startIndex, endIndex := int64(start), int64(end)
+		requests = append(requests, &slides.Request{UpdateTextStyle: &slides.UpdateTextStyleRequest{
+			ObjectId:  objectID,
+			TextRange: &slides.Range{Type: "FIXED_RANGE", StartIndex: &startIndex, EndIndex: &endIndex},
+			Style:     &slides.TextStyle{Link: buildLinkFromURL("#slideId=" + entry.SlideID)},
+			Fields:    "link",
+		}})
+		start = end + 1 // Skip the paragraph break
+	}
+	return requests
+}
@@ -0,0 +1,272 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newTOCTestSlide(id, placeholderType, title string) *slides.Page {
+	page := &slides.Page{ObjectId: id}
+	if placeholderType != "" {
+		page.PageElements = []*slides.PageElement{{
+			ObjectId: id + "-title",
+			Shape: &slides.Shape{
+				Placeholder: &slides.Placeholder{Type: placeholderType},
+				Text: &slides.TextContent{TextElements: []*slides.TextElement{
+					{TextRun: &slides.TextRun{Content: title + "\n"}},
+				}},
+			},
+		}}
+	}
+	return page
+}
+
+func newTOCTestPresentation() *slides.Presentation {
+	existingTOC := newTOCTestSlide("old-toc", "TITLE", "Agenda")
+	existingTOC.PageElements = append(existingTOC.PageElements, &slides.PageElement{
+		ObjectId: "old-toc-body",
+		Shape: &slides.Shape{
+			Placeholder: &slides.Placeholder{Type: "BODY"},
+			Text: &slides.TextContent{TextElements: []*slides.TextElement{
+				{TextRun: &slides.TextRun{Content: "Stale entry\n"}},
+			}},
+		},
+	})
+
+	layoutPlaceholder := func(id, placeholderType string) *slides.PageElement {
+		return &slides.PageElement{ObjectId: id, Shape: &slides.Shape{Placeholder: &slides.Placeholder{Type: placeholderType}}}
+	}
+
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			newTOCTestSlide("cover", "CENTERED_TITLE", "Annual review"),
+			existingTOC,
+			newTOCTestSlide("results", "TITLE", "Résultats"),
+			newTOCTestSlide("photo", "", ""),
+			newTOCTestSlide("next", "TITLE", "Next steps"),
+		},
+		Layouts: []*slides.Page{{
+			ObjectId:         "layout-title-body",
+			LayoutProperties: &slides.LayoutProperties{Name: "TITLE_AND_BODY"},
+			PageElements:     []*slides.PageElement{layoutPlaceholder("lt-title", "TITLE"), layoutPlaceholder("lt-body", "BODY")},
+		}},
+	}
+}
+
+// tocLinks returns the slide each linked range of the body points to, keyed by range start.
+func tocLinks(requests []*slides.Request, bodyID string) map[int64]string {
+	links := map[int64]string{}
+	for _, request := range requests {
+		if style := request.UpdateTextStyle; style != nil && style.ObjectId == bodyID && style.Fields == "link" {
+			links[*style.TextRange.StartIndex] = style.Style.Link.PageObjectId
+		}
+	}
+	return links
+}
+
+func TestGenerateTOC_CreatesSlide(t *testing.T) {
+	stubObjectIDSuffix(t, "abc123")
+	var batchCalls int
+	var captured []*slides.Request
+	presentation := newTOCTestPresentation()
+	presentation.Slides = append(presentation.Slides[:1], presentation.Slides[2:]...) // No existing TOC
+	tools := newBatchCaptureTestTools(presentation, nil, nil, &batchCalls, &captured)
+
+	output, err := tools.GenerateTOC(context.Background(), nil, GenerateTOCInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !output.Created || output.SlideID != "toc_abc123" || output.SlideIndex != 2 || output.SkippedSlides != 1 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+	wantEntries := []TOCEntry{
+		{SlideID: "cover", SlideIndex: 1, Title: "Annual review"},
+		{SlideID: "results", SlideIndex: 3, Title: "Résultats"},
+		{SlideID: "next", SlideIndex: 5, Title: "Next steps"},
+	}
+	if len(output.Entries) != len(wantEntries) {
+		t.Fatalf("expected %d entries, got %+v", len(wantEntries), output.Entries)
+	}
+	for i, want := range wantEntries {
+		if output.Entries[i] != want {
+			t.Errorf("entry %d = %+v, want %+v", i, output.Entries[i], want)
+		}
+	}
+
+	if batchCalls != 1 {
+		t.Fatalf("expected a single batch update, got %d", batchCalls)
+	}
+	create := captured[0].CreateSlide
+	if create == nil || create.InsertionIndex != 1 || create.SlideLayoutReference.LayoutId != "layout-title-body" {
+		t.Fatalf("unexpected create slide request: %+v", create)
+	}
+
+	texts := map[string]string{}
+	var bullets *slides.CreateParagraphBulletsRequest
+	for _, request := range captured {
+		if request.InsertText != nil {
+			texts[request.InsertText.ObjectId] = request.InsertText.Text
+		}
+		if request.CreateParagraphBullets != nil {
+			bullets = request.CreateParagraphBullets
+		}
+	}
+	if texts["toc_title_abc123"] != "Contents" {
+		t.Errorf("unexpected title: %q", texts["toc_title_abc123"])
+	}
+	if texts["toc_body_abc123"] != "Annual review\nRésultats\nNext steps" {
+		t.Errorf("unexpected body: %q", texts["toc_body_abc123"])
+	}
+	if bullets == nil || bullets.ObjectId != "toc_body_abc123" {
+		t.Errorf("expected the body to be bulleted, got %+v", bullets)
+	}
+
+	// Each title links to its slide by ID
+	wantLinks := map[int64]string{0: "cover", 14: "results", 24: "next"}
+	links := tocLinks(captured, "toc_body_abc123")
+	if len(links) != len(wantLinks) {
+		t.Fatalf("expected %d links, got %v", len(wantLinks), links)
+	}
+	for start, slideID := range wantLinks {
+		if links[start] != slideID {
+			t.Errorf("link at %d = %q, want %q", start, links[start], slideID)
+		}
+	}
+}
+
+func TestGenerateTOC_UpdatesExistingSlide(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(newTOCTestPresentation(), nil, nil, &batchCalls, &captured)
+
+	output, err := tools.GenerateTOC(context.Background(), nil, GenerateTOCInput{
+		PresentationID: "pres-1",
+		Title:          "Agenda",
+		TOCSlideID:     "old-toc",
+		Position:       5, // Ignored when updating
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Created || output.SlideID != "old-toc" || output.SlideIndex != 2 || len(output.Entries) != 3 {
+		t.Fatalf("unexpected output: %+v", output)
+	}
+	if output.Entries[1] != (TOCEntry{SlideID: "results", SlideIndex: 3, Title: "Résultats"}) {
+		t.Errorf("expected the TOC slide itself to be left out, got %+v", output.Entries)
+	}
+
+	var deleted []string
+	texts := map[string]string{}
+	for _, request := range captured {
+		if request.CreateSlide != nil {
+			t.Error("expected no new slide when updating")
+		}
+		if request.DeleteText != nil {
+			deleted = append(deleted, request.DeleteText.ObjectId)
+		}
+		if request.InsertText != nil {
+			texts[request.InsertText.ObjectId] = request.InsertText.Text
+		}
+	}
+	if len(deleted) != 2 {
+		t.Errorf("expected the old title and body to be cleared, got %v", deleted)
+	}
+	if texts["old-toc-title"] != "Agenda" || texts["old-toc-body"] != "Annual review\nRésultats\nNext steps" {
+		t.Errorf("unexpected texts: %v", texts)
+	}
+	if links := tocLinks(captured, "old-toc-body"); links[14] != "results" {
+		t.Errorf("expected the second entry to link to results, got %v", links)
+	}
+}
+
+func TestGenerateTOC_Position(t *testing.T) {
+	tests := []struct {
+		name          string
+		position      int
+		wantIndex     int64
+		wantNextIndex int // Final index of the "next" slide
+	}{
+		{"first", 1, 0, 6},
+		{"end", 6, 5, 5},
+		{"past the end", 42, 5, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newBatchCaptureTestTools(newTOCTestPresentation(), nil, nil, &batchCalls, &captured)
+
+			output, err := tools.GenerateTOC(context.Background(), nil, GenerateTOCInput{PresentationID: "pres-1", Position: tt.position})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if captured[0].CreateSlide.InsertionIndex != tt.wantIndex || output.SlideIndex != int(tt.wantIndex)+1 {
+				t.Errorf("inserted at %d, want %d", captured[0].CreateSlide.InsertionIndex, tt.wantIndex)
+			}
+			last := output.Entries[len(output.Entries)-1]
+			if last.SlideID != "next" || last.SlideIndex != tt.wantNextIndex {
+				t.Errorf("last entry = %+v, want next at %d", last, tt.wantNextIndex)
+			}
+		})
+	}
+}
+
+func TestGenerateTOC_Errors(t *testing.T) {
+	untitled := &slides.Presentation{PresentationId: "pres-1", Slides: []*slides.Page{{ObjectId: "blank"}}}
+	noLayout := newTOCTestPresentation()
+	noLayout.Layouts = nil
+
+	tests := []struct {
+		name         string
+		input        GenerateTOCInput
+		presentation *slides.Presentation
+		getErr       error
+		batchErr     error
+		wantErr      error
+	}{
+		{"missing presentation", GenerateTOCInput{}, newTOCTestPresentation(), nil, nil, ErrInvalidPresentationID},
+		{"unknown TOC slide", GenerateTOCInput{PresentationID: "pres-1", TOCSlideID: "nope"}, newTOCTestPresentation(), nil, nil, ErrSlideNotFound},
+		{"TOC slide without body", GenerateTOCInput{PresentationID: "pres-1", TOCSlideID: "results"}, newTOCTestPresentation(), nil, nil, ErrInvalidLayout},
+		{"missing layout", GenerateTOCInput{PresentationID: "pres-1"}, noLayout, nil, nil, ErrInvalidLayout},
+		{"presentation not found", GenerateTOCInput{PresentationID: "pres-1"}, nil, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", GenerateTOCInput{PresentationID: "pres-1"}, nil, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", GenerateTOCInput{PresentationID: "pres-1"}, newTOCTestPresentation(), nil, errors.New("boom"), ErrGenerateTOCFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newBatchCaptureTestTools(tt.presentation, tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.GenerateTOC(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", batchCalls)
+			}
+		})
+	}
+
+	t.Run("no titled slides", func(t *testing.T) {
+		untitledWithLayout := newTOCTestPresentation()
+		untitledWithLayout.Slides = untitled.Slides
+		var batchCalls int
+		var captured []*slides.Request
+		tools := newBatchCaptureTestTools(untitledWithLayout, nil, nil, &batchCalls, &captured)
+
+		_, err := tools.GenerateTOC(context.Background(), nil, GenerateTOCInput{PresentationID: "pres-1"})
+		if !errors.Is(err, ErrNoTOCEntries) || batchCalls != 0 {
+			t.Errorf("expected ErrNoTOCEntries without a batch update, got %v after %d calls", err, batchCalls)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// GetNotesMasterInput represents the input for the get_notes_master tool.
+type GetNotesMasterInput struct {
+	PresentationID string `json:"presentation_id"`
+}
+
+// NotesMasterPlaceholder describes one placeholder on the notes master.
+type NotesMasterPlaceholder struct {
+	ObjectID string    `json:"object_id"`
+	Type     string    `json:"type"`  // SLIDE_IMAGE, BODY, HEADER, FOOTER, DATE_AND_TIME, SLIDE_NUMBER, ...
+	Index    int       `json:"index"` // Distinguishes placeholders of the same type
+	Position *Position `json:"position,omitempty"`
+	Size     *Size     `json:"size,omitempty"`
+}
+
+// GetNotesMasterOutput represents the output of the get_notes_master tool.
+type GetNotesMasterOutput struct {
+	PresentationID string                   `json:"presentation_id"`
+	Found          bool                     `json:"found"` // False when the presentation has no notes master
+	ObjectID       string                   `json:"object_id,omitempty"`
+	Placeholders   []NotesMasterPlaceholder `json:"placeholders"`
+	ElementCount   int                      `json:"element_count"` // All top-level elements, placeholders included
+}
+
+// GetNotesMaster reports the notes master, the page every notes page inherits from, with the
+// placeholders that lay out handouts and speaker notes. A presentation without a notes master
+// returns Found false rather than an error.
+func (t *Tools) GetNotesMaster(ctx context.Context, tokenSource oauth2.TokenSource, input GetNotesMasterInput) (*GetNotesMasterOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	t.config.Logger.Info("getting notes master",
+		slog.String("presentation_id", input.PresentationID),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	output := &GetNotesMasterOutput{
+		PresentationID: input.PresentationID,
+		Placeholders:   []NotesMasterPlaceholder{},
+	}
+	if notesMaster := presentation.NotesMaster; notesMaster != nil {
+		output.Found = true
+		output.ObjectID = notesMaster.ObjectId
+		output.Placeholders = buildNotesMasterPlaceholders(notesMaster.PageElements)
+		output.ElementCount = len(notesMaster.PageElements)
+	}
+
+	t.config.Logger.Info("notes master retrieved",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Bool("found", output.Found),
+		slog.Int("placeholder_count", len(output.Placeholders)),
+	)
+
+	return output, nil
+}
+
+// buildNotesMasterPlaceholders lists the placeholder shapes among elements, in page order.
+func buildNotesMasterPlaceholders(elements []*slides.PageElement) []NotesMasterPlaceholder {
+	placeholders := []NotesMasterPlaceholder{}
+	for _, element := range elements {
+		if element == nil || element.Shape == nil || element.Shape.Placeholder == nil {
+			continue
+		}
+		position, size := extractElementGeometry(element)
+		placeholders = append(placeholders, NotesMasterPlaceholder{
+			ObjectID: element.ObjectId,
+			Type:     element.Shape.Placeholder.Type,
+			Index:    int(element.Shape.Placeholder.Index),
+			Position: position,
+			Size:     size,
+		})
+	}
+	return placeholders
+}
@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newGetNotesMasterTestTools(presentation *slides.Presentation, getErr error) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestGetNotesMaster(t *testing.T) {
+	slideImage := treeTestElement("notes-slide-image", 90, 50, 400, 225)
+	slideImage.Shape.Placeholder = &slides.Placeholder{Type: "SLIDE_IMAGE"}
+	body := treeTestElement("notes-body", 60, 300, 470, 360)
+	body.Shape.Placeholder = &slides.Placeholder{Type: "BODY", Index: 1}
+
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		NotesMaster: &slides.Page{
+			ObjectId: "notes-master-1",
+			PageElements: []*slides.PageElement{
+				slideImage,
+				treeTestElement("logo", 10, 10, 40, 40), // Not a placeholder
+				body,
+			},
+		},
+	}
+	tools := newGetNotesMasterTestTools(presentation, nil)
+
+	output, err := tools.GetNotesMaster(context.Background(), nil, GetNotesMasterInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !output.Found || output.ObjectID != "notes-master-1" {
+		t.Errorf("expected notes master notes-master-1, got found=%v id=%q", output.Found, output.ObjectID)
+	}
+	if output.ElementCount != 3 || len(output.Placeholders) != 2 {
+		t.Fatalf("expected 3 elements and 2 placeholders, got %d and %+v", output.ElementCount, output.Placeholders)
+	}
+
+	image := output.Placeholders[0]
+	if image.ObjectID != "notes-slide-image" || image.Type != "SLIDE_IMAGE" || image.Index != 0 {
+		t.Errorf("unexpected slide image placeholder: %+v", image)
+	}
+	if image.Position == nil || image.Position.X != 90 || image.Size == nil || image.Size.Width != 400 {
+		t.Errorf("unexpected slide image geometry: %+v %+v", image.Position, image.Size)
+	}
+	if got := output.Placeholders[1]; got.ObjectID != "notes-body" || got.Type != "BODY" || got.Index != 1 {
+		t.Errorf("unexpected body placeholder: %+v", got)
+	}
+}
+
+func TestGetNotesMaster_Absent(t *testing.T) {
+	tools := newGetNotesMasterTestTools(&slides.Presentation{PresentationId: "pres-1"}, nil)
+
+	output, err := tools.GetNotesMaster(context.Background(), nil, GetNotesMasterInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Found || output.ObjectID != "" || output.Placeholders == nil || len(output.Placeholders) != 0 {
+		t.Errorf("expected an empty result, got %+v", output)
+	}
+}
+
+func TestGetNotesMaster_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   GetNotesMasterInput
+		getErr  error
+		wantErr error
+	}{
+		{"missing presentation", GetNotesMasterInput{}, nil, ErrInvalidPresentationID},
+		{"presentation not found", GetNotesMasterInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 404}, ErrPresentationNotFound},
+		{"access denied", GetNotesMasterInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 403}, ErrAccessDenied},
+		{"api error", GetNotesMasterInput{PresentationID: "pres-1"}, errors.New("boom"), ErrSlidesAPIError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newGetNotesMasterTestTools(&slides.Presentation{}, tt.getErr)
+
+			_, err := tools.GetNotesMaster(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"strings"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -19,69 +21,103 @@ var (
 type GetObjectInput struct {
 	PresentationID string `json:"presentation_id"`
 	ObjectID       string `json:"object_id"`
+	IncludeETag    bool   `json:"include_etag,omitempty"` // Also fetch the Drive ETag (one extra Drive call)
+	Resolved       bool   `json:"resolved,omitempty"`     // Also report the text style inherited from layout/master placeholders
 }
 
 // GetObjectOutput represents the output of the get_object tool.
 type GetObjectOutput struct {
-	PresentationID string         `json:"presentation_id"`
-	ObjectID       string         `json:"object_id"`
-	ObjectType     string         `json:"object_type"`
-	SlideIndex     int            `json:"slide_index"` // 1-based index of containing slide
-	Position       *Position      `json:"position,omitempty"`
-	Size           *Size          `json:"size,omitempty"`
-	Shape          *ShapeDetails  `json:"shape,omitempty"`
-	Image          *ImageDetails  `json:"image,omitempty"`
-	Table          *TableDetails  `json:"table,omitempty"`
-	Video          *VideoDetails  `json:"video,omitempty"`
-	Line           *LineDetails   `json:"line,omitempty"`
-	Group          *GroupDetails  `json:"group,omitempty"`
-	Chart          *ChartDetails  `json:"chart,omitempty"`
+	PresentationID string          `json:"presentation_id"`
+	ObjectID       string          `json:"object_id"`
+	ObjectType     string          `json:"object_type"`
+	SlideIndex     int             `json:"slide_index"`               // 1-based index of containing slide
+	ParentGroupID  string          `json:"parent_group_id,omitempty"` // Enclosing group, empty for top-level objects
+	Position       *Position       `json:"position,omitempty"`
+	Size           *Size           `json:"size,omitempty"`
+	Shape          *ShapeDetails   `json:"shape,omitempty"`
+	Image          *ImageDetails   `json:"image,omitempty"`
+	Table          *TableDetails   `json:"table,omitempty"`
+	Video          *VideoDetails   `json:"video,omitempty"`
+	Line           *LineDetails    `json:"line,omitempty"`
+	Group          *GroupDetails   `json:"group,omitempty"`
+	Chart          *ChartDetails   `json:"chart,omitempty"`
 	WordArt        *WordArtDetails `json:"word_art,omitempty"`
+	RevisionID     string          `json:"revision_id,omitempty"` // Changes whenever the presentation is edited
+	ETag           string          `json:"etag,omitempty"`        // Drive ETag, when include_etag is set
 }
 
 // ShapeDetails contains detailed information about a shape.
 type ShapeDetails struct {
 	ShapeType       string             `json:"shape_type"`
 	Text            string             `json:"text,omitempty"`
+	Paragraphs      []ParagraphDetails `json:"paragraphs,omitempty"` // Text split at paragraph marks
 	TextStyle       *TextStyleDetails  `json:"text_style,omitempty"`
 	Fill            *FillDetails       `json:"fill,omitempty"`
 	Outline         *OutlineDetails    `json:"outline,omitempty"`
 	PlaceholderType string             `json:"placeholder_type,omitempty"`
+
+	// Only for placeholders: the index that tells apart placeholders of the same type on a page,
+	// and the layout (or master) placeholder this one inherits from
+	PlaceholderIndex    *int   `json:"placeholder_index,omitempty"`
+	PlaceholderParentID string `json:"placeholder_parent_id,omitempty"`
+
+	// Only with resolved: the first run's style merged with the placeholder parent chain, and
+	// where each field came from ("object", "layout" or "master")
+	ResolvedTextStyle    *TextStyleDetails `json:"resolved_text_style,omitempty"`
+	ResolvedStyleSources map[string]string `json:"resolved_style_sources,omitempty"`
+}
+
+// ParagraphDetails is one paragraph of a shape's text with its list membership.
+type ParagraphDetails struct {
+	Text       string         `json:"text"`        // Without the trailing newline
+	StartIndex int            `json:"start_index"` // Text index of the paragraph start
+	EndIndex   int            `json:"end_index"`   // Exclusive, includes the paragraph's newline
+	Bullet     *BulletDetails `json:"bullet,omitempty"`
+}
+
+// BulletDetails describes the list a paragraph belongs to.
+type BulletDetails struct {
+	ListID       string `json:"list_id"`
+	NestingLevel int    `json:"nesting_level"` // 0 for top-level items
+	Glyph        string `json:"glyph,omitempty"`
+	ListType     string `json:"list_type,omitempty"` // "bullet" or "numbered", inferred from the glyph
 }
 
 // TextStyleDetails contains text styling information.
 type TextStyleDetails struct {
-	FontFamily  string `json:"font_family,omitempty"`
-	FontSize    *float64 `json:"font_size,omitempty"` // in points
-	Bold        *bool  `json:"bold,omitempty"`
-	Italic      *bool  `json:"italic,omitempty"`
-	Underline   *bool  `json:"underline,omitempty"`
-	Color       string `json:"color,omitempty"` // hex format
-	LinkURL     string `json:"link_url,omitempty"`
+	FontFamily string   `json:"font_family,omitempty"`
+	FontSize   *float64 `json:"font_size,omitempty"` // in points
+	Bold       *bool    `json:"bold,omitempty"`
+	Italic     *bool    `json:"italic,omitempty"`
+	Underline  *bool    `json:"underline,omitempty"`
+	Color      string   `json:"color,omitempty"` // hex format
+	LinkURL    string   `json:"link_url,omitempty"`
 }
 
 // FillDetails contains fill information for shapes.
 type FillDetails struct {
-	Type        string `json:"type"` // SOLID, GRADIENT, etc.
-	SolidColor  string `json:"solid_color,omitempty"` // hex format
+	Type       string   `json:"type"`                  // SOLID, GRADIENT, etc.
+	SolidColor string   `json:"solid_color,omitempty"` // hex format
+	Alpha      *float64 `json:"alpha,omitempty"`       // 0-1, only set for semi-transparent fills
 }
 
 // OutlineDetails contains outline information for shapes.
 type OutlineDetails struct {
-	Color     string  `json:"color,omitempty"` // hex format
-	Weight    float64 `json:"weight,omitempty"` // in points
-	DashStyle string  `json:"dash_style,omitempty"`
+	Color     string   `json:"color,omitempty"`  // hex format
+	Alpha     *float64 `json:"alpha,omitempty"`  // 0-1, only set for semi-transparent outlines
+	Weight    float64  `json:"weight,omitempty"` // in points
+	DashStyle string   `json:"dash_style,omitempty"`
 }
 
 // ImageDetails contains detailed information about an image.
 type ImageDetails struct {
-	ContentURL   string        `json:"content_url,omitempty"`
-	SourceURL    string        `json:"source_url,omitempty"`
-	Crop         *CropDetails  `json:"crop,omitempty"`
-	Brightness   float64       `json:"brightness,omitempty"`
-	Contrast     float64       `json:"contrast,omitempty"`
-	Transparency float64       `json:"transparency,omitempty"`
-	Recolor      string        `json:"recolor,omitempty"`
+	ContentURL   string       `json:"content_url,omitempty"`
+	SourceURL    string       `json:"source_url,omitempty"`
+	Crop         *CropDetails `json:"crop,omitempty"`
+	Brightness   float64      `json:"brightness,omitempty"`
+	Contrast     float64      `json:"contrast,omitempty"`
+	Transparency float64      `json:"transparency,omitempty"`
+	Recolor      string       `json:"recolor,omitempty"`
 }
 
 // CropDetails contains crop information for images.
@@ -94,19 +130,20 @@ type CropDetails struct {
 
 // TableDetails contains detailed information about a table.
 type TableDetails struct {
-	Rows    int              `json:"rows"`
-	Columns int              `json:"columns"`
-	Cells   [][]CellDetails  `json:"cells"`
+	Rows    int             `json:"rows"`
+	Columns int             `json:"columns"`
+	Cells   [][]CellDetails `json:"cells"`
 }
 
 // CellDetails contains information about a single table cell.
 type CellDetails struct {
-	Row        int    `json:"row"`
-	Column     int    `json:"column"`
-	Text       string `json:"text,omitempty"`
-	RowSpan    int    `json:"row_span,omitempty"`
-	ColumnSpan int    `json:"column_span,omitempty"`
-	Background string `json:"background,omitempty"` // hex color
+	Row             int      `json:"row"`
+	Column          int      `json:"column"`
+	Text            string   `json:"text,omitempty"`
+	RowSpan         int      `json:"row_span,omitempty"`
+	ColumnSpan      int      `json:"column_span,omitempty"`
+	Background      string   `json:"background,omitempty"`       // hex color
+	BackgroundAlpha *float64 `json:"background_alpha,omitempty"` // 0-1, only set for semi-transparent backgrounds
 }
 
 // VideoDetails contains detailed information about a video.
@@ -122,14 +159,15 @@ type VideoDetails struct {
 
 // LineDetails contains detailed information about a line.
 type LineDetails struct {
-	LineType   string          `json:"line_type"`
-	StartArrow string          `json:"start_arrow,omitempty"`
-	EndArrow   string          `json:"end_arrow,omitempty"`
-	Color      string          `json:"color,omitempty"`
-	Weight     float64         `json:"weight,omitempty"` // in points
-	DashStyle  string          `json:"dash_style,omitempty"`
-	StartPoint *Position       `json:"start_point,omitempty"`
-	EndPoint   *Position       `json:"end_point,omitempty"`
+	LineType   string    `json:"line_type"`
+	StartArrow string    `json:"start_arrow,omitempty"`
+	EndArrow   string    `json:"end_arrow,omitempty"`
+	Color      string    `json:"color,omitempty"`
+	Alpha      *float64  `json:"alpha,omitempty"`  // 0-1, only set for semi-transparent lines
+	Weight     float64   `json:"weight,omitempty"` // in points
+	DashStyle  string    `json:"dash_style,omitempty"`
+	StartPoint *Position `json:"start_point,omitempty"`
+	EndPoint   *Position `json:"end_point,omitempty"`
 }
 
 // GroupDetails contains detailed information about a group.
@@ -184,11 +222,13 @@ func (t *Tools) GetObject(ctx context.Context, tokenSource oauth2.TokenSource, i
 	// Find the object in slides
 	var targetElement *slides.PageElement
 	var slideIndex int
+	var parentGroupID string
 
 	for slideIdx, slide := range presentation.Slides {
-		element := findElementByID(slide.PageElements, input.ObjectID)
+		element, parentID := findElementWithParent(slide.PageElements, input.ObjectID, "")
 		if element != nil {
 			targetElement = element
+			parentGroupID = parentID
 			slideIndex = slideIdx + 1 // 1-based
 			break
 		}
@@ -204,31 +244,20 @@ func (t *Tools) GetObject(ctx context.Context, tokenSource oauth2.TokenSource, i
 		ObjectID:       targetElement.ObjectId,
 		ObjectType:     determineObjectType(targetElement),
 		SlideIndex:     slideIndex,
+		ParentGroupID:  parentGroupID,
 	}
+	output.RevisionID, output.ETag = t.presentationRevision(ctx, tokenSource, presentation, input.IncludeETag)
 
-	// Extract position
-	if targetElement.Transform != nil {
-		output.Position = &Position{
-			X: emuToPoints(targetElement.Transform.TranslateX),
-			Y: emuToPoints(targetElement.Transform.TranslateY),
-		}
-	}
-
-	// Extract size
-	if targetElement.Size != nil {
-		output.Size = &Size{}
-		if targetElement.Size.Width != nil {
-			output.Size.Width = convertToPoints(targetElement.Size.Width)
-		}
-		if targetElement.Size.Height != nil {
-			output.Size.Height = convertToPoints(targetElement.Size.Height)
-		}
-	}
+	// Extract position and size
+	output.Position, output.Size = extractElementGeometry(targetElement)
 
 	// Extract type-specific details
 	switch {
 	case targetElement.Shape != nil:
 		output.Shape = extractShapeDetails(targetElement.Shape)
+		if input.Resolved {
+			output.Shape.ResolvedTextStyle, output.Shape.ResolvedStyleSources = resolveTextStyle(presentation, targetElement.Shape)
+		}
 	case targetElement.Image != nil:
 		output.Image = extractImageDetails(targetElement.Image)
 	case targetElement.Table != nil:
@@ -254,24 +283,142 @@ func (t *Tools) GetObject(ctx context.Context, tokenSource oauth2.TokenSource, i
 	return output, nil
 }
 
+// extractElementGeometry returns an element's position and size in points, or nil when unset.
+func extractElementGeometry(element *slides.PageElement) (*Position, *Size) {
+	var position *Position
+	if element.Transform != nil {
+		position = &Position{
+			X: emuToPoints(element.Transform.TranslateX),
+			Y: emuToPoints(element.Transform.TranslateY),
+		}
+	}
+
+	var size *Size
+	if element.Size != nil {
+		size = &Size{}
+		if element.Size.Width != nil {
+			size.Width = convertToPoints(element.Size.Width)
+		}
+		if element.Size.Height != nil {
+			size.Height = convertToPoints(element.Size.Height)
+		}
+	}
+
+	return position, size
+}
+
+// displayedSizeInPoints returns an element's rendered size: its intrinsic size multiplied by the
+// scale factors of its transform, ignoring rotation.
+func displayedSizeInPoints(element *slides.PageElement) (float64, float64, bool) {
+	if element.Size == nil || element.Size.Width == nil || element.Size.Height == nil {
+		return 0, 0, false
+	}
+
+	scaleX, scaleY := 1.0, 1.0
+	if element.Transform != nil {
+		if s := math.Hypot(element.Transform.ScaleX, element.Transform.ShearY); s != 0 {
+			scaleX = s
+		}
+		if s := math.Hypot(element.Transform.ScaleY, element.Transform.ShearX); s != 0 {
+			scaleY = s
+		}
+	}
+
+	return convertToPoints(element.Size.Width) * scaleX, convertToPoints(element.Size.Height) * scaleY, true
+}
+
+// composeTransforms returns the transform that applies child and then parent, the matrix
+// product parent x child. Translations are in EMU.
+func composeTransforms(parent, child *slides.AffineTransform) *slides.AffineTransform {
+	return &slides.AffineTransform{
+		ScaleX:     parent.ScaleX*child.ScaleX + parent.ShearX*child.ShearY,
+		ShearX:     parent.ScaleX*child.ShearX + parent.ShearX*child.ScaleY,
+		ShearY:     parent.ShearY*child.ScaleX + parent.ScaleY*child.ShearY,
+		ScaleY:     parent.ShearY*child.ShearX + parent.ScaleY*child.ScaleY,
+		TranslateX: parent.ScaleX*child.TranslateX + parent.ShearX*child.TranslateY + parent.TranslateX,
+		TranslateY: parent.ShearY*child.TranslateX + parent.ScaleY*child.TranslateY + parent.TranslateY,
+		Unit:       "EMU",
+	}
+}
+
+// pageTransform returns the transform that places element on the page, given parent, the combined
+// transform of the groups enclosing it (nil at the top level). A child's transform is relative
+// to its group; a child without one sits where its group does.
+func pageTransform(element *slides.PageElement, parent *slides.AffineTransform) *slides.AffineTransform {
+	switch {
+	case parent == nil:
+		return element.Transform
+	case element.Transform == nil:
+		return parent
+	default:
+		return composeTransforms(parent, element.Transform)
+	}
+}
+
+// pageGeometry returns an element's position on the page and displayed size in points under
+// transform (see pageTransform), or nil when unset. Rotation is ignored.
+func pageGeometry(element *slides.PageElement, transform *slides.AffineTransform) (*Position, *Size) {
+	var position *Position
+	if transform != nil {
+		position = &Position{
+			X: emuToPoints(transform.TranslateX),
+			Y: emuToPoints(transform.TranslateY),
+		}
+	}
+
+	var size *Size
+	if width, height, ok := displayedSizeInPoints(&slides.PageElement{Size: element.Size, Transform: transform}); ok {
+		size = &Size{Width: width, Height: height}
+	}
+
+	return position, size
+}
+
+// findElementOnPage searches for an element by ID, recursing into groups, and returns it with
+// its page transform. parent is the combined transform of the groups enclosing elements.
+func findElementOnPage(elements []*slides.PageElement, objectID string, parent *slides.AffineTransform) (*slides.PageElement, *slides.AffineTransform) {
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+		transform := pageTransform(element, parent)
+		if element.ObjectId == objectID {
+			return element, transform
+		}
+		if element.ElementGroup != nil {
+			if found, foundTransform := findElementOnPage(element.ElementGroup.Children, objectID, transform); found != nil {
+				return found, foundTransform
+			}
+		}
+	}
+	return nil, nil
+}
+
 // findElementByID searches for an element by ID in a list of page elements.
 func findElementByID(elements []*slides.PageElement, objectID string) *slides.PageElement {
+	element, _ := findElementWithParent(elements, objectID, "")
+	return element
+}
+
+// findElementWithParent searches for an element by ID, recursing into groups, and
+// returns it along with the ID of its enclosing group (parentID for top-level matches).
+func findElementWithParent(elements []*slides.PageElement, objectID, parentID string) (*slides.PageElement, string) {
 	for _, element := range elements {
 		if element == nil {
 			continue
 		}
 		if element.ObjectId == objectID {
-			return element
+			return element, parentID
 		}
 		// Search in groups recursively
 		if element.ElementGroup != nil {
-			found := findElementByID(element.ElementGroup.Children, objectID)
+			found, foundParentID := findElementWithParent(element.ElementGroup.Children, objectID, element.ObjectId)
 			if found != nil {
-				return found
+				return found, foundParentID
 			}
 		}
 	}
-	return nil
+	return nil, ""
 }
 
 // extractShapeDetails extracts detailed information from a shape.
@@ -284,14 +431,18 @@ func extractShapeDetails(shape *slides.Shape) *ShapeDetails {
 		ShapeType: shape.ShapeType,
 	}
 
-	// Extract placeholder type
+	// Extract placeholder type, index and parent
 	if shape.Placeholder != nil {
+		index := int(shape.Placeholder.Index)
 		details.PlaceholderType = shape.Placeholder.Type
+		details.PlaceholderIndex = &index
+		details.PlaceholderParentID = shape.Placeholder.ParentObjectId
 	}
 
 	// Extract text content
 	if shape.Text != nil {
 		details.Text = extractTextFromTextContent(shape.Text)
+		details.Paragraphs = extractParagraphs(shape.Text)
 		details.TextStyle = extractTextStyle(shape.Text)
 	}
 
@@ -304,6 +455,81 @@ func extractShapeDetails(shape *slides.Shape) *ShapeDetails {
 	return details
 }
 
+// extractParagraphs splits text content at paragraph markers. Each ParagraphMarker starts a
+// paragraph, and the runs that follow it up to the next marker make up its text.
+func extractParagraphs(textContent *slides.TextContent) []ParagraphDetails {
+	if textContent == nil {
+		return nil
+	}
+
+	var paragraphs []ParagraphDetails
+	var builder strings.Builder
+	current := -1
+
+	flush := func() {
+		if current >= 0 {
+			paragraphs[current].Text = strings.TrimSuffix(builder.String(), "\n")
+			builder.Reset()
+		}
+	}
+
+	for _, element := range textContent.TextElements {
+		if element == nil {
+			continue
+		}
+
+		if marker := element.ParagraphMarker; marker != nil {
+			flush()
+			paragraph := ParagraphDetails{
+				StartIndex: int(element.StartIndex),
+				EndIndex:   int(element.EndIndex),
+			}
+			if marker.Bullet != nil {
+				paragraph.Bullet = &BulletDetails{
+					ListID:       marker.Bullet.ListId,
+					NestingLevel: int(marker.Bullet.NestingLevel),
+					Glyph:        marker.Bullet.Glyph,
+					ListType:     listTypeFromGlyph(marker.Bullet.Glyph),
+				}
+			}
+			paragraphs = append(paragraphs, paragraph)
+			current = len(paragraphs) - 1
+			continue
+		}
+
+		// Text before any marker still forms a paragraph
+		if current < 0 {
+			paragraphs = append(paragraphs, ParagraphDetails{StartIndex: int(element.StartIndex), EndIndex: int(element.EndIndex)})
+			current = 0
+		}
+
+		switch {
+		case element.TextRun != nil:
+			builder.WriteString(element.TextRun.Content)
+		case element.AutoText != nil:
+			builder.WriteString(element.AutoText.Content)
+		}
+	}
+	flush()
+
+	return paragraphs
+}
+
+// listTypeFromGlyph tells numbered from bulleted paragraphs by their rendered glyph: numbered
+// glyphs such as "1.", "b)" or "iv." contain letters or digits, bullet symbols never do.
+// The API reports no list type, so an empty glyph yields "".
+func listTypeFromGlyph(glyph string) string {
+	if glyph == "" {
+		return ""
+	}
+	for _, r := range glyph {
+		if (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			return "numbered"
+		}
+	}
+	return "bullet"
+}
+
 // extractTextStyle extracts text styling from text content.
 func extractTextStyle(textContent *slides.TextContent) *TextStyleDetails {
 	if textContent == nil || len(textContent.TextElements) == 0 {
@@ -349,6 +575,86 @@ func extractTextStyle(textContent *slides.TextContent) *TextStyleDetails {
 	return nil
 }
 
+// maxPlaceholderDepth bounds the placeholder parent walk (slide -> layout -> master in practice).
+const maxPlaceholderDepth = 4
+
+// resolveTextStyle merges a shape's first-run text style with the styles of its placeholder
+// parents. The object's own style wins, then the layout placeholder, then the master
+// placeholder; each field is taken from the nearest level that sets it. Links are not
+// inherited. Bold, italic and underline set to false are indistinguishable from unset in the
+// API response, so only true values are reported and inherited.
+func resolveTextStyle(presentation *slides.Presentation, shape *slides.Shape) (*TextStyleDetails, map[string]string) {
+	resolved := &TextStyleDetails{}
+	sources := make(map[string]string)
+
+	merge := func(style *TextStyleDetails, source string) {
+		if style == nil {
+			return
+		}
+		if resolved.FontFamily == "" && style.FontFamily != "" {
+			resolved.FontFamily = style.FontFamily
+			sources["font_family"] = source
+		}
+		if resolved.FontSize == nil && style.FontSize != nil {
+			resolved.FontSize = style.FontSize
+			sources["font_size"] = source
+		}
+		if resolved.Bold == nil && style.Bold != nil {
+			resolved.Bold = style.Bold
+			sources["bold"] = source
+		}
+		if resolved.Italic == nil && style.Italic != nil {
+			resolved.Italic = style.Italic
+			sources["italic"] = source
+		}
+		if resolved.Underline == nil && style.Underline != nil {
+			resolved.Underline = style.Underline
+			sources["underline"] = source
+		}
+		if resolved.Color == "" && style.Color != "" {
+			resolved.Color = style.Color
+			sources["color"] = source
+		}
+		if source == "object" && style.LinkURL != "" {
+			resolved.LinkURL = style.LinkURL
+			sources["link_url"] = source
+		}
+	}
+
+	merge(extractTextStyle(shape.Text), "object")
+
+	placeholder := shape.Placeholder
+	for depth := 0; placeholder != nil && placeholder.ParentObjectId != "" && depth < maxPlaceholderDepth; depth++ {
+		parent, source := findPlaceholderParent(presentation, placeholder.ParentObjectId)
+		if parent == nil || parent.Shape == nil {
+			break
+		}
+		merge(extractTextStyle(parent.Shape.Text), source)
+		placeholder = parent.Shape.Placeholder
+	}
+
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	return resolved, sources
+}
+
+// findPlaceholderParent looks up a placeholder's parent shape on the layouts and masters, and
+// reports which kind of page it was found on.
+func findPlaceholderParent(presentation *slides.Presentation, objectID string) (*slides.PageElement, string) {
+	for _, layout := range presentation.Layouts {
+		if element := findElementByID(layout.PageElements, objectID); element != nil {
+			return element, "layout"
+		}
+	}
+	for _, master := range presentation.Masters {
+		if element := findElementByID(master.PageElements, objectID); element != nil {
+			return element, "master"
+		}
+	}
+	return nil, ""
+}
+
 // extractFillDetails extracts fill details from shape background fill.
 func extractFillDetails(fill *slides.ShapeBackgroundFill) *FillDetails {
 	if fill == nil {
@@ -361,6 +667,7 @@ func extractFillDetails(fill *slides.ShapeBackgroundFill) *FillDetails {
 		details.Type = "SOLID"
 		if fill.SolidFill.Color != nil {
 			details.SolidColor = extractColor(fill.SolidFill.Color)
+			details.Alpha = extractAlpha(fill.SolidFill)
 		}
 	}
 
@@ -378,6 +685,7 @@ func extractOutlineDetails(outline *slides.Outline) *OutlineDetails {
 	if outline.OutlineFill != nil && outline.OutlineFill.SolidFill != nil {
 		if outline.OutlineFill.SolidFill.Color != nil {
 			details.Color = extractColor(outline.OutlineFill.SolidFill.Color)
+			details.Alpha = extractAlpha(outline.OutlineFill.SolidFill)
 		}
 	}
 
@@ -412,6 +720,17 @@ func extractColor(color *slides.OpaqueColor) string {
 	return ""
 }
 
+// extractAlpha returns a solid fill's alpha when it is semi-transparent, nil otherwise.
+// Opaque fills report no alpha. The API omits an alpha of 0, so a fully transparent fill is
+// indistinguishable from one without alpha and is reported as opaque too.
+func extractAlpha(fill *slides.SolidFill) *float64 {
+	if fill == nil || fill.Alpha <= 0 || fill.Alpha >= 1 {
+		return nil
+	}
+	alpha := fill.Alpha
+	return &alpha
+}
+
 // extractImageDetails extracts detailed information from an image.
 func extractImageDetails(image *slides.Image) *ImageDetails {
 	if image == nil {
@@ -492,6 +811,7 @@ func extractTableDetails(table *slides.Table) *TableDetails {
 				fill := cell.TableCellProperties.TableCellBackgroundFill
 				if fill.SolidFill != nil && fill.SolidFill.Color != nil {
 					cellDetails.Background = extractColor(fill.SolidFill.Color)
+					cellDetails.BackgroundAlpha = extractAlpha(fill.SolidFill)
 				}
 			}
 
@@ -556,6 +876,7 @@ func extractLineDetails(element *slides.PageElement) *LineDetails {
 		if props.LineFill != nil && props.LineFill.SolidFill != nil {
 			if props.LineFill.SolidFill.Color != nil {
 				details.Color = extractColor(props.LineFill.SolidFill.Color)
+				details.Alpha = extractAlpha(props.LineFill.SolidFill)
 			}
 		}
 		if props.Weight != nil {
@@ -22,8 +22,8 @@ func TestGetObject_Success_Shape(t *testing.T) {
 							{
 								ObjectId: "shape-1",
 								Transform: &slides.AffineTransform{
-									TranslateX: 127000,   // 10 points
-									TranslateY: 254000,   // 20 points
+									TranslateX: 127000, // 10 points
+									TranslateY: 254000, // 20 points
 								},
 								Size: &slides.Size{
 									Width:  &slides.Dimension{Magnitude: 300, Unit: "PT"},
@@ -128,8 +128,8 @@ func TestGetObject_Success_Shape(t *testing.T) {
 	if output.Position == nil {
 		t.Fatal("expected position to be set")
 	}
-	expectedX := 127000.0 / 12700.0 // ~10 points
-	expectedY := 254000.0 / 12700.0 // ~20 points
+	expectedX := emuToPoints(127000) // 10 points
+	expectedY := emuToPoints(254000) // 20 points
 	if output.Position.X != expectedX {
 		t.Errorf("expected X position %f, got %f", expectedX, output.Position.X)
 	}
@@ -819,6 +819,68 @@ func TestGetObject_ObjectInGroup(t *testing.T) {
 	if output.Shape == nil || output.Shape.Text != "Nested text" {
 		t.Error("expected to find nested shape with text content")
 	}
+	if output.ParentGroupID != "group-1" {
+		t.Errorf("expected parent group ID 'group-1', got '%s'", output.ParentGroupID)
+	}
+
+	// Top-level group has no parent
+	groupOutput, err := tools.GetObject(context.Background(), tokenSource, GetObjectInput{
+		PresentationID: "test-presentation-id",
+		ObjectID:       "group-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if groupOutput.ParentGroupID != "" {
+		t.Errorf("expected empty parent group ID for top-level object, got '%s'", groupOutput.ParentGroupID)
+	}
+}
+
+func TestFindElementWithParent(t *testing.T) {
+	elements := []*slides.PageElement{
+		{ObjectId: "top-shape", Shape: &slides.Shape{}},
+		{
+			ObjectId: "outer-group",
+			ElementGroup: &slides.Group{
+				Children: []*slides.PageElement{
+					{ObjectId: "child-shape", Shape: &slides.Shape{}},
+					{
+						ObjectId: "inner-group",
+						ElementGroup: &slides.Group{
+							Children: []*slides.PageElement{
+								{ObjectId: "deep-shape", Shape: &slides.Shape{}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		objectID       string
+		expectedFound  bool
+		expectedParent string
+	}{
+		{"top-shape", true, ""},
+		{"outer-group", true, ""},
+		{"child-shape", true, "outer-group"},
+		{"inner-group", true, "outer-group"},
+		{"deep-shape", true, "inner-group"},
+		{"missing", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.objectID, func(t *testing.T) {
+			element, parentID := findElementWithParent(elements, tt.objectID, "")
+			if (element != nil) != tt.expectedFound {
+				t.Fatalf("expected found=%v, got element %v", tt.expectedFound, element)
+			}
+			if parentID != tt.expectedParent {
+				t.Errorf("expected parent '%s', got '%s'", tt.expectedParent, parentID)
+			}
+		})
+	}
 }
 
 func TestGetObject_ObjectInSecondSlide(t *testing.T) {
@@ -1130,7 +1192,9 @@ func TestGetObject_ShapeWithPlaceholder(t *testing.T) {
 								Shape: &slides.Shape{
 									ShapeType: "TEXT_BOX",
 									Placeholder: &slides.Placeholder{
-										Type: "TITLE",
+										Type:           "TITLE",
+										Index:          1,
+										ParentObjectId: "layout-title",
 									},
 									Text: &slides.TextContent{
 										TextElements: []*slides.TextElement{
@@ -1168,4 +1232,384 @@ func TestGetObject_ShapeWithPlaceholder(t *testing.T) {
 	if output.Shape.PlaceholderType != "TITLE" {
 		t.Errorf("expected placeholder type 'TITLE', got '%s'", output.Shape.PlaceholderType)
 	}
+	if output.Shape.PlaceholderIndex == nil || *output.Shape.PlaceholderIndex != 1 {
+		t.Errorf("expected placeholder index 1, got %v", output.Shape.PlaceholderIndex)
+	}
+	if output.Shape.PlaceholderParentID != "layout-title" {
+		t.Errorf("expected placeholder parent 'layout-title', got '%s'", output.Shape.PlaceholderParentID)
+	}
+}
+
+func TestExtractShapeDetails_PlaceholderFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		shape      *slides.Shape
+		wantIndex  *int
+		wantParent string
+	}{
+		{
+			name:  "not a placeholder",
+			shape: &slides.Shape{ShapeType: "RECTANGLE"},
+		},
+		{
+			name:      "index 0 without parent",
+			shape:     &slides.Shape{ShapeType: "TEXT_BOX", Placeholder: &slides.Placeholder{Type: "BODY"}},
+			wantIndex: intPtr(0),
+		},
+		{
+			name:       "second body placeholder",
+			shape:      &slides.Shape{ShapeType: "TEXT_BOX", Placeholder: &slides.Placeholder{Type: "BODY", Index: 2, ParentObjectId: "layout-body-2"}},
+			wantIndex:  intPtr(2),
+			wantParent: "layout-body-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details := extractShapeDetails(tt.shape)
+			if (details.PlaceholderIndex == nil) != (tt.wantIndex == nil) ||
+				(tt.wantIndex != nil && *details.PlaceholderIndex != *tt.wantIndex) {
+				t.Errorf("placeholder index = %v, want %v", details.PlaceholderIndex, tt.wantIndex)
+			}
+			if details.PlaceholderParentID != tt.wantParent {
+				t.Errorf("placeholder parent = %q, want %q", details.PlaceholderParentID, tt.wantParent)
+			}
+		})
+	}
+}
+
+func TestExtractParagraphs_BulletedList(t *testing.T) {
+	bullet := func(level int64) *slides.Bullet {
+		return &slides.Bullet{ListId: "list-1", NestingLevel: level, Glyph: "●"}
+	}
+	textContent := &slides.TextContent{
+		TextElements: []*slides.TextElement{
+			{StartIndex: 0, EndIndex: 7, ParagraphMarker: &slides.ParagraphMarker{}},
+			{StartIndex: 0, EndIndex: 7, TextRun: &slides.TextRun{Content: "Agenda\n"}},
+			{StartIndex: 7, EndIndex: 14, ParagraphMarker: &slides.ParagraphMarker{Bullet: bullet(0)}},
+			{StartIndex: 7, EndIndex: 10, TextRun: &slides.TextRun{Content: "Goa"}},
+			{StartIndex: 10, EndIndex: 14, TextRun: &slides.TextRun{Content: "ls\n"}},
+			{StartIndex: 14, EndIndex: 22, ParagraphMarker: &slides.ParagraphMarker{Bullet: bullet(1)}},
+			{StartIndex: 14, EndIndex: 22, TextRun: &slides.TextRun{Content: "Revenue\n"}},
+			{StartIndex: 22, EndIndex: 28, ParagraphMarker: &slides.ParagraphMarker{Bullet: bullet(0)}},
+			{StartIndex: 22, EndIndex: 28, TextRun: &slides.TextRun{Content: "Risks\n"}},
+		},
+	}
+
+	paragraphs := extractParagraphs(textContent)
+
+	want := []struct {
+		text     string
+		start    int
+		end      int
+		level    int
+		bulleted bool
+	}{
+		{text: "Agenda", start: 0, end: 7},
+		{text: "Goals", start: 7, end: 14, level: 0, bulleted: true},
+		{text: "Revenue", start: 14, end: 22, level: 1, bulleted: true},
+		{text: "Risks", start: 22, end: 28, level: 0, bulleted: true},
+	}
+
+	if len(paragraphs) != len(want) {
+		t.Fatalf("got %d paragraphs, want %d: %+v", len(paragraphs), len(want), paragraphs)
+	}
+	for i, w := range want {
+		p := paragraphs[i]
+		if p.Text != w.text || p.StartIndex != w.start || p.EndIndex != w.end {
+			t.Errorf("paragraph %d = %q [%d, %d), want %q [%d, %d)", i, p.Text, p.StartIndex, p.EndIndex, w.text, w.start, w.end)
+		}
+		if (p.Bullet != nil) != w.bulleted {
+			t.Errorf("paragraph %d bullet = %+v, want bulleted=%v", i, p.Bullet, w.bulleted)
+			continue
+		}
+		if p.Bullet != nil && (p.Bullet.NestingLevel != w.level || p.Bullet.ListID != "list-1") {
+			t.Errorf("paragraph %d bullet = %+v, want list-1 level %d", i, p.Bullet, w.level)
+		}
+	}
+}
+
+func TestExtractParagraphs_Empty(t *testing.T) {
+	if paragraphs := extractParagraphs(nil); paragraphs != nil {
+		t.Errorf("expected nil for nil text, got %+v", paragraphs)
+	}
+	if paragraphs := extractParagraphs(&slides.TextContent{}); len(paragraphs) != 0 {
+		t.Errorf("expected no paragraphs, got %+v", paragraphs)
+	}
+}
+
+func newResolvedStyleTestPresentation() *slides.Presentation {
+	placeholderShape := func(id, placeholderType, parentID string, style *slides.TextStyle) *slides.PageElement {
+		return &slides.PageElement{
+			ObjectId: id,
+			Shape: &slides.Shape{
+				ShapeType:   "TEXT_BOX",
+				Placeholder: &slides.Placeholder{Type: placeholderType, ParentObjectId: parentID},
+				Text: &slides.TextContent{TextElements: []*slides.TextElement{
+					{StartIndex: 0, EndIndex: 6, TextRun: &slides.TextRun{Content: "Title\n", Style: style}},
+				}},
+			},
+		}
+	}
+
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Masters: []*slides.Page{{
+			ObjectId: "master-1",
+			PageElements: []*slides.PageElement{
+				placeholderShape("master-title", "TITLE", "", &slides.TextStyle{
+					FontFamily:      "Lato",
+					FontSize:        &slides.Dimension{Magnitude: 40, Unit: "PT"},
+					ForegroundColor: &slides.OptionalColor{OpaqueColor: &slides.OpaqueColor{ThemeColor: "DARK1"}},
+				}),
+			},
+		}},
+		Layouts: []*slides.Page{{
+			ObjectId: "layout-1",
+			PageElements: []*slides.PageElement{
+				placeholderShape("layout-title", "TITLE", "master-title", &slides.TextStyle{
+					FontSize: &slides.Dimension{Magnitude: 32, Unit: "PT"},
+					Bold:     true,
+				}),
+			},
+		}},
+		Slides: []*slides.Page{{
+			ObjectId: "slide-1",
+			PageElements: []*slides.PageElement{
+				placeholderShape("title-1", "TITLE", "layout-title", &slides.TextStyle{Italic: true}),
+				{ObjectId: "box-1", Shape: &slides.Shape{ShapeType: "TEXT_BOX", Text: &slides.TextContent{TextElements: []*slides.TextElement{
+					{StartIndex: 0, EndIndex: 4, TextRun: &slides.TextRun{Content: "Box\n", Style: &slides.TextStyle{FontFamily: "Arial"}}},
+				}}}},
+			},
+		}},
+	}
+}
+
+func TestGetObject_ResolvedTextStyle(t *testing.T) {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return newResolvedStyleTestPresentation(), nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	t.Run("placeholder inherits from layout and master", func(t *testing.T) {
+		output, err := tools.GetObject(context.Background(), nil, GetObjectInput{PresentationID: "pres-1", ObjectID: "title-1", Resolved: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Explicit style is unchanged
+		explicit := output.Shape.TextStyle
+		if explicit.FontFamily != "" || explicit.FontSize != nil || explicit.Italic == nil {
+			t.Errorf("explicit style should only hold italic, got %+v", explicit)
+		}
+
+		resolved := output.Shape.ResolvedTextStyle
+		if resolved == nil {
+			t.Fatal("expected a resolved text style")
+		}
+		if resolved.FontFamily != "Lato" {
+			t.Errorf("FontFamily = %q, want Lato from the master", resolved.FontFamily)
+		}
+		if resolved.FontSize == nil || *resolved.FontSize != 32 {
+			t.Errorf("FontSize = %v, want 32 from the layout (nearer than the master)", resolved.FontSize)
+		}
+		if resolved.Bold == nil || !*resolved.Bold || resolved.Italic == nil || !*resolved.Italic {
+			t.Errorf("expected bold from the layout and italic from the object, got %+v", resolved)
+		}
+		if resolved.Color != "theme:DARK1" {
+			t.Errorf("Color = %q, want theme:DARK1", resolved.Color)
+		}
+
+		wantSources := map[string]string{
+			"italic":      "object",
+			"font_size":   "layout",
+			"bold":        "layout",
+			"font_family": "master",
+			"color":       "master",
+		}
+		for field, want := range wantSources {
+			if got := output.Shape.ResolvedStyleSources[field]; got != want {
+				t.Errorf("source of %s = %q, want %q", field, got, want)
+			}
+		}
+	})
+
+	t.Run("non-placeholder resolves to its own style", func(t *testing.T) {
+		output, err := tools.GetObject(context.Background(), nil, GetObjectInput{PresentationID: "pres-1", ObjectID: "box-1", Resolved: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Shape.ResolvedTextStyle == nil || output.Shape.ResolvedTextStyle.FontFamily != "Arial" {
+			t.Errorf("unexpected resolved style %+v", output.Shape.ResolvedTextStyle)
+		}
+		if len(output.Shape.ResolvedStyleSources) != 1 || output.Shape.ResolvedStyleSources["font_family"] != "object" {
+			t.Errorf("unexpected sources %v", output.Shape.ResolvedStyleSources)
+		}
+	})
+
+	t.Run("not resolved by default", func(t *testing.T) {
+		output, err := tools.GetObject(context.Background(), nil, GetObjectInput{PresentationID: "pres-1", ObjectID: "title-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Shape.ResolvedTextStyle != nil || output.Shape.ResolvedStyleSources != nil {
+			t.Error("expected no resolved style without the resolved flag")
+		}
+	})
+}
+
+func TestGetObject_SemiTransparentFill(t *testing.T) {
+	red := &slides.OpaqueColor{RgbColor: &slides.RgbColor{Red: 1}}
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				Slides: []*slides.Page{{
+					ObjectId: "slide-1",
+					PageElements: []*slides.PageElement{{
+						ObjectId: "shape-1",
+						Shape: &slides.Shape{
+							ShapeType: "RECTANGLE",
+							ShapeProperties: &slides.ShapeProperties{
+								ShapeBackgroundFill: &slides.ShapeBackgroundFill{
+									SolidFill: &slides.SolidFill{Color: red, Alpha: 0.5},
+								},
+								Outline: &slides.Outline{
+									OutlineFill: &slides.OutlineFill{
+										SolidFill: &slides.SolidFill{Color: red, Alpha: 1},
+									},
+								},
+							},
+						},
+					}},
+				}},
+			}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.GetObject(context.Background(), nil, GetObjectInput{PresentationID: "pres-1", ObjectID: "shape-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fill := output.Shape.Fill
+	if fill.SolidColor != "#FF0000" || fill.Alpha == nil || *fill.Alpha != 0.5 {
+		t.Errorf("expected a red fill at alpha 0.5, got %+v", fill)
+	}
+	if output.Shape.Outline.Alpha != nil {
+		t.Errorf("expected no alpha for an opaque outline, got %v", *output.Shape.Outline.Alpha)
+	}
+}
+
+func TestExtractAlpha(t *testing.T) {
+	tests := []struct {
+		name string
+		fill *slides.SolidFill
+		want *float64
+	}{
+		{"nil fill", nil, nil},
+		{"opaque", &slides.SolidFill{Alpha: 1}, nil},
+		{"unset", &slides.SolidFill{}, nil},
+		{"semi-transparent", &slides.SolidFill{Alpha: 0.25}, float64Ptr(0.25)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractAlpha(tt.fill)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("extractAlpha() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListTypeFromGlyph(t *testing.T) {
+	tests := map[string]string{
+		"●":    "bullet",
+		"➢":    "bullet",
+		"-":    "bullet",
+		"1.":   "numbered",
+		"12.":  "numbered",
+		"b)":   "numbered",
+		"iv.":  "numbered",
+		"A.":   "numbered",
+		"1.2.": "numbered",
+		"":     "",
+	}
+	for glyph, want := range tests {
+		if got := listTypeFromGlyph(glyph); got != want {
+			t.Errorf("listTypeFromGlyph(%q) = %q, want %q", glyph, got, want)
+		}
+	}
+}
+
+func TestGetObject_ListParagraphs(t *testing.T) {
+	marker := func(glyph string, level int64) *slides.ParagraphMarker {
+		if glyph == "" {
+			return &slides.ParagraphMarker{}
+		}
+		return &slides.ParagraphMarker{Bullet: &slides.Bullet{ListId: "list-" + glyph, NestingLevel: level, Glyph: glyph}}
+	}
+	text := &slides.TextContent{TextElements: []*slides.TextElement{
+		{StartIndex: 0, EndIndex: 6, ParagraphMarker: marker("", 0)},
+		{StartIndex: 0, EndIndex: 6, TextRun: &slides.TextRun{Content: "Intro\n"}},
+		{StartIndex: 6, EndIndex: 12, ParagraphMarker: marker("●", 0)},
+		{StartIndex: 6, EndIndex: 12, TextRun: &slides.TextRun{Content: "Point\n"}},
+		{StartIndex: 12, EndIndex: 19, ParagraphMarker: marker("○", 1)},
+		{StartIndex: 12, EndIndex: 19, TextRun: &slides.TextRun{Content: "Detail\n"}},
+		{StartIndex: 19, EndIndex: 24, ParagraphMarker: marker("1.", 0)},
+		{StartIndex: 19, EndIndex: 24, TextRun: &slides.TextRun{Content: "Step\n"}},
+	}}
+
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{
+				ObjectId:     "slide-1",
+				PageElements: []*slides.PageElement{{ObjectId: "body", Shape: &slides.Shape{ShapeType: "TEXT_BOX", Text: text}}},
+			}}}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.GetObject(context.Background(), &mockTokenSource{}, GetObjectInput{PresentationID: "pres-1", ObjectID: "body"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	paragraphs := output.Shape.Paragraphs
+	if len(paragraphs) != 4 {
+		t.Fatalf("expected 4 paragraphs, got %+v", paragraphs)
+	}
+	if paragraphs[0].Bullet != nil {
+		t.Errorf("expected no bullet on the plain paragraph, got %+v", paragraphs[0].Bullet)
+	}
+
+	want := []struct {
+		glyph    string
+		level    int
+		listType string
+	}{
+		{"●", 0, "bullet"},
+		{"○", 1, "bullet"},
+		{"1.", 0, "numbered"},
+	}
+	for i, w := range want {
+		bullet := paragraphs[i+1].Bullet
+		if bullet == nil {
+			t.Errorf("paragraph %d: expected bullet info", i+1)
+			continue
+		}
+		if bullet.Glyph != w.glyph || bullet.NestingLevel != w.level || bullet.ListType != w.listType {
+			t.Errorf("paragraph %d bullet = %+v, want %s at level %d (%s)", i+1, bullet, w.glyph, w.level, w.listType)
+		}
+	}
 }
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -25,6 +26,7 @@ var (
 type GetPresentationInput struct {
 	PresentationID    string `json:"presentation_id"`
 	IncludeThumbnails bool   `json:"include_thumbnails,omitempty"`
+	IncludeETag       bool   `json:"include_etag,omitempty"` // Also fetch the Drive ETag (one extra Drive call)
 }
 
 // GetPresentationOutput represents the output of the get_presentation tool.
@@ -32,6 +34,8 @@ type GetPresentationOutput struct {
 	PresentationID string       `json:"presentation_id"`
 	Title          string       `json:"title"`
 	Locale         string       `json:"locale,omitempty"`
+	RevisionID     string       `json:"revision_id,omitempty"` // Changes whenever the presentation is edited
+	ETag           string       `json:"etag,omitempty"`        // Drive ETag, when include_etag is set
 	SlidesCount    int          `json:"slides_count"`
 	PageSize       *PageSize    `json:"page_size,omitempty"`
 	Slides         []SlideInfo  `json:"slides"`
@@ -127,6 +131,7 @@ func (t *Tools) GetPresentation(ctx context.Context, tokenSource oauth2.TokenSou
 		Locale:         presentation.Locale,
 		SlidesCount:    len(presentation.Slides),
 	}
+	output.RevisionID, output.ETag = t.presentationRevision(ctx, tokenSource, presentation, input.IncludeETag)
 
 	// Page size
 	if presentation.PageSize != nil {
@@ -396,8 +401,36 @@ func extractSpeakerNotes(slide *slides.Page) string {
 	return ""
 }
 
+// Image downloads use their own client so a stalled content URL cannot hang a tool call, and
+// are capped so an unexpectedly large file cannot exhaust memory.
+const (
+	imageFetchTimeout  = 30 * time.Second
+	maxImageFetchBytes = 50 << 20
+)
+
+var imageFetchClient = &http.Client{Timeout: imageFetchTimeout}
+
 // fetchThumbnailImage fetches the thumbnail image from the URL.
 func fetchThumbnailImage(ctx context.Context, url string) ([]byte, error) {
+	body, err := openImageURL(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxImageFetchBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read thumbnail: %w", err)
+	}
+	if len(data) > maxImageFetchBytes {
+		return nil, fmt.Errorf("thumbnail exceeds %d bytes", maxImageFetchBytes)
+	}
+
+	return data, nil
+}
+
+// openImageURL starts downloading an image and returns its body, which the caller must close.
+func openImageURL(ctx context.Context, url string) (io.ReadCloser, error) {
 	if url == "" {
 		return nil, errors.New("empty thumbnail URL")
 	}
@@ -407,22 +440,17 @@ func fetchThumbnailImage(ctx context.Context, url string) ([]byte, error) {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := imageFetchClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch thumbnail: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
 		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read thumbnail: %w", err)
-	}
-
-	return data, nil
+	return resp.Body, nil
 }
 
 // isNotFoundError checks if an error indicates a resource was not found.
@@ -3,6 +3,9 @@ package tools
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"golang.org/x/oauth2"
@@ -52,6 +55,25 @@ func (m *mockTokenSource) Token() (*oauth2.Token, error) {
 	return &oauth2.Token{AccessToken: "test-token"}, nil
 }
 
+// newBatchCaptureTestTools returns tools whose service serves presentation (with getErr) and
+// answers batch updates with batchErr, counting them in batchCalls and keeping the last
+// requests in captured.
+func newBatchCaptureTestTools(presentation *slides.Presentation, getErr, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*batchCalls++
+			*captured = requests
+			return &slides.BatchUpdatePresentationResponse{}, batchErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
 func TestGetPresentation_Success(t *testing.T) {
 	mockService := &mockSlidesService{
 		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
@@ -835,3 +857,32 @@ func TestExtractTextFromTextContent(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchThumbnailImage_SizeLimit(t *testing.T) {
+	// The server never stops sending; the download must stop at the cap instead of reading it all
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		chunk := make([]byte, 64<<10)
+		for {
+			if _, err := w.Write(chunk); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	_, err := fetchThumbnailImage(context.Background(), server.URL)
+	if err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Errorf("expected size limit error, got %v", err)
+	}
+}
+
+func TestFetchThumbnailImage_StatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	if _, err := fetchThumbnailImage(context.Background(), server.URL); err == nil {
+		t.Error("expected error for non-200 status")
+	}
+}
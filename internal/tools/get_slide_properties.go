@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// GetSlidePropertiesInput represents the input for the get_slide_properties tool.
+type GetSlidePropertiesInput struct {
+	PresentationID string `json:"presentation_id"`
+	SlideIndex     int    `json:"slide_index,omitempty"` // 1-based index
+	SlideID        string `json:"slide_id,omitempty"`    // Alternative to slide_index
+}
+
+// GetSlidePropertiesOutput represents the output of the get_slide_properties tool.
+type GetSlidePropertiesOutput struct {
+	PresentationID       string `json:"presentation_id"`
+	SlideID              string `json:"slide_id"`
+	SlideIndex           int    `json:"slide_index"` // 1-based
+	LayoutObjectID       string `json:"layout_object_id,omitempty"`
+	LayoutName           string `json:"layout_name,omitempty"` // Layout display name
+	MasterObjectID       string `json:"master_object_id,omitempty"`
+	MasterName           string `json:"master_name,omitempty"` // Master display name
+	IsSkipped            bool   `json:"is_skipped"`            // Slide is skipped in presentation mode
+	HasNotesPage         bool   `json:"has_notes_page"`
+	NotesPageID          string `json:"notes_page_id,omitempty"`
+	SpeakerNotesObjectID string `json:"speaker_notes_object_id,omitempty"` // Notes body shape, if any
+}
+
+// GetSlideProperties returns a slide's SlideProperties: its layout and master, whether it is
+// skipped in presentation mode and its notes page.
+func (t *Tools) GetSlideProperties(ctx context.Context, tokenSource oauth2.TokenSource, input GetSlidePropertiesInput) (*GetSlidePropertiesOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.SlideIndex == 0 && input.SlideID == "" {
+		return nil, ErrInvalidSlideReference
+	}
+
+	t.config.Logger.Info("getting slide properties",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slideID, slideIndex, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := buildSlideProperties(presentation, presentation.Slides[slideIndex-1])
+	output.PresentationID = input.PresentationID
+	output.SlideID = slideID
+	output.SlideIndex = slideIndex
+
+	t.config.Logger.Info("slide properties retrieved successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("slide_id", slideID),
+		slog.Bool("is_skipped", output.IsSkipped),
+	)
+
+	return output, nil
+}
+
+// buildSlideProperties reads a slide's properties, resolving layout and master display names
+// from the presentation.
+func buildSlideProperties(presentation *slides.Presentation, slide *slides.Page) *GetSlidePropertiesOutput {
+	output := &GetSlidePropertiesOutput{}
+	props := slide.SlideProperties
+	if props == nil {
+		return output
+	}
+
+	output.LayoutObjectID = props.LayoutObjectId
+	output.MasterObjectID = props.MasterObjectId
+	output.IsSkipped = props.IsSkipped
+
+	for _, layout := range presentation.Layouts {
+		if layout.ObjectId == props.LayoutObjectId && layout.LayoutProperties != nil {
+			output.LayoutName = layout.LayoutProperties.DisplayName
+		}
+	}
+	for _, master := range presentation.Masters {
+		if master.ObjectId == props.MasterObjectId && master.MasterProperties != nil {
+			output.MasterName = master.MasterProperties.DisplayName
+		}
+	}
+
+	if props.NotesPage != nil {
+		output.HasNotesPage = true
+		output.NotesPageID = props.NotesPage.ObjectId
+		if props.NotesPage.NotesProperties != nil {
+			output.SpeakerNotesObjectID = props.NotesPage.NotesProperties.SpeakerNotesObjectId
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,118 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newSlidePropertiesTestTools(presentation *slides.Presentation, getErr error) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func slidePropertiesTestPresentation() *slides.Presentation {
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Masters: []*slides.Page{
+			{ObjectId: "master-1", MasterProperties: &slides.MasterProperties{DisplayName: "Simple Light"}},
+		},
+		Layouts: []*slides.Page{
+			{ObjectId: "layout-1", LayoutProperties: &slides.LayoutProperties{DisplayName: "Title and body", MasterObjectId: "master-1"}},
+		},
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1"},
+			{ObjectId: "slide-2", SlideProperties: &slides.SlideProperties{
+				LayoutObjectId: "layout-1",
+				MasterObjectId: "master-1",
+				IsSkipped:      true,
+				NotesPage: &slides.Page{
+					ObjectId:        "notes-2",
+					NotesProperties: &slides.NotesProperties{SpeakerNotesObjectId: "notes-body-2"},
+				},
+			}},
+		},
+	}
+}
+
+func TestGetSlideProperties(t *testing.T) {
+	tests := []struct {
+		name  string
+		input GetSlidePropertiesInput
+		want  GetSlidePropertiesOutput
+	}{
+		{
+			name:  "slide with all properties",
+			input: GetSlidePropertiesInput{PresentationID: "pres-1", SlideID: "slide-2"},
+			want: GetSlidePropertiesOutput{
+				PresentationID:       "pres-1",
+				SlideID:              "slide-2",
+				SlideIndex:           2,
+				LayoutObjectID:       "layout-1",
+				LayoutName:           "Title and body",
+				MasterObjectID:       "master-1",
+				MasterName:           "Simple Light",
+				IsSkipped:            true,
+				HasNotesPage:         true,
+				NotesPageID:          "notes-2",
+				SpeakerNotesObjectID: "notes-body-2",
+			},
+		},
+		{
+			name:  "slide without properties",
+			input: GetSlidePropertiesInput{PresentationID: "pres-1", SlideIndex: 1},
+			want:  GetSlidePropertiesOutput{PresentationID: "pres-1", SlideID: "slide-1", SlideIndex: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newSlidePropertiesTestTools(slidePropertiesTestPresentation(), nil)
+
+			output, err := tools.GetSlideProperties(context.Background(), nil, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *output != tt.want {
+				t.Errorf("got %+v, want %+v", *output, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSlideProperties_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   GetSlidePropertiesInput
+		getErr  error
+		wantErr error
+	}{
+		{"missing presentation", GetSlidePropertiesInput{SlideIndex: 1}, nil, ErrInvalidPresentationID},
+		{"missing slide reference", GetSlidePropertiesInput{PresentationID: "pres-1"}, nil, ErrInvalidSlideReference},
+		{"slide index out of range", GetSlidePropertiesInput{PresentationID: "pres-1", SlideIndex: 5}, nil, ErrSlideNotFound},
+		{"unknown slide ID", GetSlidePropertiesInput{PresentationID: "pres-1", SlideID: "nope"}, nil, ErrSlideNotFound},
+		{"presentation not found", GetSlidePropertiesInput{PresentationID: "pres-1", SlideIndex: 1}, &googleapi.Error{Code: 404}, ErrPresentationNotFound},
+		{"access denied", GetSlidePropertiesInput{PresentationID: "pres-1", SlideIndex: 1}, &googleapi.Error{Code: 403}, ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newSlidePropertiesTestTools(slidePropertiesTestPresentation(), tt.getErr)
+
+			_, err := tools.GetSlideProperties(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
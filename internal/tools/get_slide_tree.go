@@ -0,0 +1,131 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// GetSlideTreeInput represents the input for the get_slide_tree tool.
+type GetSlideTreeInput struct {
+	PresentationID string `json:"presentation_id"`
+	SlideIndex     int    `json:"slide_index,omitempty"` // 1-based index
+	SlideID        string `json:"slide_id,omitempty"`    // Alternative to slide_index
+}
+
+// SlideTreeNode is one element of a slide's element tree.
+type SlideTreeNode struct {
+	ObjectID   string          `json:"object_id"`
+	ObjectType string          `json:"object_type"`
+	Position   *Position       `json:"position,omitempty"`  // Points
+	Size       *Size           `json:"size,omitempty"`      // Points
+	ParentID   string          `json:"parent_id,omitempty"` // Enclosing group, empty at the top level
+	Depth      int             `json:"depth"`               // 0 for top-level elements
+	Children   []SlideTreeNode `json:"children,omitempty"`  // For groups, in z-order
+}
+
+// GetSlideTreeOutput represents the output of the get_slide_tree tool.
+type GetSlideTreeOutput struct {
+	PresentationID string          `json:"presentation_id"`
+	SlideID        string          `json:"slide_id"`
+	SlideIndex     int             `json:"slide_index"` // 1-based
+	Elements       []SlideTreeNode `json:"elements"`    // Top-level elements in z-order
+	TotalCount     int             `json:"total_count"` // All nodes, including group children
+	MaxDepth       int             `json:"max_depth"`
+}
+
+// GetSlideTree returns the nested structure of every element on a slide, with groups and their
+// children, each node carrying its type, ID and bounds. It only reads the presentation.
+func (t *Tools) GetSlideTree(ctx context.Context, tokenSource oauth2.TokenSource, input GetSlideTreeInput) (*GetSlideTreeOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.SlideIndex == 0 && input.SlideID == "" {
+		return nil, ErrInvalidSlideReference
+	}
+
+	t.config.Logger.Info("getting slide tree",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slideID, slideIndex, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetSlideTreeOutput{
+		PresentationID: input.PresentationID,
+		SlideID:        slideID,
+		SlideIndex:     slideIndex,
+	}
+	output.Elements = buildSlideTree(presentation.Slides[slideIndex-1].PageElements, "", nil, 0, output)
+	if output.Elements == nil {
+		output.Elements = []SlideTreeNode{}
+	}
+
+	t.config.Logger.Info("slide tree retrieved successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("slide_id", slideID),
+		slog.Int("total_count", output.TotalCount),
+		slog.Int("max_depth", output.MaxDepth),
+	)
+
+	return output, nil
+}
+
+// buildSlideTree converts elements into tree nodes, recursing into groups. Geometry is on the page:
+// parent is the combined transform of the enclosing groups, and sizes include scaling. It counts
+// nodes and tracks the deepest level in output.
+func buildSlideTree(elements []*slides.PageElement, parentID string, parent *slides.AffineTransform, depth int, output *GetSlideTreeOutput) []SlideTreeNode {
+	var nodes []SlideTreeNode
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+
+		node := SlideTreeNode{
+			ObjectID:   element.ObjectId,
+			ObjectType: determineObjectType(element),
+			ParentID:   parentID,
+			Depth:      depth,
+		}
+		transform := pageTransform(element, parent)
+		node.Position, node.Size = pageGeometry(element, transform)
+
+		output.TotalCount++
+		if depth > output.MaxDepth {
+			output.MaxDepth = depth
+		}
+
+		if element.ElementGroup != nil {
+			node.Children = buildSlideTree(element.ElementGroup.Children, element.ObjectId, transform, depth+1, output)
+		}
+
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
@@ -0,0 +1,146 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newSlideTreeTestTools(presentation *slides.Presentation, getErr error) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func treeTestElement(id string, x, y, width, height float64) *slides.PageElement {
+	return &slides.PageElement{
+		ObjectId:  id,
+		Shape:     &slides.Shape{ShapeType: "RECTANGLE"},
+		Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: pointsToEMU(x), TranslateY: pointsToEMU(y)},
+		Size: &slides.Size{
+			Width:  &slides.Dimension{Magnitude: pointsToEMU(width), Unit: "EMU"},
+			Height: &slides.Dimension{Magnitude: pointsToEMU(height), Unit: "EMU"},
+		},
+	}
+}
+
+func TestGetSlideTree(t *testing.T) {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{{
+			ObjectId: "slide-1",
+			PageElements: []*slides.PageElement{
+				treeTestElement("title", 20, 10, 600, 50),
+				{
+					ObjectId:  "group-1",
+					Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: pointsToEMU(100), TranslateY: pointsToEMU(100)},
+					ElementGroup: &slides.Group{Children: []*slides.PageElement{
+						treeTestElement("child-1", 100, 100, 50, 50),
+						treeTestElement("child-2", 200, 100, 50, 50),
+					}},
+				},
+			},
+		}},
+	}
+	tools := newSlideTreeTestTools(presentation, nil)
+
+	output, err := tools.GetSlideTree(context.Background(), nil, GetSlideTreeInput{PresentationID: "pres-1", SlideIndex: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.SlideID != "slide-1" || output.TotalCount != 4 || output.MaxDepth != 1 {
+		t.Errorf("unexpected summary: slide %s, total %d, depth %d", output.SlideID, output.TotalCount, output.MaxDepth)
+	}
+	if len(output.Elements) != 2 {
+		t.Fatalf("expected 2 top-level elements, got %d", len(output.Elements))
+	}
+
+	title := output.Elements[0]
+	if title.ObjectType != "RECTANGLE" || title.Position == nil || title.Position.X != 20 || title.Size == nil || title.Size.Width != 600 {
+		t.Errorf("unexpected title node: %+v", title)
+	}
+
+	group := output.Elements[1]
+	if group.ObjectID != "group-1" || group.ObjectType != "GROUP" || group.Depth != 0 {
+		t.Errorf("unexpected group node: %+v", group)
+	}
+	if len(group.Children) != 2 {
+		t.Fatalf("expected 2 group children, got %d", len(group.Children))
+	}
+	for i, wantID := range []string{"child-1", "child-2"} {
+		child := group.Children[i]
+		if child.ObjectID != wantID || child.ParentID != "group-1" || child.Depth != 1 {
+			t.Errorf("child %d = %+v, want %s under group-1 at depth 1", i, child, wantID)
+		}
+	}
+	if x := group.Children[1].Position.X; x != 300 {
+		t.Errorf("expected second child at page x=300, got %v", x)
+	}
+}
+
+func TestGetSlideTree_ScaledChildInTranslatedGroup(t *testing.T) {
+	child := treeTestElement("child-1", 10, 20, 50, 40)
+	child.Transform.ScaleX = 2
+	child.Transform.ScaleY = 0.5
+	presentation := &slides.Presentation{
+		Slides: []*slides.Page{{
+			ObjectId: "slide-1",
+			PageElements: []*slides.PageElement{{
+				ObjectId:     "group-1",
+				Transform:    &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: pointsToEMU(100), TranslateY: pointsToEMU(50)},
+				ElementGroup: &slides.Group{Children: []*slides.PageElement{child}},
+			}},
+		}},
+	}
+	tools := newSlideTreeTestTools(presentation, nil)
+
+	output, err := tools.GetSlideTree(context.Background(), nil, GetSlideTreeInput{PresentationID: "pres-1", SlideIndex: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	node := output.Elements[0].Children[0]
+	if node.Position == nil || node.Position.X != 110 || node.Position.Y != 70 {
+		t.Errorf("expected child at page (110, 70), got %+v", node.Position)
+	}
+	if node.Size == nil || node.Size.Width != 100 || node.Size.Height != 20 {
+		t.Errorf("expected displayed size 100x20, got %+v", node.Size)
+	}
+}
+
+func TestGetSlideTree_Errors(t *testing.T) {
+	presentation := &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}
+
+	tests := []struct {
+		name    string
+		input   GetSlideTreeInput
+		getErr  error
+		wantErr error
+	}{
+		{"missing presentation", GetSlideTreeInput{SlideIndex: 1}, nil, ErrInvalidPresentationID},
+		{"missing slide reference", GetSlideTreeInput{PresentationID: "pres-1"}, nil, ErrInvalidSlideReference},
+		{"unknown slide", GetSlideTreeInput{PresentationID: "pres-1", SlideID: "nope"}, nil, ErrSlideNotFound},
+		{"presentation not found", GetSlideTreeInput{PresentationID: "pres-1", SlideIndex: 1}, &googleapi.Error{Code: 404}, ErrPresentationNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newSlideTreeTestTools(presentation, tt.getErr)
+
+			_, err := tools.GetSlideTree(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -38,9 +37,6 @@ type GroupObjectsOutput struct {
 	ObjectIDs []string `json:"object_ids,omitempty"` // For "ungroup": the ungrouped object IDs
 }
 
-// groupTimeNowFunc allows overriding time.Now for tests.
-var groupTimeNowFunc = time.Now
-
 // GroupObjects groups or ungroups objects in a presentation.
 func (t *Tools) GroupObjects(ctx context.Context, tokenSource oauth2.TokenSource, input GroupObjectsInput) (*GroupObjectsOutput, error) {
 	// Validate common input
@@ -141,7 +137,7 @@ func (t *Tools) groupObjects(ctx context.Context, slidesService SlidesService, p
 	}
 
 	// Generate a unique group object ID
-	groupObjectID := fmt.Sprintf("group_%d", groupTimeNowFunc().UnixNano())
+	groupObjectID := newObjectID("group")
 
 	// Create the group request
 	req := &slides.Request{
@@ -4,19 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
 )
 
 func TestGroupObjects(t *testing.T) {
-	// Save and restore original time function
-	originalTimeFunc := groupTimeNowFunc
-	defer func() { groupTimeNowFunc = originalTimeFunc }()
-	groupTimeNowFunc = func() time.Time {
-		return time.Unix(1234567890, 0)
-	}
+	// Fixed ID suffix for deterministic object IDs
+	stubObjectIDSuffix(t, "1234567890000000000")
 
 	tests := []struct {
 		name             string
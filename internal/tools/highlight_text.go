@@ -0,0 +1,299 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for highlight_text tool.
+var (
+	ErrHighlightTextFailed   = errors.New("failed to highlight text")
+	ErrInvalidHighlightColor = errors.New("invalid highlight color")
+)
+
+// defaultHighlightColor is used when no color is specified (yellow marker).
+const defaultHighlightColor = "#FFFF00"
+
+// HighlightTextInput represents the input for the highlight_text tool.
+type HighlightTextInput struct {
+	PresentationID string `json:"presentation_id"`
+	Query          string `json:"query"`
+	CaseSensitive  bool   `json:"case_sensitive,omitempty"` // Default: false
+	Color          string `json:"color,omitempty"`          // Hex background color, default "#FFFF00"
+	Scope          string `json:"scope,omitempty"`          // "all" | "slide" | "object" - Default: "all"
+	SlideID        string `json:"slide_id,omitempty"`       // Required when scope is "slide"
+	ObjectID       string `json:"object_id,omitempty"`      // Required when scope is "object"
+}
+
+// HighlightTextOutput represents the output of the highlight_text tool.
+type HighlightTextOutput struct {
+	PresentationID string           `json:"presentation_id"`
+	Query          string           `json:"query"`
+	Color          string           `json:"color"`
+	HighlightCount int              `json:"highlight_count"`
+	Highlights     []HighlightMatch `json:"highlights"`
+}
+
+// HighlightMatch describes a single highlighted character range.
+type HighlightMatch struct {
+	SlideIndex int    `json:"slide_index"` // 1-based
+	SlideID    string `json:"slide_id"`
+	ObjectID   string `json:"object_id"` // Table cells use "tableId[row,col]"
+	StartIndex int    `json:"start_index"`
+	EndIndex   int    `json:"end_index"`
+}
+
+// highlightTarget holds a match with everything needed to build its request.
+type highlightTarget struct {
+	match        HighlightMatch
+	objectID     string
+	cellLocation *slides.TableCellLocation
+}
+
+// HighlightText finds all occurrences of a string and applies a background color to them.
+func (t *Tools) HighlightText(ctx context.Context, tokenSource oauth2.TokenSource, input HighlightTextInput) (*HighlightTextOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.Query == "" {
+		return nil, fmt.Errorf("%w: query is required", ErrInvalidQuery)
+	}
+
+	scope := strings.ToLower(strings.TrimSpace(input.Scope))
+	if scope == "" {
+		scope = "all"
+	}
+	if scope != "all" && scope != "slide" && scope != "object" {
+		return nil, fmt.Errorf("%w: scope must be 'all', 'slide', or 'object'", ErrInvalidScope)
+	}
+	if scope == "slide" && input.SlideID == "" {
+		return nil, fmt.Errorf("%w: slide_id is required when scope is 'slide'", ErrInvalidScope)
+	}
+	if scope == "object" && input.ObjectID == "" {
+		return nil, fmt.Errorf("%w: object_id is required when scope is 'object'", ErrInvalidScope)
+	}
+
+	color := input.Color
+	if color == "" {
+		color = defaultHighlightColor
+	}
+	rgb := parseHexColor(color)
+	if rgb == nil {
+		return nil, fmt.Errorf("%w: '%s' is not a valid hex color", ErrInvalidHighlightColor, color)
+	}
+
+	t.config.Logger.Info("highlighting text in presentation",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("query", input.Query),
+		slog.Bool("case_sensitive", input.CaseSensitive),
+		slog.String("scope", scope),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// Validate scope targets exist
+	switch scope {
+	case "slide":
+		if _, _, err := findSlide(presentation, 0, input.SlideID); err != nil {
+			return nil, err
+		}
+	case "object":
+		if findSlideContainingObject(presentation.Slides, input.ObjectID) == nil {
+			return nil, fmt.Errorf("%w: object '%s' not found", ErrObjectNotFound, input.ObjectID)
+		}
+	}
+
+	// Collect match ranges
+	var targets []highlightTarget
+	for slideIdx, slide := range presentation.Slides {
+		if slide == nil {
+			continue
+		}
+		if scope == "slide" && slide.ObjectId != input.SlideID {
+			continue
+		}
+		filterObjectID := ""
+		if scope == "object" {
+			filterObjectID = input.ObjectID
+		}
+		for _, target := range findHighlightTargets(slide.PageElements, input.Query, input.CaseSensitive, filterObjectID) {
+			target.match.SlideIndex = slideIdx + 1
+			target.match.SlideID = slide.ObjectId
+			targets = append(targets, target)
+		}
+	}
+
+	highlights := make([]HighlightMatch, 0, len(targets))
+	for _, target := range targets {
+		highlights = append(highlights, target.match)
+	}
+
+	if len(targets) > 0 {
+		requests := buildHighlightRequests(targets, rgb)
+
+		_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, ErrPresentationNotFound
+			}
+			if isForbiddenError(err) {
+				return nil, ErrAccessDenied
+			}
+			return nil, fmt.Errorf("%w: %v", ErrHighlightTextFailed, err)
+		}
+	}
+
+	output := &HighlightTextOutput{
+		PresentationID: input.PresentationID,
+		Query:          input.Query,
+		Color:          color,
+		HighlightCount: len(highlights),
+		Highlights:     highlights,
+	}
+
+	t.config.Logger.Info("text highlighted successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("highlight_count", output.HighlightCount),
+	)
+
+	return output, nil
+}
+
+// findHighlightTargets scans elements (recursing into groups) for query occurrences.
+// When filterObjectID is set, only that object is scanned.
+func findHighlightTargets(elements []*slides.PageElement, query string, caseSensitive bool, filterObjectID string) []highlightTarget {
+	var targets []highlightTarget
+
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+
+		if element.ElementGroup != nil {
+			targets = append(targets, findHighlightTargets(element.ElementGroup.Children, query, caseSensitive, filterObjectID)...)
+			continue
+		}
+
+		if filterObjectID != "" && element.ObjectId != filterObjectID {
+			continue
+		}
+
+		if element.Shape != nil && element.Shape.Text != nil {
+			text := rawTextFromTextContent(element.Shape.Text)
+			for _, span := range findQuerySpans(text, query, caseSensitive, false) {
+				targets = append(targets, highlightTarget{
+					match: HighlightMatch{
+						ObjectID:   element.ObjectId,
+						StartIndex: utf16Index(text, span.start),
+						EndIndex:   utf16Index(text, span.end),
+					},
+					objectID: element.ObjectId,
+				})
+			}
+		}
+
+		if element.Table != nil {
+			for rowIdx, row := range element.Table.TableRows {
+				if row == nil {
+					continue
+				}
+				for colIdx, cell := range row.TableCells {
+					if cell == nil || cell.Text == nil {
+						continue
+					}
+					text := rawTextFromTextContent(cell.Text)
+					for _, span := range findQuerySpans(text, query, caseSensitive, false) {
+						targets = append(targets, highlightTarget{
+							match: HighlightMatch{
+								ObjectID:   fmt.Sprintf("%s[%d,%d]", element.ObjectId, rowIdx, colIdx),
+								StartIndex: utf16Index(text, span.start),
+								EndIndex:   utf16Index(text, span.end),
+							},
+							objectID: element.ObjectId,
+							cellLocation: &slides.TableCellLocation{
+								RowIndex:        int64(rowIdx),
+								ColumnIndex:     int64(colIdx),
+								ForceSendFields: []string{"RowIndex", "ColumnIndex"},
+							},
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return targets
+}
+
+// rawTextFromTextContent concatenates text runs without trimming, so offsets
+// line up with the indices the Slides API expects.
+func rawTextFromTextContent(textContent *slides.TextContent) string {
+	if textContent == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, textElement := range textContent.TextElements {
+		if textElement == nil {
+			continue
+		}
+		if textElement.TextRun != nil {
+			sb.WriteString(textElement.TextRun.Content)
+		} else if textElement.AutoText != nil {
+			sb.WriteString(textElement.AutoText.Content)
+		}
+	}
+	return sb.String()
+}
+
+// buildHighlightRequests creates one UpdateTextStyle request per highlighted range.
+func buildHighlightRequests(targets []highlightTarget, rgb *slides.RgbColor) []*slides.Request {
+	requests := make([]*slides.Request, 0, len(targets))
+	for _, target := range targets {
+		startIdx := int64(target.match.StartIndex)
+		endIdx := int64(target.match.EndIndex)
+		requests = append(requests, &slides.Request{
+			UpdateTextStyle: &slides.UpdateTextStyleRequest{
+				ObjectId:     target.objectID,
+				CellLocation: target.cellLocation,
+				Style: &slides.TextStyle{
+					BackgroundColor: &slides.OptionalColor{
+						OpaqueColor: &slides.OpaqueColor{
+							RgbColor: rgb,
+						},
+					},
+				},
+				TextRange: &slides.Range{
+					Type:       "FIXED_RANGE",
+					StartIndex: &startIdx,
+					EndIndex:   &endIdx,
+				},
+				Fields: "backgroundColor",
+			},
+		})
+	}
+	return requests
+}
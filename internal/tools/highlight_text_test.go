@@ -0,0 +1,291 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func highlightTestPresentation() *slides.Presentation {
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "shape-1",
+						Shape: &slides.Shape{
+							Text: &slides.TextContent{
+								TextElements: []*slides.TextElement{
+									{TextRun: &slides.TextRun{Content: "Todo: review. "}},
+									{TextRun: &slides.TextRun{Content: "TODO again\n"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "table-1",
+						Table: &slides.Table{
+							TableRows: []*slides.TableRow{
+								{
+									TableCells: []*slides.TableCell{
+										{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+											{TextRun: &slides.TextRun{Content: "nothing\n"}},
+										}}},
+										{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+											{TextRun: &slides.TextRun{Content: "a todo\n"}},
+										}}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestHighlightText_RepeatedMatches(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return highlightTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	factory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	}
+	tools := NewTools(DefaultToolsConfig(), factory)
+
+	output, err := tools.HighlightText(context.Background(), &mockTokenSource{}, HighlightTextInput{
+		PresentationID: "pres-1",
+		Query:          "todo",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.HighlightCount != 3 {
+		t.Fatalf("expected 3 highlights, got %d", output.HighlightCount)
+	}
+	if output.Color != defaultHighlightColor {
+		t.Errorf("expected default color, got '%s'", output.Color)
+	}
+
+	expected := []struct {
+		objectID string
+		start    int64
+		end      int64
+		cell     bool
+	}{
+		{"shape-1", 0, 4, false},
+		{"shape-1", 14, 18, false},
+		{"table-1", 2, 6, true},
+	}
+
+	if len(capturedRequests) != len(expected) {
+		t.Fatalf("expected %d requests, got %d", len(expected), len(capturedRequests))
+	}
+
+	for i, exp := range expected {
+		req := capturedRequests[i].UpdateTextStyle
+		if req == nil {
+			t.Fatalf("request %d: expected UpdateTextStyle", i)
+		}
+		if req.ObjectId != exp.objectID {
+			t.Errorf("request %d: expected object '%s', got '%s'", i, exp.objectID, req.ObjectId)
+		}
+		if req.TextRange.Type != "FIXED_RANGE" {
+			t.Errorf("request %d: expected FIXED_RANGE, got '%s'", i, req.TextRange.Type)
+		}
+		if *req.TextRange.StartIndex != exp.start || *req.TextRange.EndIndex != exp.end {
+			t.Errorf("request %d: expected range [%d,%d), got [%d,%d)", i, exp.start, exp.end, *req.TextRange.StartIndex, *req.TextRange.EndIndex)
+		}
+		if req.Fields != "backgroundColor" {
+			t.Errorf("request %d: expected fields 'backgroundColor', got '%s'", i, req.Fields)
+		}
+		if exp.cell {
+			if req.CellLocation == nil || req.CellLocation.RowIndex != 0 || req.CellLocation.ColumnIndex != 1 {
+				t.Errorf("request %d: expected cell location [0,1], got %+v", i, req.CellLocation)
+			}
+		} else if req.CellLocation != nil {
+			t.Errorf("request %d: expected no cell location", i)
+		}
+	}
+}
+
+func TestHighlightText_CaseSensitiveAndScope(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return highlightTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	factory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	}
+	tools := NewTools(DefaultToolsConfig(), factory)
+
+	output, err := tools.HighlightText(context.Background(), &mockTokenSource{}, HighlightTextInput{
+		PresentationID: "pres-1",
+		Query:          "TODO",
+		CaseSensitive:  true,
+		Color:          "#00FF00",
+		Scope:          "slide",
+		SlideID:        "slide-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.HighlightCount != 1 || len(capturedRequests) != 1 {
+		t.Fatalf("expected 1 highlight, got %d (%d requests)", output.HighlightCount, len(capturedRequests))
+	}
+	if *capturedRequests[0].UpdateTextStyle.TextRange.StartIndex != 14 {
+		t.Errorf("expected start index 14, got %d", *capturedRequests[0].UpdateTextStyle.TextRange.StartIndex)
+	}
+	green := capturedRequests[0].UpdateTextStyle.Style.BackgroundColor.OpaqueColor.RgbColor
+	if green.Green != 1 || green.Red != 0 {
+		t.Errorf("expected green background, got %+v", green)
+	}
+}
+
+func TestHighlightText_NoMatchesSkipsBatchUpdate(t *testing.T) {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return highlightTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			t.Fatal("BatchUpdate should not be called without matches")
+			return nil, nil
+		},
+	}
+
+	factory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	}
+	tools := NewTools(DefaultToolsConfig(), factory)
+
+	output, err := tools.HighlightText(context.Background(), &mockTokenSource{}, HighlightTextInput{
+		PresentationID: "pres-1",
+		Query:          "absent",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.HighlightCount != 0 {
+		t.Errorf("expected 0 highlights, got %d", output.HighlightCount)
+	}
+}
+
+func TestHighlightText_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       HighlightTextInput
+		expectedErr error
+	}{
+		{"missing presentation ID", HighlightTextInput{Query: "x"}, ErrInvalidPresentationID},
+		{"missing query", HighlightTextInput{PresentationID: "p"}, ErrInvalidQuery},
+		{"invalid scope", HighlightTextInput{PresentationID: "p", Query: "x", Scope: "page"}, ErrInvalidScope},
+		{"slide scope without slide ID", HighlightTextInput{PresentationID: "p", Query: "x", Scope: "slide"}, ErrInvalidScope},
+		{"object scope without object ID", HighlightTextInput{PresentationID: "p", Query: "x", Scope: "object"}, ErrInvalidScope},
+		{"invalid color", HighlightTextInput{PresentationID: "p", Query: "x", Color: "yellow"}, ErrInvalidHighlightColor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := NewTools(DefaultToolsConfig(), nil)
+			_, err := tools.HighlightText(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestHighlightText_ObjectNotFound(t *testing.T) {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return highlightTestPresentation(), nil
+		},
+	}
+
+	factory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	}
+	tools := NewTools(DefaultToolsConfig(), factory)
+
+	_, err := tools.HighlightText(context.Background(), &mockTokenSource{}, HighlightTextInput{
+		PresentationID: "pres-1",
+		Query:          "todo",
+		Scope:          "object",
+		ObjectID:       "missing",
+	})
+	if !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("expected ErrObjectNotFound, got %v", err)
+	}
+}
+
+func TestFindHighlightTargets_UTF16Indices(t *testing.T) {
+	elements := []*slides.PageElement{{
+		ObjectId: "shape-1",
+		Shape: &slides.Shape{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+			{TextRun: &slides.TextRun{Content: "⚠️ todo 😀 todo\n"}},
+		}}},
+	}}
+
+	targets := findHighlightTargets(elements, "todo", false, "")
+	if len(targets) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(targets))
+	}
+
+	// "⚠️" is U+26A0 U+FE0F (2 units) and "😀" a surrogate pair (2 units)
+	want := [][2]int{{3, 7}, {11, 15}}
+	for i, target := range targets {
+		if target.match.StartIndex != want[i][0] || target.match.EndIndex != want[i][1] {
+			t.Errorf("match %d: %d-%d, want %d-%d", i, target.match.StartIndex, target.match.EndIndex, want[i][0], want[i][1])
+		}
+	}
+}
+
+func TestFindHighlightTargets_CaseFoldingKeepsOffsets(t *testing.T) {
+	elements := []*slides.PageElement{{
+		ObjectId: "shape-1",
+		Shape: &slides.Shape{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+			{TextRun: &slides.TextRun{Content: "İİ Kelvin 5K and 5k\n"}},
+		}}},
+	}}
+
+	// Lowercasing "İ" and the Kelvin sign changes their byte length, so matching must not
+	targets := findHighlightTargets(elements, "5K", false, "")
+	if len(targets) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(targets))
+	}
+
+	want := [][2]int{{10, 12}, {17, 19}}
+	for i, target := range targets {
+		if target.match.StartIndex != want[i][0] || target.match.EndIndex != want[i][1] {
+			t.Errorf("match %d: %d-%d, want %d-%d", i, target.match.StartIndex, target.match.EndIndex, want[i][0], want[i][1])
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+)
+
+// Sentinel errors for insert_divider tool.
+var (
+	ErrInvalidDividerPosition = errors.New("invalid divider position")
+)
+
+// InsertDividerInput represents the input for the insert_divider tool.
+type InsertDividerInput struct {
+	PresentationID string   `json:"presentation_id"`
+	SlideIndex     int      `json:"slide_index,omitempty"` // 1-based index
+	SlideID        string   `json:"slide_id,omitempty"`    // Alternative to slide_index
+	Y              *float64 `json:"y"`                     // Vertical position in points from the top edge
+	LineColor      string   `json:"line_color,omitempty"`  // Hex color string (e.g., "#CCCCCC")
+	LineWeight     float64  `json:"line_weight,omitempty"` // In points
+	LineDash       string   `json:"line_dash,omitempty"`   // SOLID, DASH, DOT, DASH_DOT, LONG_DASH, LONG_DASH_DOT
+}
+
+// InsertDividerOutput represents the output of the insert_divider tool.
+type InsertDividerOutput struct {
+	ObjectID   string `json:"object_id"`
+	SlideID    string `json:"slide_id"`
+	StartPoint Point  `json:"start_point"`
+	EndPoint   Point  `json:"end_point"`
+}
+
+// InsertDivider creates a horizontal line across the full page width at the given height.
+// It is create_line with endpoints taken from the presentation's page size.
+func (t *Tools) InsertDivider(ctx context.Context, tokenSource oauth2.TokenSource, input InsertDividerInput) (*InsertDividerOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	if input.SlideIndex == 0 && input.SlideID == "" {
+		return nil, ErrInvalidSlideReference
+	}
+
+	if input.Y == nil {
+		return nil, fmt.Errorf("%w: y is required", ErrInvalidDividerPosition)
+	}
+
+	if input.LineWeight < 0 {
+		return nil, fmt.Errorf("%w: line_weight cannot be negative", ErrInvalidOutlineWeight)
+	}
+
+	t.config.Logger.Info("inserting divider",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+		slog.Float64("y", *input.Y),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation for the target slide and the page size
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slideID, _, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	if err != nil {
+		return nil, err
+	}
+
+	pageWidth, pageHeight := pageSizeInPoints(presentation.PageSize)
+	if *input.Y < 0 || *input.Y > pageHeight {
+		return nil, fmt.Errorf("%w: y must be between 0 and the page height (%g points), got %g", ErrInvalidDividerPosition, pageHeight, *input.Y)
+	}
+
+	objectID := newObjectID("divider")
+	lineInput := CreateLineInput{
+		StartPoint: &Point{X: 0, Y: *input.Y},
+		EndPoint:   &Point{X: pageWidth, Y: *input.Y},
+		LineType:   "STRAIGHT",
+		LineColor:  input.LineColor,
+		LineWeight: input.LineWeight,
+		LineDash:   input.LineDash,
+	}
+	requests := buildCreateLineRequests(objectID, slideID, lineInput)
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCreateLineFailed, err)
+	}
+
+	output := &InsertDividerOutput{
+		ObjectID:   objectID,
+		SlideID:    slideID,
+		StartPoint: *lineInput.StartPoint,
+		EndPoint:   *lineInput.EndPoint,
+	}
+
+	t.config.Logger.Info("divider inserted successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("object_id", objectID),
+		slog.Float64("width", pageWidth),
+	)
+
+	return output, nil
+}
@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func newInsertDividerTestTools(captured *[]*slides.Request, pageSize *slides.Size) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				PageSize:       pageSize,
+				Slides:         []*slides.Page{{ObjectId: "slide-1"}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*captured = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestInsertDivider_SpansPageWidth(t *testing.T) {
+	// 4:3 page: 9144000 x 6858000 EMU = 720 x 540 points
+	pageSize := &slides.Size{
+		Width:  &slides.Dimension{Magnitude: 9144000, Unit: "EMU"},
+		Height: &slides.Dimension{Magnitude: 6858000, Unit: "EMU"},
+	}
+
+	stubObjectIDSuffix(t, "1705312800000000000")
+	var captured []*slides.Request
+	tools := newInsertDividerTestTools(&captured, pageSize)
+
+	output, err := tools.InsertDivider(context.Background(), nil, InsertDividerInput{
+		PresentationID: "pres-1",
+		SlideIndex:     1,
+		Y:              float64Ptr(270),
+		LineColor:      "#CCCCCC",
+		LineWeight:     2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.SlideID != "slide-1" {
+		t.Errorf("SlideID = %q, want slide-1", output.SlideID)
+	}
+	if output.ObjectID != "divider_1705312800000000000" {
+		t.Errorf("ObjectID = %q, want divider_1705312800000000000", output.ObjectID)
+	}
+	if output.StartPoint != (Point{X: 0, Y: 270}) || output.EndPoint != (Point{X: 720, Y: 270}) {
+		t.Errorf("endpoints = %+v -> %+v, want (0,270) -> (720,270)", output.StartPoint, output.EndPoint)
+	}
+
+	if len(captured) != 2 {
+		t.Fatalf("expected CreateLine and UpdateLineProperties, got %d requests", len(captured))
+	}
+	createLine := captured[0].CreateLine
+	if createLine == nil {
+		t.Fatal("request 0: expected CreateLine")
+	}
+	props := createLine.ElementProperties
+	if props.PageObjectId != "slide-1" {
+		t.Errorf("PageObjectId = %q, want slide-1", props.PageObjectId)
+	}
+	if props.Size.Width.Magnitude != 9144000 || props.Size.Height.Magnitude != 0 {
+		t.Errorf("size = %gx%g EMU, want 9144000x0", props.Size.Width.Magnitude, props.Size.Height.Magnitude)
+	}
+	if props.Transform.TranslateX != 0 || props.Transform.TranslateY != pointsToEMU(270) {
+		t.Errorf("translate = (%g, %g), want (0, %g)", props.Transform.TranslateX, props.Transform.TranslateY, pointsToEMU(270))
+	}
+
+	lineProps := captured[1].UpdateLineProperties
+	if lineProps == nil || lineProps.Fields != "lineFill.solidFill.color,weight" {
+		t.Errorf("request 1: expected color and weight update, got %+v", captured[1])
+	}
+}
+
+func TestInsertDivider_DefaultPageSize(t *testing.T) {
+	var captured []*slides.Request
+	tools := newInsertDividerTestTools(&captured, nil)
+
+	output, err := tools.InsertDivider(context.Background(), nil, InsertDividerInput{
+		PresentationID: "pres-1",
+		SlideID:        "slide-1",
+		Y:              float64Ptr(0),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.EndPoint.X != defaultPageWidthPoints {
+		t.Errorf("EndPoint.X = %g, want %g", output.EndPoint.X, defaultPageWidthPoints)
+	}
+	if len(captured) != 1 {
+		t.Errorf("expected only CreateLine without styling, got %d requests", len(captured))
+	}
+}
+
+func TestInsertDivider_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   InsertDividerInput
+		wantErr error
+	}{
+		{"missing presentation", InsertDividerInput{SlideIndex: 1, Y: float64Ptr(10)}, ErrInvalidPresentationID},
+		{"missing slide", InsertDividerInput{PresentationID: "pres-1", Y: float64Ptr(10)}, ErrInvalidSlideReference},
+		{"missing y", InsertDividerInput{PresentationID: "pres-1", SlideIndex: 1}, ErrInvalidDividerPosition},
+		{"negative y", InsertDividerInput{PresentationID: "pres-1", SlideIndex: 1, Y: float64Ptr(-1)}, ErrInvalidDividerPosition},
+		{"below the page", InsertDividerInput{PresentationID: "pres-1", SlideIndex: 1, Y: float64Ptr(406)}, ErrInvalidDividerPosition},
+		{"negative weight", InsertDividerInput{PresentationID: "pres-1", SlideIndex: 1, Y: float64Ptr(10), LineWeight: -1}, ErrInvalidOutlineWeight},
+		{"unknown slide", InsertDividerInput{PresentationID: "pres-1", SlideID: "missing", Y: float64Ptr(10)}, ErrSlideNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []*slides.Request
+			tools := newInsertDividerTestTools(&captured, nil)
+
+			_, err := tools.InsertDivider(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if captured != nil {
+				t.Error("expected no batch update")
+			}
+		})
+	}
+}
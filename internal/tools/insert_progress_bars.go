@@ -0,0 +1,187 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for insert_progress_bars tool.
+var (
+	ErrInsertProgressBarsFailed = errors.New("failed to insert progress bars")
+	ErrInvalidProgressBar       = errors.New("invalid progress bar")
+)
+
+// progressBarIDPrefix marks progress bars so remove_objects_by_prefix can find them again.
+const progressBarIDPrefix = "progress_"
+
+// Progress bar defaults.
+const (
+	defaultProgressBarEdge   = "bottom"
+	defaultProgressBarHeight = 4.0 // Points
+	defaultProgressBarColor  = "#4285F4"
+)
+
+// InsertProgressBarsInput represents the input for the insert_progress_bars tool.
+type InsertProgressBarsInput struct {
+	PresentationID string  `json:"presentation_id"`
+	StartSlide     int     `json:"start_slide,omitempty"` // 1-based, inclusive; default first slide
+	EndSlide       int     `json:"end_slide,omitempty"`   // 1-based, inclusive; default last slide
+	Edge           string  `json:"edge,omitempty"`        // "bottom" (default) or "top"
+	Height         float64 `json:"height,omitempty"`      // Bar thickness in points, default 4
+	Color          string  `json:"color,omitempty"`       // Hex color, default #4285F4
+}
+
+// ProgressBar describes the bar added to one slide.
+type ProgressBar struct {
+	SlideID  string  `json:"slide_id"`
+	ObjectID string  `json:"object_id"`
+	Width    float64 `json:"width"` // Points
+}
+
+// InsertProgressBarsOutput represents the output of the insert_progress_bars tool.
+type InsertProgressBarsOutput struct {
+	ObjectIDs []string      `json:"object_ids"` // In slide order, for later removal
+	Bars      []ProgressBar `json:"bars"`
+	Edge      string        `json:"edge"`
+	Height    float64       `json:"height"` // Points
+}
+
+// InsertProgressBars adds a thin rectangle along the top or bottom edge of each slide in a range,
+// as wide as the slide's progress through the range: slide k of n spans k/n of the page width.
+// Bar object IDs start with "progress_" so remove_objects_by_prefix can remove them.
+func (t *Tools) InsertProgressBars(ctx context.Context, tokenSource oauth2.TokenSource, input InsertProgressBarsInput) (*InsertProgressBarsOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if err := validateProgressBarInput(&input); err != nil {
+		return nil, err
+	}
+
+	t.config.Logger.Info("inserting progress bars",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("start_slide", input.StartSlide),
+		slog.Int("end_slide", input.EndSlide),
+		slog.String("edge", input.Edge),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation for its slides and page size
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	targetSlides, err := watermarkSlideRange(presentation, input.StartSlide, input.EndSlide)
+	if err != nil {
+		return nil, err
+	}
+
+	pageWidth, pageHeight := pageSizeInPoints(presentation.PageSize)
+	if input.Height >= pageHeight {
+		return nil, fmt.Errorf("%w: height %.1f must be less than the page height %.1f", ErrInvalidProgressBar, input.Height, pageHeight)
+	}
+	y := 0.0
+	if input.Edge == "bottom" {
+		y = pageHeight - input.Height
+	}
+
+	output := &InsertProgressBarsOutput{
+		ObjectIDs: make([]string, 0, len(targetSlides)),
+		Bars:      make([]ProgressBar, 0, len(targetSlides)),
+		Edge:      input.Edge,
+		Height:    input.Height,
+	}
+
+	baseID := newObjectID("progress")
+	var requests []*slides.Request
+	for i, slide := range targetSlides {
+		objectID := fmt.Sprintf("%s_%d", baseID, i+1)
+		width := progressBarWidth(pageWidth, i+1, len(targetSlides))
+
+		requests = append(requests, buildCreateShapeRequests(objectID, slide.ObjectId, "RECTANGLE", CreateShapeInput{
+			Position:     &PositionInput{X: 0, Y: y},
+			Size:         &SizeInput{Width: width, Height: input.Height},
+			FillColor:    input.Color,
+			OutlineColor: "transparent",
+		})...)
+		output.ObjectIDs = append(output.ObjectIDs, objectID)
+		output.Bars = append(output.Bars, ProgressBar{SlideID: slide.ObjectId, ObjectID: objectID, Width: width})
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInsertProgressBarsFailed, err)
+	}
+
+	t.config.Logger.Info("progress bars inserted successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slides", len(output.ObjectIDs)),
+	)
+
+	return output, nil
+}
+
+// validateProgressBarInput checks the slide range and bar options and fills in defaults.
+func validateProgressBarInput(input *InsertProgressBarsInput) error {
+	if input.StartSlide < 0 || input.EndSlide < 0 {
+		return fmt.Errorf("%w: start_slide and end_slide must be positive", ErrInvalidSlideReference)
+	}
+	if input.EndSlide > 0 && input.StartSlide > input.EndSlide {
+		return fmt.Errorf("%w: start_slide %d is after end_slide %d", ErrInvalidSlideReference, input.StartSlide, input.EndSlide)
+	}
+
+	if input.Edge == "" {
+		input.Edge = defaultProgressBarEdge
+	}
+	input.Edge = strings.ToLower(input.Edge)
+	if input.Edge != "top" && input.Edge != "bottom" {
+		return fmt.Errorf("%w: edge must be top or bottom, got '%s'", ErrInvalidProgressBar, input.Edge)
+	}
+
+	if input.Height < 0 || math.IsNaN(input.Height) || math.IsInf(input.Height, 0) {
+		return fmt.Errorf("%w: height must be a positive number of points", ErrInvalidProgressBar)
+	}
+	if input.Height == 0 {
+		input.Height = defaultProgressBarHeight
+	}
+
+	if input.Color == "" {
+		input.Color = defaultProgressBarColor
+	}
+	if parseHexColor(input.Color) == nil {
+		return fmt.Errorf("%w: color must be a hex color like #4285F4, got '%s'", ErrInvalidProgressBar, input.Color)
+	}
+	return nil
+}
+
+// progressBarWidth returns the width of the bar for the position-th of total slides (1-based),
+// rounded to 0.01pt.
+func progressBarWidth(pageWidth float64, position, total int) float64 {
+	return math.Round(pageWidth*float64(position)/float64(total)*100) / 100
+}
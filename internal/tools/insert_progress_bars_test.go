@@ -0,0 +1,147 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/slides/v1"
+)
+
+func newProgressBarsTestTools(slideCount int, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		PageSize: &slides.Size{
+			Width:  &slides.Dimension{Magnitude: pointsToEMU(720), Unit: "EMU"},
+			Height: &slides.Dimension{Magnitude: pointsToEMU(405), Unit: "EMU"},
+		},
+	}
+	for i := 1; i <= slideCount; i++ {
+		presentation.Slides = append(presentation.Slides, &slides.Page{ObjectId: "slide-" + string(rune('0'+i))})
+	}
+
+	return newBatchCaptureTestTools(presentation, nil, batchErr, batchCalls, captured)
+}
+
+func TestInsertProgressBars_ProportionalWidths(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newProgressBarsTestTools(4, nil, &batchCalls, &captured)
+
+	output, err := tools.InsertProgressBars(context.Background(), nil, InsertProgressBarsInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 {
+		t.Fatalf("expected a single batch update, got %d", batchCalls)
+	}
+
+	var shapes []*slides.CreateShapeRequest
+	for _, req := range captured {
+		if req.CreateShape != nil {
+			shapes = append(shapes, req.CreateShape)
+		}
+	}
+	if len(shapes) != 4 || len(output.ObjectIDs) != 4 {
+		t.Fatalf("expected 4 bars, got %d shapes and %d IDs", len(shapes), len(output.ObjectIDs))
+	}
+
+	wantWidths := []float64{180, 360, 540, 720}
+	for i, shape := range shapes {
+		if shape.ShapeType != "RECTANGLE" {
+			t.Errorf("bar %d: shape type %s", i, shape.ShapeType)
+		}
+		if page := shape.ElementProperties.PageObjectId; page != output.Bars[i].SlideID {
+			t.Errorf("bar %d: on %s, output says %s", i, page, output.Bars[i].SlideID)
+		}
+		if got := shape.ElementProperties.Size.Width.Magnitude; got != pointsToEMU(wantWidths[i]) {
+			t.Errorf("bar %d: width %v EMU, want %vpt", i, got, wantWidths[i])
+		}
+		if output.Bars[i].Width != wantWidths[i] {
+			t.Errorf("bar %d: reported width %v, want %v", i, output.Bars[i].Width, wantWidths[i])
+		}
+		if got := shape.ElementProperties.Transform.TranslateY; got != pointsToEMU(405-defaultProgressBarHeight) {
+			t.Errorf("bar %d: expected to sit on the bottom edge, got y=%v EMU", i, got)
+		}
+		if !strings.HasPrefix(shape.ObjectId, progressBarIDPrefix) || shape.ObjectId != output.ObjectIDs[i] {
+			t.Errorf("bar %d: unexpected object ID %s", i, shape.ObjectId)
+		}
+	}
+}
+
+func TestInsertProgressBars_RangeAndTopEdge(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newProgressBarsTestTools(5, nil, &batchCalls, &captured)
+
+	output, err := tools.InsertProgressBars(context.Background(), nil, InsertProgressBarsInput{
+		PresentationID: "pres-1",
+		StartSlide:     2,
+		EndSlide:       3,
+		Edge:           "TOP",
+		Height:         6,
+		Color:          "#FF0000",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(output.Bars) != 2 || output.Bars[0].SlideID != "slide-2" || output.Bars[1].SlideID != "slide-3" {
+		t.Fatalf("expected bars on slides 2 and 3, got %+v", output.Bars)
+	}
+	if output.Bars[0].Width != 360 || output.Bars[1].Width != 720 {
+		t.Errorf("expected widths proportional within the range, got %+v", output.Bars)
+	}
+	if output.Edge != "top" || output.Height != 6 {
+		t.Errorf("unexpected edge/height: %s %v", output.Edge, output.Height)
+	}
+
+	create := captured[0].CreateShape
+	if create.ElementProperties.Transform.TranslateY != 0 || create.ElementProperties.Size.Height.Magnitude != pointsToEMU(6) {
+		t.Errorf("expected a 6pt bar on the top edge, got %+v", create.ElementProperties)
+	}
+	props := captured[1].UpdateShapeProperties
+	if props == nil || props.ShapeProperties.ShapeBackgroundFill.SolidFill.Color.RgbColor.Red != 1 {
+		t.Errorf("expected a red fill request, got %+v", captured[1])
+	}
+	if props.ShapeProperties.Outline == nil || props.ShapeProperties.Outline.PropertyState != "NOT_RENDERED" {
+		t.Error("expected the outline to be hidden")
+	}
+}
+
+func TestInsertProgressBars_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     InsertProgressBarsInput
+		batchErr  error
+		wantErr   error
+		wantBatch bool
+	}{
+		{"missing presentation", InsertProgressBarsInput{}, nil, ErrInvalidPresentationID, false},
+		{"start after end", InsertProgressBarsInput{PresentationID: "pres-1", StartSlide: 3, EndSlide: 2}, nil, ErrInvalidSlideReference, false},
+		{"range out of bounds", InsertProgressBarsInput{PresentationID: "pres-1", EndSlide: 9}, nil, ErrSlideNotFound, false},
+		{"invalid edge", InsertProgressBarsInput{PresentationID: "pres-1", Edge: "left"}, nil, ErrInvalidProgressBar, false},
+		{"negative height", InsertProgressBarsInput{PresentationID: "pres-1", Height: -2}, nil, ErrInvalidProgressBar, false},
+		{"height taller than page", InsertProgressBarsInput{PresentationID: "pres-1", Height: 500}, nil, ErrInvalidProgressBar, false},
+		{"invalid color", InsertProgressBarsInput{PresentationID: "pres-1", Color: "blue"}, nil, ErrInvalidProgressBar, false},
+		{"batch update failure", InsertProgressBarsInput{PresentationID: "pres-1"}, errors.New("boom"), ErrInsertProgressBarsFailed, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newProgressBarsTestTools(4, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.InsertProgressBars(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if (batchCalls > 0) != tt.wantBatch {
+				t.Errorf("batch update called %d times", batchCalls)
+			}
+		})
+	}
+}
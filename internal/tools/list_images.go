@@ -0,0 +1,211 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for list_images tool.
+var (
+	ErrInvalidMinDPI = errors.New("invalid minimum DPI")
+)
+
+// defaultMinImageDPI is the effective resolution below which an image is flagged. 150 DPI is
+// a common floor for print; projected slides usually look fine well below it.
+const defaultMinImageDPI = 150.0
+
+// ListImagesInput represents the input for the list_images tool.
+type ListImagesInput struct {
+	PresentationID  string  `json:"presentation_id"`
+	SlideIndices    []int   `json:"slide_indices,omitempty"`    // 1-based, optional - default all slides
+	FetchDimensions bool    `json:"fetch_dimensions,omitempty"` // Download each image to read its pixel size and DPI
+	MinDPI          float64 `json:"min_dpi,omitempty"`          // DPI threshold for low_resolution (default 150)
+}
+
+// ImageListing describes one image on a slide.
+type ImageListing struct {
+	SlideIndex    int       `json:"slide_index"` // 1-based
+	SlideID       string    `json:"slide_id"`
+	ObjectID      string    `json:"object_id"`
+	Position      *Position `json:"position,omitempty"`
+	DisplayedSize *Size     `json:"displayed_size,omitempty"` // Rendered size in points, after scaling
+	SourceURL     string    `json:"source_url,omitempty"`
+
+	// Only with fetch_dimensions
+	PixelWidth      int     `json:"pixel_width,omitempty"`  // Natural image width
+	PixelHeight     int     `json:"pixel_height,omitempty"` // Natural image height
+	EffectiveDPI    float64 `json:"effective_dpi,omitempty"`
+	LowResolution   bool    `json:"low_resolution,omitempty"`
+	DimensionsError string  `json:"dimensions_error,omitempty"` // Why the pixel size could not be read
+}
+
+// ListImagesOutput represents the output of the list_images tool.
+type ListImagesOutput struct {
+	PresentationID     string         `json:"presentation_id"`
+	Images             []ImageListing `json:"images"`
+	TotalCount         int            `json:"total_count"`
+	MinDPI             float64        `json:"min_dpi,omitempty"`              // Threshold used; only with fetch_dimensions
+	LowResolutionCount int            `json:"low_resolution_count,omitempty"` // Only with fetch_dimensions
+}
+
+// ListImages lists the images on slides with their displayed size. With FetchDimensions it
+// also reads each image's pixel size and flags images whose effective DPI is below MinDPI.
+func (t *Tools) ListImages(ctx context.Context, tokenSource oauth2.TokenSource, input ListImagesInput) (*ListImagesOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	if input.MinDPI < 0 || math.IsNaN(input.MinDPI) || math.IsInf(input.MinDPI, 0) {
+		return nil, fmt.Errorf("%w: min_dpi must be a positive number, got %v", ErrInvalidMinDPI, input.MinDPI)
+	}
+	minDPI := input.MinDPI
+	if minDPI == 0 {
+		minDPI = defaultMinImageDPI
+	}
+
+	t.config.Logger.Info("listing images",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Any("slide_indices", input.SlideIndices),
+		slog.Bool("fetch_dimensions", input.FetchDimensions),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	allowedSlides := make(map[int]bool, len(input.SlideIndices))
+	for _, idx := range input.SlideIndices {
+		if idx < 1 || idx > len(presentation.Slides) {
+			return nil, fmt.Errorf("%w: slide index %d out of range (1-%d)", ErrSlideNotFound, idx, len(presentation.Slides))
+		}
+		allowedSlides[idx] = true
+	}
+
+	output := &ListImagesOutput{
+		PresentationID: input.PresentationID,
+		Images:         []ImageListing{},
+	}
+
+	for i, slide := range presentation.Slides {
+		if len(allowedSlides) > 0 && !allowedSlides[i+1] {
+			continue
+		}
+		for _, element := range collectImageElements(slide.PageElements) {
+			listing := buildImageListing(element, i+1, slide.ObjectId)
+			if input.FetchDimensions {
+				t.addImageResolution(ctx, &listing, element, minDPI)
+				if listing.LowResolution {
+					output.LowResolutionCount++
+				}
+			}
+			output.Images = append(output.Images, listing)
+		}
+	}
+
+	output.TotalCount = len(output.Images)
+	if input.FetchDimensions {
+		output.MinDPI = minDPI
+	}
+
+	t.config.Logger.Info("images listed successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("total_count", output.TotalCount),
+		slog.Int("low_resolution_count", output.LowResolutionCount),
+	)
+
+	return output, nil
+}
+
+// collectImageElements returns the image elements in page order, looking inside groups.
+func collectImageElements(elements []*slides.PageElement) []*slides.PageElement {
+	var images []*slides.PageElement
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+		if element.ElementGroup != nil {
+			images = append(images, collectImageElements(element.ElementGroup.Children)...)
+			continue
+		}
+		if element.Image != nil {
+			images = append(images, element)
+		}
+	}
+	return images
+}
+
+// buildImageListing describes an image without downloading it.
+func buildImageListing(element *slides.PageElement, slideIndex int, slideID string) ImageListing {
+	listing := ImageListing{
+		SlideIndex: slideIndex,
+		SlideID:    slideID,
+		ObjectID:   element.ObjectId,
+		SourceURL:  element.Image.SourceUrl,
+	}
+	listing.Position, _ = extractElementGeometry(element)
+	if width, height, ok := displayedSizeInPoints(element); ok {
+		listing.DisplayedSize = &Size{Width: width, Height: height}
+	}
+	return listing
+}
+
+// addImageResolution reads the image's pixel size and fills in its effective DPI. Failures are
+// recorded on the listing so one unreachable image does not fail the whole listing.
+func (t *Tools) addImageResolution(ctx context.Context, listing *ImageListing, element *slides.PageElement, minDPI float64) {
+	width, height, err := t.readImageSize(ctx, element.Image)
+	if err != nil {
+		listing.DimensionsError = err.Error()
+		return
+	}
+	listing.PixelWidth = width
+	listing.PixelHeight = height
+
+	if listing.DisplayedSize == nil {
+		return
+	}
+	listing.EffectiveDPI = effectiveImageDPI(width, height, listing.DisplayedSize, element.Image.ImageProperties)
+	listing.LowResolution = listing.EffectiveDPI > 0 && listing.EffectiveDPI < minDPI
+}
+
+// effectiveImageDPI returns the pixels per inch of the visible part of an image at its displayed
+// size. Cropped edges are excluded, and the lower of the two axes is reported.
+func effectiveImageDPI(pixelWidth, pixelHeight int, displayed *Size, props *slides.ImageProperties) float64 {
+	if displayed.Width <= 0 || displayed.Height <= 0 {
+		return 0
+	}
+
+	visibleX, visibleY := 1.0, 1.0
+	if props != nil && props.CropProperties != nil {
+		crop := props.CropProperties
+		visibleX = 1 - crop.LeftOffset - crop.RightOffset
+		visibleY = 1 - crop.TopOffset - crop.BottomOffset
+	}
+	if visibleX <= 0 || visibleY <= 0 {
+		return 0
+	}
+
+	dpiX := float64(pixelWidth) * visibleX / (displayed.Width / pointsPerInch)
+	dpiY := float64(pixelHeight) * visibleY / (displayed.Height / pointsPerInch)
+	return math.Round(math.Min(dpiX, dpiY)*10) / 10
+}
@@ -0,0 +1,202 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// newListImagesTestServer serves PNGs of the sizes given by path.
+func newListImagesTestServer(t *testing.T, sizes map[string][2]int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		size, ok := sizes[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		var data bytes.Buffer
+		if err := png.Encode(&data, image.NewRGBA(image.Rect(0, 0, size[0], size[1]))); err != nil {
+			t.Errorf("failed to encode test image: %v", err)
+		}
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(data.Bytes())
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newListImagesTestPresentation(baseURL string) *slides.Presentation {
+	imageElement := func(id, path string, widthPt, heightPt, scale float64) *slides.PageElement {
+		return &slides.PageElement{
+			ObjectId: id,
+			Size: &slides.Size{
+				Width:  &slides.Dimension{Magnitude: pointsToEMU(widthPt), Unit: "EMU"},
+				Height: &slides.Dimension{Magnitude: pointsToEMU(heightPt), Unit: "EMU"},
+			},
+			Transform: &slides.AffineTransform{ScaleX: scale, ScaleY: scale, TranslateX: pointsToEMU(10), TranslateY: pointsToEMU(20), Unit: "EMU"},
+			Image:     &slides.Image{ContentUrl: baseURL + path, SourceUrl: "https://example.com" + path},
+		}
+	}
+
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					// 100px shown at 360pt (5 inches): 20 DPI
+					imageElement("small-large", "/small.png", 180, 180, 2),
+					{ObjectId: "title", Shape: &slides.Shape{ShapeType: "TEXT_BOX"}},
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "group-1",
+						ElementGroup: &slides.Group{Children: []*slides.PageElement{
+							// 1500px shown at 360pt: 300 DPI
+							imageElement("sharp", "/large.png", 360, 360, 1),
+							imageElement("missing", "/gone.png", 100, 100, 1),
+						}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newListImagesTestTools(presentation *slides.Presentation) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, nil
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestListImages_FlagsLowResolution(t *testing.T) {
+	server := newListImagesTestServer(t, map[string][2]int{
+		"/small.png": {100, 100},
+		"/large.png": {1500, 1500},
+	})
+	tools := newListImagesTestTools(newListImagesTestPresentation(server.URL))
+
+	output, err := tools.ListImages(context.Background(), nil, ListImagesInput{
+		PresentationID:  "pres-1",
+		FetchDimensions: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.TotalCount != 3 || output.LowResolutionCount != 1 || output.MinDPI != defaultMinImageDPI {
+		t.Fatalf("unexpected totals: %d images, %d low resolution, min DPI %v", output.TotalCount, output.LowResolutionCount, output.MinDPI)
+	}
+
+	small := output.Images[0]
+	if small.ObjectID != "small-large" || small.SlideIndex != 1 || small.SlideID != "slide-1" {
+		t.Errorf("unexpected first image %+v", small)
+	}
+	if small.DisplayedSize == nil || small.DisplayedSize.Width != 360 || small.DisplayedSize.Height != 360 {
+		t.Errorf("expected scaled 360x360pt size, got %+v", small.DisplayedSize)
+	}
+	if small.PixelWidth != 100 || small.PixelHeight != 100 || small.EffectiveDPI != 20 || !small.LowResolution {
+		t.Errorf("expected 100x100px at 20 DPI flagged, got %+v", small)
+	}
+
+	sharp := output.Images[1]
+	if sharp.ObjectID != "sharp" || sharp.SlideIndex != 2 || sharp.EffectiveDPI != 300 || sharp.LowResolution {
+		t.Errorf("expected grouped image at 300 DPI not flagged, got %+v", sharp)
+	}
+
+	missing := output.Images[2]
+	if missing.DimensionsError == "" || missing.PixelWidth != 0 || missing.LowResolution {
+		t.Errorf("expected an unreadable image to carry dimensions_error, got %+v", missing)
+	}
+}
+
+func TestListImages_WithoutFetch(t *testing.T) {
+	tools := newListImagesTestTools(newListImagesTestPresentation("http://127.0.0.1:0"))
+
+	output, err := tools.ListImages(context.Background(), nil, ListImagesInput{
+		PresentationID: "pres-1",
+		SlideIndices:   []int{1},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.TotalCount != 1 || output.MinDPI != 0 {
+		t.Fatalf("expected one image and no DPI threshold, got %+v", output)
+	}
+	listing := output.Images[0]
+	if listing.PixelWidth != 0 || listing.EffectiveDPI != 0 || listing.DimensionsError != "" {
+		t.Errorf("expected no resolution data without fetch_dimensions, got %+v", listing)
+	}
+	if listing.Position == nil || listing.Position.X != 10 || listing.Position.Y != 20 {
+		t.Errorf("unexpected position %+v", listing.Position)
+	}
+	if listing.SourceURL != "https://example.com/small.png" {
+		t.Errorf("SourceURL = %q", listing.SourceURL)
+	}
+}
+
+func TestEffectiveImageDPI(t *testing.T) {
+	tests := []struct {
+		name      string
+		width     int
+		height    int
+		displayed *Size
+		props     *slides.ImageProperties
+		want      float64
+	}{
+		{"one inch", 300, 300, &Size{Width: 72, Height: 72}, nil, 300},
+		{"lower axis wins", 600, 150, &Size{Width: 144, Height: 72}, nil, 150},
+		{"crop excludes trimmed pixels", 400, 200, &Size{Width: 72, Height: 72},
+			&slides.ImageProperties{CropProperties: &slides.CropProperties{LeftOffset: 0.25, RightOffset: 0.25}}, 200},
+		{"zero size", 300, 300, &Size{}, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := effectiveImageDPI(tt.width, tt.height, tt.displayed, tt.props); got != tt.want {
+				t.Errorf("effectiveImageDPI = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListImages_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   ListImagesInput
+		wantErr error
+	}{
+		{"missing presentation", ListImagesInput{}, ErrInvalidPresentationID},
+		{"negative min DPI", ListImagesInput{PresentationID: "pres-1", MinDPI: -1}, ErrInvalidMinDPI},
+		{"slide out of range", ListImagesInput{PresentationID: "pres-1", SlideIndices: []int{3}}, ErrSlideNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newListImagesTestTools(newListImagesTestPresentation("http://127.0.0.1:0"))
+
+			_, err := tools.ListImages(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
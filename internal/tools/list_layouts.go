@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// ListLayoutsInput represents the input for the list_layouts tool.
+type ListLayoutsInput struct {
+	PresentationID string `json:"presentation_id"`
+}
+
+// LayoutListing describes one layout of the presentation.
+type LayoutListing struct {
+	ObjectID    string `json:"object_id"`
+	DisplayName string `json:"display_name,omitempty"` // Human-readable name shown in the editor
+	LayoutType  string `json:"layout_type,omitempty"`  // Predefined type name (e.g. TITLE_AND_BODY), or a custom name
+	Predefined  bool   `json:"predefined"`             // LayoutType is one of the types accepted by add_slide
+	MasterID    string `json:"master_id,omitempty"`
+	MasterName  string `json:"master_name,omitempty"`
+	SlideCount  int    `json:"slide_count"` // Slides currently using this layout
+}
+
+// ListLayoutsOutput represents the output of the list_layouts tool.
+type ListLayoutsOutput struct {
+	PresentationID string          `json:"presentation_id"`
+	Layouts        []LayoutListing `json:"layouts"`
+	TotalCount     int             `json:"total_count"`
+}
+
+// ListLayouts lists the layouts actually present in the deck, including custom ones, with the
+// master each belongs to.
+func (t *Tools) ListLayouts(ctx context.Context, tokenSource oauth2.TokenSource, input ListLayoutsInput) (*ListLayoutsOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	t.config.Logger.Info("listing layouts",
+		slog.String("presentation_id", input.PresentationID),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	output := &ListLayoutsOutput{
+		PresentationID: input.PresentationID,
+		Layouts:        buildLayoutListings(presentation),
+	}
+	output.TotalCount = len(output.Layouts)
+
+	t.config.Logger.Info("layouts listed successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("total_count", output.TotalCount),
+	)
+
+	return output, nil
+}
+
+// buildLayoutListings describes each layout in presentation order, resolving master names and
+// counting the slides that use it.
+func buildLayoutListings(presentation *slides.Presentation) []LayoutListing {
+	masterNames := make(map[string]string, len(presentation.Masters))
+	for _, master := range presentation.Masters {
+		if master.MasterProperties != nil {
+			masterNames[master.ObjectId] = master.MasterProperties.DisplayName
+		}
+	}
+
+	slideCounts := make(map[string]int)
+	for _, slide := range presentation.Slides {
+		if slide.SlideProperties != nil {
+			slideCounts[slide.SlideProperties.LayoutObjectId]++
+		}
+	}
+
+	listings := make([]LayoutListing, 0, len(presentation.Layouts))
+	for _, layout := range presentation.Layouts {
+		listing := LayoutListing{
+			ObjectID:   layout.ObjectId,
+			SlideCount: slideCounts[layout.ObjectId],
+		}
+		if layout.LayoutProperties != nil {
+			listing.DisplayName = layout.LayoutProperties.DisplayName
+			listing.LayoutType = layout.LayoutProperties.Name
+			listing.Predefined = validLayoutTypes[layout.LayoutProperties.Name]
+			listing.MasterID = layout.LayoutProperties.MasterObjectId
+			listing.MasterName = masterNames[listing.MasterID]
+		}
+		listings = append(listings, listing)
+	}
+	return listings
+}
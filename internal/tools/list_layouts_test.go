@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newListLayoutsTestTools(presentation *slides.Presentation, getErr error) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestListLayouts(t *testing.T) {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Masters: []*slides.Page{
+			{ObjectId: "master-1", MasterProperties: &slides.MasterProperties{DisplayName: "Simple Light"}},
+			{ObjectId: "master-2", MasterProperties: &slides.MasterProperties{DisplayName: "Brand"}},
+		},
+		Layouts: []*slides.Page{
+			{ObjectId: "layout-1", LayoutProperties: &slides.LayoutProperties{
+				Name: "TITLE_AND_BODY", DisplayName: "Title and body", MasterObjectId: "master-1",
+			}},
+			{ObjectId: "layout-2", LayoutProperties: &slides.LayoutProperties{
+				Name: "CUSTOM_1", DisplayName: "Quote with photo", MasterObjectId: "master-2",
+			}},
+			{ObjectId: "layout-3"},
+		},
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1", SlideProperties: &slides.SlideProperties{LayoutObjectId: "layout-1"}},
+			{ObjectId: "slide-2", SlideProperties: &slides.SlideProperties{LayoutObjectId: "layout-2"}},
+			{ObjectId: "slide-3", SlideProperties: &slides.SlideProperties{LayoutObjectId: "layout-1"}},
+		},
+	}
+	tools := newListLayoutsTestTools(presentation, nil)
+
+	output, err := tools.ListLayouts(context.Background(), nil, ListLayoutsInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []LayoutListing{
+		{ObjectID: "layout-1", DisplayName: "Title and body", LayoutType: "TITLE_AND_BODY", Predefined: true, MasterID: "master-1", MasterName: "Simple Light", SlideCount: 2},
+		{ObjectID: "layout-2", DisplayName: "Quote with photo", LayoutType: "CUSTOM_1", MasterID: "master-2", MasterName: "Brand", SlideCount: 1},
+		{ObjectID: "layout-3"},
+	}
+	if output.TotalCount != len(want) || len(output.Layouts) != len(want) {
+		t.Fatalf("expected %d layouts, got %+v", len(want), output)
+	}
+	for i, layout := range output.Layouts {
+		if layout != want[i] {
+			t.Errorf("layout %d = %+v, want %+v", i, layout, want[i])
+		}
+	}
+}
+
+func TestListLayouts_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   ListLayoutsInput
+		getErr  error
+		wantErr error
+	}{
+		{"missing presentation", ListLayoutsInput{}, nil, ErrInvalidPresentationID},
+		{"presentation not found", ListLayoutsInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 404}, ErrPresentationNotFound},
+		{"access denied", ListLayoutsInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 403}, ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := newListLayoutsTestTools(&slides.Presentation{}, tt.getErr)
+
+			_, err := tools.ListLayouts(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
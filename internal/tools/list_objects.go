@@ -14,19 +14,23 @@ type ListObjectsInput struct {
 	PresentationID string   `json:"presentation_id"`
 	SlideIndices   []int    `json:"slide_indices,omitempty"`   // 1-based indices, optional - default all slides
 	ObjectTypes    []string `json:"object_types,omitempty"`    // Filter by type: SHAPE, IMAGE, TABLE, VIDEO, LINE, etc.
+	IncludeMasters bool     `json:"include_masters,omitempty"` // Also list objects on master pages
+	IncludeLayouts bool     `json:"include_layouts,omitempty"` // Also list objects on layout pages
 }
 
 // ListObjectsOutput represents the output of the list_objects tool.
 type ListObjectsOutput struct {
-	PresentationID string         `json:"presentation_id"`
+	PresentationID string          `json:"presentation_id"`
 	Objects        []ObjectListing `json:"objects"`
-	TotalCount     int            `json:"total_count"`
-	FilteredBy     *FilterInfo    `json:"filtered_by,omitempty"`
+	TotalCount     int             `json:"total_count"`
+	FilteredBy     *FilterInfo     `json:"filtered_by,omitempty"`
 }
 
 // ObjectListing provides information about an object for listing purposes.
 type ObjectListing struct {
-	SlideIndex     int       `json:"slide_index"`      // 1-based
+	SlideIndex     int       `json:"slide_index"`         // 1-based; 0 for masters and layouts
+	PageType       string    `json:"page_type,omitempty"` // "MASTER" or "LAYOUT"; empty for slides
+	PageID         string    `json:"page_id,omitempty"`   // Set for masters and layouts
 	ObjectID       string    `json:"object_id"`
 	ObjectType     string    `json:"object_type"`
 	Position       *Position `json:"position,omitempty"`
@@ -85,10 +89,14 @@ func (t *Tools) ListObjects(ctx context.Context, tokenSource oauth2.TokenSource,
 		}
 	}
 
-	// Build set of allowed slide indices (1-based)
+	// Build set of allowed slide indices (1-based). A missing slide is an error rather
+	// than an empty result, so callers can tell a bad reference from a slide with no matches.
 	allowedSlideIndices := make(map[int]bool)
 	if len(input.SlideIndices) > 0 {
 		for _, idx := range input.SlideIndices {
+			if idx < 1 || idx > len(presentation.Slides) {
+				return nil, fmt.Errorf("%w: slide index %d out of range (1-%d)", ErrSlideNotFound, idx, len(presentation.Slides))
+			}
 			allowedSlideIndices[idx] = true
 		}
 	}
@@ -101,17 +109,23 @@ func (t *Tools) ListObjects(ctx context.Context, tokenSource oauth2.TokenSource,
 		}
 	}
 
-	// Process each slide
-	for slideIdx, slide := range presentation.Slides {
-		slideIndex := slideIdx + 1 // 1-based
+	// Process each slide, plus masters and layouts when requested
+	for _, page := range traversalPages(presentation, input.IncludeMasters, input.IncludeLayouts) {
+		slideIndex := page.slideIndex()
 
-		// Check slide index filter
-		if len(allowedSlideIndices) > 0 && !allowedSlideIndices[slideIndex] {
+		// Check slide index filter; it only restricts regular slides
+		if page.PageType == pageTypeSlide && len(allowedSlideIndices) > 0 && !allowedSlideIndices[slideIndex] {
 			continue
 		}
 
-		// Extract objects from this slide
-		objects := extractObjectListings(slide.PageElements, slideIndex, allowedTypes)
+		// Extract objects from this page
+		objects := extractObjectListings(page.Page.PageElements, slideIndex, allowedTypes)
+		if pageType := page.nonSlideType(); pageType != "" {
+			for i := range objects {
+				objects[i].PageType = pageType
+				objects[i].PageID = page.Page.ObjectId
+			}
+		}
 		output.Objects = append(output.Objects, objects...)
 	}
 
@@ -802,18 +802,27 @@ func TestListObjects_InvalidSlideIndex(t *testing.T) {
 	tokenSource := &mockTokenSource{}
 
 	// Request slide index 99 which doesn't exist
-	output, err := tools.ListObjects(context.Background(), tokenSource, ListObjectsInput{
+	_, err := tools.ListObjects(context.Background(), tokenSource, ListObjectsInput{
 		PresentationID: "test-presentation-id",
 		SlideIndices:   []int{99},
 	})
 
+	// A missing slide is an error, unlike a slide whose objects don't match the filters
+	if !errors.Is(err, ErrSlideNotFound) {
+		t.Errorf("expected ErrSlideNotFound, got %v", err)
+	}
+
+	// An existing slide with no matching objects returns an empty list
+	output, err := tools.ListObjects(context.Background(), tokenSource, ListObjectsInput{
+		PresentationID: "test-presentation-id",
+		SlideIndices:   []int{1},
+		ObjectTypes:    []string{"IMAGE"},
+	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-
-	// Should return empty results (no error, just no matching slides)
 	if output.TotalCount != 0 {
-		t.Errorf("expected total count 0 for invalid slide index, got %d", output.TotalCount)
+		t.Errorf("expected total count 0 for empty match, got %d", output.TotalCount)
 	}
 }
 
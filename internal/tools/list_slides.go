@@ -16,12 +16,15 @@ import (
 type ListSlidesInput struct {
 	PresentationID    string `json:"presentation_id"`
 	IncludeThumbnails bool   `json:"include_thumbnails,omitempty"`
+	IncludeETag       bool   `json:"include_etag,omitempty"` // Also fetch the Drive ETag (one extra Drive call)
 }
 
 // ListSlidesOutput represents the output of the list_slides tool.
 type ListSlidesOutput struct {
 	PresentationID string           `json:"presentation_id"`
 	Title          string           `json:"title"`
+	RevisionID     string           `json:"revision_id,omitempty"` // Changes whenever the presentation is edited
+	ETag           string           `json:"etag,omitempty"`        // Drive ETag, when include_etag is set
 	Slides         []SlideListItem  `json:"slides"`
 	Statistics     SlidesStatistics `json:"statistics"`
 }
@@ -81,6 +84,7 @@ func (t *Tools) ListSlides(ctx context.Context, tokenSource oauth2.TokenSource,
 			TotalSlides: len(presentation.Slides),
 		},
 	}
+	output.RevisionID, output.ETag = t.presentationRevision(ctx, tokenSource, presentation, input.IncludeETag)
 
 	// Process each slide
 	for i, slide := range presentation.Slides {
@@ -2,6 +2,7 @@ package tools
 
 import (
 	"context"
+	"encoding/csv"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -14,50 +15,96 @@ import (
 // Sentinel errors for manage_hyperlinks tool.
 var (
 	ErrManageHyperlinksFailed = errors.New("failed to manage hyperlinks")
-	ErrInvalidHyperlinkAction = errors.New("invalid action: must be 'list', 'add', or 'remove'")
+	ErrInvalidHyperlinkAction = errors.New("invalid action: must be 'list', 'add', 'remove', or 'bulk'")
 	ErrInvalidHyperlinkURL    = errors.New("url is required for add action")
 	ErrNoHyperlinkToRemove    = errors.New("no hyperlink found at specified range")
+	ErrInvalidLinkColor       = errors.New("invalid link color")
+	ErrInvalidLinkListFormat  = errors.New("invalid list format: must be 'json' or 'csv'")
 )
 
+// hyperlinkCSVHeader lists the columns of the list action's CSV format.
+var hyperlinkCSVHeader = []string{"slide_index", "object_id", "object_type", "link_type", "target", "text"}
+
 // ManageHyperlinksInput represents the input for the manage_hyperlinks tool.
 type ManageHyperlinksInput struct {
 	PresentationID string `json:"presentation_id"`
-	Action         string `json:"action"` // "list", "add", "remove"
+	Action         string `json:"action"` // "list", "add", "remove", "bulk"
 
 	// For list action
 	Scope    string `json:"scope,omitempty"`     // "all", "slide", "object" - default "all"
 	SlideID  string `json:"slide_id,omitempty"`  // Required when scope is "slide"
 	ObjectID string `json:"object_id,omitempty"` // Required when scope is "object" or for add/remove
 
+	IncludeMasters  bool `json:"include_masters,omitempty"`  // List action: also scan master pages
+	IncludeLayouts  bool `json:"include_layouts,omitempty"`  // List action: also scan layout pages
+	IncludeGeometry bool `json:"include_geometry,omitempty"` // List action: add the linked element's position and size
+
+	Format string `json:"format,omitempty"` // List action: "json" (default) or "csv"
+
 	// For add/remove actions on text
 	StartIndex *int `json:"start_index,omitempty"` // For text link range
 	EndIndex   *int `json:"end_index,omitempty"`   // For text link range
 
 	// For add action
 	URL string `json:"url,omitempty"` // External URL, internal slide link, or presentation link
+
+	// For add action and bulk add/update: also color and underline linked text
+	StyleLink bool   `json:"style_link,omitempty"`
+	LinkColor string `json:"link_color,omitempty"` // Hex color, default ToolsConfig.LinkColor
+
+	// For bulk action
+	Operations []HyperlinkOperation `json:"operations,omitempty"` // Applied in a single batch update
+}
+
+// HyperlinkOperation is a single add/update/remove item of a bulk action.
+type HyperlinkOperation struct {
+	Action     string `json:"action"` // "add", "update", "remove"
+	ObjectID   string `json:"object_id"`
+	StartIndex *int   `json:"start_index,omitempty"`
+	EndIndex   *int   `json:"end_index,omitempty"`
+	URL        string `json:"url,omitempty"`        // Required for add/update
+	StyleLink  bool   `json:"style_link,omitempty"` // Color and underline the linked text (also set by the input's style_link)
+}
+
+// HyperlinkOperationResult reports the outcome of a single bulk item.
+type HyperlinkOperationResult struct {
+	Index    int    `json:"index"`
+	Action   string `json:"action"`
+	ObjectID string `json:"object_id"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
 }
 
 // ManageHyperlinksOutput represents the output of the manage_hyperlinks tool.
 type ManageHyperlinksOutput struct {
 	PresentationID string          `json:"presentation_id"`
 	Action         string          `json:"action"`
-	Links          []HyperlinkInfo `json:"links,omitempty"`  // For list action
+	Links          []HyperlinkInfo `json:"links,omitempty"` // For list action
+	CSV            string          `json:"csv,omitempty"`   // For list action with format "csv", replaces links
 	Success        bool            `json:"success,omitempty"`
 	Message        string          `json:"message,omitempty"`
+
+	// For bulk action
+	Results      []HyperlinkOperationResult `json:"results,omitempty"`
+	SuccessCount int                        `json:"success_count,omitempty"`
+	FailureCount int                        `json:"failure_count,omitempty"`
 }
 
 // HyperlinkInfo represents information about a hyperlink.
 type HyperlinkInfo struct {
-	SlideIndex int    `json:"slide_index"` // 1-based
-	SlideID    string `json:"slide_id"`
-	ObjectID   string `json:"object_id"`
-	ObjectType string `json:"object_type"`
-	StartIndex int    `json:"start_index"`
-	EndIndex   int    `json:"end_index"`
-	URL        string `json:"url,omitempty"`       // External URL
-	SlideLink  string `json:"slide_link,omitempty"` // Internal slide ID link
-	LinkType   string `json:"link_type"`           // "external", "internal_slide", "internal_position"
-	Text       string `json:"text"`                // The linked text
+	SlideIndex int       `json:"slide_index"`         // 1-based; 0 for masters and layouts
+	SlideID    string    `json:"slide_id"`            // Page object ID
+	PageType   string    `json:"page_type,omitempty"` // "MASTER" or "LAYOUT"; empty for slides
+	ObjectID   string    `json:"object_id"`
+	ObjectType string    `json:"object_type"`
+	StartIndex int       `json:"start_index"`
+	EndIndex   int       `json:"end_index"`
+	URL        string    `json:"url,omitempty"`        // External URL
+	SlideLink  string    `json:"slide_link,omitempty"` // Internal slide ID link
+	LinkType   string    `json:"link_type"`            // "external", "internal_slide", "internal_position"
+	Text       string    `json:"text"`                 // The linked text
+	Position   *Position `json:"position,omitempty"`   // Owning element position in points, with include_geometry
+	Size       *Size     `json:"size,omitempty"`       // Owning element size in points, with include_geometry
 }
 
 // ManageHyperlinks manages hyperlinks in a presentation.
@@ -68,7 +115,7 @@ func (t *Tools) ManageHyperlinks(ctx context.Context, tokenSource oauth2.TokenSo
 	}
 
 	action := strings.ToLower(strings.TrimSpace(input.Action))
-	if action != "list" && action != "add" && action != "remove" {
+	if action != "list" && action != "add" && action != "remove" && action != "bulk" {
 		return nil, fmt.Errorf("%w: got '%s'", ErrInvalidHyperlinkAction, input.Action)
 	}
 
@@ -102,6 +149,8 @@ func (t *Tools) ManageHyperlinks(ctx context.Context, tokenSource oauth2.TokenSo
 		return t.addHyperlink(ctx, slidesService, presentation, input)
 	case "remove":
 		return t.removeHyperlink(ctx, slidesService, presentation, input)
+	case "bulk":
+		return t.bulkManageHyperlinks(ctx, slidesService, presentation, input)
 	default:
 		return nil, fmt.Errorf("%w: got '%s'", ErrInvalidHyperlinkAction, action)
 	}
@@ -126,15 +175,34 @@ func (t *Tools) listHyperlinks(_ context.Context, presentation *slides.Presentat
 		return nil, fmt.Errorf("%w: object_id is required when scope is 'object'", ErrInvalidObjectID)
 	}
 
+	format := strings.ToLower(strings.TrimSpace(input.Format))
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "csv" {
+		return nil, fmt.Errorf("%w: got '%s'", ErrInvalidLinkListFormat, input.Format)
+	}
+
 	var links []HyperlinkInfo
+	pages := traversalPages(presentation, input.IncludeMasters, input.IncludeLayouts)
+	slideFound := false
 
-	for slideIdx, slide := range presentation.Slides {
+	for _, page := range pages {
 		// Apply slide filter
-		if scope == "slide" && slide.ObjectId != input.SlideID {
+		if scope == "slide" && page.Page.ObjectId != input.SlideID {
 			continue
 		}
+		slideFound = true
 
-		slideLinks := extractLinksFromSlide(slide, slideIdx+1, input.ObjectID)
+		slideLinks := extractLinksFromSlide(page.Page, page.slideIndex(), input.ObjectID)
+		if pageType := page.nonSlideType(); pageType != "" {
+			for i := range slideLinks {
+				slideLinks[i].PageType = pageType
+			}
+		}
+		if input.IncludeGeometry {
+			addHyperlinkGeometry(slideLinks, page.Page.PageElements)
+		}
 		links = append(links, slideLinks...)
 
 		// If we found the specific slide, no need to continue
@@ -143,11 +211,16 @@ func (t *Tools) listHyperlinks(_ context.Context, presentation *slides.Presentat
 		}
 	}
 
+	// A named slide that doesn't exist is an error, not an empty list
+	if scope == "slide" && !slideFound {
+		return nil, fmt.Errorf("%w: slide_id '%s' not found", ErrSlideNotFound, input.SlideID)
+	}
+
 	// If scope is object but no links found, check if object exists
 	if scope == "object" && len(links) == 0 {
 		found := false
-		for _, slide := range presentation.Slides {
-			if findElementByID(slide.PageElements, input.ObjectID) != nil {
+		for _, page := range pages {
+			if findElementByID(page.Page.PageElements, input.ObjectID) != nil {
 				found = true
 				break
 			}
@@ -164,6 +237,14 @@ func (t *Tools) listHyperlinks(_ context.Context, presentation *slides.Presentat
 		Success:        true,
 		Message:        fmt.Sprintf("Found %d hyperlink(s)", len(links)),
 	}
+	if format == "csv" {
+		csvData, err := encodeHyperlinksCSV(links)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to encode CSV: %v", ErrManageHyperlinksFailed, err)
+		}
+		output.Links = nil
+		output.CSV = csvData
+	}
 
 	t.config.Logger.Info("hyperlinks listed successfully",
 		slog.String("presentation_id", input.PresentationID),
@@ -173,6 +254,52 @@ func (t *Tools) listHyperlinks(_ context.Context, presentation *slides.Presentat
 	return output, nil
 }
 
+// encodeHyperlinksCSV renders links as CSV with a header row. The target column holds the
+// external URL, or the slide link for internal links.
+func encodeHyperlinksCSV(links []HyperlinkInfo) (string, error) {
+	var sb strings.Builder
+	writer := csv.NewWriter(&sb)
+	if err := writer.Write(hyperlinkCSVHeader); err != nil {
+		return "", err
+	}
+	for _, link := range links {
+		target := link.URL
+		if target == "" {
+			target = link.SlideLink
+		}
+		record := []string{
+			fmt.Sprintf("%d", link.SlideIndex),
+			link.ObjectID,
+			link.ObjectType,
+			link.LinkType,
+			target,
+			link.Text,
+		}
+		if err := writer.Write(record); err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// addHyperlinkGeometry sets each link's position on the page and displayed size from its owning
+// element, applying the transforms of enclosing groups. Table cell links ("tableId[row,col]")
+// report the geometry of the whole table.
+func addHyperlinkGeometry(links []HyperlinkInfo, elements []*slides.PageElement) {
+	for i := range links {
+		ownerID, _, _ := strings.Cut(links[i].ObjectID, "[")
+		element, transform := findElementOnPage(elements, ownerID, nil)
+		if element == nil {
+			continue
+		}
+		links[i].Position, links[i].Size = pageGeometry(element, transform)
+	}
+}
+
 // extractLinksFromSlide extracts all hyperlinks from a slide.
 func extractLinksFromSlide(slide *slides.Page, slideIndex int, filterObjectID string) []HyperlinkInfo {
 	var links []HyperlinkInfo
@@ -375,72 +502,29 @@ func (t *Tools) addHyperlink(ctx context.Context, slidesService SlidesService, p
 	}
 
 	// Validate indices if provided
-	if input.StartIndex != nil && *input.StartIndex < 0 {
-		return nil, fmt.Errorf("%w: start_index cannot be negative", ErrInvalidTextRange)
-	}
-	if input.EndIndex != nil && *input.EndIndex < 0 {
-		return nil, fmt.Errorf("%w: end_index cannot be negative", ErrInvalidTextRange)
+	if err := validateHyperlinkRange(input.StartIndex, input.EndIndex); err != nil {
+		return nil, err
 	}
-	if input.StartIndex != nil && input.EndIndex != nil && *input.StartIndex >= *input.EndIndex {
-		return nil, fmt.Errorf("%w: start_index must be less than end_index", ErrInvalidTextRange)
+
+	linkColor, err := t.linkStyleColor(input.LinkColor)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build the appropriate request based on object type
-	var requests []*slides.Request
-
-	// Check if this is a text-based link (shape/text) or object link (image/shape)
-	if targetElement.Shape != nil && targetElement.Shape.Text != nil && input.StartIndex != nil && input.EndIndex != nil {
-		// Text link - use UpdateTextStyle
-		link := buildLinkFromURL(input.URL)
-
-		textRange := &slides.Range{
-			Type: "FIXED_RANGE",
-		}
-		startIdx64 := int64(*input.StartIndex)
-		endIdx64 := int64(*input.EndIndex)
-		textRange.StartIndex = &startIdx64
-		textRange.EndIndex = &endIdx64
-
-		requests = append(requests, &slides.Request{
-			UpdateTextStyle: &slides.UpdateTextStyleRequest{
-				ObjectId: input.ObjectID,
-				Style: &slides.TextStyle{
-					Link: link,
-				},
-				TextRange: textRange,
-				Fields:    "link",
-			},
-		})
-	} else if targetElement.Shape != nil {
-		// Shape object link - use UpdateShapeProperties
-		link := buildLinkFromURL(input.URL)
-		requests = append(requests, &slides.Request{
-			UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
-				ObjectId: input.ObjectID,
-				ShapeProperties: &slides.ShapeProperties{
-					Link: link,
-				},
-				Fields: "link",
-			},
-		})
-	} else if targetElement.Image != nil {
-		// Image link - use UpdateImageProperties
-		link := buildLinkFromURL(input.URL)
-		requests = append(requests, &slides.Request{
-			UpdateImageProperties: &slides.UpdateImagePropertiesRequest{
-				ObjectId: input.ObjectID,
-				ImageProperties: &slides.ImageProperties{
-					Link: link,
-				},
-				Fields: "link",
-			},
-		})
-	} else {
+	request := buildHyperlinkRequest(targetElement, input.ObjectID, input.StartIndex, input.EndIndex, buildLinkFromURL(input.URL))
+	if request == nil {
 		return nil, fmt.Errorf("%w: cannot add hyperlink to this object type", ErrManageHyperlinksFailed)
 	}
+	requests := []*slides.Request{request}
+	if input.StyleLink {
+		if styleRequest := buildLinkStyleRequest(targetElement, input.ObjectID, input.StartIndex, input.EndIndex, linkColor); styleRequest != nil {
+			requests = append(requests, styleRequest)
+		}
+	}
 
 	// Execute batch update
-	_, err := slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, ErrPresentationNotFound
@@ -528,91 +612,278 @@ func (t *Tools) removeHyperlink(ctx context.Context, slidesService SlidesService
 	}
 
 	// Validate indices if provided for text
-	if input.StartIndex != nil && *input.StartIndex < 0 {
-		return nil, fmt.Errorf("%w: start_index cannot be negative", ErrInvalidTextRange)
+	if err := validateHyperlinkRange(input.StartIndex, input.EndIndex); err != nil {
+		return nil, err
 	}
-	if input.EndIndex != nil && *input.EndIndex < 0 {
-		return nil, fmt.Errorf("%w: end_index cannot be negative", ErrInvalidTextRange)
+
+	// A nil link clears the hyperlink
+	request := buildHyperlinkRequest(targetElement, input.ObjectID, input.StartIndex, input.EndIndex, nil)
+	if request == nil {
+		return nil, fmt.Errorf("%w: cannot remove hyperlink from this object type", ErrManageHyperlinksFailed)
 	}
-	if input.StartIndex != nil && input.EndIndex != nil && *input.StartIndex >= *input.EndIndex {
-		return nil, fmt.Errorf("%w: start_index must be less than end_index", ErrInvalidTextRange)
+	requests := []*slides.Request{request}
+
+	// Execute batch update
+	_, err := slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrManageHyperlinksFailed, err)
 	}
 
-	// Build the appropriate request based on object type
-	var requests []*slides.Request
+	output := &ManageHyperlinksOutput{
+		PresentationID: input.PresentationID,
+		Action:         "remove",
+		Success:        true,
+		Message:        fmt.Sprintf("Hyperlink removed from object '%s'", input.ObjectID),
+	}
 
-	// Check if this is a text-based link removal or object link removal
-	if targetElement.Shape != nil && targetElement.Shape.Text != nil && input.StartIndex != nil && input.EndIndex != nil {
-		// Text link - use UpdateTextStyle with nil link to remove
-		textRange := &slides.Range{
-			Type: "FIXED_RANGE",
-		}
-		startIdx64 := int64(*input.StartIndex)
-		endIdx64 := int64(*input.EndIndex)
-		textRange.StartIndex = &startIdx64
-		textRange.EndIndex = &endIdx64
+	t.config.Logger.Info("hyperlink removed successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("object_id", input.ObjectID),
+	)
 
-		// Setting link field to empty removes the link
-		requests = append(requests, &slides.Request{
+	return output, nil
+}
+
+// validateHyperlinkRange validates optional text range indices for link operations.
+func validateHyperlinkRange(startIndex, endIndex *int) error {
+	if startIndex != nil && *startIndex < 0 {
+		return fmt.Errorf("%w: start_index cannot be negative", ErrInvalidTextRange)
+	}
+	if endIndex != nil && *endIndex < 0 {
+		return fmt.Errorf("%w: end_index cannot be negative", ErrInvalidTextRange)
+	}
+	if startIndex != nil && endIndex != nil && *startIndex >= *endIndex {
+		return fmt.Errorf("%w: start_index must be less than end_index", ErrInvalidTextRange)
+	}
+	return nil
+}
+
+// buildHyperlinkRequest creates the request that sets (or clears, when link is nil) a
+// hyperlink on a text range, a shape, or an image. Returns nil for unsupported objects.
+func buildHyperlinkRequest(element *slides.PageElement, objectID string, startIndex, endIndex *int, link *slides.Link) *slides.Request {
+	// Text link - use UpdateTextStyle on the range
+	if element.Shape != nil && element.Shape.Text != nil && startIndex != nil && endIndex != nil {
+		startIdx64 := int64(*startIndex)
+		endIdx64 := int64(*endIndex)
+		return &slides.Request{
 			UpdateTextStyle: &slides.UpdateTextStyleRequest{
-				ObjectId: input.ObjectID,
+				ObjectId: objectID,
 				Style: &slides.TextStyle{
-					// Empty link removes the hyperlink
-					Link: nil,
+					Link: link,
 				},
-				TextRange: textRange,
-				Fields:    "link",
+				TextRange: &slides.Range{
+					Type:       "FIXED_RANGE",
+					StartIndex: &startIdx64,
+					EndIndex:   &endIdx64,
+				},
+				Fields: "link",
 			},
-		})
-	} else if targetElement.Shape != nil {
-		// Shape object link - remove using UpdateShapeProperties
-		requests = append(requests, &slides.Request{
+		}
+	}
+
+	// Shape object link - use UpdateShapeProperties
+	if element.Shape != nil {
+		return &slides.Request{
 			UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
-				ObjectId: input.ObjectID,
+				ObjectId: objectID,
 				ShapeProperties: &slides.ShapeProperties{
-					Link: nil,
+					Link: link,
 				},
 				Fields: "link",
 			},
-		})
-	} else if targetElement.Image != nil {
-		// Image link - remove using UpdateImageProperties
-		requests = append(requests, &slides.Request{
+		}
+	}
+
+	// Image link - use UpdateImageProperties
+	if element.Image != nil {
+		return &slides.Request{
 			UpdateImageProperties: &slides.UpdateImagePropertiesRequest{
-				ObjectId: input.ObjectID,
+				ObjectId: objectID,
 				ImageProperties: &slides.ImageProperties{
-					Link: nil,
+					Link: link,
 				},
 				Fields: "link",
 			},
-		})
-	} else {
-		return nil, fmt.Errorf("%w: cannot remove hyperlink from this object type", ErrManageHyperlinksFailed)
+		}
 	}
 
-	// Execute batch update
-	_, err := slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	return nil
+}
+
+// linkStyleColor returns the color style_link applies: the given hex color, else
+// ToolsConfig.LinkColor, else DefaultLinkColor.
+func (t *Tools) linkStyleColor(color string) (*slides.RgbColor, error) {
+	if color == "" {
+		color = t.config.LinkColor
+	}
+	if color == "" {
+		color = DefaultLinkColor
+	}
+	rgb := parseHexColor(color)
+	if rgb == nil {
+		return nil, fmt.Errorf("%w: '%s' is not a hex color like #1155CC", ErrInvalidLinkColor, color)
+	}
+	return rgb, nil
+}
+
+// buildLinkStyleRequest gives a linked text range the conventional link look: colored and
+// underlined. Slides does not style links on its own. Returns nil when the link is not on a
+// text range, since shape and image links have no text to style.
+func buildLinkStyleRequest(element *slides.PageElement, objectID string, startIndex, endIndex *int, color *slides.RgbColor) *slides.Request {
+	if element.Shape == nil || element.Shape.Text == nil || startIndex == nil || endIndex == nil {
+		return nil
+	}
+	startIdx64 := int64(*startIndex)
+	endIdx64 := int64(*endIndex)
+	return &slides.Request{
+		UpdateTextStyle: &slides.UpdateTextStyleRequest{
+			ObjectId: objectID,
+			Style: &slides.TextStyle{
+				ForegroundColor: &slides.OptionalColor{
+					OpaqueColor: &slides.OpaqueColor{RgbColor: color},
+				},
+				Underline: true,
+			},
+			TextRange: &slides.Range{
+				Type:       "FIXED_RANGE",
+				StartIndex: &startIdx64,
+				EndIndex:   &endIdx64,
+			},
+			Fields: "foregroundColor,underline",
+		},
+	}
+}
+
+// bulkManageHyperlinks applies many add/update/remove operations in a single batch update.
+// Invalid items are reported individually; the remaining items are still applied.
+func (t *Tools) bulkManageHyperlinks(ctx context.Context, slidesService SlidesService, presentation *slides.Presentation, input ManageHyperlinksInput) (*ManageHyperlinksOutput, error) {
+	if len(input.Operations) == 0 {
+		return nil, fmt.Errorf("%w: operations are required for bulk action", ErrManageHyperlinksFailed)
+	}
+
+	linkColor, err := t.linkStyleColor(input.LinkColor)
 	if err != nil {
-		if isNotFoundError(err) {
-			return nil, ErrPresentationNotFound
+		return nil, err
+	}
+
+	// One step per operation plus the final batch update
+	progress := ProgressFromContext(ctx)
+	totalSteps := len(input.Operations) + 1
+
+	results := make([]HyperlinkOperationResult, len(input.Operations))
+	var requests []*slides.Request
+	var pendingIndices []int
+
+	for i, op := range input.Operations {
+		opAction := strings.ToLower(strings.TrimSpace(op.Action))
+		results[i] = HyperlinkOperationResult{
+			Index:    i,
+			Action:   opAction,
+			ObjectID: op.ObjectID,
 		}
-		if isForbiddenError(err) {
-			return nil, ErrAccessDenied
+
+		var styleColor *slides.RgbColor
+		if input.StyleLink || op.StyleLink {
+			styleColor = linkColor
+		}
+
+		opRequests, err := buildBulkHyperlinkRequests(presentation, opAction, op, styleColor)
+		if err != nil {
+			results[i].Error = err.Error()
+		} else {
+			requests = append(requests, opRequests...)
+			pendingIndices = append(pendingIndices, i)
+		}
+
+		progress.Report(i+1, totalSteps, fmt.Sprintf("Prepared hyperlink operation %d of %d", i+1, len(input.Operations)))
+	}
+
+	if len(requests) > 0 {
+		_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, ErrPresentationNotFound
+			}
+			if isForbiddenError(err) {
+				return nil, ErrAccessDenied
+			}
+			return nil, fmt.Errorf("%w: %v", ErrManageHyperlinksFailed, err)
+		}
+		for _, idx := range pendingIndices {
+			results[idx].Success = true
 		}
-		return nil, fmt.Errorf("%w: %v", ErrManageHyperlinksFailed, err)
 	}
+	progress.Report(totalSteps, totalSteps, "Applied hyperlink operations")
+
+	successCount := len(pendingIndices)
+	failureCount := len(results) - successCount
 
 	output := &ManageHyperlinksOutput{
 		PresentationID: input.PresentationID,
-		Action:         "remove",
-		Success:        true,
-		Message:        fmt.Sprintf("Hyperlink removed from object '%s'", input.ObjectID),
+		Action:         "bulk",
+		Success:        failureCount == 0,
+		Message:        fmt.Sprintf("Applied %d of %d hyperlink operation(s)", successCount, len(results)),
+		Results:        results,
+		SuccessCount:   successCount,
+		FailureCount:   failureCount,
 	}
 
-	t.config.Logger.Info("hyperlink removed successfully",
+	t.config.Logger.Info("bulk hyperlink operations completed",
 		slog.String("presentation_id", input.PresentationID),
-		slog.String("object_id", input.ObjectID),
+		slog.Int("success_count", successCount),
+		slog.Int("failure_count", failureCount),
 	)
 
 	return output, nil
 }
+
+// buildBulkHyperlinkRequests validates a single bulk item and builds its requests. A non-nil
+// styleColor adds link styling to add/update items on text ranges.
+func buildBulkHyperlinkRequests(presentation *slides.Presentation, action string, op HyperlinkOperation, styleColor *slides.RgbColor) ([]*slides.Request, error) {
+	if action != "add" && action != "update" && action != "remove" {
+		return nil, fmt.Errorf("%w: operation action must be 'add', 'update', or 'remove', got '%s'", ErrInvalidHyperlinkAction, op.Action)
+	}
+	if op.ObjectID == "" {
+		return nil, fmt.Errorf("%w: object_id is required", ErrInvalidObjectID)
+	}
+	if action != "remove" && op.URL == "" {
+		return nil, ErrInvalidHyperlinkURL
+	}
+	if err := validateHyperlinkRange(op.StartIndex, op.EndIndex); err != nil {
+		return nil, err
+	}
+
+	var targetElement *slides.PageElement
+	for _, slide := range presentation.Slides {
+		if element := findElementByID(slide.PageElements, op.ObjectID); element != nil {
+			targetElement = element
+			break
+		}
+	}
+	if targetElement == nil {
+		return nil, fmt.Errorf("%w: object '%s' not found in presentation", ErrObjectNotFound, op.ObjectID)
+	}
+
+	var link *slides.Link
+	if action != "remove" {
+		link = buildLinkFromURL(op.URL)
+	}
+
+	request := buildHyperlinkRequest(targetElement, op.ObjectID, op.StartIndex, op.EndIndex, link)
+	if request == nil {
+		return nil, fmt.Errorf("%w: object type does not support hyperlinks", ErrManageHyperlinksFailed)
+	}
+	requests := []*slides.Request{request}
+	if link != nil && styleColor != nil {
+		if styleRequest := buildLinkStyleRequest(targetElement, op.ObjectID, op.StartIndex, op.EndIndex, styleColor); styleRequest != nil {
+			requests = append(requests, styleRequest)
+		}
+	}
+	return requests, nil
+}
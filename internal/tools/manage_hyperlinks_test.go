@@ -1192,4 +1192,651 @@ func TestManageHyperlinks(t *testing.T) {
 			t.Errorf("expected ErrInvalidObjectID, got %v", err)
 		}
 	})
+
+	t.Run("list distinguishes missing targets from empty matches", func(t *testing.T) {
+		mockService := &mockSlidesService{
+			GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+				return &slides.Presentation{
+					PresentationId: "test-pres",
+					Slides: []*slides.Page{
+						{
+							ObjectId: "slide-1",
+							PageElements: []*slides.PageElement{
+								{ObjectId: "plain-shape", Shape: &slides.Shape{ShapeType: "RECTANGLE"}},
+							},
+						},
+					},
+				}, nil
+			},
+		}
+		tools := NewTools(DefaultToolsConfig(), createHyperlinkMockFactory(mockService))
+
+		tests := []struct {
+			name        string
+			input       ManageHyperlinksInput
+			expectedErr error
+		}{
+			{"missing slide", ManageHyperlinksInput{Scope: "slide", SlideID: "no-such-slide"}, ErrSlideNotFound},
+			{"slide without links", ManageHyperlinksInput{Scope: "slide", SlideID: "slide-1"}, nil},
+			{"missing object", ManageHyperlinksInput{Scope: "object", ObjectID: "no-such-object"}, ErrObjectNotFound},
+			{"object without links", ManageHyperlinksInput{Scope: "object", ObjectID: "plain-shape"}, nil},
+		}
+
+		for _, tt := range tests {
+			tt.input.PresentationID = "test-pres"
+			tt.input.Action = "list"
+			output, err := tools.ManageHyperlinks(ctx, nil, tt.input)
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("%s: expected %v, got %v", tt.name, tt.expectedErr, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", tt.name, err)
+				continue
+			}
+			if len(output.Links) != 0 {
+				t.Errorf("%s: expected no links, got %d", tt.name, len(output.Links))
+			}
+		}
+	})
+}
+
+func TestManageHyperlinks_ListIncludeGeometry(t *testing.T) {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "test-pres",
+				Slides: []*slides.Page{
+					{
+						ObjectId: "slide-1",
+						PageElements: []*slides.PageElement{
+							{
+								ObjectId: "shape-1",
+								Size: &slides.Size{
+									Width:  &slides.Dimension{Magnitude: 2540000, Unit: "EMU"},
+									Height: &slides.Dimension{Magnitude: 635000, Unit: "EMU"},
+								},
+								Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: 1270000, TranslateY: 381000, Unit: "EMU"},
+								Shape: &slides.Shape{
+									ShapeType: "TEXT_BOX",
+									Text: &slides.TextContent{
+										TextElements: createTextElementsWithLink("Click here", "https://example.com"),
+									},
+								},
+							},
+							{
+								ObjectId: "table-1",
+								Size: &slides.Size{
+									Width:  &slides.Dimension{Magnitude: 3810000, Unit: "EMU"},
+									Height: &slides.Dimension{Magnitude: 1270000, Unit: "EMU"},
+								},
+								Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: 0, TranslateY: 2540000, Unit: "EMU"},
+								Table: &slides.Table{
+									TableRows: []*slides.TableRow{
+										{TableCells: []*slides.TableCell{
+											{Text: &slides.TextContent{TextElements: createTextElementsWithLink("Docs", "https://docs.example.com")}},
+										}},
+									},
+								},
+							},
+							{
+								ObjectId:  "group-1",
+								Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: 5080000, TranslateY: 635000, Unit: "EMU"},
+								ElementGroup: &slides.Group{Children: []*slides.PageElement{
+									{
+										ObjectId: "grouped-1",
+										Size: &slides.Size{
+											Width:  &slides.Dimension{Magnitude: 1270000, Unit: "EMU"},
+											Height: &slides.Dimension{Magnitude: 635000, Unit: "EMU"},
+										},
+										Transform: &slides.AffineTransform{ScaleX: 2, ScaleY: 0.5, TranslateX: 127000, TranslateY: 254000, Unit: "EMU"},
+										Shape: &slides.Shape{
+											ShapeType: "TEXT_BOX",
+											Text: &slides.TextContent{
+												TextElements: createTextElementsWithLink("Grouped", "https://grouped.example.com"),
+											},
+										},
+									},
+								}},
+							},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	tools := NewTools(DefaultToolsConfig(), createHyperlinkMockFactory(mockService))
+
+	t.Run("geometry is added for shape and table cell links", func(t *testing.T) {
+		output, err := tools.ManageHyperlinks(context.Background(), nil, ManageHyperlinksInput{
+			PresentationID:  "test-pres",
+			Action:          "list",
+			IncludeGeometry: true,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(output.Links) != 3 {
+			t.Fatalf("expected 3 links, got %d", len(output.Links))
+		}
+
+		shapeLink := output.Links[0]
+		if shapeLink.ObjectID != "shape-1" {
+			t.Fatalf("expected first link on shape-1, got %s", shapeLink.ObjectID)
+		}
+		if shapeLink.Position == nil || shapeLink.Position.X != 100 || shapeLink.Position.Y != 30 {
+			t.Errorf("expected shape position (100, 30), got %+v", shapeLink.Position)
+		}
+		if shapeLink.Size == nil || shapeLink.Size.Width != 200 || shapeLink.Size.Height != 50 {
+			t.Errorf("expected shape size 200x50, got %+v", shapeLink.Size)
+		}
+
+		cellLink := output.Links[1]
+		if cellLink.ObjectID != "table-1[0,0]" {
+			t.Fatalf("expected second link in table-1[0,0], got %s", cellLink.ObjectID)
+		}
+		if cellLink.Position == nil || cellLink.Position.X != 0 || cellLink.Position.Y != 200 {
+			t.Errorf("expected table position (0, 200), got %+v", cellLink.Position)
+		}
+		if cellLink.Size == nil || cellLink.Size.Width != 300 || cellLink.Size.Height != 100 {
+			t.Errorf("expected table size 300x100, got %+v", cellLink.Size)
+		}
+
+		groupedLink := output.Links[2]
+		if groupedLink.ObjectID != "grouped-1" {
+			t.Fatalf("expected third link on grouped-1, got %s", groupedLink.ObjectID)
+		}
+		if groupedLink.Position == nil || groupedLink.Position.X != 410 || groupedLink.Position.Y != 70 {
+			t.Errorf("expected grouped position on the page (410, 70), got %+v", groupedLink.Position)
+		}
+		if groupedLink.Size == nil || groupedLink.Size.Width != 200 || groupedLink.Size.Height != 25 {
+			t.Errorf("expected grouped displayed size 200x25, got %+v", groupedLink.Size)
+		}
+	})
+
+	t.Run("geometry is omitted by default", func(t *testing.T) {
+		output, err := tools.ManageHyperlinks(context.Background(), nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "list",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		for _, link := range output.Links {
+			if link.Position != nil || link.Size != nil {
+				t.Errorf("expected no geometry for %s, got %+v %+v", link.ObjectID, link.Position, link.Size)
+			}
+		}
+	})
+}
+
+func TestManageHyperlinks_Bulk(t *testing.T) {
+	ctx := context.Background()
+
+	presentation := &slides.Presentation{
+		PresentationId: "test-pres",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "text-1",
+						Shape: &slides.Shape{
+							Text: &slides.TextContent{TextElements: createTextElementsWithLink("Click here", "https://old.example.com")},
+						},
+					},
+					{
+						ObjectId: "image-1",
+						Image:    &slides.Image{},
+					},
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "text-2",
+						Shape: &slides.Shape{
+							Text: &slides.TextContent{TextElements: createTextElementsNoLink("Next")},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("mixes add, update and remove in a single batch", func(t *testing.T) {
+		batchCalls := 0
+		var capturedRequests []*slides.Request
+		mockService := &mockSlidesService{
+			GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+				return presentation, nil
+			},
+			BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+				batchCalls++
+				capturedRequests = requests
+				return &slides.BatchUpdatePresentationResponse{}, nil
+			},
+		}
+
+		tools := NewTools(DefaultToolsConfig(), createHyperlinkMockFactory(mockService))
+		output, err := tools.ManageHyperlinks(ctx, nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "bulk",
+			Operations: []HyperlinkOperation{
+				{Action: "update", ObjectID: "text-1", StartIndex: intPtr(0), EndIndex: intPtr(10), URL: "https://new.example.com"},
+				{Action: "remove", ObjectID: "image-1"},
+				{Action: "add", ObjectID: "text-2", StartIndex: intPtr(0), EndIndex: intPtr(4), URL: "#slide=1"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if batchCalls != 1 {
+			t.Errorf("expected 1 batch update call, got %d", batchCalls)
+		}
+		if len(capturedRequests) != 3 {
+			t.Fatalf("expected 3 requests, got %d", len(capturedRequests))
+		}
+
+		if req := capturedRequests[0].UpdateTextStyle; req == nil || req.ObjectId != "text-1" || req.Style.Link.Url != "https://new.example.com" {
+			t.Errorf("unexpected first request: %+v", capturedRequests[0])
+		}
+		if req := capturedRequests[1].UpdateImageProperties; req == nil || req.ObjectId != "image-1" || req.ImageProperties.Link != nil {
+			t.Errorf("expected image link removal, got %+v", capturedRequests[1])
+		}
+		if req := capturedRequests[2].UpdateTextStyle; req == nil || req.ObjectId != "text-2" || req.Style.Link.SlideIndex != 0 {
+			t.Errorf("unexpected third request: %+v", capturedRequests[2])
+		}
+
+		if !output.Success || output.SuccessCount != 3 || output.FailureCount != 0 {
+			t.Errorf("expected 3 successes, got success=%v count=%d failures=%d", output.Success, output.SuccessCount, output.FailureCount)
+		}
+		for i, result := range output.Results {
+			if result.Index != i || !result.Success {
+				t.Errorf("result %d: unexpected %+v", i, result)
+			}
+		}
+	})
+
+	t.Run("reports per-item failures and applies the rest", func(t *testing.T) {
+		var capturedRequests []*slides.Request
+		mockService := &mockSlidesService{
+			GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+				return presentation, nil
+			},
+			BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+				capturedRequests = requests
+				return &slides.BatchUpdatePresentationResponse{}, nil
+			},
+		}
+
+		tools := NewTools(DefaultToolsConfig(), createHyperlinkMockFactory(mockService))
+		output, err := tools.ManageHyperlinks(ctx, nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "bulk",
+			Operations: []HyperlinkOperation{
+				{Action: "add", ObjectID: "missing", URL: "https://example.com"},
+				{Action: "add", ObjectID: "text-2"},
+				{Action: "remove", ObjectID: "text-1", StartIndex: intPtr(0), EndIndex: intPtr(10)},
+				{Action: "rename", ObjectID: "text-1"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(capturedRequests) != 1 {
+			t.Fatalf("expected 1 request, got %d", len(capturedRequests))
+		}
+		if output.Success {
+			t.Error("expected overall success to be false")
+		}
+		if output.SuccessCount != 1 || output.FailureCount != 3 {
+			t.Errorf("expected 1 success and 3 failures, got %d and %d", output.SuccessCount, output.FailureCount)
+		}
+		expectedSuccess := []bool{false, false, true, false}
+		for i, result := range output.Results {
+			if result.Success != expectedSuccess[i] {
+				t.Errorf("result %d: expected success=%v, got %+v", i, expectedSuccess[i], result)
+			}
+			if !result.Success && result.Error == "" {
+				t.Errorf("result %d: expected error message", i)
+			}
+		}
+	})
+
+	t.Run("requires operations", func(t *testing.T) {
+		mockService := &mockSlidesService{
+			GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+				return presentation, nil
+			},
+		}
+
+		tools := NewTools(DefaultToolsConfig(), createHyperlinkMockFactory(mockService))
+		_, err := tools.ManageHyperlinks(ctx, nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "bulk",
+		})
+		if !errors.Is(err, ErrManageHyperlinksFailed) {
+			t.Errorf("expected ErrManageHyperlinksFailed, got %v", err)
+		}
+	})
+}
+
+func TestManageHyperlinks_BulkReportsProgress(t *testing.T) {
+	presentation := &slides.Presentation{
+		Slides: []*slides.Page{{
+			ObjectId: "slide-1",
+			PageElements: []*slides.PageElement{
+				{ObjectId: "text-1", Shape: &slides.Shape{Text: &slides.TextContent{TextElements: createTextElementsNoLink("Hello")}}},
+			},
+		}},
+	}
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	recorder := &progressRecorder{}
+	ctx := WithProgress(context.Background(), recorder)
+
+	tools := NewTools(DefaultToolsConfig(), createHyperlinkMockFactory(mockService))
+	_, err := tools.ManageHyperlinks(ctx, nil, ManageHyperlinksInput{
+		PresentationID: "test-pres",
+		Action:         "bulk",
+		Operations: []HyperlinkOperation{
+			{Action: "add", ObjectID: "text-1", URL: "https://example.com"},
+			{Action: "remove", ObjectID: "missing"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Failed operations still count as completed steps
+	if len(recorder.completed) != 3 {
+		t.Fatalf("expected 3 progress reports, got %d", len(recorder.completed))
+	}
+	if recorder.completed[2] != 3 || recorder.totals[2] != 3 {
+		t.Errorf("final report = %d/%d, want 3/3", recorder.completed[2], recorder.totals[2])
+	}
+}
+
+func TestManageHyperlinks_StyleLink(t *testing.T) {
+	ctx := context.Background()
+
+	presentation := &slides.Presentation{
+		PresentationId: "test-pres",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "text-1",
+						Shape: &slides.Shape{
+							Text: &slides.TextContent{TextElements: createTextElementsNoLink("Read the docs")},
+						},
+					},
+					{
+						ObjectId: "image-1",
+						Image:    &slides.Image{},
+					},
+				},
+			},
+		},
+	}
+
+	newTools := func(config ToolsConfig, captured *[]*slides.Request) *Tools {
+		mockService := &mockSlidesService{
+			GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+				return presentation, nil
+			},
+			BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+				*captured = requests
+				return &slides.BatchUpdatePresentationResponse{}, nil
+			},
+		}
+		return NewTools(config, createHyperlinkMockFactory(mockService))
+	}
+
+	assertStyleRequest := func(t *testing.T, req *slides.Request, objectID string, start, end int64, wantColor *slides.RgbColor) {
+		t.Helper()
+		style := req.UpdateTextStyle
+		if style == nil || style.ObjectId != objectID {
+			t.Fatalf("expected link style request on %s, got %+v", objectID, req)
+		}
+		if style.Fields != "foregroundColor,underline" || !style.Style.Underline || style.Style.Link != nil {
+			t.Errorf("expected color and underline only, got fields %q style %+v", style.Fields, style.Style)
+		}
+		if *style.TextRange.StartIndex != start || *style.TextRange.EndIndex != end {
+			t.Errorf("expected range %d-%d, got %d-%d", start, end, *style.TextRange.StartIndex, *style.TextRange.EndIndex)
+		}
+		if got := style.Style.ForegroundColor.OpaqueColor.RgbColor; got.Red != wantColor.Red || got.Green != wantColor.Green || got.Blue != wantColor.Blue {
+			t.Errorf("color = %+v, want %+v", got, wantColor)
+		}
+	}
+
+	t.Run("add applies the default link style after the link", func(t *testing.T) {
+		var captured []*slides.Request
+		tools := newTools(DefaultToolsConfig(), &captured)
+
+		_, err := tools.ManageHyperlinks(ctx, nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "add",
+			ObjectID:       "text-1",
+			StartIndex:     intPtr(4),
+			EndIndex:       intPtr(8),
+			URL:            "https://example.com/docs",
+			StyleLink:      true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(captured) != 2 {
+			t.Fatalf("expected link and style requests, got %d", len(captured))
+		}
+		if req := captured[0].UpdateTextStyle; req == nil || req.Fields != "link" {
+			t.Errorf("expected link request first, got %+v", captured[0])
+		}
+		assertStyleRequest(t, captured[1], "text-1", 4, 8, parseHexColor(DefaultLinkColor))
+	})
+
+	t.Run("configured color is used", func(t *testing.T) {
+		var captured []*slides.Request
+		config := DefaultToolsConfig()
+		config.LinkColor = "#FF0000"
+		tools := newTools(config, &captured)
+
+		_, err := tools.ManageHyperlinks(ctx, nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "add",
+			ObjectID:       "text-1",
+			StartIndex:     intPtr(0),
+			EndIndex:       intPtr(4),
+			URL:            "https://example.com",
+			StyleLink:      true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(captured) != 2 {
+			t.Fatalf("expected 2 requests, got %d", len(captured))
+		}
+		assertStyleRequest(t, captured[1], "text-1", 0, 4, &slides.RgbColor{Red: 1})
+	})
+
+	t.Run("without style_link only the link is set", func(t *testing.T) {
+		var captured []*slides.Request
+		tools := newTools(DefaultToolsConfig(), &captured)
+
+		_, err := tools.ManageHyperlinks(ctx, nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "add",
+			ObjectID:       "text-1",
+			StartIndex:     intPtr(0),
+			EndIndex:       intPtr(4),
+			URL:            "https://example.com",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(captured) != 1 {
+			t.Errorf("expected only the link request, got %d", len(captured))
+		}
+	})
+
+	t.Run("bulk styles add and update but not remove or image links", func(t *testing.T) {
+		var captured []*slides.Request
+		tools := newTools(DefaultToolsConfig(), &captured)
+
+		_, err := tools.ManageHyperlinks(ctx, nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "bulk",
+			LinkColor:      "#00FF00",
+			Operations: []HyperlinkOperation{
+				{Action: "update", ObjectID: "text-1", StartIndex: intPtr(0), EndIndex: intPtr(4), URL: "https://example.com", StyleLink: true},
+				{Action: "add", ObjectID: "image-1", URL: "https://example.com", StyleLink: true},
+				{Action: "remove", ObjectID: "text-1", StartIndex: intPtr(5), EndIndex: intPtr(8), StyleLink: true},
+				{Action: "add", ObjectID: "text-1", StartIndex: intPtr(9), EndIndex: intPtr(13), URL: "https://example.com/docs"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(captured) != 5 {
+			t.Fatalf("expected 5 requests, got %d", len(captured))
+		}
+		assertStyleRequest(t, captured[1], "text-1", 0, 4, &slides.RgbColor{Green: 1})
+		if captured[2].UpdateImageProperties == nil || captured[3].UpdateTextStyle.Fields != "link" || captured[4].UpdateTextStyle.Fields != "link" {
+			t.Errorf("expected unstyled image link, removal and plain add, got %+v %+v %+v", captured[2], captured[3], captured[4])
+		}
+	})
+
+	t.Run("invalid color", func(t *testing.T) {
+		var captured []*slides.Request
+		tools := newTools(DefaultToolsConfig(), &captured)
+
+		_, err := tools.ManageHyperlinks(ctx, nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "add",
+			ObjectID:       "text-1",
+			StartIndex:     intPtr(0),
+			EndIndex:       intPtr(4),
+			URL:            "https://example.com",
+			StyleLink:      true,
+			LinkColor:      "blue",
+		})
+		if !errors.Is(err, ErrInvalidLinkColor) {
+			t.Errorf("expected ErrInvalidLinkColor, got %v", err)
+		}
+	})
+}
+
+func TestManageHyperlinks_ListCSV(t *testing.T) {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "test-pres",
+				Slides: []*slides.Page{
+					{
+						ObjectId: "slide-1",
+						PageElements: []*slides.PageElement{
+							{
+								ObjectId: "shape-1",
+								Shape: &slides.Shape{
+									ShapeType: "TEXT_BOX",
+									Text:      &slides.TextContent{TextElements: createTextElementsWithLink("Docs, v2", "https://example.com/docs")},
+								},
+							},
+						},
+					},
+					{
+						ObjectId: "slide-2",
+						PageElements: []*slides.PageElement{
+							{
+								ObjectId: "shape-2",
+								Shape: &slides.Shape{
+									ShapeType: "TEXT_BOX",
+									Text: &slides.TextContent{TextElements: []*slides.TextElement{{
+										TextRun: &slides.TextRun{
+											Content: "Back",
+											Style:   &slides.TextStyle{Link: &slides.Link{PageObjectId: "slide-1"}},
+										},
+									}}},
+								},
+							},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	tools := NewTools(DefaultToolsConfig(), createHyperlinkMockFactory(mockService))
+
+	t.Run("csv replaces the links list", func(t *testing.T) {
+		output, err := tools.ManageHyperlinks(context.Background(), nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "list",
+			Format:         "CSV",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if output.Links != nil {
+			t.Errorf("expected no links in csv format, got %d", len(output.Links))
+		}
+
+		want := "slide_index,object_id,object_type,link_type,target,text\n" +
+			"1,shape-1,TEXT_BOX,external,https://example.com/docs,\"Docs, v2\"\n" +
+			"2,shape-2,TEXT_BOX,internal_slide,slide-1,Back\n"
+		if output.CSV != want {
+			t.Errorf("unexpected CSV:\n%s\nwant:\n%s", output.CSV, want)
+		}
+	})
+
+	t.Run("json is the default", func(t *testing.T) {
+		output, err := tools.ManageHyperlinks(context.Background(), nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "list",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(output.Links) != 2 || output.CSV != "" {
+			t.Errorf("expected 2 links and no CSV, got %d links, CSV %q", len(output.Links), output.CSV)
+		}
+	})
+
+	t.Run("unknown format is rejected", func(t *testing.T) {
+		_, err := tools.ManageHyperlinks(context.Background(), nil, ManageHyperlinksInput{
+			PresentationID: "test-pres",
+			Action:         "list",
+			Format:         "xml",
+		})
+		if !errors.Is(err, ErrInvalidLinkListFormat) {
+			t.Errorf("expected ErrInvalidLinkListFormat, got %v", err)
+		}
+	})
+}
+
+func TestEncodeHyperlinksCSV_Empty(t *testing.T) {
+	got, err := encodeHyperlinksCSV(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "slide_index,object_id,object_type,link_type,target,text\n" {
+		t.Errorf("expected only the header, got %q", got)
+	}
 }
@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+)
+
+// Sentinel errors for get_locale and set_locale tools.
+var (
+	ErrLocaleNotSupported = errors.New("setting the presentation locale is not supported")
+	ErrInvalidLocale      = errors.New("invalid locale")
+)
+
+// GetLocaleInput represents the input for the get_locale tool.
+type GetLocaleInput struct {
+	PresentationID string `json:"presentation_id"`
+}
+
+// GetLocaleOutput represents the output of the get_locale tool.
+type GetLocaleOutput struct {
+	PresentationID string `json:"presentation_id"`
+	Locale         string `json:"locale"` // IETF BCP 47 tag, e.g. "en-US"; empty if the API reports none
+}
+
+// SetLocaleInput represents the input for the set_locale tool.
+type SetLocaleInput struct {
+	PresentationID string `json:"presentation_id"`
+	Locale         string `json:"locale"` // IETF BCP 47 tag, e.g. "fr-FR"
+}
+
+// SetLocaleOutput represents the output of the set_locale tool.
+type SetLocaleOutput struct {
+	PresentationID string `json:"presentation_id"`
+	Locale         string `json:"locale"`
+}
+
+// GetLocale returns the presentation's locale, which drives spellcheck and screen reader language.
+func (t *Tools) GetLocale(ctx context.Context, tokenSource oauth2.TokenSource, input GetLocaleInput) (*GetLocaleOutput, error) {
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	t.config.Logger.Info("getting presentation locale",
+		slog.String("presentation_id", input.PresentationID),
+	)
+
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	return &GetLocaleOutput{
+		PresentationID: input.PresentationID,
+		Locale:         presentation.Locale,
+	}, nil
+}
+
+// SetLocale always fails: the Slides API exposes the locale as read-only and has no batch
+// request that changes it, and Drive file metadata does not carry a document language.
+// The tool exists so callers get an explicit answer instead of a missing tool.
+func (t *Tools) SetLocale(_ context.Context, _ oauth2.TokenSource, input SetLocaleInput) (*SetLocaleOutput, error) {
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.Locale == "" {
+		return nil, fmt.Errorf("%w: locale is required", ErrInvalidLocale)
+	}
+
+	t.config.Logger.Info("set locale requested but not supported",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("locale", input.Locale),
+	)
+
+	return nil, fmt.Errorf("%w: the Google Slides API treats the locale as read-only; change it in the Slides UI under File > Language", ErrLocaleNotSupported)
+}
@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestGetLocale(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      GetLocaleInput
+		getErr     error
+		wantLocale string
+		wantErr    error
+	}{
+		{name: "returns presentation locale", input: GetLocaleInput{PresentationID: "pres"}, wantLocale: "fr-FR"},
+		{name: "missing presentation ID", input: GetLocaleInput{}, wantErr: ErrInvalidPresentationID},
+		{name: "presentation not found", input: GetLocaleInput{PresentationID: "pres"}, getErr: errors.New("googleapi: Error 404: not found"), wantErr: ErrPresentationNotFound},
+		{name: "access denied", input: GetLocaleInput{PresentationID: "pres"}, getErr: errors.New("googleapi: Error 403: forbidden"), wantErr: ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &slides.Presentation{PresentationId: presentationID, Locale: "fr-FR"}, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			output, err := tools.GetLocale(context.Background(), &mockTokenSource{}, tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.Locale != tt.wantLocale {
+				t.Errorf("Locale = %q, want %q", output.Locale, tt.wantLocale)
+			}
+		})
+	}
+}
+
+func TestSetLocale_NotSupported(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	tests := []struct {
+		name    string
+		input   SetLocaleInput
+		wantErr error
+	}{
+		{name: "valid input", input: SetLocaleInput{PresentationID: "pres", Locale: "de-DE"}, wantErr: ErrLocaleNotSupported},
+		{name: "missing presentation ID", input: SetLocaleInput{Locale: "de-DE"}, wantErr: ErrInvalidPresentationID},
+		{name: "missing locale", input: SetLocaleInput{PresentationID: "pres"}, wantErr: ErrInvalidLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tools.SetLocale(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
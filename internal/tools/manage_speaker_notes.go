@@ -13,19 +13,27 @@ import (
 
 // Sentinel errors for manage_speaker_notes tool.
 var (
-	ErrManageSpeakerNotesFailed = errors.New("failed to manage speaker notes")
+	ErrManageSpeakerNotesFailed  = errors.New("failed to manage speaker notes")
 	ErrInvalidSpeakerNotesAction = errors.New("invalid speaker notes action")
 	ErrNotesTextRequired         = errors.New("notes_text is required for this action")
 	ErrNotesShapeNotFound        = errors.New("speaker notes shape not found")
+	ErrInvalidNotesRuns          = errors.New("invalid notes runs")
 )
 
 // ManageSpeakerNotesInput represents the input for the manage_speaker_notes tool.
 type ManageSpeakerNotesInput struct {
-	PresentationID string `json:"presentation_id"`
-	SlideIndex     int    `json:"slide_index,omitempty"` // 1-based index
-	SlideID        string `json:"slide_id,omitempty"`
-	Action         string `json:"action"`              // "get" | "set" | "append" | "clear"
-	NotesText      string `json:"notes_text,omitempty"` // Required for "set" and "append"
+	PresentationID string          `json:"presentation_id"`
+	SlideIndex     int             `json:"slide_index,omitempty"` // 1-based index
+	SlideID        string          `json:"slide_id,omitempty"`
+	Action         string          `json:"action"`               // "get" | "set" | "append" | "clear"
+	NotesText      string          `json:"notes_text,omitempty"` // Required for "set" and "append" unless runs are given
+	Runs           []NotesRunInput `json:"runs,omitempty"`       // Styled alternative to notes_text for "set" and "append"
+}
+
+// NotesRunInput is a piece of speaker notes text with an optional style.
+type NotesRunInput struct {
+	Text  string              `json:"text"`
+	Style *StyleTextStyleSpec `json:"style,omitempty"`
 }
 
 // ManageSpeakerNotesOutput represents the output of the manage_speaker_notes tool.
@@ -58,6 +66,24 @@ func (t *Tools) ManageSpeakerNotes(ctx context.Context, tokenSource oauth2.Token
 		return nil, fmt.Errorf("%w: action must be 'get', 'set', 'append', or 'clear'", ErrInvalidSpeakerNotesAction)
 	}
 
+	// Styled runs replace notes_text and only apply to set and append
+	if len(input.Runs) > 0 {
+		if action != "set" && action != "append" {
+			return nil, fmt.Errorf("%w: runs are only supported for 'set' and 'append' actions", ErrInvalidNotesRuns)
+		}
+		if input.NotesText != "" {
+			return nil, fmt.Errorf("%w: provide either notes_text or runs, not both", ErrInvalidNotesRuns)
+		}
+		var sb strings.Builder
+		for i, run := range input.Runs {
+			if run.Text == "" {
+				return nil, fmt.Errorf("%w: runs[%d].text is required", ErrInvalidNotesRuns, i)
+			}
+			sb.WriteString(run.Text)
+		}
+		input.NotesText = sb.String()
+	}
+
 	// Notes text is required for set and append
 	if (action == "set" || action == "append") && input.NotesText == "" {
 		return nil, fmt.Errorf("%w: notes_text is required for '%s' action", ErrNotesTextRequired, action)
@@ -134,6 +160,15 @@ func (t *Tools) ManageSpeakerNotes(ctx context.Context, tokenSource oauth2.Token
 	// Build requests based on action
 	requests, expectedNotes := buildSpeakerNotesRequests(notesShapeID, action, input.NotesText, currentNotes)
 
+	// Style each run after its text has been inserted
+	if len(input.Runs) > 0 {
+		baseIndex := 0
+		if action == "append" {
+			baseIndex = utf16Len(currentNotes)
+		}
+		requests = append(requests, buildNotesRunStyleRequests(notesShapeID, baseIndex, input.Runs)...)
+	}
+
 	// Execute batch update if there are requests
 	if len(requests) > 0 {
 		_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
@@ -235,8 +270,8 @@ func buildSpeakerNotesRequests(shapeID, action, notesText, currentNotes string)
 		expectedNotes = notesText
 
 	case "append":
-		// Insert text at the end
-		insertionIdx := len(currentNotes)
+		// Insert text at the end; the API counts UTF-16 code units, not bytes
+		insertionIdx := utf16Len(currentNotes)
 		requests = append(requests, &slides.Request{
 			InsertText: &slides.InsertTextRequest{
 				ObjectId:       shapeID,
@@ -263,3 +298,32 @@ func buildSpeakerNotesRequests(shapeID, action, notesText, currentNotes string)
 
 	return requests, expectedNotes
 }
+
+// buildNotesRunStyleRequests creates UpdateTextStyle requests for styled runs inserted
+// at baseIndex in the notes shape. Runs without a style are skipped.
+func buildNotesRunStyleRequests(shapeID string, baseIndex int, runs []NotesRunInput) []*slides.Request {
+	var requests []*slides.Request
+
+	offset := baseIndex
+	for _, run := range runs {
+		start := offset
+		end := offset + utf16Len(run.Text)
+		offset = end
+
+		if run.Style == nil {
+			continue
+		}
+
+		request, _ := buildStyleTextRequest(StyleTextInput{
+			ObjectID:   shapeID,
+			StartIndex: &start,
+			EndIndex:   &end,
+			Style:      run.Style,
+		})
+		if request != nil {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
@@ -1054,3 +1054,214 @@ func TestFindSpeakerNotesShape(t *testing.T) {
 		})
 	}
 }
+
+func TestManageSpeakerNotes_StyledRuns(t *testing.T) {
+	ctx := context.Background()
+	boolTrue := true
+
+	tests := []struct {
+		name          string
+		action        string
+		existingNotes string
+		runs          []NotesRunInput
+		wantContent   string
+		wantRanges    [][2]int64
+	}{
+		{
+			name:          "set with styled runs",
+			action:        "set",
+			existingNotes: "Old notes",
+			runs: []NotesRunInput{
+				{Text: "Key: ", Style: &StyleTextStyleSpec{Bold: &boolTrue}},
+				{Text: "remember this"},
+				{Text: "!", Style: &StyleTextStyleSpec{ForegroundColor: "#FF0000"}},
+			},
+			wantContent: "Key: remember this!",
+			wantRanges:  [][2]int64{{0, 5}, {18, 19}},
+		},
+		{
+			name:          "append offsets styles after existing notes",
+			action:        "append",
+			existingNotes: "Intro",
+			runs: []NotesRunInput{
+				{Text: " detail", Style: &StyleTextStyleSpec{Italic: &boolTrue}},
+			},
+			wantContent: "Intro detail",
+			wantRanges:  [][2]int64{{5, 12}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedRequests []*slides.Request
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return createPresentationWithSpeakerNotes("slide-1", "notes-shape-1", tt.existingNotes), nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					capturedRequests = requests
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			output, err := tools.ManageSpeakerNotes(ctx, nil, ManageSpeakerNotesInput{
+				PresentationID: "test-presentation",
+				SlideIndex:     1,
+				Action:         tt.action,
+				Runs:           tt.runs,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.NotesContent != tt.wantContent {
+				t.Errorf("NotesContent = %q, want %q", output.NotesContent, tt.wantContent)
+			}
+
+			var styleRequests []*slides.UpdateTextStyleRequest
+			for _, req := range capturedRequests {
+				if req.UpdateTextStyle != nil {
+					styleRequests = append(styleRequests, req.UpdateTextStyle)
+				}
+			}
+			if len(styleRequests) != len(tt.wantRanges) {
+				t.Fatalf("expected %d style requests, got %d", len(tt.wantRanges), len(styleRequests))
+			}
+
+			// Style requests must follow the text insertion
+			if capturedRequests[len(capturedRequests)-len(styleRequests)].UpdateTextStyle == nil {
+				t.Error("expected style requests after insert requests")
+			}
+
+			for i, req := range styleRequests {
+				if req.ObjectId != "notes-shape-1" {
+					t.Errorf("style request %d ObjectId = %q, want notes-shape-1", i, req.ObjectId)
+				}
+				if req.TextRange == nil || req.TextRange.Type != "FIXED_RANGE" {
+					t.Fatalf("style request %d expected FIXED_RANGE", i)
+				}
+				if *req.TextRange.StartIndex != tt.wantRanges[i][0] || *req.TextRange.EndIndex != tt.wantRanges[i][1] {
+					t.Errorf("style request %d range = [%d,%d), want [%d,%d)", i,
+						*req.TextRange.StartIndex, *req.TextRange.EndIndex, tt.wantRanges[i][0], tt.wantRanges[i][1])
+				}
+			}
+		})
+	}
+}
+
+func TestManageSpeakerNotes_StyledRunsValidation(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		input   ManageSpeakerNotesInput
+		wantErr error
+	}{
+		{
+			name: "runs with notes_text",
+			input: ManageSpeakerNotesInput{
+				PresentationID: "test-presentation",
+				SlideIndex:     1,
+				Action:         "set",
+				NotesText:      "text",
+				Runs:           []NotesRunInput{{Text: "run"}},
+			},
+			wantErr: ErrInvalidNotesRuns,
+		},
+		{
+			name: "runs with get action",
+			input: ManageSpeakerNotesInput{
+				PresentationID: "test-presentation",
+				SlideIndex:     1,
+				Action:         "get",
+				Runs:           []NotesRunInput{{Text: "run"}},
+			},
+			wantErr: ErrInvalidNotesRuns,
+		},
+		{
+			name: "empty run text",
+			input: ManageSpeakerNotesInput{
+				PresentationID: "test-presentation",
+				SlideIndex:     1,
+				Action:         "set",
+				Runs:           []NotesRunInput{{Text: ""}},
+			},
+			wantErr: ErrInvalidNotesRuns,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return &mockSlidesService{}, nil
+			})
+
+			_, err := tools.ManageSpeakerNotes(ctx, nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestManageSpeakerNotes_StyledRunsNoNotesShape(t *testing.T) {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return createPresentationWithoutSpeakerNotes("slide-1"), nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	_, err := tools.ManageSpeakerNotes(context.Background(), nil, ManageSpeakerNotesInput{
+		PresentationID: "test-presentation",
+		SlideIndex:     1,
+		Action:         "set",
+		Runs:           []NotesRunInput{{Text: "run"}},
+	})
+	if !errors.Is(err, ErrNotesShapeNotFound) {
+		t.Errorf("expected ErrNotesShapeNotFound, got %v", err)
+	}
+}
+
+func TestBuildNotesRunStyleRequests_UTF16Indices(t *testing.T) {
+	bold := &StyleTextStyleSpec{Bold: boolPtr(true)}
+	requests := buildNotesRunStyleRequests("notes-shape", utf16Len("Intro 🎤\n"), []NotesRunInput{
+		{Text: "🔥 Hot take: "},
+		{Text: "ship it", Style: bold},
+		{Text: " ✅", Style: bold},
+	})
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 style requests, got %d", len(requests))
+	}
+
+	// "Intro 🎤\n" is 9 units and "🔥 Hot take: " is 13, so "ship it" starts at 22
+	want := [][2]int64{{22, 29}, {29, 31}}
+	for i, request := range requests {
+		textRange := request.UpdateTextStyle.TextRange
+		if textRange.Type != "FIXED_RANGE" || *textRange.StartIndex != want[i][0] || *textRange.EndIndex != want[i][1] {
+			t.Errorf("request %d: range %s %d-%d, want FIXED_RANGE %d-%d",
+				i, textRange.Type, *textRange.StartIndex, *textRange.EndIndex, want[i][0], want[i][1])
+		}
+	}
+}
+
+func TestBuildSpeakerNotesRequests_AppendUTF16Index(t *testing.T) {
+	// "Intro 🎤 café" is 16 bytes but 13 UTF-16 code units
+	requests, expectedNotes := buildSpeakerNotesRequests("notes-1", "append", " ✅", "Intro 🎤 café")
+
+	if len(requests) != 1 || requests[0].InsertText == nil {
+		t.Fatalf("expected a single InsertText request, got %+v", requests)
+	}
+	if got := requests[0].InsertText.InsertionIndex; got != 13 {
+		t.Errorf("InsertionIndex = %d, want 13", got)
+	}
+	if expectedNotes != "Intro 🎤 café ✅" {
+		t.Errorf("expectedNotes = %q", expectedNotes)
+	}
+}
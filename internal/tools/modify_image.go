@@ -4,7 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"image"
+	_ "image/gif" // Register decoders for image.DecodeConfig
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
 	"log/slog"
+	"math"
+	"strconv"
 	"strings"
 
 	"golang.org/x/oauth2"
@@ -20,24 +27,30 @@ var (
 	ErrInvalidBrightnessValue = errors.New("brightness must be between -1 and 1")
 	ErrInvalidContrastValue   = errors.New("contrast must be between -1 and 1")
 	ErrInvalidTransparency    = errors.New("transparency must be between 0 and 1")
+	ErrInvalidCropRect        = errors.New("invalid pixel crop rectangle")
+	ErrInvalidAspectRatio     = errors.New("invalid aspect ratio")
 )
 
 // ModifyImageInput represents the input for the modify_image tool.
 type ModifyImageInput struct {
-	PresentationID string                `json:"presentation_id"`
-	ObjectID       string                `json:"object_id"`
+	PresentationID string                 `json:"presentation_id"`
+	ObjectID       string                 `json:"object_id"`
 	Properties     *ImageModifyProperties `json:"properties"`
+
+	ReturnFinalState bool `json:"return_final_state,omitempty"` // Re-fetch and report the resulting geometry
 }
 
 // ImageModifyProperties represents the image properties to modify.
 type ImageModifyProperties struct {
-	Position     *PositionInput     `json:"position,omitempty"`     // Position in points
-	Size         *SizeInput         `json:"size,omitempty"`         // Size in points
-	Crop         *CropInput         `json:"crop,omitempty"`         // Crop percentages (0-1)
-	Brightness   *float64           `json:"brightness,omitempty"`   // -1 to 1
-	Contrast     *float64           `json:"contrast,omitempty"`     // -1 to 1
-	Transparency *float64           `json:"transparency,omitempty"` // 0 to 1
-	Recolor      *string            `json:"recolor,omitempty"`      // Preset name or "none" to remove
+	Position     *PositionInput `json:"position,omitempty"`       // Position in points
+	Size         *SizeInput     `json:"size,omitempty"`           // Size in points
+	Crop         *CropInput     `json:"crop,omitempty"`           // Crop percentages (0-1)
+	CropPixels   *CropPixels    `json:"crop_pixels,omitempty"`    // Alternative to Crop: region to keep, in image pixels
+	CropToAspect string         `json:"crop_to_aspect,omitempty"` // Alternative to Crop: center-crop to a ratio like "16:9"
+	Brightness   *float64       `json:"brightness,omitempty"`     // -1 to 1
+	Contrast     *float64       `json:"contrast,omitempty"`       // -1 to 1
+	Transparency *float64       `json:"transparency,omitempty"`   // 0 to 1
+	Recolor      *string        `json:"recolor,omitempty"`        // Preset name or "none" to remove
 }
 
 // CropInput represents crop values for an image.
@@ -48,10 +61,26 @@ type CropInput struct {
 	Right  *float64 `json:"right,omitempty"`  // 0-1 percentage from right
 }
 
+// CropPixels describes the region of an image to keep, in the image's own pixels.
+// ImageWidth and ImageHeight give the natural size; when omitted it is read from the image.
+type CropPixels struct {
+	X           int `json:"x"`      // Left edge of the kept region
+	Y           int `json:"y"`      // Top edge of the kept region
+	Width       int `json:"width"`  // Width of the kept region
+	Height      int `json:"height"` // Height of the kept region
+	ImageWidth  int `json:"image_width,omitempty"`
+	ImageHeight int `json:"image_height,omitempty"`
+}
+
 // ModifyImageOutput represents the output of the modify_image tool.
 type ModifyImageOutput struct {
-	ObjectID          string   `json:"object_id"`
+	ObjectID           string   `json:"object_id"`
 	ModifiedProperties []string `json:"modified_properties"`
+	Summary            string   `json:"summary"` // Human-readable description of the changes
+
+	AppliedCrop *CropInput `json:"applied_crop,omitempty"` // Fractions sent to the API for crop_pixels or crop_to_aspect
+
+	FinalState *ObjectState `json:"final_state,omitempty"` // Only with return_final_state
 }
 
 // ModifyImage modifies properties of an existing image.
@@ -119,8 +148,35 @@ func (t *Tools) ModifyImage(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, fmt.Errorf("%w: object '%s' is not an image (type: %s)", ErrNotImageObject, input.ObjectID, determineObjectType(targetElement))
 	}
 
+	// Convert a pixel crop to the fractional offsets the API expects
+	props := input.Properties
+	var appliedCrop *CropInput
+	if props.CropPixels != nil {
+		width, height, err := t.naturalImageSize(ctx, props.CropPixels, targetElement.Image)
+		if err != nil {
+			return nil, err
+		}
+		appliedCrop, err = cropPixelsToFractions(props.CropPixels, width, height)
+		if err != nil {
+			return nil, err
+		}
+		converted := *props
+		converted.Crop = appliedCrop
+		props = &converted
+	} else if props.CropToAspect != "" {
+		ratio, _ := parseAspectRatio(props.CropToAspect) // Checked by validateImageProperties
+		width, height, err := t.readImageSize(ctx, targetElement.Image)
+		if err != nil {
+			return nil, fmt.Errorf("%w: cannot crop to %s: %v", ErrModifyImageFailed, props.CropToAspect, err)
+		}
+		appliedCrop = aspectCropFractions(ratio, width, height)
+		converted := *props
+		converted.Crop = appliedCrop
+		props = &converted
+	}
+
 	// Build requests and track modified properties
-	requests, modifiedProps := buildModifyImageRequests(input.ObjectID, input.Properties, targetElement)
+	requests, modifiedProps := buildModifyImageRequests(input.ObjectID, props, targetElement)
 
 	if len(requests) == 0 {
 		return nil, ErrNoImageProperties
@@ -139,8 +195,14 @@ func (t *Tools) ModifyImage(ctx context.Context, tokenSource oauth2.TokenSource,
 	}
 
 	output := &ModifyImageOutput{
-		ObjectID:          input.ObjectID,
+		ObjectID:           input.ObjectID,
 		ModifiedProperties: modifiedProps,
+		Summary:            fmt.Sprintf("Modified %s on image '%s'", strings.Join(modifiedProps, ", "), input.ObjectID),
+		AppliedCrop:        appliedCrop,
+	}
+
+	if input.ReturnFinalState {
+		output.FinalState = t.readBackObjectState(ctx, slidesService, input.PresentationID, input.ObjectID)
 	}
 
 	t.config.Logger.Info("image modified successfully",
@@ -154,6 +216,24 @@ func (t *Tools) ModifyImage(ctx context.Context, tokenSource oauth2.TokenSource,
 
 // validateImageProperties validates the input property values.
 func validateImageProperties(props *ImageModifyProperties) error {
+	if props.CropPixels != nil {
+		if props.Crop != nil {
+			return fmt.Errorf("%w: crop and crop_pixels cannot be combined", ErrInvalidCropRect)
+		}
+		if err := validateCropPixels(props.CropPixels); err != nil {
+			return err
+		}
+	}
+
+	if props.CropToAspect != "" {
+		if props.Crop != nil || props.CropPixels != nil {
+			return fmt.Errorf("%w: crop_to_aspect cannot be combined with crop or crop_pixels", ErrInvalidAspectRatio)
+		}
+		if _, err := parseAspectRatio(props.CropToAspect); err != nil {
+			return err
+		}
+	}
+
 	if props.Crop != nil {
 		if err := validateCropValues(props.Crop); err != nil {
 			return err
@@ -210,6 +290,124 @@ func validateCropValues(crop *CropInput) error {
 	return nil
 }
 
+// validateCropPixels checks the parts of a pixel crop that do not depend on the image size.
+func validateCropPixels(crop *CropPixels) error {
+	if crop.X < 0 || crop.Y < 0 {
+		return fmt.Errorf("%w: x and y must be non-negative", ErrInvalidCropRect)
+	}
+	if crop.Width <= 0 || crop.Height <= 0 {
+		return fmt.Errorf("%w: width and height must be positive", ErrInvalidCropRect)
+	}
+	if crop.ImageWidth < 0 || crop.ImageHeight < 0 || (crop.ImageWidth == 0) != (crop.ImageHeight == 0) {
+		return fmt.Errorf("%w: image_width and image_height must both be positive or both omitted", ErrInvalidCropRect)
+	}
+	return nil
+}
+
+// naturalImageSize returns the image's size in pixels, from the input when given or else
+// by downloading the image and reading its header.
+func (t *Tools) naturalImageSize(ctx context.Context, crop *CropPixels, img *slides.Image) (int, int, error) {
+	if crop.ImageWidth > 0 && crop.ImageHeight > 0 {
+		return crop.ImageWidth, crop.ImageHeight, nil
+	}
+
+	width, height, err := t.readImageSize(ctx, img)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v; provide image_width and image_height", ErrInvalidCropRect, err)
+	}
+	return width, height, nil
+}
+
+// readImageSize downloads the image and reads its size in pixels from the header.
+func (t *Tools) readImageSize(ctx context.Context, img *slides.Image) (int, int, error) {
+	if img.ContentUrl == "" {
+		return 0, 0, errors.New("image has no content URL")
+	}
+
+	config, format, err := fetchImageConfig(ctx, img.ContentUrl)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	t.config.Logger.Debug("read natural image size",
+		slog.String("format", format),
+		slog.Int("width", config.Width),
+		slog.Int("height", config.Height),
+	)
+
+	return config.Width, config.Height, nil
+}
+
+// fetchImageConfig downloads just enough of an image to decode its header, so its size is known
+// without reading the whole file.
+func fetchImageConfig(ctx context.Context, url string) (image.Config, string, error) {
+	body, err := openImageURL(ctx, url)
+	if err != nil {
+		return image.Config{}, "", fmt.Errorf("failed to download image to read its size: %v", err)
+	}
+	defer body.Close()
+
+	config, format, err := image.DecodeConfig(io.LimitReader(body, maxImageFetchBytes))
+	if err != nil {
+		return image.Config{}, "", fmt.Errorf("cannot read image size (%v)", err)
+	}
+	return config, format, nil
+}
+
+// cropPixelsToFractions converts a kept pixel region to the API's crop offsets, each a fraction
+// of the image size trimmed from that edge. The result is checked with validateCropValues.
+func cropPixelsToFractions(crop *CropPixels, imageWidth, imageHeight int) (*CropInput, error) {
+	if imageWidth <= 0 || imageHeight <= 0 {
+		return nil, fmt.Errorf("%w: image size %dx%d is invalid", ErrInvalidCropRect, imageWidth, imageHeight)
+	}
+	if crop.X+crop.Width > imageWidth || crop.Y+crop.Height > imageHeight {
+		return nil, fmt.Errorf("%w: region %dx%d at (%d,%d) exceeds image size %dx%d",
+			ErrInvalidCropRect, crop.Width, crop.Height, crop.X, crop.Y, imageWidth, imageHeight)
+	}
+
+	w := float64(imageWidth)
+	h := float64(imageHeight)
+	left := float64(crop.X) / w
+	top := float64(crop.Y) / h
+	right := float64(imageWidth-crop.X-crop.Width) / w
+	bottom := float64(imageHeight-crop.Y-crop.Height) / h
+
+	fractions := &CropInput{Top: &top, Bottom: &bottom, Left: &left, Right: &right}
+	if err := validateCropValues(fractions); err != nil {
+		return nil, err
+	}
+	return fractions, nil
+}
+
+// parseAspectRatio parses a "width:height" ratio such as "16:9" or "1.91:1" into width/height.
+func parseAspectRatio(value string) (float64, error) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("%w: '%s' must have the form 'width:height', e.g. '16:9'", ErrInvalidAspectRatio, value)
+	}
+	width, errW := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	height, errH := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if errW != nil || errH != nil || width <= 0 || height <= 0 || math.IsInf(width, 0) || math.IsInf(height, 0) {
+		return 0, fmt.Errorf("%w: '%s' must use two positive numbers, e.g. '16:9'", ErrInvalidAspectRatio, value)
+	}
+	return width / height, nil
+}
+
+// aspectCropFractions center-crops an image to the given width/height ratio, trimming equal
+// amounts from the two edges of the dimension that is too long.
+func aspectCropFractions(ratio float64, imageWidth, imageHeight int) *CropInput {
+	var top, bottom, left, right float64
+	imageRatio := float64(imageWidth) / float64(imageHeight)
+	if imageRatio > ratio {
+		left = (1 - ratio/imageRatio) / 2
+		right = left
+	} else {
+		top = (1 - imageRatio/ratio) / 2
+		bottom = top
+	}
+	return &CropInput{Top: &top, Bottom: &bottom, Left: &left, Right: &right}
+}
+
 // hasImagePropertiesToModify checks if any image properties are set.
 func hasImagePropertiesToModify(props *ImageModifyProperties) bool {
 	if props == nil {
@@ -218,6 +416,8 @@ func hasImagePropertiesToModify(props *ImageModifyProperties) bool {
 	return props.Position != nil ||
 		props.Size != nil ||
 		props.Crop != nil ||
+		props.CropPixels != nil ||
+		props.CropToAspect != "" ||
 		props.Brightness != nil ||
 		props.Contrast != nil ||
 		props.Transparency != nil ||
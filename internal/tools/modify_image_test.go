@@ -1,8 +1,14 @@
 package tools
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"image"
+	"image/png"
+	"math"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"golang.org/x/oauth2"
@@ -33,8 +39,8 @@ func TestModifyImage_Success(t *testing.T) {
 									Unit:       "EMU",
 								},
 								Size: &slides.Size{
-									Width:  &slides.Dimension{Magnitude: 200 * 12700, Unit: "EMU"},
-									Height: &slides.Dimension{Magnitude: 150 * 12700, Unit: "EMU"},
+									Width:  &slides.Dimension{Magnitude: 200 * emuPerPoint, Unit: "EMU"},
+									Height: &slides.Dimension{Magnitude: 150 * emuPerPoint, Unit: "EMU"},
 								},
 							},
 						},
@@ -76,6 +82,10 @@ func TestModifyImage_Success(t *testing.T) {
 		t.Errorf("expected modified properties [brightness], got %v", output.ModifiedProperties)
 	}
 
+	if output.Summary != "Modified brightness on image 'image-1'" {
+		t.Errorf("unexpected summary: %s", output.Summary)
+	}
+
 	// Verify UpdateImageProperties request was made
 	found := false
 	for _, req := range capturedRequests {
@@ -111,13 +121,13 @@ func TestModifyImage_Position(t *testing.T) {
 								Transform: &slides.AffineTransform{
 									ScaleX:     1,
 									ScaleY:     1,
-									TranslateX: 100 * 12700,
-									TranslateY: 50 * 12700,
+									TranslateX: 100 * emuPerPoint,
+									TranslateY: 50 * emuPerPoint,
 									Unit:       "EMU",
 								},
 								Size: &slides.Size{
-									Width:  &slides.Dimension{Magnitude: 200 * 12700, Unit: "EMU"},
-									Height: &slides.Dimension{Magnitude: 150 * 12700, Unit: "EMU"},
+									Width:  &slides.Dimension{Magnitude: 200 * emuPerPoint, Unit: "EMU"},
+									Height: &slides.Dimension{Magnitude: 150 * emuPerPoint, Unit: "EMU"},
 								},
 							},
 						},
@@ -192,13 +202,13 @@ func TestModifyImage_Size(t *testing.T) {
 								Transform: &slides.AffineTransform{
 									ScaleX:     1,
 									ScaleY:     1,
-									TranslateX: 100 * 12700,
-									TranslateY: 50 * 12700,
+									TranslateX: 100 * emuPerPoint,
+									TranslateY: 50 * emuPerPoint,
 									Unit:       "EMU",
 								},
 								Size: &slides.Size{
-									Width:  &slides.Dimension{Magnitude: 200 * 12700, Unit: "EMU"},
-									Height: &slides.Dimension{Magnitude: 150 * 12700, Unit: "EMU"},
+									Width:  &slides.Dimension{Magnitude: 200 * emuPerPoint, Unit: "EMU"},
+									Height: &slides.Dimension{Magnitude: 150 * emuPerPoint, Unit: "EMU"},
 								},
 							},
 						},
@@ -644,13 +654,13 @@ func TestModifyImage_MultipleProperties(t *testing.T) {
 								Transform: &slides.AffineTransform{
 									ScaleX:     1,
 									ScaleY:     1,
-									TranslateX: 100 * 12700,
-									TranslateY: 50 * 12700,
+									TranslateX: 100 * emuPerPoint,
+									TranslateY: 50 * emuPerPoint,
 									Unit:       "EMU",
 								},
 								Size: &slides.Size{
-									Width:  &slides.Dimension{Magnitude: 200 * 12700, Unit: "EMU"},
-									Height: &slides.Dimension{Magnitude: 150 * 12700, Unit: "EMU"},
+									Width:  &slides.Dimension{Magnitude: 200 * emuPerPoint, Unit: "EMU"},
+									Height: &slides.Dimension{Magnitude: 150 * emuPerPoint, Unit: "EMU"},
 								},
 							},
 						},
@@ -1164,6 +1174,7 @@ func TestHasImagePropertiesToModify(t *testing.T) {
 		{"contrast only", &ImageModifyProperties{Contrast: ptrFloat64(-0.3)}, true},
 		{"transparency only", &ImageModifyProperties{Transparency: ptrFloat64(0.2)}, true},
 		{"crop only", &ImageModifyProperties{Crop: &CropInput{Top: ptrFloat64(0.1)}}, true},
+		{"crop pixels only", &ImageModifyProperties{CropPixels: &CropPixels{Width: 10, Height: 10}}, true},
 		{"recolor only", &ImageModifyProperties{Recolor: ptrString("GRAYSCALE")}, true},
 		{"multiple", &ImageModifyProperties{Position: &PositionInput{X: 100, Y: 50}, Brightness: ptrFloat64(0.5)}, true},
 	}
@@ -1178,7 +1189,389 @@ func TestHasImagePropertiesToModify(t *testing.T) {
 	}
 }
 
+func TestCropPixelsToFractions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		crop       *CropPixels
+		width      int
+		height     int
+		wantTop    float64
+		wantBottom float64
+		wantLeft   float64
+		wantRight  float64
+		expectErr  bool
+	}{
+		{"centered region", &CropPixels{X: 100, Y: 50, Width: 600, Height: 300}, 800, 400, 0.125, 0.125, 0.125, 0.125, false},
+		{"top left corner", &CropPixels{X: 0, Y: 0, Width: 400, Height: 100}, 800, 400, 0, 0.75, 0, 0.5, false},
+		{"whole image", &CropPixels{X: 0, Y: 0, Width: 800, Height: 400}, 800, 400, 0, 0, 0, 0, false},
+		{"exceeds width", &CropPixels{X: 500, Y: 0, Width: 400, Height: 100}, 800, 400, 0, 0, 0, 0, true},
+		{"exceeds height", &CropPixels{X: 0, Y: 350, Width: 100, Height: 100}, 800, 400, 0, 0, 0, 0, true},
+		{"unknown image size", &CropPixels{Width: 10, Height: 10}, 0, 0, 0, 0, 0, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crop, err := cropPixelsToFractions(tc.crop, tc.width, tc.height)
+			if tc.expectErr {
+				if !errors.Is(err, ErrInvalidCropRect) {
+					t.Errorf("expected ErrInvalidCropRect, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := []float64{*crop.Top, *crop.Bottom, *crop.Left, *crop.Right}
+			want := []float64{tc.wantTop, tc.wantBottom, tc.wantLeft, tc.wantRight}
+			for i := range got {
+				if math.Abs(got[i]-want[i]) > 1e-9 {
+					t.Errorf("offsets (top, bottom, left, right) = %v, want %v", got, want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestModifyImage_CropPixels(t *testing.T) {
+	// Serve an 800x400 PNG so the natural size is read from the image itself
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, image.NewRGBA(image.Rect(0, 0, 800, 400))); err != nil {
+		t.Fatalf("failed to encode test image: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(pngData.Bytes())
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		name      string
+		crop      *CropPixels
+		expectErr error
+	}{
+		{"size read from image", &CropPixels{X: 200, Y: 100, Width: 400, Height: 200}, nil},
+		{"size provided", &CropPixels{X: 200, Y: 100, Width: 400, Height: 200, ImageWidth: 800, ImageHeight: 400}, nil},
+		{"region outside image", &CropPixels{X: 600, Y: 0, Width: 400, Height: 200}, ErrInvalidCropRect},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var capturedRequests []*slides.Request
+			mockSlides := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return &slides.Presentation{
+						PresentationId: "test-presentation",
+						Slides: []*slides.Page{{
+							ObjectId: "slide-1",
+							PageElements: []*slides.PageElement{
+								{ObjectId: "image-1", Image: &slides.Image{ContentUrl: server.URL}},
+							},
+						}},
+					}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					capturedRequests = requests
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockSlides, nil
+			})
+
+			output, err := tools.ModifyImage(context.Background(), &mockTokenSource{}, ModifyImageInput{
+				PresentationID: "test-presentation",
+				ObjectID:       "image-1",
+				Properties:     &ImageModifyProperties{CropPixels: tc.crop},
+			})
+			if tc.expectErr != nil {
+				if !errors.Is(err, tc.expectErr) {
+					t.Fatalf("expected %v, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.AppliedCrop == nil || *output.AppliedCrop.Left != 0.25 || *output.AppliedCrop.Top != 0.25 {
+				t.Errorf("unexpected applied crop: %+v", output.AppliedCrop)
+			}
+			if len(capturedRequests) != 1 || capturedRequests[0].UpdateImageProperties == nil {
+				t.Fatalf("expected one UpdateImageProperties request, got %d", len(capturedRequests))
+			}
+			crop := capturedRequests[0].UpdateImageProperties.ImageProperties.CropProperties
+			if crop.TopOffset != 0.25 || crop.BottomOffset != 0.25 || crop.LeftOffset != 0.25 || crop.RightOffset != 0.25 {
+				t.Errorf("unexpected crop offsets: %+v", crop)
+			}
+		})
+	}
+}
+
+func TestValidateCropPixels(t *testing.T) {
+	testCases := []struct {
+		name      string
+		props     *ImageModifyProperties
+		expectErr bool
+	}{
+		{"valid", &ImageModifyProperties{CropPixels: &CropPixels{X: 1, Y: 2, Width: 3, Height: 4}}, false},
+		{"negative origin", &ImageModifyProperties{CropPixels: &CropPixels{X: -1, Width: 3, Height: 4}}, true},
+		{"zero width", &ImageModifyProperties{CropPixels: &CropPixels{Height: 4}}, true},
+		{"only image width", &ImageModifyProperties{CropPixels: &CropPixels{Width: 3, Height: 4, ImageWidth: 100}}, true},
+		{"combined with crop", &ImageModifyProperties{Crop: &CropInput{Top: ptrFloat64(0.1)}, CropPixels: &CropPixels{Width: 3, Height: 4}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateImageProperties(tc.props)
+			if tc.expectErr && !errors.Is(err, ErrInvalidCropRect) {
+				t.Errorf("expected ErrInvalidCropRect, got %v", err)
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestFetchImageConfig_ReadsHeaderOnly(t *testing.T) {
+	// The image is followed by an endless stream; only the header may be read
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, image.NewRGBA(image.Rect(0, 0, 640, 480))); err != nil {
+		t.Fatalf("failed to encode test image: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		if _, err := w.Write(pngData.Bytes()); err != nil {
+			return
+		}
+		padding := make([]byte, 64<<10)
+		for {
+			if _, err := w.Write(padding); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	config, format, err := fetchImageConfig(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if format != "png" || config.Width != 640 || config.Height != 480 {
+		t.Errorf("expected 640x480 png, got %dx%d %s", config.Width, config.Height, format)
+	}
+}
+
+func TestModifyImage_CropToAspect(t *testing.T) {
+	// Serve an 800x400 landscape PNG; a 1:1 crop keeps the middle 400 pixels
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, image.NewRGBA(image.Rect(0, 0, 800, 400))); err != nil {
+		t.Fatalf("failed to encode test image: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(pngData.Bytes())
+	}))
+	defer server.Close()
+
+	var capturedRequests []*slides.Request
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "test-presentation",
+				Slides: []*slides.Page{{
+					ObjectId: "slide-1",
+					PageElements: []*slides.PageElement{
+						{ObjectId: "image-1", Image: &slides.Image{ContentUrl: server.URL}},
+					},
+				}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	})
+
+	output, err := tools.ModifyImage(context.Background(), &mockTokenSource{}, ModifyImageInput{
+		PresentationID: "test-presentation",
+		ObjectID:       "image-1",
+		Properties:     &ImageModifyProperties{CropToAspect: "1:1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.AppliedCrop == nil || *output.AppliedCrop.Left != 0.25 || *output.AppliedCrop.Right != 0.25 {
+		t.Errorf("expected symmetric 0.25 left/right crop, got %+v", output.AppliedCrop)
+	}
+	if len(capturedRequests) != 1 || capturedRequests[0].UpdateImageProperties == nil {
+		t.Fatalf("expected one UpdateImageProperties request, got %d", len(capturedRequests))
+	}
+	crop := capturedRequests[0].UpdateImageProperties.ImageProperties.CropProperties
+	if crop.LeftOffset != 0.25 || crop.RightOffset != 0.25 || crop.TopOffset != 0 || crop.BottomOffset != 0 {
+		t.Errorf("unexpected crop offsets: %+v", crop)
+	}
+}
+
+func TestAspectCropFractions(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ratio  string
+		width  int
+		height int
+		want   []float64 // top, bottom, left, right
+	}{
+		{"landscape to square", "1:1", 800, 400, []float64{0, 0, 0.25, 0.25}},
+		{"portrait to square", "1:1", 300, 600, []float64{0.25, 0.25, 0, 0}},
+		{"square to widescreen", "16:9", 900, 900, []float64{0.21875, 0.21875, 0, 0}},
+		{"already at ratio", "16:9", 1920, 1080, []float64{0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ratio, err := parseAspectRatio(tc.ratio)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			crop := aspectCropFractions(ratio, tc.width, tc.height)
+
+			got := []float64{*crop.Top, *crop.Bottom, *crop.Left, *crop.Right}
+			for i := range got {
+				if math.Abs(got[i]-tc.want[i]) > 1e-9 {
+					t.Errorf("offsets (top, bottom, left, right) = %v, want %v", got, tc.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCropToAspect(t *testing.T) {
+	testCases := []struct {
+		name      string
+		props     *ImageModifyProperties
+		expectErr bool
+	}{
+		{"widescreen", &ImageModifyProperties{CropToAspect: "16:9"}, false},
+		{"decimal ratio", &ImageModifyProperties{CropToAspect: "1.91:1"}, false},
+		{"missing colon", &ImageModifyProperties{CropToAspect: "16x9"}, true},
+		{"too many parts", &ImageModifyProperties{CropToAspect: "16:9:1"}, true},
+		{"zero height", &ImageModifyProperties{CropToAspect: "4:0"}, true},
+		{"negative width", &ImageModifyProperties{CropToAspect: "-4:3"}, true},
+		{"not a number", &ImageModifyProperties{CropToAspect: "wide:3"}, true},
+		{"combined with crop", &ImageModifyProperties{CropToAspect: "1:1", Crop: &CropInput{Top: ptrFloat64(0.1)}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateImageProperties(tc.props)
+			if tc.expectErr && !errors.Is(err, ErrInvalidAspectRatio) {
+				t.Errorf("expected ErrInvalidAspectRatio, got %v", err)
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
 // Helper to create string pointer
 func ptrString(s string) *string {
 	return &s
 }
+
+func TestModifyImage_ReturnFinalState(t *testing.T) {
+	getCalls := 0
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			getCalls++
+			width := 200.0
+			if getCalls > 1 {
+				width = 300
+			}
+			return &slides.Presentation{
+				Slides: []*slides.Page{{
+					ObjectId: "slide-1",
+					PageElements: []*slides.PageElement{{
+						ObjectId:  "image-1",
+						Image:     &slides.Image{},
+						Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: pointsToEMU(100), TranslateY: pointsToEMU(50), Unit: "EMU"},
+						Size: &slides.Size{
+							Width:  &slides.Dimension{Magnitude: pointsToEMU(width), Unit: "EMU"},
+							Height: &slides.Dimension{Magnitude: pointsToEMU(150), Unit: "EMU"},
+						},
+					}},
+				}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	}, nil)
+
+	output, err := tools.ModifyImage(context.Background(), &mockTokenSource{}, ModifyImageInput{
+		PresentationID:   "test-presentation",
+		ObjectID:         "image-1",
+		Properties:       &ImageModifyProperties{Size: &SizeInput{Width: 300, Height: 150}},
+		ReturnFinalState: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if getCalls != 2 {
+		t.Errorf("expected 2 GetPresentation calls, got %d", getCalls)
+	}
+	if output.FinalState == nil || output.FinalState.Size == nil || output.FinalState.Position == nil {
+		t.Fatalf("expected final state with position and size, got %+v", output.FinalState)
+	}
+	if output.FinalState.Size.Width != 300 || output.FinalState.Position.X != 100 {
+		t.Errorf("unexpected final state: position %+v, size %+v", output.FinalState.Position, output.FinalState.Size)
+	}
+}
+
+func TestModifyImage_ReturnFinalStateReadBackFails(t *testing.T) {
+	getCalls := 0
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			getCalls++
+			if getCalls > 1 {
+				return nil, errors.New("server error")
+			}
+			return &slides.Presentation{
+				Slides: []*slides.Page{{ObjectId: "slide-1", PageElements: []*slides.PageElement{{ObjectId: "image-1", Image: &slides.Image{}}}}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	}, nil)
+
+	transparency := 0.5
+	output, err := tools.ModifyImage(context.Background(), &mockTokenSource{}, ModifyImageInput{
+		PresentationID:   "test-presentation",
+		ObjectID:         "image-1",
+		Properties:       &ImageModifyProperties{Transparency: &transparency},
+		ReturnFinalState: true,
+	})
+	if err != nil {
+		t.Fatalf("the mutation succeeded, expected no error, got %v", err)
+	}
+	if output.FinalState != nil {
+		t.Errorf("expected no final state when the read-back fails, got %+v", output.FinalState)
+	}
+}
@@ -26,17 +26,24 @@ type ModifyShapeInput struct {
 
 // ShapeProperties defines properties to update.
 type ShapeProperties struct {
-	FillColor     string  `json:"fill_color,omitempty"`    // Hex string or "transparent"
-	OutlineColor  string  `json:"outline_color,omitempty"` // Hex string or "transparent"
+	FillColor     string   `json:"fill_color,omitempty"`     // Hex string or "transparent"
+	OutlineColor  string   `json:"outline_color,omitempty"`  // Hex string or "transparent"
 	OutlineWeight *float64 `json:"outline_weight,omitempty"` // In points
-	OutlineDash   string  `json:"outline_dash,omitempty"`   // Enum: SOLID, DASH, DOT, DASH_DOT
-	Shadow        *bool   `json:"shadow,omitempty"`         // Enable/disable shadow
+	OutlineDash   string   `json:"outline_dash,omitempty"`   // Enum: SOLID, DASH, DOT, DASH_DOT
+	OutlineState  string   `json:"outline_state,omitempty"`  // RENDERED, NOT_RENDERED or INHERIT, independent of color
+	Shadow        *bool    `json:"shadow,omitempty"`         // Enable/disable shadow
+	TopInset      *float64 `json:"top_inset,omitempty"`      // Text padding in points
+	BottomInset   *float64 `json:"bottom_inset,omitempty"`   // Text padding in points
+	LeftInset     *float64 `json:"left_inset,omitempty"`     // Text padding in points
+	RightInset    *float64 `json:"right_inset,omitempty"`    // Text padding in points
+	Opacity       *float64 `json:"opacity,omitempty"`        // 0 (invisible) to 1 (opaque), applied to fill and outline
 }
 
 // ModifyShapeOutput represents the output of the modify_shape tool.
 type ModifyShapeOutput struct {
 	ObjectID          string   `json:"object_id"`
 	UpdatedProperties []string `json:"updated_properties"`
+	InsetsIgnored     bool     `json:"insets_ignored,omitempty"` // True when text insets were given but not applied
 }
 
 // ModifyShape modifies the properties of a shape.
@@ -52,6 +59,29 @@ func (t *Tools) ModifyShape(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, ErrNoProperties
 	}
 
+	props := input.Properties
+	insetsSet, err := validateTextInsets(props.TopInset, props.BottomInset, props.LeftInset, props.RightInset)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateOpacity(props.Opacity); err != nil {
+		return nil, err
+	}
+
+	outlineState, err := normalizeOutlineState(props.OutlineState, props.OutlineColor)
+	if err != nil {
+		return nil, err
+	}
+	props.OutlineState = outlineState
+
+	// The Slides API has no text inset fields, so they are reported as ignored rather than sent
+	if insetsSet {
+		t.config.Logger.Warn("text insets are not supported by the Slides API and were ignored",
+			slog.String("object_id", input.ObjectID),
+		)
+	}
+
 	t.config.Logger.Info("modifying shape",
 		slog.String("presentation_id", input.PresentationID),
 		slog.String("object_id", input.ObjectID),
@@ -69,6 +99,9 @@ func (t *Tools) ModifyShape(ctx context.Context, tokenSource oauth2.TokenSource,
 
 	requests := buildModifyShapeRequests(input.ObjectID, input.Properties)
 	if len(requests) == 0 {
+		if insetsSet {
+			return &ModifyShapeOutput{ObjectID: input.ObjectID, UpdatedProperties: []string{}, InsetsIgnored: true}, nil
+		}
 		return nil, ErrNoProperties
 	}
 
@@ -98,13 +131,20 @@ func (t *Tools) ModifyShape(ctx context.Context, tokenSource oauth2.TokenSource,
 	if input.Properties.OutlineDash != "" {
 		updatedProps = append(updatedProps, "outline_dash")
 	}
+	if input.Properties.OutlineState != "" {
+		updatedProps = append(updatedProps, "outline_state")
+	}
 	if input.Properties.Shadow != nil {
 		updatedProps = append(updatedProps, "shadow")
 	}
+	if input.Properties.Opacity != nil {
+		updatedProps = append(updatedProps, "opacity")
+	}
 
 	output := &ModifyShapeOutput{
 		ObjectID:          input.ObjectID,
 		UpdatedProperties: updatedProps,
+		InsetsIgnored:     insetsSet,
 	}
 
 	t.config.Logger.Info("shape modified successfully",
@@ -147,9 +187,9 @@ func buildModifyShapeRequests(objectID string, props *ShapeProperties) []*slides
 	}
 
 	// Outline
-	if props.OutlineColor != "" || props.OutlineWeight != nil || props.OutlineDash != "" {
+	if props.OutlineColor != "" || props.OutlineWeight != nil || props.OutlineDash != "" || props.OutlineState != "" {
 		shapeProps.Outline = &slides.Outline{}
-		
+
 		if props.OutlineColor != "" {
 			if strings.ToLower(props.OutlineColor) == "transparent" {
 				shapeProps.Outline.PropertyState = "NOT_RENDERED"
@@ -181,6 +221,10 @@ func buildModifyShapeRequests(objectID string, props *ShapeProperties) []*slides
 			shapeProps.Outline.DashStyle = strings.ToUpper(props.OutlineDash)
 			fields = append(fields, "outline.dashStyle")
 		}
+
+		if props.OutlineState != "" {
+			fields = applyOutlineState(shapeProps.Outline, props.OutlineState, fields)
+		}
 	}
 
 	// Shadow
@@ -188,7 +232,7 @@ func buildModifyShapeRequests(objectID string, props *ShapeProperties) []*slides
 		shapeProps.Shadow = &slides.Shadow{}
 		if *props.Shadow {
 			// Enable shadow (default assumption if just true)
-			// Typically we might want to set a type or ensure it's rendered. 
+			// Typically we might want to set a type or ensure it's rendered.
 			// Setting PropertyState to RENDERED might be default but explicit is good?
 			// Google Slides API default shadow logic: usually implies setting a type or visible.
 			// Let's assume generic OUTER shadow if enabling.
@@ -199,14 +243,18 @@ func buildModifyShapeRequests(objectID string, props *ShapeProperties) []*slides
 		fields = append(fields, "shadow")
 	}
 
+	var request *slides.Request
 	if len(fields) > 0 {
-		requests = append(requests, &slides.Request{
+		request = &slides.Request{
 			UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
 				ObjectId:        objectID,
 				ShapeProperties: shapeProps,
 				Fields:          strings.Join(fields, ","),
 			},
-		})
+		}
+	}
+	if request = withShapeOpacity(request, objectID, props.Opacity); request != nil {
+		requests = append(requests, request)
 	}
 
 	return requests
@@ -234,4 +234,155 @@ func float64PtrLocal(v float64) *float64 {
 
 func boolPtrLocal(v bool) *bool {
 	return &v
-}
\ No newline at end of file
+}
+func TestModifyShape_TextInsets(t *testing.T) {
+	var batchCalls int
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			batchCalls++
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	t.Run("insets only make no API call", func(t *testing.T) {
+		batchCalls = 0
+		output, err := tools.ModifyShape(context.Background(), nil, ModifyShapeInput{
+			PresentationID: "pres-1",
+			ObjectID:       "shape-1",
+			Properties:     &ShapeProperties{TopInset: float64Ptr(6), RightInset: float64Ptr(6)},
+		})
+		require.NoError(t, err)
+		assert.True(t, output.InsetsIgnored)
+		assert.Empty(t, output.UpdatedProperties)
+		assert.Equal(t, 0, batchCalls)
+	})
+
+	t.Run("insets with other properties still update the shape", func(t *testing.T) {
+		batchCalls = 0
+		output, err := tools.ModifyShape(context.Background(), nil, ModifyShapeInput{
+			PresentationID: "pres-1",
+			ObjectID:       "shape-1",
+			Properties:     &ShapeProperties{FillColor: "#00FF00", LeftInset: float64Ptr(3)},
+		})
+		require.NoError(t, err)
+		assert.True(t, output.InsetsIgnored)
+		assert.Equal(t, []string{"fill_color"}, output.UpdatedProperties)
+		assert.Equal(t, 1, batchCalls)
+		require.Len(t, capturedRequests, 1)
+		assert.Equal(t, "shapeBackgroundFill", capturedRequests[0].UpdateShapeProperties.Fields)
+	})
+
+	t.Run("negative inset is rejected", func(t *testing.T) {
+		batchCalls = 0
+		_, err := tools.ModifyShape(context.Background(), nil, ModifyShapeInput{
+			PresentationID: "pres-1",
+			ObjectID:       "shape-1",
+			Properties:     &ShapeProperties{TopInset: float64Ptr(-0.5)},
+		})
+		assert.ErrorIs(t, err, ErrInvalidTextInset)
+		assert.Equal(t, 0, batchCalls)
+	})
+}
+
+func TestModifyShape_Opacity(t *testing.T) {
+	var batchCalls int
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			batchCalls++
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	t.Run("opacity sets fill and outline alpha together", func(t *testing.T) {
+		output, err := tools.ModifyShape(context.Background(), nil, ModifyShapeInput{
+			PresentationID: "pres-1",
+			ObjectID:       "shape-1",
+			Properties:     &ShapeProperties{Opacity: float64Ptr(0.5)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"opacity"}, output.UpdatedProperties)
+		require.Len(t, capturedRequests, 1)
+
+		update := capturedRequests[0].UpdateShapeProperties
+		require.NotNil(t, update)
+		assert.Equal(t, "shapeBackgroundFill.solidFill.alpha,outline.outlineFill.solidFill.alpha", update.Fields)
+		assert.Equal(t, 0.5, update.ShapeProperties.ShapeBackgroundFill.SolidFill.Alpha)
+		assert.Equal(t, 0.5, update.ShapeProperties.Outline.OutlineFill.SolidFill.Alpha)
+	})
+
+	t.Run("opacity merges into an outline weight update", func(t *testing.T) {
+		_, err := tools.ModifyShape(context.Background(), nil, ModifyShapeInput{
+			PresentationID: "pres-1",
+			ObjectID:       "shape-1",
+			Properties:     &ShapeProperties{OutlineColor: "#000000", OutlineWeight: float64Ptr(2), Opacity: float64Ptr(0.8)},
+		})
+		require.NoError(t, err)
+		require.Len(t, capturedRequests, 1)
+
+		update := capturedRequests[0].UpdateShapeProperties
+		assert.Equal(t, "outline.outlineFill,outline.weight,shapeBackgroundFill.solidFill.alpha", update.Fields)
+		assert.Equal(t, 0.8, update.ShapeProperties.Outline.OutlineFill.SolidFill.Alpha)
+		assert.NotNil(t, update.ShapeProperties.Outline.OutlineFill.SolidFill.Color)
+	})
+
+	t.Run("out of range opacity is rejected", func(t *testing.T) {
+		batchCalls = 0
+		_, err := tools.ModifyShape(context.Background(), nil, ModifyShapeInput{
+			PresentationID: "pres-1",
+			ObjectID:       "shape-1",
+			Properties:     &ShapeProperties{Opacity: float64Ptr(1.2)},
+		})
+		assert.ErrorIs(t, err, ErrInvalidOpacity)
+		assert.Equal(t, 0, batchCalls)
+	})
+}
+
+func TestModifyShape_OutlineState(t *testing.T) {
+	for _, state := range []string{"RENDERED", "NOT_RENDERED", "INHERIT"} {
+		t.Run(state, func(t *testing.T) {
+			requests := buildModifyShapeRequests("shape-1", &ShapeProperties{OutlineState: state})
+			require.Len(t, requests, 1)
+			update := requests[0].UpdateShapeProperties
+			assert.Equal(t, "outline.propertyState", update.Fields)
+			assert.Equal(t, state, update.ShapeProperties.Outline.PropertyState)
+			assert.Nil(t, update.ShapeProperties.Outline.OutlineFill)
+		})
+	}
+
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return &mockSlidesService{
+			BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+				return &slides.BatchUpdatePresentationResponse{}, nil
+			},
+		}, nil
+	})
+
+	t.Run("reported as updated", func(t *testing.T) {
+		output, err := tools.ModifyShape(context.Background(), nil, ModifyShapeInput{
+			PresentationID: "pres-1",
+			ObjectID:       "shape-1",
+			Properties:     &ShapeProperties{OutlineState: "not_rendered"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"outline_state"}, output.UpdatedProperties)
+	})
+
+	t.Run("invalid state is rejected", func(t *testing.T) {
+		_, err := tools.ModifyShape(context.Background(), nil, ModifyShapeInput{
+			PresentationID: "pres-1",
+			ObjectID:       "shape-1",
+			Properties:     &ShapeProperties{OutlineState: "HIDDEN"},
+		})
+		assert.ErrorIs(t, err, ErrInvalidOutlineState)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -12,12 +13,12 @@ import (
 
 // Sentinel errors for modify_text tool.
 var (
-	ErrModifyTextFailed  = errors.New("failed to modify text")
-	ErrInvalidAction     = errors.New("invalid action")
-	ErrInvalidObjectID   = errors.New("invalid object_id")
-	ErrTextRequired      = errors.New("text is required for this action")
-	ErrInvalidTextRange  = errors.New("invalid text range")
-	ErrNotTextObject     = errors.New("object does not contain editable text")
+	ErrModifyTextFailed = errors.New("failed to modify text")
+	ErrInvalidAction    = errors.New("invalid action")
+	ErrInvalidObjectID  = errors.New("invalid object_id")
+	ErrTextRequired     = errors.New("text is required for this action")
+	ErrInvalidTextRange = errors.New("invalid text range")
+	ErrNotTextObject    = errors.New("object does not contain editable text")
 )
 
 // ModifyTextInput represents the input for the modify_text tool.
@@ -26,8 +27,10 @@ type ModifyTextInput struct {
 	ObjectID       string `json:"object_id"`
 	Action         string `json:"action"` // "replace" | "append" | "prepend" | "delete"
 	Text           string `json:"text,omitempty"`
-	StartIndex     *int   `json:"start_index,omitempty"` // Optional, for partial replacement
-	EndIndex       *int   `json:"end_index,omitempty"`   // Optional, for partial replacement
+	StartIndex     *int   `json:"start_index,omitempty"`    // Optional, for partial replacement or deletion
+	EndIndex       *int   `json:"end_index,omitempty"`      // Optional, for partial replacement or deletion
+	SoftBreaks     bool   `json:"soft_breaks,omitempty"`    // Insert newlines as line breaks within a paragraph
+	PreserveStyle  bool   `json:"preserve_style,omitempty"` // For full replace: re-apply the first run's style to the new text
 }
 
 // ModifyTextOutput represents the output of the modify_text tool.
@@ -35,6 +38,8 @@ type ModifyTextOutput struct {
 	ObjectID    string `json:"object_id"`
 	UpdatedText string `json:"updated_text"`
 	Action      string `json:"action"`
+
+	PreservedStyle []string `json:"preserved_style,omitempty"` // Style fields re-applied with preserve_style
 }
 
 // ModifyText modifies text content in an existing shape.
@@ -63,6 +68,12 @@ func (t *Tools) ModifyText(ctx context.Context, tokenSource oauth2.TokenSource,
 		return nil, fmt.Errorf("%w: text is required for '%s' action", ErrTextRequired, input.Action)
 	}
 
+	if input.PreserveStyle && input.Action != "replace" {
+		return nil, fmt.Errorf("%w: preserve_style only applies to the 'replace' action", ErrInvalidAction)
+	}
+
+	input.Text = normalizeLineBreaks(input.Text, input.SoftBreaks)
+
 	// Validate indices if provided
 	if input.StartIndex != nil && *input.StartIndex < 0 {
 		return nil, fmt.Errorf("%w: start_index cannot be negative", ErrInvalidTextRange)
@@ -73,6 +84,11 @@ func (t *Tools) ModifyText(ctx context.Context, tokenSource oauth2.TokenSource,
 	if input.StartIndex != nil && input.EndIndex != nil && *input.StartIndex > *input.EndIndex {
 		return nil, fmt.Errorf("%w: start_index cannot be greater than end_index", ErrInvalidTextRange)
 	}
+	if input.Action == "delete" {
+		if err := validateDeleteTextRange(input.StartIndex, input.EndIndex); err != nil {
+			return nil, err
+		}
+	}
 
 	t.config.Logger.Info("modifying text",
 		slog.String("presentation_id", input.PresentationID),
@@ -126,6 +142,18 @@ func (t *Tools) ModifyText(ctx context.Context, tokenSource oauth2.TokenSource,
 	// Build requests based on action
 	requests, expectedText := buildModifyTextRequests(input, currentText)
 
+	// A full replace deletes every run, so the new text would fall back to the
+	// placeholder defaults; re-apply the style the text started with instead.
+	// A range replace needs nothing, since inserted text takes its neighbour's style.
+	var preservedFields []string
+	if input.PreserveStyle && (input.StartIndex == nil || input.EndIndex == nil) {
+		var styleRequest *slides.Request
+		styleRequest, preservedFields = buildPreserveStyleRequest(input.ObjectID, firstRunStyle(targetElement.Shape.Text))
+		if styleRequest != nil {
+			requests = append(requests, styleRequest)
+		}
+	}
+
 	// Execute batch update
 	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
 	if err != nil {
@@ -139,9 +167,10 @@ func (t *Tools) ModifyText(ctx context.Context, tokenSource oauth2.TokenSource,
 	}
 
 	output := &ModifyTextOutput{
-		ObjectID:    input.ObjectID,
-		UpdatedText: expectedText,
-		Action:      input.Action,
+		ObjectID:       input.ObjectID,
+		UpdatedText:    expectedText,
+		Action:         input.Action,
+		PreservedStyle: preservedFields,
 	}
 
 	t.config.Logger.Info("text modified successfully",
@@ -165,8 +194,8 @@ func buildModifyTextRequests(input ModifyTextInput, currentText string) ([]*slid
 			startIdx := *input.StartIndex
 			endIdx := *input.EndIndex
 
-			// Clamp indices to current text length
-			textLen := len(currentText)
+			// Clamp indices to current text length, counted in UTF-16 units like the API
+			textLen := utf16Len(currentText)
 			if startIdx > textLen {
 				startIdx = textLen
 			}
@@ -200,7 +229,7 @@ func buildModifyTextRequests(input ModifyTextInput, currentText string) ([]*slid
 			})
 
 			// Calculate expected text
-			expectedText = currentText[:startIdx] + input.Text + currentText[endIdx:]
+			expectedText = currentText[:utf16ToByteOffset(currentText, startIdx)] + input.Text + currentText[utf16ToByteOffset(currentText, endIdx):]
 		} else {
 			// Full replacement - delete all text first, then insert new text
 			if len(currentText) > 0 {
@@ -228,8 +257,8 @@ func buildModifyTextRequests(input ModifyTextInput, currentText string) ([]*slid
 	case "append":
 		// Insert text at the end
 		// Note: Google Slides adds a trailing newline character automatically
-		// We insert at position len(currentText) which handles this
-		insertionIdx := len(currentText)
+		// We insert at the text's UTF-16 length which handles this
+		insertionIdx := utf16Len(currentText)
 		requests = append(requests, &slides.Request{
 			InsertText: &slides.InsertTextRequest{
 				ObjectId:       input.ObjectID,
@@ -253,6 +282,18 @@ func buildModifyTextRequests(input ModifyTextInput, currentText string) ([]*slid
 		expectedText = input.Text + currentText
 
 	case "delete":
+		if input.StartIndex != nil && input.EndIndex != nil {
+			// Partial deletion, clamped to current text length
+			startIdx := min(*input.StartIndex, utf16Len(currentText))
+			endIdx := min(*input.EndIndex, utf16Len(currentText))
+			if endIdx > startIdx {
+				requests = append(requests, buildDeleteTextRangeRequest(input.ObjectID, startIdx, endIdx))
+			}
+
+			expectedText = currentText[:utf16ToByteOffset(currentText, startIdx)] + currentText[utf16ToByteOffset(currentText, endIdx):]
+			break
+		}
+
 		// Delete all text
 		if len(currentText) > 0 {
 			requests = append(requests, &slides.Request{
@@ -270,3 +311,133 @@ func buildModifyTextRequests(input ModifyTextInput, currentText string) ([]*slid
 
 	return requests, expectedText
 }
+
+// firstRunStyle returns the style of the first text run, or nil if the text has none.
+func firstRunStyle(text *slides.TextContent) *slides.TextStyle {
+	if text == nil {
+		return nil
+	}
+	for _, element := range text.TextElements {
+		if element.TextRun != nil && element.TextRun.Style != nil {
+			return element.TextRun.Style
+		}
+	}
+	return nil
+}
+
+// buildPreserveStyleRequest creates an UpdateTextStyle over all text that re-applies a captured
+// run style. Only fields set on the run are written; links are left out so a linked first word
+// does not turn the whole replacement into a link. Returns nil when there is nothing to apply.
+func buildPreserveStyleRequest(objectID string, captured *slides.TextStyle) (*slides.Request, []string) {
+	if captured == nil {
+		return nil, nil
+	}
+
+	style := &slides.TextStyle{}
+	var fields []string
+	if captured.FontFamily != "" {
+		style.FontFamily = captured.FontFamily
+		fields = append(fields, "fontFamily")
+	}
+	if captured.WeightedFontFamily != nil {
+		style.WeightedFontFamily = captured.WeightedFontFamily
+		fields = append(fields, "weightedFontFamily")
+	}
+	if captured.FontSize != nil {
+		style.FontSize = captured.FontSize
+		fields = append(fields, "fontSize")
+	}
+	if captured.Bold {
+		style.Bold = true
+		fields = append(fields, "bold")
+	}
+	if captured.Italic {
+		style.Italic = true
+		fields = append(fields, "italic")
+	}
+	if captured.Underline {
+		style.Underline = true
+		fields = append(fields, "underline")
+	}
+	if captured.Strikethrough {
+		style.Strikethrough = true
+		fields = append(fields, "strikethrough")
+	}
+	if captured.SmallCaps {
+		style.SmallCaps = true
+		fields = append(fields, "smallCaps")
+	}
+	if captured.ForegroundColor != nil {
+		style.ForegroundColor = captured.ForegroundColor
+		fields = append(fields, "foregroundColor")
+	}
+	if captured.BackgroundColor != nil {
+		style.BackgroundColor = captured.BackgroundColor
+		fields = append(fields, "backgroundColor")
+	}
+	if captured.BaselineOffset != "" && captured.BaselineOffset != "NONE" {
+		style.BaselineOffset = captured.BaselineOffset
+		fields = append(fields, "baselineOffset")
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return &slides.Request{
+		UpdateTextStyle: &slides.UpdateTextStyleRequest{
+			ObjectId:  objectID,
+			TextRange: &slides.Range{Type: "ALL"},
+			Style:     style,
+			Fields:    strings.Join(fields, ","),
+		},
+	}, fields
+}
+
+// validateDeleteTextRange checks the optional range of a delete action.
+// Omitting both indices deletes all text; otherwise both are required and must form a non-empty range.
+func validateDeleteTextRange(startIndex, endIndex *int) error {
+	if startIndex == nil && endIndex == nil {
+		return nil
+	}
+	if startIndex == nil || endIndex == nil {
+		return fmt.Errorf("%w: both start_index and end_index are required to delete a range", ErrInvalidTextRange)
+	}
+	if *startIndex < 0 || *endIndex < 0 {
+		return fmt.Errorf("%w: start_index and end_index cannot be negative", ErrInvalidTextRange)
+	}
+	if *startIndex >= *endIndex {
+		return fmt.Errorf("%w: start_index must be less than end_index", ErrInvalidTextRange)
+	}
+	return nil
+}
+
+// buildDeleteTextRangeRequest creates a DeleteText request for the [startIndex, endIndex) range.
+func buildDeleteTextRangeRequest(objectID string, startIndex, endIndex int) *slides.Request {
+	start := int64(startIndex)
+	end := int64(endIndex)
+	return &slides.Request{
+		DeleteText: &slides.DeleteTextRequest{
+			ObjectId: objectID,
+			TextRange: &slides.Range{
+				Type:       "FIXED_RANGE",
+				StartIndex: &start,
+				EndIndex:   &end,
+			},
+		},
+	}
+}
+
+// softBreakChar is the vertical tab Slides uses for a line break inside a paragraph.
+const softBreakChar = "\v"
+
+// normalizeLineBreaks converts CRLF/CR to "\n" (paragraph break). When softBreaks
+// is set, newlines become vertical tabs so the text stays in a single paragraph.
+func normalizeLineBreaks(text string, softBreaks bool) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	if softBreaks {
+		text = strings.ReplaceAll(text, "\n", softBreakChar)
+	}
+	return text
+}
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 
 	"golang.org/x/oauth2"
@@ -540,10 +541,9 @@ func TestModifyText_ImageNotSupported(t *testing.T) {
 	}
 }
 
-func TestModifyText_DeleteWithIndices_IgnoresIndices(t *testing.T) {
-	// Delete action does not use indices - it always deletes all text
-	// This test verifies that indices are ignored for delete action
+func TestModifyText_DeleteRange(t *testing.T) {
 	ctx := context.Background()
+	var capturedRequests []*slides.Request
 
 	presentation := &slides.Presentation{
 		PresentationId: "test-presentation",
@@ -576,6 +576,7 @@ func TestModifyText_DeleteWithIndices_IgnoresIndices(t *testing.T) {
 			return presentation, nil
 		},
 		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
 			return &slides.BatchUpdatePresentationResponse{}, nil
 		},
 	}
@@ -584,7 +585,7 @@ func TestModifyText_DeleteWithIndices_IgnoresIndices(t *testing.T) {
 		return mockService, nil
 	})
 
-	// Even with indices, delete should clear all text
+	// Indices restrict the delete to a fixed range
 	output, err := tools.ModifyText(ctx, nil, ModifyTextInput{
 		PresentationID: "test-presentation",
 		ObjectID:       "shape-1",
@@ -598,8 +599,45 @@ func TestModifyText_DeleteWithIndices_IgnoresIndices(t *testing.T) {
 		return
 	}
 
-	if output.UpdatedText != "" {
-		t.Errorf("expected empty text after delete, got %q", output.UpdatedText)
+	if output.UpdatedText != " World" {
+		t.Errorf("UpdatedText = %q, want %q", output.UpdatedText, " World")
+	}
+
+	if len(capturedRequests) != 1 || capturedRequests[0].DeleteText == nil {
+		t.Fatalf("expected a single DeleteText request, got %+v", capturedRequests)
+	}
+	textRange := capturedRequests[0].DeleteText.TextRange
+	if textRange.Type != "FIXED_RANGE" || *textRange.StartIndex != 0 || *textRange.EndIndex != 5 {
+		t.Errorf("TextRange = %s [%d, %d), want FIXED_RANGE [0, 5)", textRange.Type, *textRange.StartIndex, *textRange.EndIndex)
+	}
+}
+
+func TestValidateDeleteTextRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   *int
+		end     *int
+		wantErr bool
+	}{
+		{name: "no indices deletes all", wantErr: false},
+		{name: "valid range", start: intPtr(1), end: intPtr(3), wantErr: false},
+		{name: "start only", start: intPtr(1), wantErr: true},
+		{name: "end only", end: intPtr(3), wantErr: true},
+		{name: "empty range", start: intPtr(2), end: intPtr(2), wantErr: true},
+		{name: "reversed range", start: intPtr(3), end: intPtr(1), wantErr: true},
+		{name: "negative start", start: intPtr(-1), end: intPtr(2), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDeleteTextRange(tt.start, tt.end)
+			if tt.wantErr && !errors.Is(err, ErrInvalidTextRange) {
+				t.Errorf("expected ErrInvalidTextRange, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
 	}
 }
 
@@ -691,6 +729,30 @@ func TestBuildModifyTextRequests(t *testing.T) {
 			wantExpected: "",
 			wantReqCount: 1, // Only Delete
 		},
+		{
+			name: "delete range",
+			input: ModifyTextInput{
+				ObjectID:   "shape-1",
+				Action:     "delete",
+				StartIndex: intPtr(1),
+				EndIndex:   intPtr(3),
+			},
+			currentText:  "Hello",
+			wantExpected: "Hlo",
+			wantReqCount: 1, // Only Delete
+		},
+		{
+			name: "delete range clamped to text length",
+			input: ModifyTextInput{
+				ObjectID:   "shape-1",
+				Action:     "delete",
+				StartIndex: intPtr(3),
+				EndIndex:   intPtr(50),
+			},
+			currentText:  "Hello",
+			wantExpected: "Hel",
+			wantReqCount: 1, // Only Delete
+		},
 		{
 			name: "delete empty",
 			input: ModifyTextInput{
@@ -701,6 +763,31 @@ func TestBuildModifyTextRequests(t *testing.T) {
 			wantExpected: "",
 			wantReqCount: 0, // No requests needed
 		},
+		{
+			name: "replace range after emoji",
+			input: ModifyTextInput{
+				ObjectID:   "shape-1",
+				Action:     "replace",
+				Text:       "Done",
+				StartIndex: intPtr(3), // UTF-16: the emoji is two units
+				EndIndex:   intPtr(7),
+			},
+			currentText:  "🚀 Todo!",
+			wantExpected: "🚀 Done!",
+			wantReqCount: 2,
+		},
+		{
+			name: "delete range clamped to UTF-16 length",
+			input: ModifyTextInput{
+				ObjectID:   "shape-1",
+				Action:     "delete",
+				StartIndex: intPtr(2),
+				EndIndex:   intPtr(50),
+			},
+			currentText:  "😀😀",
+			wantExpected: "😀",
+			wantReqCount: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -835,3 +922,213 @@ func TestModifyText_ObjectInGroup(t *testing.T) {
 		t.Errorf("UpdatedText = %q, want %q", output.UpdatedText, "Updated nested text")
 	}
 }
+
+func TestNormalizeLineBreaks(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		softBreaks bool
+		expected   string
+	}{
+		{"paragraph breaks unchanged", "Line 1\nLine 2", false, "Line 1\nLine 2"},
+		{"CRLF normalized to newline", "Line 1\r\nLine 2\rLine 3", false, "Line 1\nLine 2\nLine 3"},
+		{"soft breaks use vertical tab", "Line 1\nLine 2", true, "Line 1\vLine 2"},
+		{"soft breaks with CRLF", "Line 1\r\nLine 2", true, "Line 1\vLine 2"},
+		{"no newlines", "Single line", true, "Single line"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalizeLineBreaks(tt.text, tt.softBreaks)
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestModifyText_SoftBreaks(t *testing.T) {
+	for _, softBreaks := range []bool{false, true} {
+		var insertedText string
+		mockService := &mockSlidesService{
+			GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+				return &slides.Presentation{
+					PresentationId: "test-presentation",
+					Slides: []*slides.Page{
+						{
+							ObjectId: "slide-1",
+							PageElements: []*slides.PageElement{
+								{
+									ObjectId: "shape-1",
+									Shape: &slides.Shape{
+										ShapeType: "TEXT_BOX",
+										Text: &slides.TextContent{
+											TextElements: []*slides.TextElement{
+												{TextRun: &slides.TextRun{Content: "Hello"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}, nil
+			},
+			BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+				for _, req := range requests {
+					if req.InsertText != nil {
+						insertedText = req.InsertText.Text
+					}
+				}
+				return &slides.BatchUpdatePresentationResponse{}, nil
+			},
+		}
+
+		tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+			return mockService, nil
+		})
+
+		_, err := tools.ModifyText(context.Background(), nil, ModifyTextInput{
+			PresentationID: "test-presentation",
+			ObjectID:       "shape-1",
+			Action:         "append",
+			Text:           "\nFirst\nSecond",
+			SoftBreaks:     softBreaks,
+		})
+		if err != nil {
+			t.Fatalf("soft_breaks=%v: unexpected error: %v", softBreaks, err)
+		}
+
+		expected := "\nFirst\nSecond"
+		if softBreaks {
+			expected = "\vFirst\vSecond"
+		}
+		if insertedText != expected {
+			t.Errorf("soft_breaks=%v: expected inserted text %q, got %q", softBreaks, expected, insertedText)
+		}
+	}
+}
+
+func TestModifyText_PreserveStyle(t *testing.T) {
+	titleStyle := &slides.TextStyle{
+		FontFamily: "Montserrat",
+		FontSize:   &slides.Dimension{Magnitude: 40, Unit: "PT"},
+		Bold:       true,
+		ForegroundColor: &slides.OptionalColor{
+			OpaqueColor: &slides.OpaqueColor{RgbColor: &slides.RgbColor{Red: 1}},
+		},
+		Link: &slides.Link{Url: "https://example.com"},
+	}
+
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "test-presentation",
+				Slides: []*slides.Page{
+					{
+						ObjectId: "slide-1",
+						PageElements: []*slides.PageElement{
+							{
+								ObjectId: "title-1",
+								Shape: &slides.Shape{
+									ShapeType: "TEXT_BOX",
+									Text: &slides.TextContent{
+										TextElements: []*slides.TextElement{
+											{ParagraphMarker: &slides.ParagraphMarker{}},
+											{TextRun: &slides.TextRun{Content: "Old title\n", Style: titleStyle}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.ModifyText(context.Background(), nil, ModifyTextInput{
+		PresentationID: "test-presentation",
+		ObjectID:       "title-1",
+		Action:         "replace",
+		Text:           "New title",
+		PreserveStyle:  true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(capturedRequests) != 3 {
+		t.Fatalf("expected delete, insert and style requests, got %d", len(capturedRequests))
+	}
+	if capturedRequests[0].DeleteText == nil || capturedRequests[1].InsertText == nil {
+		t.Fatalf("expected DeleteText then InsertText before the style request")
+	}
+
+	styleReq := capturedRequests[2].UpdateTextStyle
+	if styleReq == nil {
+		t.Fatal("expected UpdateTextStyle as the last request")
+	}
+	if styleReq.ObjectId != "title-1" || styleReq.TextRange.Type != "ALL" {
+		t.Errorf("unexpected target: %s %s", styleReq.ObjectId, styleReq.TextRange.Type)
+	}
+	if styleReq.Fields != "fontFamily,fontSize,bold,foregroundColor" {
+		t.Errorf("Fields = %q", styleReq.Fields)
+	}
+	if styleReq.Style.FontFamily != "Montserrat" || styleReq.Style.FontSize.Magnitude != 40 || !styleReq.Style.Bold {
+		t.Errorf("style not carried over: %+v", styleReq.Style)
+	}
+	if styleReq.Style.ForegroundColor.OpaqueColor.RgbColor.Red != 1 {
+		t.Errorf("foreground color not carried over: %+v", styleReq.Style.ForegroundColor)
+	}
+	if styleReq.Style.Link != nil {
+		t.Error("expected the first run's link not to be re-applied")
+	}
+	if !reflect.DeepEqual(output.PreservedStyle, []string{"fontFamily", "fontSize", "bold", "foregroundColor"}) {
+		t.Errorf("PreservedStyle = %v", output.PreservedStyle)
+	}
+}
+
+func TestModifyText_PreserveStyleErrors(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	_, err := tools.ModifyText(context.Background(), nil, ModifyTextInput{
+		PresentationID: "test-presentation",
+		ObjectID:       "shape-1",
+		Action:         "append",
+		Text:           "more",
+		PreserveStyle:  true,
+	})
+	if !errors.Is(err, ErrInvalidAction) {
+		t.Errorf("expected ErrInvalidAction for append with preserve_style, got %v", err)
+	}
+
+	// Unstyled text has nothing to re-apply
+	if req, fields := buildPreserveStyleRequest("shape-1", firstRunStyle(&slides.TextContent{
+		TextElements: []*slides.TextElement{{TextRun: &slides.TextRun{Content: "plain", Style: &slides.TextStyle{}}}},
+	})); req != nil || fields != nil {
+		t.Errorf("expected no request for an empty style, got %+v", req)
+	}
+}
+
+func TestBuildModifyTextRequests_UTF16Indices(t *testing.T) {
+	appendRequests, _ := buildModifyTextRequests(ModifyTextInput{ObjectID: "shape-1", Action: "append", Text: "!"}, "Hi 👋")
+	if got := appendRequests[0].InsertText.InsertionIndex; got != 5 {
+		t.Errorf("append InsertionIndex = %d, want 5 (UTF-16 units, not %d bytes)", got, len("Hi 👋"))
+	}
+
+	deleteRequests, _ := buildModifyTextRequests(ModifyTextInput{ObjectID: "shape-1", Action: "delete", StartIndex: intPtr(0), EndIndex: intPtr(99)}, "😀ok")
+	textRange := deleteRequests[0].DeleteText.TextRange
+	if *textRange.StartIndex != 0 || *textRange.EndIndex != 4 {
+		t.Errorf("delete range = %d-%d, want 0-4", *textRange.StartIndex, *textRange.EndIndex)
+	}
+}
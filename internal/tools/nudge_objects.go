@@ -0,0 +1,193 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for nudge_objects tool.
+var (
+	ErrNoObjectsToNudge   = errors.New("no objects to nudge")
+	ErrInvalidNudgeDelta  = errors.New("invalid nudge delta")
+	ErrNudgeObjectsFailed = errors.New("failed to nudge objects")
+)
+
+// NudgeObjectsInput represents the input for the nudge_objects tool.
+type NudgeObjectsInput struct {
+	PresentationID string   `json:"presentation_id"`
+	ObjectIDs      []string `json:"object_ids"`        // Objects to move by the same offset
+	DeltaX         float64  `json:"delta_x,omitempty"` // Points; positive moves right
+	DeltaY         float64  `json:"delta_y,omitempty"` // Points; positive moves down
+}
+
+// NudgeObjectsOutput represents the output of the nudge_objects tool.
+type NudgeObjectsOutput struct {
+	PresentationID string   `json:"presentation_id"`
+	ObjectIDs      []string `json:"object_ids"` // Nudged objects, without duplicates or children of listed groups
+	DeltaX         float64  `json:"delta_x"`    // Points
+	DeltaY         float64  `json:"delta_y"`    // Points
+	NudgedCount    int      `json:"nudged_count"`
+}
+
+// NudgeObjects shifts every object by the same offset with one RELATIVE transform each, all in a
+// single batch, so a cluster of elements keeps its layout. The presentation is read only to check
+// that every object exists; current positions are not needed.
+func (t *Tools) NudgeObjects(ctx context.Context, tokenSource oauth2.TokenSource, input NudgeObjectsInput) (*NudgeObjectsOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if len(input.ObjectIDs) == 0 {
+		return nil, fmt.Errorf("%w: object_ids is required", ErrNoObjectsToNudge)
+	}
+	for _, delta := range []float64{input.DeltaX, input.DeltaY} {
+		if math.IsNaN(delta) || math.IsInf(delta, 0) {
+			return nil, fmt.Errorf("%w: delta_x and delta_y must be finite numbers", ErrInvalidNudgeDelta)
+		}
+	}
+	if input.DeltaX == 0 && input.DeltaY == 0 {
+		return nil, fmt.Errorf("%w: delta_x or delta_y must be non-zero", ErrInvalidNudgeDelta)
+	}
+
+	var objectIDs []string
+	seen := make(map[string]bool)
+	for _, objectID := range input.ObjectIDs {
+		if objectID == "" {
+			return nil, fmt.Errorf("%w: object_ids cannot contain empty IDs", ErrInvalidObjectID)
+		}
+		if !seen[objectID] {
+			seen[objectID] = true
+			objectIDs = append(objectIDs, objectID)
+		}
+	}
+
+	t.config.Logger.Info("nudging objects",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("object_count", len(objectIDs)),
+		slog.Float64("delta_x", input.DeltaX),
+		slog.Float64("delta_y", input.DeltaY),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to verify the objects exist
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	for _, objectID := range objectIDs {
+		if findElementByIDRecursively(presentation.Slides, objectID) == nil {
+			return nil, fmt.Errorf("%w: object '%s' not found", ErrObjectNotFound, objectID)
+		}
+	}
+
+	// Moving a group moves its children, so a listed child of a listed group would move twice
+	objectIDs = dropListedGroupChildren(presentation.Slides, objectIDs)
+
+	requests := buildNudgeRequests(objectIDs, input.DeltaX, input.DeltaY)
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrNudgeObjectsFailed, err)
+	}
+
+	output := &NudgeObjectsOutput{
+		PresentationID: input.PresentationID,
+		ObjectIDs:      objectIDs,
+		DeltaX:         input.DeltaX,
+		DeltaY:         input.DeltaY,
+		NudgedCount:    len(objectIDs),
+	}
+
+	t.config.Logger.Info("objects nudged successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("nudged_count", output.NudgedCount),
+	)
+
+	return output, nil
+}
+
+// dropListedGroupChildren returns objectIDs without the IDs nested in a group that is also listed,
+// keeping the input order. Groups are searched like findSlideElementIDsByPrefix does: the walk
+// stops at a listed element, so nothing below it is kept.
+func dropListedGroupChildren(pages []*slides.Page, objectIDs []string) []string {
+	listed := make(map[string]bool, len(objectIDs))
+	for _, objectID := range objectIDs {
+		listed[objectID] = true
+	}
+
+	kept := make(map[string]bool, len(objectIDs))
+	var walk func(elements []*slides.PageElement)
+	walk = func(elements []*slides.PageElement) {
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+			if listed[element.ObjectId] {
+				kept[element.ObjectId] = true
+				continue
+			}
+			if element.ElementGroup != nil {
+				walk(element.ElementGroup.Children)
+			}
+		}
+	}
+	for _, page := range pages {
+		walk(page.PageElements)
+	}
+
+	filtered := make([]string, 0, len(objectIDs))
+	for _, objectID := range objectIDs {
+		if kept[objectID] {
+			filtered = append(filtered, objectID)
+		}
+	}
+	return filtered
+}
+
+// buildNudgeRequests returns one RELATIVE translation per object. A relative transform is
+// pre-multiplied onto the existing one, so the offset applies in page space whatever the
+// object's current rotation or scale.
+func buildNudgeRequests(objectIDs []string, deltaX, deltaY float64) []*slides.Request {
+	requests := make([]*slides.Request, 0, len(objectIDs))
+	for _, objectID := range objectIDs {
+		requests = append(requests, &slides.Request{
+			UpdatePageElementTransform: &slides.UpdatePageElementTransformRequest{
+				ObjectId: objectID,
+				Transform: &slides.AffineTransform{
+					ScaleX:     1,
+					ScaleY:     1,
+					TranslateX: pointsToEMU(deltaX),
+					TranslateY: pointsToEMU(deltaY),
+					Unit:       "EMU",
+				},
+				ApplyMode: "RELATIVE",
+			},
+		})
+	}
+	return requests
+}
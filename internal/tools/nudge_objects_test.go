@@ -0,0 +1,147 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newNudgeObjectsTestTools(getErr, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1", PageElements: []*slides.PageElement{
+				{ObjectId: "title", Shape: &slides.Shape{ShapeType: "TEXT_BOX"}},
+				{ObjectId: "group", ElementGroup: &slides.Group{Children: []*slides.PageElement{
+					{ObjectId: "child", Shape: &slides.Shape{ShapeType: "RECTANGLE"}},
+				}}},
+			}},
+			{ObjectId: "slide-2", PageElements: []*slides.PageElement{
+				{ObjectId: "logo", Image: &slides.Image{}},
+			}},
+		},
+	}
+
+	return newBatchCaptureTestTools(presentation, getErr, batchErr, batchCalls, captured)
+}
+
+func TestNudgeObjects(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newNudgeObjectsTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.NudgeObjects(context.Background(), nil, NudgeObjectsInput{
+		PresentationID: "pres-1",
+		ObjectIDs:      []string{"title", "child", "logo", "title"},
+		DeltaX:         10,
+		DeltaY:         -5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 {
+		t.Fatalf("expected a single batch update, got %d", batchCalls)
+	}
+	wantIDs := []string{"title", "child", "logo"}
+	if len(captured) != len(wantIDs) {
+		t.Fatalf("expected %d requests, got %d", len(wantIDs), len(captured))
+	}
+	for i, request := range captured {
+		update := request.UpdatePageElementTransform
+		if update == nil {
+			t.Fatalf("request %d: expected UpdatePageElementTransform, got %+v", i, request)
+		}
+		if update.ObjectId != wantIDs[i] || update.ApplyMode != "RELATIVE" {
+			t.Errorf("request %d: expected a relative transform of %s, got %s (%s)", i, wantIDs[i], update.ObjectId, update.ApplyMode)
+		}
+		transform := update.Transform
+		if transform.ScaleX != 1 || transform.ScaleY != 1 || transform.ShearX != 0 || transform.ShearY != 0 {
+			t.Errorf("request %d: expected a pure translation, got %+v", i, transform)
+		}
+		if transform.TranslateX != 10*emuPerPoint || transform.TranslateY != -5*emuPerPoint || transform.Unit != "EMU" {
+			t.Errorf("request %d: expected a (10pt, -5pt) offset, got (%v, %v %s)", i, transform.TranslateX, transform.TranslateY, transform.Unit)
+		}
+	}
+
+	if output.NudgedCount != 3 || output.DeltaX != 10 || output.DeltaY != -5 || len(output.ObjectIDs) != 3 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+}
+
+func TestNudgeObjects_ListedGroupAndChild(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newNudgeObjectsTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.NudgeObjects(context.Background(), nil, NudgeObjectsInput{
+		PresentationID: "pres-1",
+		ObjectIDs:      []string{"child", "title", "group"},
+		DeltaX:         10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The child moves with its group, so it gets no transform of its own
+	wantIDs := []string{"title", "group"}
+	if len(captured) != len(wantIDs) {
+		t.Fatalf("expected %d requests, got %d", len(wantIDs), len(captured))
+	}
+	for i, request := range captured {
+		if objectID := request.UpdatePageElementTransform.ObjectId; objectID != wantIDs[i] {
+			t.Errorf("request %d: expected %s, got %s", i, wantIDs[i], objectID)
+		}
+	}
+	if output.NudgedCount != 2 || len(output.ObjectIDs) != 2 || output.ObjectIDs[0] != "title" || output.ObjectIDs[1] != "group" {
+		t.Errorf("unexpected output: %+v", output)
+	}
+}
+
+func TestNudgeObjects_Errors(t *testing.T) {
+	valid := NudgeObjectsInput{PresentationID: "pres-1", ObjectIDs: []string{"title", "logo"}, DeltaX: 5}
+	with := func(modify func(*NudgeObjectsInput)) NudgeObjectsInput {
+		input := valid
+		modify(&input)
+		return input
+	}
+
+	tests := []struct {
+		name     string
+		input    NudgeObjectsInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", with(func(i *NudgeObjectsInput) { i.PresentationID = "" }), nil, nil, ErrInvalidPresentationID},
+		{"no objects", with(func(i *NudgeObjectsInput) { i.ObjectIDs = nil }), nil, nil, ErrNoObjectsToNudge},
+		{"empty object ID", with(func(i *NudgeObjectsInput) { i.ObjectIDs = []string{"title", ""} }), nil, nil, ErrInvalidObjectID},
+		{"zero delta", with(func(i *NudgeObjectsInput) { i.DeltaX = 0 }), nil, nil, ErrInvalidNudgeDelta},
+		{"NaN delta", with(func(i *NudgeObjectsInput) { i.DeltaY = math.NaN() }), nil, nil, ErrInvalidNudgeDelta},
+		{"infinite delta", with(func(i *NudgeObjectsInput) { i.DeltaX = math.Inf(1) }), nil, nil, ErrInvalidNudgeDelta},
+		{"unknown object", with(func(i *NudgeObjectsInput) { i.ObjectIDs = []string{"title", "nope"} }), nil, nil, ErrObjectNotFound},
+		{"presentation not found", valid, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", valid, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", valid, nil, errors.New("boom"), ErrNudgeObjectsFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newNudgeObjectsTestTools(tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.NudgeObjects(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", batchCalls)
+			}
+		})
+	}
+}
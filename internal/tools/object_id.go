@@ -0,0 +1,27 @@
+package tools
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// objectIDSuffixBytes is the amount of randomness in generated IDs (16 hex characters).
+// Slides accepts IDs of 5 to 50 characters from [a-zA-Z0-9_-:], so prefixes stay well within the limit.
+const objectIDSuffixBytes = 8
+
+// objectIDSuffixFunc returns the unique part of generated object IDs and upload file names.
+// Tests replace it to get deterministic IDs.
+var objectIDSuffixFunc = randomObjectIDSuffix
+
+// randomObjectIDSuffix returns a random hex string. Unlike a timestamp, it does not collide
+// when one batch creates many objects within the same clock tick.
+func randomObjectIDSuffix() string {
+	b := make([]byte, objectIDSuffixBytes)
+	_, _ = rand.Read(b) // crypto/rand.Read never returns an error
+	return hex.EncodeToString(b)
+}
+
+// newObjectID returns a collision-resistant ID of the form "<prefix>_<suffix>".
+func newObjectID(prefix string) string {
+	return prefix + "_" + objectIDSuffixFunc()
+}
@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"regexp"
+	"testing"
+)
+
+// stubObjectIDSuffix makes generated IDs deterministic for the duration of a test.
+func stubObjectIDSuffix(t *testing.T, suffix string) {
+	t.Helper()
+	original := objectIDSuffixFunc
+	objectIDSuffixFunc = func() string { return suffix }
+	t.Cleanup(func() { objectIDSuffixFunc = original })
+}
+
+func TestNewObjectID_Unique(t *testing.T) {
+	// Slides object IDs: 5-50 characters, starting with an alphanumeric or underscore
+	validID := regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_\-:]{4,49}$`)
+
+	const count = 10000
+	seen := make(map[string]bool, count)
+	for i := 0; i < count; i++ {
+		id := batchGenerateObjectID("slides_background")
+		if seen[id] {
+			t.Fatalf("duplicate object ID %q after %d generations", id, i)
+		}
+		if !validID.MatchString(id) {
+			t.Fatalf("object ID %q is not a valid Slides object ID", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestNewObjectID_InjectedSuffix(t *testing.T) {
+	stubObjectIDSuffix(t, "fixed")
+
+	if got := newObjectID("shape"); got != "shape_fixed" {
+		t.Errorf("newObjectID = %q, want shape_fixed", got)
+	}
+	if got := generateImageObjectID(); got != "image_fixed" {
+		t.Errorf("generateImageObjectID = %q, want image_fixed", got)
+	}
+}
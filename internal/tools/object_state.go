@@ -0,0 +1,44 @@
+package tools
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ObjectState is an object's geometry as read back from the API after a mutation.
+type ObjectState struct {
+	Position *Position `json:"position,omitempty"` // Points
+	Size     *Size     `json:"size,omitempty"`     // Displayed size in points: intrinsic size scaled by the transform
+}
+
+// readBackObjectState fetches the presentation once more and extracts the object's resulting geometry.
+// The mutation has already succeeded, so a failed read-back is logged and reported as nil
+// rather than failing the call.
+func (t *Tools) readBackObjectState(ctx context.Context, slidesService SlidesService, presentationID, objectID string) *ObjectState {
+	presentation, err := slidesService.GetPresentation(ctx, presentationID)
+	if err != nil {
+		t.config.Logger.Warn("failed to read back object state",
+			slog.String("presentation_id", presentationID),
+			slog.String("object_id", objectID),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+
+	element := findElementByIDRecursively(presentation.Slides, objectID)
+	if element == nil {
+		t.config.Logger.Warn("object not found when reading back state",
+			slog.String("presentation_id", presentationID),
+			slog.String("object_id", objectID),
+		)
+		return nil
+	}
+
+	// The API keeps the intrinsic size and resizes through the transform's scale factors
+	position, _ := extractElementGeometry(element)
+	state := &ObjectState{Position: position}
+	if width, height, ok := displayedSizeInPoints(element); ok {
+		state.Size = &Size{Width: width, Height: height}
+	}
+	return state
+}
@@ -0,0 +1,44 @@
+package tools
+
+import "strings"
+
+// defaultOutlineIndent is the number of leading spaces that make one outline nesting level.
+const defaultOutlineIndent = 2
+
+// outlineToNestedParagraphs rewrites each paragraph's leading indentation as leading tabs,
+// which CreateParagraphBullets turns into nesting levels (removing the tabs). A tab counts
+// as one level and every indentWidth spaces count as one level; leftover spaces are dropped.
+// It returns the rewritten text and the nesting level of each paragraph.
+func outlineToNestedParagraphs(text string, indentWidth int) (string, []int) {
+	if indentWidth <= 0 {
+		indentWidth = defaultOutlineIndent
+	}
+
+	lines := strings.Split(text, "\n")
+	levels := make([]int, len(lines))
+	for i, line := range lines {
+		tabs, spaces := 0, 0
+		content := strings.TrimLeftFunc(line, func(r rune) bool {
+			switch r {
+			case '\t':
+				tabs++
+			case ' ':
+				spaces++
+			default:
+				return false
+			}
+			return true
+		})
+
+		// Blank lines stay at the top level
+		if content == "" {
+			lines[i] = ""
+			continue
+		}
+
+		levels[i] = tabs + spaces/indentWidth
+		lines[i] = strings.Repeat("\t", levels[i]) + content
+	}
+
+	return strings.Join(lines, "\n"), levels
+}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOutlineToNestedParagraphs(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		indentWidth int
+		wantText    string
+		wantLevels  []int
+	}{
+		{
+			name:        "two spaces per level",
+			text:        "Goals\n  Revenue\n    Q1\n  Hiring\nRisks",
+			indentWidth: 0,
+			wantText:    "Goals\n\tRevenue\n\t\tQ1\n\tHiring\nRisks",
+			wantLevels:  []int{0, 1, 2, 1, 0},
+		},
+		{
+			name:        "tabs count as one level each",
+			text:        "A\n\tB\n\t\tC",
+			indentWidth: 2,
+			wantText:    "A\n\tB\n\t\tC",
+			wantLevels:  []int{0, 1, 2},
+		},
+		{
+			name:        "custom width drops leftover spaces",
+			text:        "A\n    B\n      C\n ",
+			indentWidth: 4,
+			wantText:    "A\n\tB\n\tC\n",
+			wantLevels:  []int{0, 1, 1, 0},
+		},
+		{
+			name:        "mixed tab and spaces",
+			text:        "A\n\t  B",
+			indentWidth: 2,
+			wantText:    "A\n\t\tB",
+			wantLevels:  []int{0, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotText, gotLevels := outlineToNestedParagraphs(tt.text, tt.indentWidth)
+			if gotText != tt.wantText {
+				t.Errorf("text = %q, want %q", gotText, tt.wantText)
+			}
+			if !reflect.DeepEqual(gotLevels, tt.wantLevels) {
+				t.Errorf("levels = %v, want %v", gotLevels, tt.wantLevels)
+			}
+		})
+	}
+}
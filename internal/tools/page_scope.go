@@ -0,0 +1,61 @@
+package tools
+
+import "google.golang.org/api/slides/v1"
+
+// Page types reported for objects found outside regular slides.
+const (
+	pageTypeSlide  = "SLIDE"
+	pageTypeMaster = "MASTER"
+	pageTypeLayout = "LAYOUT"
+)
+
+// scopedPage is a page visited by a presentation-wide traversal.
+type scopedPage struct {
+	Page     *slides.Page
+	PageType string // pageTypeSlide, pageTypeMaster or pageTypeLayout
+	Index    int    // 1-based position within its page type
+}
+
+// traversalPages returns the slides of a presentation, followed by its masters and layouts when requested.
+// Templates often keep branding text and logos on masters and layouts, which slide-only scans miss.
+func traversalPages(presentation *slides.Presentation, includeMasters, includeLayouts bool) []scopedPage {
+	var pages []scopedPage
+	if presentation == nil {
+		return pages
+	}
+
+	appendPages := func(source []*slides.Page, pageType string) {
+		for i, page := range source {
+			if page == nil {
+				continue
+			}
+			pages = append(pages, scopedPage{Page: page, PageType: pageType, Index: i + 1})
+		}
+	}
+
+	appendPages(presentation.Slides, pageTypeSlide)
+	if includeMasters {
+		appendPages(presentation.Masters, pageTypeMaster)
+	}
+	if includeLayouts {
+		appendPages(presentation.Layouts, pageTypeLayout)
+	}
+	return pages
+}
+
+// slideIndex returns the 1-based slide index, or 0 for masters and layouts.
+func (p scopedPage) slideIndex() int {
+	if p.PageType != pageTypeSlide {
+		return 0
+	}
+	return p.Index
+}
+
+// nonSlideType returns the page type for masters and layouts, and "" for slides
+// so slide-only outputs keep their existing shape.
+func (p scopedPage) nonSlideType() string {
+	if p.PageType == pageTypeSlide {
+		return ""
+	}
+	return p.PageType
+}
@@ -0,0 +1,159 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// newBrandedTemplatePresentation returns a deck whose footer text and link live on the master.
+func newBrandedTemplatePresentation() *slides.Presentation {
+	brandShape := func(id string) *slides.PageElement {
+		return &slides.PageElement{
+			ObjectId: id,
+			Shape: &slides.Shape{
+				ShapeType: "TEXT_BOX",
+				Text:      &slides.TextContent{TextElements: createTextElementsWithLink("Acme Corp", "https://acme.example")},
+			},
+		}
+	}
+	return &slides.Presentation{
+		PresentationId: "template",
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1", PageElements: []*slides.PageElement{
+				{ObjectId: "title", Shape: &slides.Shape{Text: &slides.TextContent{TextElements: createTextElementsNoLink("Agenda")}}},
+			}},
+		},
+		Masters: []*slides.Page{{ObjectId: "master-1", PageElements: []*slides.PageElement{brandShape("master-brand")}}},
+		Layouts: []*slides.Page{{ObjectId: "layout-1", PageElements: []*slides.PageElement{brandShape("layout-brand")}}},
+	}
+}
+
+func newPageScopeTestTools(presentation *slides.Presentation) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, nil
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestTraversalPages(t *testing.T) {
+	presentation := newBrandedTemplatePresentation()
+
+	tests := []struct {
+		name           string
+		includeMasters bool
+		includeLayouts bool
+		wantIDs        []string
+	}{
+		{name: "slides only by default", wantIDs: []string{"slide-1"}},
+		{name: "with masters", includeMasters: true, wantIDs: []string{"slide-1", "master-1"}},
+		{name: "with layouts", includeLayouts: true, wantIDs: []string{"slide-1", "layout-1"}},
+		{name: "with both", includeMasters: true, includeLayouts: true, wantIDs: []string{"slide-1", "master-1", "layout-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages := traversalPages(presentation, tt.includeMasters, tt.includeLayouts)
+			if len(pages) != len(tt.wantIDs) {
+				t.Fatalf("got %d pages, want %d", len(pages), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if pages[i].Page.ObjectId != id {
+					t.Errorf("page %d = %q, want %q", i, pages[i].Page.ObjectId, id)
+				}
+			}
+		})
+	}
+
+	if pages := traversalPages(nil, true, true); len(pages) != 0 {
+		t.Errorf("expected no pages for nil presentation, got %d", len(pages))
+	}
+}
+
+func TestSearchText_IncludeMasters(t *testing.T) {
+	tools := newPageScopeTestTools(newBrandedTemplatePresentation())
+
+	output, err := tools.SearchText(context.Background(), nil, SearchTextInput{PresentationID: "template", Query: "acme"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.TotalMatches != 0 {
+		t.Errorf("expected master text to be ignored by default, got %d matches", output.TotalMatches)
+	}
+
+	output, err = tools.SearchText(context.Background(), nil, SearchTextInput{PresentationID: "template", Query: "acme", IncludeMasters: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.TotalMatches != 1 || len(output.Results) != 1 {
+		t.Fatalf("expected 1 match on the master, got %+v", output.Results)
+	}
+	result := output.Results[0]
+	if result.SlideID != "master-1" || result.PageType != "MASTER" || result.SlideIndex != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestListObjects_IncludeLayouts(t *testing.T) {
+	tools := newPageScopeTestTools(newBrandedTemplatePresentation())
+
+	output, err := tools.ListObjects(context.Background(), nil, ListObjectsInput{PresentationID: "template"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.TotalCount != 1 {
+		t.Errorf("expected only slide objects by default, got %d", output.TotalCount)
+	}
+
+	// The slide index filter restricts slides but not the included layouts
+	presentation := newBrandedTemplatePresentation()
+	presentation.Slides = append(presentation.Slides, &slides.Page{ObjectId: "slide-2"})
+	tools = newPageScopeTestTools(presentation)
+	output, err = tools.ListObjects(context.Background(), nil, ListObjectsInput{
+		PresentationID: "template",
+		SlideIndices:   []int{2},
+		IncludeLayouts: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.TotalCount != 1 {
+		t.Fatalf("expected the layout object only, got %+v", output.Objects)
+	}
+	obj := output.Objects[0]
+	if obj.ObjectID != "layout-brand" || obj.PageType != "LAYOUT" || obj.PageID != "layout-1" {
+		t.Errorf("unexpected object: %+v", obj)
+	}
+}
+
+func TestManageHyperlinks_ListIncludeMasters(t *testing.T) {
+	tools := newPageScopeTestTools(newBrandedTemplatePresentation())
+
+	output, err := tools.ManageHyperlinks(context.Background(), nil, ManageHyperlinksInput{PresentationID: "template", Action: "list"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.Links) != 0 {
+		t.Errorf("expected master links to be ignored by default, got %d", len(output.Links))
+	}
+
+	output, err = tools.ManageHyperlinks(context.Background(), nil, ManageHyperlinksInput{
+		PresentationID: "template",
+		Action:         "list",
+		Scope:          "object",
+		ObjectID:       "master-brand",
+		IncludeMasters: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.Links) != 1 || output.Links[0].PageType != "MASTER" || output.Links[0].URL != "https://acme.example" {
+		t.Errorf("unexpected links: %+v", output.Links)
+	}
+}
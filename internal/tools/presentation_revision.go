@@ -0,0 +1,39 @@
+package tools
+
+import (
+	"context"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// presentationRevision returns the caching identifiers for a presentation a read tool just
+// fetched: the Slides revision ID and, when includeETag is set, the Drive ETag. The ETag costs
+// an extra Drive call; a failed lookup is logged and leaves it empty, since it is only a hint.
+func (t *Tools) presentationRevision(ctx context.Context, tokenSource oauth2.TokenSource, presentation *slides.Presentation, includeETag bool) (string, string) {
+	revisionID := presentation.RevisionId
+	if !includeETag {
+		return revisionID, ""
+	}
+
+	driveService, err := t.driveServiceFactory(ctx, tokenSource)
+	if err != nil {
+		t.config.Logger.Warn("failed to create drive service for etag",
+			slog.String("presentation_id", presentation.PresentationId),
+			slog.String("error", err.Error()),
+		)
+		return revisionID, ""
+	}
+
+	etag, err := driveService.GetFileETag(ctx, presentation.PresentationId)
+	if err != nil {
+		t.config.Logger.Warn("failed to get presentation etag",
+			slog.String("presentation_id", presentation.PresentationId),
+			slog.String("error", err.Error()),
+		)
+		return revisionID, ""
+	}
+
+	return revisionID, etag
+}
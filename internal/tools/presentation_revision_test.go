@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func newRevisionTestTools(etag string, etagErr error) (*Tools, *int) {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Title:          "Deck",
+		RevisionId:     "rev-42",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					{ObjectId: "shape-1", Shape: &slides.Shape{ShapeType: "RECTANGLE"}},
+				},
+			},
+		},
+	}
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, nil
+		},
+	}
+
+	etagCalls := 0
+	mockDrive := &mockDriveService{
+		GetFileETagFunc: func(ctx context.Context, fileID string) (string, error) {
+			etagCalls++
+			if fileID != "pres-1" {
+				return "", errors.New("unexpected file ID " + fileID)
+			}
+			return etag, etagErr
+		},
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(),
+		func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) { return mockSlides, nil },
+		func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) { return mockDrive, nil },
+	)
+	return tools, &etagCalls
+}
+
+func TestPresentationRevision_ReadTools(t *testing.T) {
+	tests := []struct {
+		name        string
+		includeETag bool
+		etagErr     error
+		wantETag    string
+		wantCalls   int
+	}{
+		{name: "revision only", wantCalls: 0},
+		{name: "with etag", includeETag: true, wantETag: `"etag-1"`, wantCalls: 1},
+		{name: "etag failure is not fatal", includeETag: true, etagErr: errors.New("drive down"), wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tools, calls := newRevisionTestTools(`"etag-1"`, tt.etagErr)
+			presentationOut, err := tools.GetPresentation(ctx, nil, GetPresentationInput{PresentationID: "pres-1", IncludeETag: tt.includeETag})
+			if err != nil {
+				t.Fatalf("GetPresentation: unexpected error: %v", err)
+			}
+			if presentationOut.RevisionID != "rev-42" || presentationOut.ETag != tt.wantETag {
+				t.Errorf("GetPresentation: got revision %q etag %q", presentationOut.RevisionID, presentationOut.ETag)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("GetPresentation: expected %d etag calls, got %d", tt.wantCalls, *calls)
+			}
+
+			tools, calls = newRevisionTestTools(`"etag-1"`, tt.etagErr)
+			slidesOut, err := tools.ListSlides(ctx, nil, ListSlidesInput{PresentationID: "pres-1", IncludeETag: tt.includeETag})
+			if err != nil {
+				t.Fatalf("ListSlides: unexpected error: %v", err)
+			}
+			if slidesOut.RevisionID != "rev-42" || slidesOut.ETag != tt.wantETag {
+				t.Errorf("ListSlides: got revision %q etag %q", slidesOut.RevisionID, slidesOut.ETag)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("ListSlides: expected %d etag calls, got %d", tt.wantCalls, *calls)
+			}
+
+			tools, calls = newRevisionTestTools(`"etag-1"`, tt.etagErr)
+			objectOut, err := tools.GetObject(ctx, nil, GetObjectInput{PresentationID: "pres-1", ObjectID: "shape-1", IncludeETag: tt.includeETag})
+			if err != nil {
+				t.Fatalf("GetObject: unexpected error: %v", err)
+			}
+			if objectOut.RevisionID != "rev-42" || objectOut.ETag != tt.wantETag {
+				t.Errorf("GetObject: got revision %q etag %q", objectOut.RevisionID, objectOut.ETag)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("GetObject: expected %d etag calls, got %d", tt.wantCalls, *calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package tools
+
+import "context"
+
+// Progress receives step updates from long-running tools.
+// Implementations must be safe to call from the tool's goroutine and should not block.
+type Progress interface {
+	Report(completed, total int, message string)
+}
+
+// ProgressFunc adapts a function to the Progress interface.
+type ProgressFunc func(completed, total int, message string)
+
+// Report calls f.
+func (f ProgressFunc) Report(completed, total int, message string) {
+	f(completed, total, message)
+}
+
+// noopProgress discards updates. It is used when the caller did not ask for progress.
+type noopProgress struct{}
+
+// Report does nothing.
+func (noopProgress) Report(int, int, string) {}
+
+// progressContextKey is the context key for the Progress of a tool call.
+type progressContextKey struct{}
+
+// WithProgress returns a context that makes tools report their steps to p.
+func WithProgress(ctx context.Context, p Progress) context.Context {
+	if p == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, progressContextKey{}, p)
+}
+
+// ProgressFromContext returns the Progress attached to ctx, or a no-op one.
+func ProgressFromContext(ctx context.Context) Progress {
+	if p, ok := ctx.Value(progressContextKey{}).(Progress); ok {
+		return p
+	}
+	return noopProgress{}
+}
@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"context"
+	"testing"
+)
+
+// progressRecorder collects progress updates for assertions.
+type progressRecorder struct {
+	completed []int
+	totals    []int
+	messages  []string
+}
+
+func (r *progressRecorder) Report(completed, total int, message string) {
+	r.completed = append(r.completed, completed)
+	r.totals = append(r.totals, total)
+	r.messages = append(r.messages, message)
+}
+
+func TestProgressFromContext(t *testing.T) {
+	if _, ok := ProgressFromContext(context.Background()).(noopProgress); !ok {
+		t.Error("expected no-op progress when none is attached")
+	}
+
+	if _, ok := ProgressFromContext(WithProgress(context.Background(), nil)).(noopProgress); !ok {
+		t.Error("expected no-op progress when nil is attached")
+	}
+
+	calls := 0
+	ctx := WithProgress(context.Background(), ProgressFunc(func(completed, total int, message string) {
+		calls++
+		if completed != 2 || total != 5 || message != "step" {
+			t.Errorf("Report(%d, %d, %q), want (2, 5, \"step\")", completed, total, message)
+		}
+	}))
+	ProgressFromContext(ctx).Report(2, 5, "step")
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
@@ -0,0 +1,566 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QR codes are encoded in byte mode, so any UTF-8 text fits, following ISO/IEC 18004.
+// Versions 1 to 40 are chosen automatically as the smallest that holds the data.
+const (
+	qrMinVersion     = 1
+	qrMaxVersion     = 40
+	qrQuietZone      = 4 // Modules of white border required around the symbol
+	qrTargetPixels   = 1024
+	qrPenaltyRun     = 3
+	qrPenaltyBlock   = 3
+	qrPenaltyFinder  = 40
+	qrPenaltyBalance = 10
+)
+
+// qrErrorCorrectionLevel is one of the four QR error correction levels.
+type qrErrorCorrectionLevel struct {
+	name       string
+	ordinal    int // Row in the block tables
+	formatBits int // Value encoded in the format information
+}
+
+// qrErrorCorrectionLevels maps level names to levels, from least to most redundant.
+var qrErrorCorrectionLevels = map[string]qrErrorCorrectionLevel{
+	"L": {"L", 0, 1}, // ~7% of codewords can be restored
+	"M": {"M", 1, 0}, // ~15%
+	"Q": {"Q", 2, 3}, // ~25%
+	"H": {"H", 3, 2}, // ~30%
+}
+
+// qrECCCodewordsPerBlock holds the error correction codewords per block, indexed by level
+// ordinal and version (index 0 is unused).
+var qrECCCodewordsPerBlock = [4][41]int{
+	{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// qrErrorCorrectionBlocks holds the number of error correction blocks, indexed like
+// qrECCCodewordsPerBlock.
+var qrErrorCorrectionBlocks = [4][41]int{
+	{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// qrCode is an encoded QR symbol. modules[y][x] is true for dark modules.
+type qrCode struct {
+	version    int
+	level      qrErrorCorrectionLevel
+	size       int
+	mask       int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+// parseQRErrorCorrectionLevel returns the level for a name (case-insensitive), M if empty.
+func parseQRErrorCorrectionLevel(name string) (qrErrorCorrectionLevel, bool) {
+	if name == "" {
+		name = "M"
+	}
+	level, ok := qrErrorCorrectionLevels[strings.ToUpper(strings.TrimSpace(name))]
+	return level, ok
+}
+
+// qrMaxPayloadBytes returns the most bytes a QR code of the given level can hold.
+func qrMaxPayloadBytes(level qrErrorCorrectionLevel) int {
+	return (qrNumDataCodewords(qrMaxVersion, level)*8 - 4 - qrCharCountBits(qrMaxVersion)) / 8
+}
+
+// encodeQRCode encodes data in byte mode in the smallest version that fits, with the mask
+// pattern scoring the lowest penalty.
+func encodeQRCode(data []byte, level qrErrorCorrectionLevel) (*qrCode, error) {
+	version := 0
+	for v := qrMinVersion; v <= qrMaxVersion; v++ {
+		if 4+qrCharCountBits(v)+len(data)*8 <= qrNumDataCodewords(v, level)*8 {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("%w: %d bytes exceed the %d bytes a level %s QR code can hold", ErrQRCodeDataTooLong, len(data), qrMaxPayloadBytes(level), level.name)
+	}
+
+	codewords := qrAddErrorCorrection(qrDataCodewords(data, version, level), version, level)
+
+	size := version*4 + 17
+	code := &qrCode{version: version, level: level, size: size}
+	code.modules = make([][]bool, size)
+	code.isFunction = make([][]bool, size)
+	for y := range size {
+		code.modules[y] = make([]bool, size)
+		code.isFunction[y] = make([]bool, size)
+	}
+
+	code.drawFunctionPatterns()
+	code.drawCodewords(codewords)
+
+	// Pick the mask with the lowest penalty; applying a mask twice undoes it
+	bestPenalty := -1
+	for mask := range 8 {
+		code.applyMask(mask)
+		code.drawFormatBits(mask)
+		if penalty := code.penaltyScore(); bestPenalty < 0 || penalty < bestPenalty {
+			code.mask, bestPenalty = mask, penalty
+		}
+		code.applyMask(mask)
+	}
+	code.applyMask(code.mask)
+	code.drawFormatBits(code.mask)
+
+	return code, nil
+}
+
+// qrCharCountBits returns the length of the byte mode character count field for a version.
+func qrCharCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+// qrNumRawDataModules returns the modules available for data and error correction codewords
+// once the function patterns of a version are placed.
+func qrNumRawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		result -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+// qrNumDataCodewords returns the data codewords a version holds at a level.
+func qrNumDataCodewords(version int, level qrErrorCorrectionLevel) int {
+	return qrNumRawDataModules(version)/8 -
+		qrECCCodewordsPerBlock[level.ordinal][version]*qrErrorCorrectionBlocks[level.ordinal][version]
+}
+
+// qrDataCodewords builds the byte mode segment, terminator and padding for a version.
+func qrDataCodewords(data []byte, version int, level qrErrorCorrectionLevel) []byte {
+	capacityBits := qrNumDataCodewords(version, level) * 8
+
+	var bits []bool
+	appendBits := func(value, length int) {
+		for i := length - 1; i >= 0; i-- {
+			bits = append(bits, (value>>i)&1 == 1)
+		}
+	}
+
+	appendBits(0b0100, 4) // Byte mode indicator
+	appendBits(len(data), qrCharCountBits(version))
+	for _, b := range data {
+		appendBits(int(b), 8)
+	}
+
+	// Terminator, then zeros up to a byte boundary, then alternating pad bytes
+	appendBits(0, min(4, capacityBits-len(bits)))
+	appendBits(0, (8-len(bits)%8)%8)
+	for pad := 0xEC; len(bits) < capacityBits; pad ^= 0xEC ^ 0x11 {
+		appendBits(pad, 8)
+	}
+
+	codewords := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i/8] |= 1 << (7 - i%8)
+		}
+	}
+	return codewords
+}
+
+// qrAddErrorCorrection splits data into blocks, appends each block's Reed-Solomon codewords
+// and interleaves the blocks into the final codeword sequence.
+func qrAddErrorCorrection(data []byte, version int, level qrErrorCorrectionLevel) []byte {
+	numBlocks := qrErrorCorrectionBlocks[level.ordinal][version]
+	blockECCLen := qrECCCodewordsPerBlock[level.ordinal][version]
+	rawCodewords := qrNumRawDataModules(version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLen := rawCodewords / numBlocks
+
+	divisor := reedSolomonDivisor(blockECCLen)
+	blocks := make([][]byte, numBlocks)
+	offset := 0
+	for i := range numBlocks {
+		dataLen := shortBlockLen - blockECCLen
+		if i >= numShortBlocks {
+			dataLen++
+		}
+		block := append([]byte{}, data[offset:offset+dataLen]...)
+		offset += dataLen
+		ecc := reedSolomonRemainder(block, divisor)
+		if i < numShortBlocks {
+			block = append(block, 0) // Placeholder so all blocks line up, skipped below
+		}
+		blocks[i] = append(block, ecc...)
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := range blocks[0] {
+		for j, block := range blocks {
+			if i != shortBlockLen-blockECCLen || j >= numShortBlocks {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+// reedSolomonDivisor returns the generator polynomial of a degree over GF(2^8), highest
+// coefficient first and the leading 1 omitted.
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for range degree {
+		for j := range result {
+			result[j] = gf256Multiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gf256Multiply(root, 0x02)
+	}
+	return result
+}
+
+// reedSolomonRemainder returns the error correction codewords of data for a divisor.
+func reedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coefficient := range divisor {
+			result[i] ^= gf256Multiply(coefficient, factor)
+		}
+	}
+	return result
+}
+
+// gf256Multiply multiplies two elements of GF(2^8) modulo the QR polynomial 0x11D.
+func gf256Multiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+// setFunctionModule colors a module and reserves it from data placement and masking.
+func (q *qrCode) setFunctionModule(x, y int, dark bool) {
+	q.modules[y][x] = dark
+	q.isFunction[y][x] = true
+}
+
+// drawFunctionPatterns draws the timing, finder and alignment patterns and reserves the
+// format and version areas.
+func (q *qrCode) drawFunctionPatterns() {
+	for i := range q.size {
+		q.setFunctionModule(6, i, i%2 == 0)
+		q.setFunctionModule(i, 6, i%2 == 0)
+	}
+
+	q.drawFinderPattern(3, 3)
+	q.drawFinderPattern(q.size-4, 3)
+	q.drawFinderPattern(3, q.size-4)
+
+	positions := qrAlignmentPatternPositions(q.version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// Skip the corners taken by finder patterns
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			q.drawAlignmentPattern(x, y)
+		}
+	}
+
+	q.drawFormatBits(0) // Placeholder, rewritten once the mask is chosen
+	q.drawVersionBits()
+}
+
+// drawFinderPattern draws a finder pattern and its separator centered on (x, y).
+func (q *qrCode) drawFinderPattern(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || xx >= q.size || yy < 0 || yy >= q.size {
+				continue
+			}
+			distance := max(absInt(dx), absInt(dy))
+			q.setFunctionModule(xx, yy, distance != 2 && distance != 4)
+		}
+	}
+}
+
+// drawAlignmentPattern draws a 5x5 alignment pattern centered on (x, y).
+func (q *qrCode) drawAlignmentPattern(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			q.setFunctionModule(x+dx, y+dy, max(absInt(dx), absInt(dy)) != 1)
+		}
+	}
+}
+
+// qrAlignmentPatternPositions returns the alignment pattern center coordinates of a version,
+// used on both axes.
+func qrAlignmentPatternPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	numAlign := version/7 + 2
+	step := (version*8 + numAlign*3 + 5) / (numAlign*4 - 4) * 2
+	positions := make([]int, numAlign)
+	positions[0] = 6
+	for i, pos := numAlign-1, version*4+17-7; i >= 1; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+	return positions
+}
+
+// qrFormatBits returns the 15-bit format information for a level and mask, BCH-protected
+// and XORed with the fixed format mask.
+func qrFormatBits(level qrErrorCorrectionLevel, mask int) int {
+	data := level.formatBits<<3 | mask
+	remainder := data
+	for range 10 {
+		remainder = (remainder << 1) ^ ((remainder >> 9) * 0x537)
+	}
+	return (data<<10 | remainder) ^ 0x5412
+}
+
+// qrVersionBits returns the 18-bit BCH-protected version information (versions 7 and up).
+func qrVersionBits(version int) int {
+	remainder := version
+	for range 12 {
+		remainder = (remainder << 1) ^ ((remainder >> 11) * 0x1F25)
+	}
+	return version<<12 | remainder
+}
+
+// drawFormatBits draws both copies of the format information and the dark module.
+func (q *qrCode) drawFormatBits(mask int) {
+	bits := qrFormatBits(q.level, mask)
+	bit := func(i int) bool { return (bits>>i)&1 == 1 }
+
+	// Copy around the top left finder
+	for i := 0; i <= 5; i++ {
+		q.setFunctionModule(8, i, bit(i))
+	}
+	q.setFunctionModule(8, 7, bit(6))
+	q.setFunctionModule(8, 8, bit(7))
+	q.setFunctionModule(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		q.setFunctionModule(14-i, 8, bit(i))
+	}
+
+	// Copy split between the top right and bottom left finders
+	for i := range 8 {
+		q.setFunctionModule(q.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		q.setFunctionModule(8, q.size-15+i, bit(i))
+	}
+	q.setFunctionModule(8, q.size-8, true)
+}
+
+// drawVersionBits draws both copies of the version information for versions 7 and up.
+func (q *qrCode) drawVersionBits() {
+	if q.version < 7 {
+		return
+	}
+	bits := qrVersionBits(q.version)
+	for i := range 18 {
+		dark := (bits>>i)&1 == 1
+		a, b := q.size-11+i%3, i/3
+		q.setFunctionModule(a, b, dark)
+		q.setFunctionModule(b, a, dark)
+	}
+}
+
+// drawCodewords places the codeword bits in the zigzag order of the standard, two columns
+// at a time from the bottom right, skipping function modules.
+func (q *qrCode) drawCodewords(codewords []byte) {
+	i := 0
+	for right := q.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5 // Skip the vertical timing pattern
+		}
+		upward := (right+1)&2 == 0
+		for vert := range q.size {
+			y := vert
+			if upward {
+				y = q.size - 1 - vert
+			}
+			for j := range 2 {
+				x := right - j
+				if q.isFunction[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				q.modules[y][x] = (codewords[i/8]>>(7-i%8))&1 == 1
+				i++
+			}
+		}
+	}
+}
+
+// applyMask flips the data modules selected by a mask pattern.
+func (q *qrCode) applyMask(mask int) {
+	for y := range q.size {
+		for x := range q.size {
+			if q.isFunction[y][x] {
+				continue
+			}
+			var flip bool
+			switch mask {
+			case 0:
+				flip = (x+y)%2 == 0
+			case 1:
+				flip = y%2 == 0
+			case 2:
+				flip = x%3 == 0
+			case 3:
+				flip = (x+y)%3 == 0
+			case 4:
+				flip = (x/3+y/2)%2 == 0
+			case 5:
+				flip = x*y%2+x*y%3 == 0
+			case 6:
+				flip = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				flip = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if flip {
+				q.modules[y][x] = !q.modules[y][x]
+			}
+		}
+	}
+}
+
+// penaltyScore rates how hard the symbol is to scan: long same-colored runs, 2x2 blocks,
+// finder-like patterns and an unbalanced share of dark modules all add to it.
+func (q *qrCode) penaltyScore() int {
+	penalty := 0
+	at := func(x, y int, horizontal bool) bool {
+		if horizontal {
+			return q.modules[y][x]
+		}
+		return q.modules[x][y]
+	}
+
+	finderLike := []bool{true, false, true, true, true, false, true}
+	for _, horizontal := range []bool{true, false} {
+		for line := range q.size {
+			run := 1
+			for i := 1; i < q.size; i++ {
+				if at(i, line, horizontal) == at(i-1, line, horizontal) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					penalty += qrPenaltyRun + run - 5
+				}
+				run = 1
+			}
+			if run >= 5 {
+				penalty += qrPenaltyRun + run - 5
+			}
+
+			// 1:1:3:1:1 finder-like pattern with four light modules on either side
+			for i := 0; i+len(finderLike) <= q.size; i++ {
+				matches := true
+				for k, dark := range finderLike {
+					if at(i+k, line, horizontal) != dark {
+						matches = false
+						break
+					}
+				}
+				if matches && (q.isLight(i-4, i, line, horizontal) || q.isLight(i+7, i+11, line, horizontal)) {
+					penalty += qrPenaltyFinder
+				}
+			}
+		}
+	}
+
+	dark := 0
+	for y := range q.size {
+		for x := range q.size {
+			if q.modules[y][x] {
+				dark++
+			}
+			if x+1 < q.size && y+1 < q.size {
+				color := q.modules[y][x]
+				if color == q.modules[y][x+1] && color == q.modules[y+1][x] && color == q.modules[y+1][x+1] {
+					penalty += qrPenaltyBlock
+				}
+			}
+		}
+	}
+
+	total := q.size * q.size
+	deviation := absInt(dark*100/total - 50)
+	penalty += deviation / 5 * qrPenaltyBalance
+	return penalty
+}
+
+// isLight reports whether the modules in [from, to) along a line are all light. Positions
+// outside the symbol count as light, as they fall in the quiet zone.
+func (q *qrCode) isLight(from, to, line int, horizontal bool) bool {
+	for i := from; i < to; i++ {
+		if i < 0 || i >= q.size {
+			continue
+		}
+		if (horizontal && q.modules[line][i]) || (!horizontal && q.modules[i][line]) {
+			return false
+		}
+	}
+	return true
+}
+
+// renderPNG draws the symbol black on white with the standard quiet zone, scaled to whole
+// pixels per module so the image is close to qrTargetPixels wide.
+func (q *qrCode) renderPNG() ([]byte, error) {
+	modules := q.size + 2*qrQuietZone
+	scale := max(1, qrTargetPixels/modules)
+	width := modules * scale
+
+	pixels := make([]byte, width*width*4)
+	for py := range width {
+		for px := range width {
+			x, y := px/scale-qrQuietZone, py/scale-qrQuietZone
+			var value byte = 255
+			if x >= 0 && x < q.size && y >= 0 && y < q.size && q.modules[y][x] {
+				value = 0
+			}
+			idx := (py*width + px) * 4
+			pixels[idx] = value
+			pixels[idx+1] = value
+			pixels[idx+2] = value
+			pixels[idx+3] = 255
+		}
+	}
+
+	return encodePNG(width, width, pixels)
+}
+
+// absInt returns the absolute value of an int.
+func absInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
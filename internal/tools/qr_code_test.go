@@ -0,0 +1,320 @@
+package tools
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestGF256Multiply(t *testing.T) {
+	tests := []struct{ x, y, want byte }{
+		{0, 0x53, 0},
+		{1, 0x53, 0x53},
+		{2, 0x80, 0x1D}, // Reduced by the 0x11D polynomial
+		{0x53, 0xCA, 0x8F},
+	}
+	for _, tt := range tests {
+		if got := gf256Multiply(tt.x, tt.y); got != tt.want {
+			t.Errorf("gf256Multiply(%#x, %#x) = %#x, want %#x", tt.x, tt.y, got, tt.want)
+		}
+		if got := gf256Multiply(tt.y, tt.x); got != tt.want {
+			t.Errorf("gf256Multiply is not commutative for %#x, %#x", tt.x, tt.y)
+		}
+	}
+}
+
+func TestReedSolomonRemainder(t *testing.T) {
+	// "HELLO WORLD" as a 1-M symbol, from the worked example of the standard
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	got := reedSolomonRemainder(data, reedSolomonDivisor(len(want)))
+	if !bytes.Equal(got, want) {
+		t.Errorf("reedSolomonRemainder() = %v, want %v", got, want)
+	}
+}
+
+func TestQRFormatAndVersionBits(t *testing.T) {
+	tests := []struct {
+		level string
+		mask  int
+		want  int
+	}{
+		{"L", 0, 0b111011111000100},
+		{"M", 0, 0b101010000010010},
+		{"Q", 0, 0b011010101011111},
+		{"H", 0, 0b001011010001001},
+		{"M", 5, 0b100000011001110},
+		{"H", 7, 0b000100000111011},
+	}
+	for _, tt := range tests {
+		if got := qrFormatBits(qrErrorCorrectionLevels[tt.level], tt.mask); got != tt.want {
+			t.Errorf("qrFormatBits(%s, %d) = %015b, want %015b", tt.level, tt.mask, got, tt.want)
+		}
+	}
+
+	versions := map[int]int{7: 0x07C94, 8: 0x085BC, 21: 0x15683, 40: 0x28C69}
+	for version, want := range versions {
+		if got := qrVersionBits(version); got != want {
+			t.Errorf("qrVersionBits(%d) = %#x, want %#x", version, got, want)
+		}
+	}
+}
+
+func TestQRCapacity(t *testing.T) {
+	// Byte mode capacities from the standard, in L, M, Q, H order
+	capacities := map[int][4]int{
+		1:  {17, 14, 11, 7},
+		2:  {32, 26, 20, 14},
+		5:  {106, 84, 60, 44},
+		9:  {230, 180, 130, 98},
+		10: {271, 213, 151, 119},
+		20: {858, 666, 482, 382},
+		27: {1465, 1125, 805, 625},
+		28: {1528, 1190, 868, 658},
+		40: {2953, 2331, 1663, 1273},
+	}
+	for version, want := range capacities {
+		for _, level := range qrErrorCorrectionLevels {
+			capacity := (qrNumDataCodewords(version, level)*8 - 4 - qrCharCountBits(version)) / 8
+			if capacity != want[level.ordinal] {
+				t.Errorf("version %d-%s holds %d bytes, want %d", version, level.name, capacity, want[level.ordinal])
+			}
+		}
+	}
+	if got := qrMaxPayloadBytes(qrErrorCorrectionLevels["M"]); got != 2331 {
+		t.Errorf("qrMaxPayloadBytes(M) = %d, want 2331", got)
+	}
+}
+
+func TestQRAlignmentPatternPositions(t *testing.T) {
+	tests := map[int][]int{
+		1:  nil,
+		2:  {6, 18},
+		7:  {6, 22, 38},
+		14: {6, 26, 46, 66},
+		32: {6, 34, 60, 86, 112, 138},
+		36: {6, 24, 50, 76, 102, 128, 154},
+		40: {6, 30, 58, 86, 114, 142, 170},
+	}
+	for version, want := range tests {
+		got := qrAlignmentPatternPositions(version)
+		if len(got) != len(want) {
+			t.Errorf("version %d: got %v, want %v", version, got, want)
+			continue
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("version %d: got %v, want %v", version, got, want)
+				break
+			}
+		}
+	}
+}
+
+// readQRCode decodes a symbol the way a scanner would once the grid is sampled: read the
+// format information, unmask, collect codewords, check every block's error correction and
+// parse the byte mode segment.
+func readQRCode(t *testing.T, code *qrCode) []byte {
+	t.Helper()
+
+	// Format information from the copy around the top left finder
+	var format int
+	for i := 0; i <= 5; i++ {
+		if code.modules[i][8] {
+			format |= 1 << i
+		}
+	}
+	for i, module := range []bool{code.modules[7][8], code.modules[8][8], code.modules[8][7]} {
+		if module {
+			format |= 1 << (6 + i)
+		}
+	}
+	for i := 9; i < 15; i++ {
+		if code.modules[8][14-i] {
+			format |= 1 << i
+		}
+	}
+	if format != qrFormatBits(code.level, code.mask) {
+		t.Fatalf("format bits %015b do not encode level %s mask %d", format, code.level.name, code.mask)
+	}
+
+	unmasked := &qrCode{version: code.version, size: code.size, modules: make([][]bool, code.size), isFunction: code.isFunction}
+	for y := range code.modules {
+		unmasked.modules[y] = append([]bool{}, code.modules[y]...)
+	}
+	unmasked.applyMask(code.mask)
+
+	var bits []bool
+	for right := code.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := range code.size {
+			y := vert
+			if (right+1)&2 == 0 {
+				y = code.size - 1 - vert
+			}
+			for j := range 2 {
+				if !code.isFunction[y][right-j] {
+					bits = append(bits, unmasked.modules[y][right-j])
+				}
+			}
+		}
+	}
+	if want := qrNumRawDataModules(code.version); len(bits) != want {
+		t.Fatalf("found %d data modules, want %d", len(bits), want)
+	}
+	codewords := make([]byte, len(bits)/8)
+	for i := range codewords {
+		for _, bit := range bits[i*8 : i*8+8] {
+			codewords[i] <<= 1
+			if bit {
+				codewords[i] |= 1
+			}
+		}
+	}
+
+	// De-interleave into blocks and check each has zero syndromes
+	numBlocks := qrErrorCorrectionBlocks[code.level.ordinal][code.version]
+	eccLen := qrECCCodewordsPerBlock[code.level.ordinal][code.version]
+	numShortBlocks := numBlocks - len(codewords)%numBlocks
+	shortDataLen := len(codewords)/numBlocks - eccLen
+	blocks := make([][]byte, numBlocks)
+	next := 0
+	for i := 0; i <= shortDataLen; i++ {
+		for j := range blocks {
+			if i < shortDataLen || j >= numShortBlocks {
+				blocks[j] = append(blocks[j], codewords[next])
+				next++
+			}
+		}
+	}
+	for range eccLen {
+		for j := range blocks {
+			blocks[j] = append(blocks[j], codewords[next])
+			next++
+		}
+	}
+
+	var data []byte
+	for j, block := range blocks {
+		root := byte(1)
+		for range eccLen {
+			var syndrome byte
+			for _, b := range block {
+				syndrome = gf256Multiply(syndrome, root) ^ b
+			}
+			if syndrome != 0 {
+				t.Fatalf("block %d has a non-zero syndrome", j)
+			}
+			root = gf256Multiply(root, 0x02)
+		}
+		data = append(data, block[:len(block)-eccLen]...)
+	}
+
+	// Byte mode segment: 4-bit mode, count, then the bytes
+	readBits := func(offset, length int) int {
+		value := 0
+		for i := offset; i < offset+length; i++ {
+			value = value<<1 | int(data[i/8]>>(7-i%8)&1)
+		}
+		return value
+	}
+	if mode := readBits(0, 4); mode != 0b0100 {
+		t.Fatalf("mode = %04b, want byte mode", mode)
+	}
+	countBits := qrCharCountBits(code.version)
+	count := readBits(4, countBits)
+	payload := make([]byte, count)
+	for i := range payload {
+		payload[i] = byte(readBits(4+countBits+i*8, 8))
+	}
+	return payload
+}
+
+func TestEncodeQRCode_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		level       string
+		wantVersion int
+	}{
+		{"short url", "https://example.com", "M", 2},
+		{"version 1 limit", strings.Repeat("a", 14), "M", 1},
+		{"just past version 1", strings.Repeat("a", 15), "M", 2},
+		{"high redundancy", "https://example.com/promo", "H", 4},
+		{"version info", strings.Repeat("x", 150), "L", 7},
+		{"long count field", strings.Repeat("y", 250), "Q", 14},
+		{"unicode", "Café ☕ https://example.com/menu", "M", 3},
+		{"largest", strings.Repeat("z", 2953), "L", 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := encodeQRCode([]byte(tt.data), qrErrorCorrectionLevels[tt.level])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if code.version != tt.wantVersion || code.size != tt.wantVersion*4+17 {
+				t.Errorf("version %d (%d modules), want %d", code.version, code.size, tt.wantVersion)
+			}
+			if got := readQRCode(t, code); string(got) != tt.data {
+				t.Errorf("decoded %q, want %q", got, tt.data)
+			}
+		})
+	}
+}
+
+func TestEncodeQRCode_TooLong(t *testing.T) {
+	_, err := encodeQRCode(make([]byte, 1274), qrErrorCorrectionLevels["H"])
+	if err == nil || !strings.Contains(err.Error(), "1273") {
+		t.Errorf("expected ErrQRCodeDataTooLong mentioning the 1273 byte limit, got %v", err)
+	}
+}
+
+func TestQRCode_RenderPNG(t *testing.T) {
+	code, err := encodeQRCode([]byte("https://example.com"), qrErrorCorrectionLevels["M"])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := code.renderPNG()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if detectImageMimeType(data) != "image/png" {
+		t.Fatal("expected PNG magic bytes")
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("generated PNG does not decode: %v", err)
+	}
+
+	modules := code.size + 2*qrQuietZone
+	scale := qrTargetPixels / modules
+	bounds := img.Bounds()
+	if bounds.Dx() != modules*scale || bounds.Dy() != bounds.Dx() {
+		t.Fatalf("image is %dx%d, want a %d pixel square", bounds.Dx(), bounds.Dy(), modules*scale)
+	}
+
+	isDark := func(x, y int) bool {
+		r, _, _, _ := img.At((x+qrQuietZone)*scale+scale/2, (y+qrQuietZone)*scale+scale/2).RGBA()
+		return r == 0
+	}
+	if isDark(-1, -1) || isDark(-qrQuietZone, 0) {
+		t.Error("expected a light quiet zone")
+	}
+	for y := range code.size {
+		for x := range code.size {
+			if isDark(x, y) != code.modules[y][x] {
+				t.Fatalf("pixel for module (%d, %d) does not match", x, y)
+			}
+		}
+	}
+	// Top left finder: dark ring, light ring, dark center
+	if !isDark(0, 0) || isDark(1, 1) || !isDark(3, 3) || isDark(7, 7) {
+		t.Error("expected a finder pattern in the top left corner")
+	}
+}
@@ -0,0 +1,139 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for remove_empty_slides tool.
+var (
+	ErrInvalidMinKeep          = errors.New("invalid min_keep")
+	ErrRemoveEmptySlidesFailed = errors.New("failed to remove empty slides")
+)
+
+// RemoveEmptySlidesInput represents the input for the remove_empty_slides tool.
+type RemoveEmptySlidesInput struct {
+	PresentationID string `json:"presentation_id"`
+	DryRun         bool   `json:"dry_run,omitempty"`  // Report what would be removed without deleting
+	MinKeep        int    `json:"min_keep,omitempty"` // Slides that always remain (default and minimum 1)
+}
+
+// RemoveEmptySlidesOutput represents the output of the remove_empty_slides tool.
+type RemoveEmptySlidesOutput struct {
+	RemovedSlideIDs     []string `json:"removed_slide_ids"`              // Would be removed with dry_run
+	KeptEmptySlideIDs   []string `json:"kept_empty_slide_ids,omitempty"` // Empty but kept to honor min_keep
+	RemovedCount        int      `json:"removed_count"`
+	RemainingSlideCount int      `json:"remaining_slide_count"`
+	DryRun              bool     `json:"dry_run"`
+}
+
+// RemoveEmptySlides deletes slides without meaningful content: every element is a placeholder
+// with no text. At least MinKeep slides always remain; when that limit is reached, the earliest
+// empty slides are the ones kept.
+func (t *Tools) RemoveEmptySlides(ctx context.Context, tokenSource oauth2.TokenSource, input RemoveEmptySlidesInput) (*RemoveEmptySlidesOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.MinKeep < 0 {
+		return nil, fmt.Errorf("%w: min_keep must be at least 1, got %d", ErrInvalidMinKeep, input.MinKeep)
+	}
+	minKeep := max(input.MinKeep, 1)
+
+	t.config.Logger.Info("removing empty slides",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Bool("dry_run", input.DryRun),
+		slog.Int("min_keep", minKeep),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to inspect the slide contents
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	var emptySlideIDs []string
+	for _, slide := range presentation.Slides {
+		if isEmptySlide(slide) {
+			emptySlideIDs = append(emptySlideIDs, slide.ObjectId)
+		}
+	}
+
+	// Remove from the end so a deck of blank slides keeps its first ones
+	removable := min(len(emptySlideIDs), max(len(presentation.Slides)-minKeep, 0))
+	kept := len(emptySlideIDs) - removable
+
+	output := &RemoveEmptySlidesOutput{
+		RemovedSlideIDs:     append([]string{}, emptySlideIDs[kept:]...),
+		RemovedCount:        removable,
+		RemainingSlideCount: len(presentation.Slides) - removable,
+		DryRun:              input.DryRun,
+	}
+	if kept > 0 {
+		output.KeptEmptySlideIDs = emptySlideIDs[:kept]
+	}
+
+	if input.DryRun || removable == 0 {
+		t.config.Logger.Info("no empty slides deleted",
+			slog.String("presentation_id", input.PresentationID),
+			slog.Bool("dry_run", input.DryRun),
+			slog.Int("empty_slides", len(emptySlideIDs)),
+		)
+		return output, nil
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, buildDeleteObjectRequests(output.RemovedSlideIDs))
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrRemoveEmptySlidesFailed, err)
+	}
+
+	t.config.Logger.Info("empty slides removed successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("removed_count", output.RemovedCount),
+		slog.Int("remaining_slide_count", output.RemainingSlideCount),
+	)
+
+	return output, nil
+}
+
+// isEmptySlide reports whether a slide has no meaningful content. Placeholder shapes only count
+// once they hold text; any other element, including groups, images and placeholder images,
+// is content.
+func isEmptySlide(slide *slides.Page) bool {
+	for _, element := range slide.PageElements {
+		if element == nil {
+			continue
+		}
+		if element.Shape == nil || element.Shape.Placeholder == nil {
+			return false
+		}
+		if element.Shape.Text != nil && extractTextFromTextContent(element.Shape.Text) != "" {
+			return false
+		}
+	}
+	return true
+}
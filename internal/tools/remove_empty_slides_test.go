@@ -0,0 +1,176 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newEmptySlidesTestPlaceholder(id, text string) *slides.PageElement {
+	shape := &slides.Shape{ShapeType: "TEXT_BOX", Placeholder: &slides.Placeholder{Type: "BODY"}}
+	if text != "" {
+		shape.Text = &slides.TextContent{TextElements: []*slides.TextElement{
+			{TextRun: &slides.TextRun{Content: text}},
+		}}
+	}
+	return &slides.PageElement{ObjectId: id, Shape: shape}
+}
+
+func newEmptySlidesTestTools(slideList []*slides.Page, getErr, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	return newBatchCaptureTestTools(&slides.Presentation{PresentationId: "pres-1", Slides: slideList}, getErr, batchErr, batchCalls, captured)
+}
+
+func newEmptySlidesTestDeck() []*slides.Page {
+	return []*slides.Page{
+		{ObjectId: "cover", PageElements: []*slides.PageElement{newEmptySlidesTestPlaceholder("cover-title", "Welcome\n")}},
+		{ObjectId: "blank", PageElements: []*slides.PageElement{newEmptySlidesTestPlaceholder("blank-title", "")}},
+		{ObjectId: "photo", PageElements: []*slides.PageElement{newEmptySlidesTestPlaceholder("photo-title", ""), treeTestElement("image", 0, 0, 100, 100)}},
+		{ObjectId: "whitespace", PageElements: []*slides.PageElement{newEmptySlidesTestPlaceholder("ws-body", "  \n")}},
+		{ObjectId: "no-elements"},
+	}
+}
+
+func TestIsEmptySlide(t *testing.T) {
+	tests := []struct {
+		name  string
+		slide *slides.Page
+		want  bool
+	}{
+		{"no elements", &slides.Page{}, true},
+		{"empty placeholder", &slides.Page{PageElements: []*slides.PageElement{newEmptySlidesTestPlaceholder("p", "")}}, true},
+		{"whitespace placeholder", &slides.Page{PageElements: []*slides.PageElement{newEmptySlidesTestPlaceholder("p", " \n")}}, true},
+		{"placeholder with text", &slides.Page{PageElements: []*slides.PageElement{newEmptySlidesTestPlaceholder("p", "Hello\n")}}, false},
+		{"free text box", &slides.Page{PageElements: []*slides.PageElement{{ObjectId: "box", Shape: &slides.Shape{ShapeType: "TEXT_BOX"}}}}, false},
+		{"image", &slides.Page{PageElements: []*slides.PageElement{{ObjectId: "img", Image: &slides.Image{}}}}, false},
+		{"group", &slides.Page{PageElements: []*slides.PageElement{{ObjectId: "g", ElementGroup: &slides.Group{}}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isEmptySlide(tt.slide); got != tt.want {
+				t.Errorf("isEmptySlide() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveEmptySlides(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newEmptySlidesTestTools(newEmptySlidesTestDeck(), nil, nil, &batchCalls, &captured)
+
+	output, err := tools.RemoveEmptySlides(context.Background(), nil, RemoveEmptySlidesInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"blank", "whitespace", "no-elements"}
+	if len(output.RemovedSlideIDs) != len(want) || output.RemovedCount != len(want) || output.RemainingSlideCount != 2 {
+		t.Fatalf("unexpected output: %+v", output)
+	}
+	if batchCalls != 1 || len(captured) != len(want) {
+		t.Fatalf("expected one batch with %d deletions, got %d calls and %d requests", len(want), batchCalls, len(captured))
+	}
+	for i, slideID := range want {
+		if output.RemovedSlideIDs[i] != slideID || captured[i].DeleteObject.ObjectId != slideID {
+			t.Errorf("removal %d = %s, want %s", i, output.RemovedSlideIDs[i], slideID)
+		}
+	}
+}
+
+func TestRemoveEmptySlides_DryRun(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newEmptySlidesTestTools(newEmptySlidesTestDeck(), nil, nil, &batchCalls, &captured)
+
+	output, err := tools.RemoveEmptySlides(context.Background(), nil, RemoveEmptySlidesInput{PresentationID: "pres-1", DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if batchCalls != 0 {
+		t.Errorf("expected no batch update in a dry run, got %d", batchCalls)
+	}
+	if !output.DryRun || output.RemovedCount != 3 || output.RemainingSlideCount != 2 {
+		t.Errorf("expected the dry run to report 3 removals, got %+v", output)
+	}
+}
+
+func TestRemoveEmptySlides_MinKeep(t *testing.T) {
+	tests := []struct {
+		name        string
+		slides      []*slides.Page
+		minKeep     int
+		wantRemoved []string
+		wantKept    []string
+	}{
+		{"limits removals", newEmptySlidesTestDeck(), 4, []string{"no-elements"}, []string{"blank", "whitespace"}},
+		{"more than the deck", newEmptySlidesTestDeck(), 10, []string{}, []string{"blank", "whitespace", "no-elements"}},
+		{"all empty keeps the first", []*slides.Page{{ObjectId: "a"}, {ObjectId: "b"}}, 0, []string{"b"}, []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newEmptySlidesTestTools(tt.slides, nil, nil, &batchCalls, &captured)
+
+			output, err := tools.RemoveEmptySlides(context.Background(), nil, RemoveEmptySlidesInput{PresentationID: "pres-1", MinKeep: tt.minKeep})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(output.RemovedSlideIDs) != len(tt.wantRemoved) || len(output.KeptEmptySlideIDs) != len(tt.wantKept) {
+				t.Fatalf("removed %v and kept %v, want %v and %v", output.RemovedSlideIDs, output.KeptEmptySlideIDs, tt.wantRemoved, tt.wantKept)
+			}
+			for i, slideID := range tt.wantRemoved {
+				if output.RemovedSlideIDs[i] != slideID {
+					t.Errorf("removed %v, want %v", output.RemovedSlideIDs, tt.wantRemoved)
+				}
+			}
+			for i, slideID := range tt.wantKept {
+				if output.KeptEmptySlideIDs[i] != slideID {
+					t.Errorf("kept %v, want %v", output.KeptEmptySlideIDs, tt.wantKept)
+				}
+			}
+			if wantCalls := min(len(tt.wantRemoved), 1); batchCalls != wantCalls {
+				t.Errorf("expected %d batch updates, got %d", wantCalls, batchCalls)
+			}
+		})
+	}
+}
+
+func TestRemoveEmptySlides_Errors(t *testing.T) {
+	valid := RemoveEmptySlidesInput{PresentationID: "pres-1"}
+
+	tests := []struct {
+		name     string
+		input    RemoveEmptySlidesInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", RemoveEmptySlidesInput{}, nil, nil, ErrInvalidPresentationID},
+		{"negative min_keep", RemoveEmptySlidesInput{PresentationID: "pres-1", MinKeep: -1}, nil, nil, ErrInvalidMinKeep},
+		{"presentation not found", valid, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", valid, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", valid, nil, errors.New("boom"), ErrRemoveEmptySlidesFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newEmptySlidesTestTools(newEmptySlidesTestDeck(), tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.RemoveEmptySlides(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", batchCalls)
+			}
+		})
+	}
+}
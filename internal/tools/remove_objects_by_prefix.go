@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for remove_objects_by_prefix tool.
+var (
+	ErrInvalidPrefix               = errors.New("invalid object ID prefix")
+	ErrRemoveObjectsByPrefixFailed = errors.New("failed to remove objects by prefix")
+)
+
+// RemoveObjectsByPrefixInput represents the input for the remove_objects_by_prefix tool.
+type RemoveObjectsByPrefixInput struct {
+	PresentationID string `json:"presentation_id"`
+	Prefix         string `json:"prefix"` // e.g. "watermark_"; slides have no element metadata, so the ID prefix is the tag
+}
+
+// RemoveObjectsByPrefixOutput represents the output of the remove_objects_by_prefix tool.
+type RemoveObjectsByPrefixOutput struct {
+	DeletedIDs []string `json:"deleted_ids"`
+	Count      int      `json:"count"`
+}
+
+// RemoveObjectsByPrefix deletes every slide element whose object ID starts with the prefix.
+// Tools that create decorations (such as add_watermark) give them a recognizable prefix so they
+// can be cleaned up this way.
+func (t *Tools) RemoveObjectsByPrefix(ctx context.Context, tokenSource oauth2.TokenSource, input RemoveObjectsByPrefixInput) (*RemoveObjectsByPrefixOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if strings.TrimSpace(input.Prefix) == "" {
+		return nil, fmt.Errorf("%w: prefix is required", ErrInvalidPrefix)
+	}
+
+	t.config.Logger.Info("removing objects by prefix",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("prefix", input.Prefix),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to find the tagged objects
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	output := &RemoveObjectsByPrefixOutput{DeletedIDs: []string{}}
+
+	objectIDs := findSlideElementIDsByPrefix(presentation, input.Prefix)
+	if len(objectIDs) == 0 {
+		t.config.Logger.Info("no objects match prefix",
+			slog.String("presentation_id", input.PresentationID),
+			slog.String("prefix", input.Prefix),
+		)
+		return output, nil
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, buildDeleteObjectRequests(objectIDs))
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrRemoveObjectsByPrefixFailed, err)
+	}
+
+	output.DeletedIDs = objectIDs
+	output.Count = len(objectIDs)
+
+	t.config.Logger.Info("objects removed by prefix successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("prefix", input.Prefix),
+		slog.Int("count", output.Count),
+	)
+
+	return output, nil
+}
+
+// findSlideElementIDsByPrefix returns the IDs of slide elements starting with prefix, in slide
+// order. Groups are searched too, but children of a matching group are left out since deleting
+// the group removes them.
+func findSlideElementIDsByPrefix(presentation *slides.Presentation, prefix string) []string {
+	var objectIDs []string
+	var walk func(elements []*slides.PageElement)
+	walk = func(elements []*slides.PageElement) {
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+			if strings.HasPrefix(element.ObjectId, prefix) {
+				objectIDs = append(objectIDs, element.ObjectId)
+				continue
+			}
+			if element.ElementGroup != nil {
+				walk(element.ElementGroup.Children)
+			}
+		}
+	}
+
+	for _, slide := range presentation.Slides {
+		walk(slide.PageElements)
+	}
+	return objectIDs
+}
+
+// buildDeleteObjectRequests returns one DeleteObject request per ID.
+func buildDeleteObjectRequests(objectIDs []string) []*slides.Request {
+	requests := make([]*slides.Request, 0, len(objectIDs))
+	for _, objectID := range objectIDs {
+		requests = append(requests, &slides.Request{
+			DeleteObject: &slides.DeleteObjectRequest{ObjectId: objectID},
+		})
+	}
+	return requests
+}
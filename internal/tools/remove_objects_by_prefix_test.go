@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func newRemoveByPrefixTestTools(captured *[]*slides.Request, batchErr error) *Tools {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1", PageElements: []*slides.PageElement{
+				{ObjectId: "wm_1"},
+				{ObjectId: "title"},
+				{ObjectId: "group-1", ElementGroup: &slides.Group{Children: []*slides.PageElement{
+					{ObjectId: "wm_in_group"},
+					{ObjectId: "logo"},
+				}}},
+			}},
+			{ObjectId: "slide-2", PageElements: []*slides.PageElement{
+				{ObjectId: "wm_group", ElementGroup: &slides.Group{Children: []*slides.PageElement{
+					{ObjectId: "wm_child"},
+				}}},
+				{ObjectId: "body_wm_1"},
+			}},
+		},
+	}
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*captured = requests
+			return &slides.BatchUpdatePresentationResponse{}, batchErr
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestRemoveObjectsByPrefix(t *testing.T) {
+	var captured []*slides.Request
+	tools := newRemoveByPrefixTestTools(&captured, nil)
+
+	output, err := tools.RemoveObjectsByPrefix(context.Background(), nil, RemoveObjectsByPrefixInput{
+		PresentationID: "pres-1",
+		Prefix:         "wm_",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Only prefixed objects; the child of a deleted group is not deleted separately
+	want := []string{"wm_1", "wm_in_group", "wm_group"}
+	if output.Count != len(want) || len(captured) != len(want) {
+		t.Fatalf("expected %d deletions, got output %+v and %d requests", len(want), output, len(captured))
+	}
+	for i, id := range want {
+		if output.DeletedIDs[i] != id {
+			t.Errorf("DeletedIDs[%d] = %s, want %s", i, output.DeletedIDs[i], id)
+		}
+		if captured[i].DeleteObject == nil || captured[i].DeleteObject.ObjectId != id {
+			t.Errorf("request %d: expected delete of %s, got %+v", i, id, captured[i])
+		}
+	}
+}
+
+func TestRemoveObjectsByPrefix_NoMatches(t *testing.T) {
+	var captured []*slides.Request
+	tools := newRemoveByPrefixTestTools(&captured, nil)
+
+	output, err := tools.RemoveObjectsByPrefix(context.Background(), nil, RemoveObjectsByPrefixInput{
+		PresentationID: "pres-1",
+		Prefix:         "badge_",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Count != 0 || len(output.DeletedIDs) != 0 || captured != nil {
+		t.Errorf("expected nothing deleted and no batch update, got %+v", output)
+	}
+}
+
+func TestRemoveObjectsByPrefix_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   RemoveObjectsByPrefixInput
+		wantErr error
+	}{
+		{"missing presentation", RemoveObjectsByPrefixInput{Prefix: "wm_"}, ErrInvalidPresentationID},
+		{"empty prefix", RemoveObjectsByPrefixInput{PresentationID: "pres-1"}, ErrInvalidPrefix},
+		{"blank prefix", RemoveObjectsByPrefixInput{PresentationID: "pres-1", Prefix: "  "}, ErrInvalidPrefix},
+		{"batch update failure", RemoveObjectsByPrefixInput{PresentationID: "pres-1", Prefix: "wm_"}, ErrRemoveObjectsByPrefixFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []*slides.Request
+			tools := newRemoveByPrefixTestTools(&captured, errors.New("boom"))
+
+			_, err := tools.RemoveObjectsByPrefix(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
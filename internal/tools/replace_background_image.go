@@ -0,0 +1,180 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for replace_background_image tool.
+var (
+	ErrNoBackgroundImage            = errors.New("slide has no image background")
+	ErrReplaceBackgroundImageFailed = errors.New("failed to replace background image")
+)
+
+// Background image fill modes. The Slides API only models stretched picture fills; a tiled
+// background set in another editor comes back without a picture fill and is not replaceable.
+const backgroundFillModeStretched = "stretched"
+
+// ReplaceBackgroundImageInput represents the input for the replace_background_image tool.
+type ReplaceBackgroundImageInput struct {
+	PresentationID string `json:"presentation_id"`
+	SlideIndex     int    `json:"slide_index,omitempty"` // 1-based
+	SlideID        string `json:"slide_id,omitempty"`    // Alternative to slide_index
+
+	// Exactly one image source is required, as for set_background
+	ImageBase64      string `json:"image_base64,omitempty"`        // Base64 encoded image data, uploaded to Drive
+	ImageDriveFileID string `json:"image_drive_file_id,omitempty"` // Existing Drive image, reused without upload
+	ImageURL         string `json:"image_url,omitempty"`           // Publicly accessible image URL
+	MakePublic       bool   `json:"make_public,omitempty"`         // Share image_drive_file_id via link before use
+}
+
+// ReplaceBackgroundImageOutput represents the output of the replace_background_image tool.
+type ReplaceBackgroundImageOutput struct {
+	SlideID          string `json:"slide_id"`
+	SlideIndex       int    `json:"slide_index"` // 1-based
+	FillMode         string `json:"fill_mode"`   // Mode kept from the previous background, e.g. "stretched"
+	PreviousImageURL string `json:"previous_image_url,omitempty"`
+	ImageURL         string `json:"image_url"`
+}
+
+// ReplaceBackgroundImage swaps the image of a slide's existing image background, reapplying
+// the fill with the mode the slide already uses. Slides without an image background of their
+// own (solid, inherited or hidden backgrounds) are rejected with ErrNoBackgroundImage.
+func (t *Tools) ReplaceBackgroundImage(ctx context.Context, tokenSource oauth2.TokenSource, input ReplaceBackgroundImageInput) (*ReplaceBackgroundImageOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.SlideIndex == 0 && input.SlideID == "" {
+		return nil, ErrInvalidSlideReference
+	}
+	imageSpec := SetBackgroundInput{
+		ImageBase64:      input.ImageBase64,
+		ImageDriveFileID: input.ImageDriveFileID,
+		ImageURL:         input.ImageURL,
+		MakePublic:       input.MakePublic,
+	}
+	if err := t.validateBackgroundSpec("image", imageSpec); err != nil {
+		return nil, err
+	}
+
+	t.config.Logger.Info("replacing background image",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation for the current background
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slideID, slideIndex, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	if err != nil {
+		return nil, err
+	}
+
+	var currentFill *slides.PageBackgroundFill
+	if slide := presentation.Slides[slideIndex-1]; slide.PageProperties != nil {
+		currentFill = slide.PageProperties.PageBackgroundFill
+	}
+	fillMode, err := backgroundImageFillMode(currentFill)
+	if err != nil {
+		return nil, err
+	}
+
+	// Upload or resolve the new image only once the slide is known to qualify
+	newFill, uploadedFileID, err := t.buildPageBackgroundFill(ctx, tokenSource, "image", imageSpec, presentation.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	imageURL := newFill.StretchedPictureFill.ContentUrl
+
+	requests := []*slides.Request{
+		{
+			UpdatePageProperties: &slides.UpdatePagePropertiesRequest{
+				ObjectId: slideID,
+				PageProperties: &slides.PageProperties{
+					PageBackgroundFill: buildBackgroundImageFill(fillMode, imageURL),
+				},
+				Fields: "pageBackgroundFill",
+			},
+		},
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if uploadedFileID != "" {
+			t.cleanupBackgroundUploads(ctx, tokenSource, []string{uploadedFileID})
+		}
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrReplaceBackgroundImageFailed, err)
+	}
+
+	output := &ReplaceBackgroundImageOutput{
+		SlideID:          slideID,
+		SlideIndex:       slideIndex,
+		FillMode:         fillMode,
+		PreviousImageURL: currentFill.StretchedPictureFill.ContentUrl,
+		ImageURL:         imageURL,
+	}
+
+	t.config.Logger.Info("background image replaced successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("slide_id", slideID),
+		slog.String("fill_mode", fillMode),
+	)
+
+	return output, nil
+}
+
+// backgroundImageFillMode returns the mode of a slide's own image background, or
+// ErrNoBackgroundImage when the fill is not a rendered picture fill.
+func backgroundImageFillMode(fill *slides.PageBackgroundFill) (string, error) {
+	if fill == nil || fill.StretchedPictureFill == nil {
+		if fill != nil && fill.SolidFill != nil {
+			return "", fmt.Errorf("%w: background is a solid color, use set_background instead", ErrNoBackgroundImage)
+		}
+		return "", ErrNoBackgroundImage
+	}
+	switch fill.PropertyState {
+	case "INHERIT":
+		return "", fmt.Errorf("%w: background image is inherited from the layout or master", ErrNoBackgroundImage)
+	case "NOT_RENDERED":
+		return "", fmt.Errorf("%w: background is not rendered", ErrNoBackgroundImage)
+	}
+	return backgroundFillModeStretched, nil
+}
+
+// buildBackgroundImageFill builds a rendered picture fill for imageURL in the given mode.
+func buildBackgroundImageFill(fillMode, imageURL string) *slides.PageBackgroundFill {
+	fill := &slides.PageBackgroundFill{PropertyState: "RENDERED"}
+	if fillMode == backgroundFillModeStretched {
+		fill.StretchedPictureFill = &slides.StretchedPictureFill{ContentUrl: imageURL}
+	}
+	return fill
+}
@@ -0,0 +1,192 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newReplaceBackgroundTestTools(background *slides.PageBackgroundFill, batchErr error, captured *[]*slides.Request, deleted *[]string) *Tools {
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "pres-1",
+				Slides: []*slides.Page{
+					{ObjectId: "slide-1"},
+					{ObjectId: "slide-2", PageProperties: &slides.PageProperties{PageBackgroundFill: background}},
+				},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*captured = requests
+			return &slides.BatchUpdatePresentationResponse{}, batchErr
+		},
+	}
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			return &drive.File{Id: "uploaded-bg"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			*deleted = append(*deleted, fileID)
+			return nil
+		},
+	}
+
+	return NewToolsWithDrive(DefaultToolsConfig(),
+		func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) { return mockSlides, nil },
+		func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) { return mockDrive, nil },
+	)
+}
+
+func stretchedBackground(url string) *slides.PageBackgroundFill {
+	return &slides.PageBackgroundFill{
+		PropertyState:        "RENDERED",
+		StretchedPictureFill: &slides.StretchedPictureFill{ContentUrl: url},
+	}
+}
+
+func TestReplaceBackgroundImage_PreservesFillMode(t *testing.T) {
+	var captured []*slides.Request
+	var deleted []string
+	tools := newReplaceBackgroundTestTools(stretchedBackground("https://example.com/old.png"), nil, &captured, &deleted)
+
+	output, err := tools.ReplaceBackgroundImage(context.Background(), nil, ReplaceBackgroundImageInput{
+		PresentationID: "pres-1",
+		SlideID:        "slide-2",
+		ImageBase64:    base64.StdEncoding.EncodeToString(testPNGBytes),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(captured) != 1 || captured[0].UpdatePageProperties == nil {
+		t.Fatalf("expected one UpdatePageProperties request, got %+v", captured)
+	}
+	update := captured[0].UpdatePageProperties
+	if update.ObjectId != "slide-2" || update.Fields != "pageBackgroundFill" {
+		t.Errorf("unexpected target or fields: %s %s", update.ObjectId, update.Fields)
+	}
+	fill := update.PageProperties.PageBackgroundFill
+	if fill.StretchedPictureFill == nil || fill.SolidFill != nil {
+		t.Fatalf("expected the stretched picture fill to be kept, got %+v", fill)
+	}
+	wantURL := driveImageContentURL("uploaded-bg")
+	if fill.StretchedPictureFill.ContentUrl != wantURL || fill.PropertyState != "RENDERED" {
+		t.Errorf("unexpected fill: %+v", fill)
+	}
+
+	if output.FillMode != backgroundFillModeStretched || output.SlideIndex != 2 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+	if output.PreviousImageURL != "https://example.com/old.png" || output.ImageURL != wantURL {
+		t.Errorf("unexpected image URLs: %+v", output)
+	}
+}
+
+func TestReplaceBackgroundImage_ImageURL(t *testing.T) {
+	var captured []*slides.Request
+	var deleted []string
+	tools := newReplaceBackgroundTestTools(stretchedBackground("https://example.com/old.png"), nil, &captured, &deleted)
+
+	_, err := tools.ReplaceBackgroundImage(context.Background(), nil, ReplaceBackgroundImageInput{
+		PresentationID: "pres-1",
+		SlideIndex:     2,
+		ImageURL:       "https://example.com/new.png",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := captured[0].UpdatePageProperties.PageProperties.PageBackgroundFill.StretchedPictureFill.ContentUrl; got != "https://example.com/new.png" {
+		t.Errorf("expected the new URL, got %s", got)
+	}
+}
+
+func TestReplaceBackgroundImage_BatchFailureCleansUpUpload(t *testing.T) {
+	var captured []*slides.Request
+	var deleted []string
+	tools := newReplaceBackgroundTestTools(stretchedBackground("https://example.com/old.png"), errors.New("boom"), &captured, &deleted)
+
+	_, err := tools.ReplaceBackgroundImage(context.Background(), nil, ReplaceBackgroundImageInput{
+		PresentationID: "pres-1",
+		SlideIndex:     2,
+		ImageBase64:    base64.StdEncoding.EncodeToString(testPNGBytes),
+	})
+	if !errors.Is(err, ErrReplaceBackgroundImageFailed) {
+		t.Fatalf("expected ErrReplaceBackgroundImageFailed, got %v", err)
+	}
+	if len(deleted) != 1 || deleted[0] != "uploaded-bg" {
+		t.Errorf("expected the uploaded image to be removed, got %v", deleted)
+	}
+}
+
+func TestReplaceBackgroundImage_Errors(t *testing.T) {
+	solid := &slides.PageBackgroundFill{SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{RgbColor: &slides.RgbColor{}}}}
+	inherited := stretchedBackground("https://example.com/layout.png")
+	inherited.PropertyState = "INHERIT"
+	hidden := stretchedBackground("https://example.com/hidden.png")
+	hidden.PropertyState = "NOT_RENDERED"
+
+	image := "https://example.com/new.png"
+	tests := []struct {
+		name       string
+		background *slides.PageBackgroundFill
+		input      ReplaceBackgroundImageInput
+		wantErr    error
+	}{
+		{"missing presentation", nil, ReplaceBackgroundImageInput{SlideIndex: 2, ImageURL: image}, ErrInvalidPresentationID},
+		{"missing slide", nil, ReplaceBackgroundImageInput{PresentationID: "pres-1", ImageURL: image}, ErrInvalidSlideReference},
+		{"missing image", nil, ReplaceBackgroundImageInput{PresentationID: "pres-1", SlideIndex: 2}, ErrInvalidImageData},
+		{"two images", nil, ReplaceBackgroundImageInput{PresentationID: "pres-1", SlideIndex: 2, ImageURL: image, ImageDriveFileID: "file-1"}, ErrInvalidImageData},
+		{"slide not found", nil, ReplaceBackgroundImageInput{PresentationID: "pres-1", SlideIndex: 5, ImageURL: image}, ErrSlideNotFound},
+		{"no background", nil, ReplaceBackgroundImageInput{PresentationID: "pres-1", SlideIndex: 1, ImageURL: image}, ErrNoBackgroundImage},
+		{"solid background", solid, ReplaceBackgroundImageInput{PresentationID: "pres-1", SlideIndex: 2, ImageURL: image}, ErrNoBackgroundImage},
+		{"inherited background", inherited, ReplaceBackgroundImageInput{PresentationID: "pres-1", SlideIndex: 2, ImageURL: image}, ErrNoBackgroundImage},
+		{"hidden background", hidden, ReplaceBackgroundImageInput{PresentationID: "pres-1", SlideIndex: 2, ImageURL: image}, ErrNoBackgroundImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []*slides.Request
+			var deleted []string
+			tools := newReplaceBackgroundTestTools(tt.background, nil, &captured, &deleted)
+
+			_, err := tools.ReplaceBackgroundImage(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if len(captured) != 0 {
+				t.Errorf("expected no batch update, got %d requests", len(captured))
+			}
+		})
+	}
+}
+
+func TestReplaceBackgroundImage_PresentationNotFound(t *testing.T) {
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return nil, &googleapi.Error{Code: 404}
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	})
+
+	_, err := tools.ReplaceBackgroundImage(context.Background(), nil, ReplaceBackgroundImageInput{
+		PresentationID: "pres-1",
+		SlideIndex:     1,
+		ImageURL:       "https://example.com/new.png",
+	})
+	if !errors.Is(err, ErrPresentationNotFound) {
+		t.Errorf("expected ErrPresentationNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,254 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for replace_font tool.
+var (
+	ErrReplaceFontFailed = errors.New("failed to replace font")
+	ErrInvalidFontFamily = errors.New("invalid font family")
+)
+
+// ReplaceFontInput represents the input for the replace_font tool.
+type ReplaceFontInput struct {
+	PresentationID string `json:"presentation_id"`
+	FromFontFamily string `json:"from_font_family,omitempty"` // Empty means all fonts
+	ToFontFamily   string `json:"to_font_family"`
+}
+
+// ReplaceFontOutput represents the output of the replace_font tool.
+type ReplaceFontOutput struct {
+	PresentationID  string   `json:"presentation_id"`
+	FromFontFamily  string   `json:"from_font_family,omitempty"`
+	ToFontFamily    string   `json:"to_font_family"`
+	RunsUpdated     int      `json:"runs_updated"`
+	AffectedObjects []string `json:"affected_objects"` // Table cells use "tableId[row,col]"
+}
+
+// fontRunTarget is a text run range to restyle.
+type fontRunTarget struct {
+	objectID     string
+	cellLocation *slides.TableCellLocation
+	startIndex   int64
+	endIndex     int64
+}
+
+// ReplaceFont replaces a font family across all text in a presentation.
+func (t *Tools) ReplaceFont(ctx context.Context, tokenSource oauth2.TokenSource, input ReplaceFontInput) (*ReplaceFontOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if strings.TrimSpace(input.ToFontFamily) == "" {
+		return nil, fmt.Errorf("%w: to_font_family is required", ErrInvalidFontFamily)
+	}
+
+	t.config.Logger.Info("replacing font in presentation",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("from_font_family", input.FromFontFamily),
+		slog.String("to_font_family", input.ToFontFamily),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// Collect matching runs
+	var targets []fontRunTarget
+	affected := make(map[string]bool)
+	var affectedObjects []string
+
+	for _, slide := range presentation.Slides {
+		if slide == nil {
+			continue
+		}
+		for _, target := range findFontRunTargets(slide.PageElements, input.FromFontFamily, input.ToFontFamily) {
+			targets = append(targets, target)
+
+			key := target.objectID
+			if target.cellLocation != nil {
+				key = fmt.Sprintf("%s[%d,%d]", target.objectID, target.cellLocation.RowIndex, target.cellLocation.ColumnIndex)
+			}
+			if !affected[key] {
+				affected[key] = true
+				affectedObjects = append(affectedObjects, key)
+			}
+		}
+	}
+
+	if len(targets) > 0 {
+		requests := buildReplaceFontRequests(targets, input.ToFontFamily)
+
+		_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, ErrPresentationNotFound
+			}
+			if isForbiddenError(err) {
+				return nil, ErrAccessDenied
+			}
+			return nil, fmt.Errorf("%w: %v", ErrReplaceFontFailed, err)
+		}
+	}
+
+	if affectedObjects == nil {
+		affectedObjects = []string{}
+	}
+
+	output := &ReplaceFontOutput{
+		PresentationID:  input.PresentationID,
+		FromFontFamily:  input.FromFontFamily,
+		ToFontFamily:    input.ToFontFamily,
+		RunsUpdated:     len(targets),
+		AffectedObjects: affectedObjects,
+	}
+
+	t.config.Logger.Info("font replaced successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("runs_updated", output.RunsUpdated),
+		slog.Int("objects_affected", len(affectedObjects)),
+	)
+
+	return output, nil
+}
+
+// findFontRunTargets collects text runs using fromFont (any font when empty) in
+// shapes, table cells and groups. Runs already using toFont are skipped.
+func findFontRunTargets(elements []*slides.PageElement, fromFont, toFont string) []fontRunTarget {
+	var targets []fontRunTarget
+
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+
+		if element.Shape != nil && element.Shape.Text != nil {
+			for _, r := range findFontRuns(element.Shape.Text, fromFont, toFont) {
+				targets = append(targets, fontRunTarget{
+					objectID:   element.ObjectId,
+					startIndex: r[0],
+					endIndex:   r[1],
+				})
+			}
+		}
+
+		if element.Table != nil {
+			for rowIdx, row := range element.Table.TableRows {
+				if row == nil {
+					continue
+				}
+				for colIdx, cell := range row.TableCells {
+					if cell == nil || cell.Text == nil {
+						continue
+					}
+					for _, r := range findFontRuns(cell.Text, fromFont, toFont) {
+						targets = append(targets, fontRunTarget{
+							objectID: element.ObjectId,
+							cellLocation: &slides.TableCellLocation{
+								RowIndex:        int64(rowIdx),
+								ColumnIndex:     int64(colIdx),
+								ForceSendFields: []string{"RowIndex", "ColumnIndex"},
+							},
+							startIndex: r[0],
+							endIndex:   r[1],
+						})
+					}
+				}
+			}
+		}
+
+		if element.ElementGroup != nil {
+			targets = append(targets, findFontRunTargets(element.ElementGroup.Children, fromFont, toFont)...)
+		}
+	}
+
+	return targets
+}
+
+// findFontRuns returns [start, end) ranges of matching text runs within text content.
+// API-provided indices are used when present, otherwise offsets are accumulated in UTF-16
+// code units.
+func findFontRuns(textContent *slides.TextContent, fromFont, toFont string) [][2]int64 {
+	var ranges [][2]int64
+	var offset int64
+
+	for _, textElement := range textContent.TextElements {
+		if textElement == nil || textElement.TextRun == nil {
+			continue
+		}
+
+		start := offset
+		end := offset + int64(utf16Len(textElement.TextRun.Content))
+		if textElement.EndIndex > 0 {
+			start = textElement.StartIndex
+			end = textElement.EndIndex
+		}
+		offset = end
+
+		if end <= start {
+			continue
+		}
+
+		var font string
+		if textElement.TextRun.Style != nil {
+			font = textElement.TextRun.Style.FontFamily
+		}
+		if strings.EqualFold(font, toFont) {
+			continue
+		}
+		if fromFont != "" && !strings.EqualFold(font, fromFont) {
+			continue
+		}
+
+		ranges = append(ranges, [2]int64{start, end})
+	}
+
+	return ranges
+}
+
+// buildReplaceFontRequests creates one UpdateTextStyle request per matching run.
+func buildReplaceFontRequests(targets []fontRunTarget, toFont string) []*slides.Request {
+	requests := make([]*slides.Request, 0, len(targets))
+	for _, target := range targets {
+		startIdx := target.startIndex
+		endIdx := target.endIndex
+		requests = append(requests, &slides.Request{
+			UpdateTextStyle: &slides.UpdateTextStyleRequest{
+				ObjectId:     target.objectID,
+				CellLocation: target.cellLocation,
+				Style: &slides.TextStyle{
+					FontFamily: toFont,
+				},
+				TextRange: &slides.Range{
+					Type:       "FIXED_RANGE",
+					StartIndex: &startIdx,
+					EndIndex:   &endIdx,
+				},
+				Fields: "fontFamily",
+			},
+		})
+	}
+	return requests
+}
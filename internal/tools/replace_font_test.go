@@ -0,0 +1,243 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func replaceFontTestPresentation() *slides.Presentation {
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "shape-1",
+						Shape: &slides.Shape{
+							Text: &slides.TextContent{
+								TextElements: []*slides.TextElement{
+									{ParagraphMarker: &slides.ParagraphMarker{}},
+									{TextRun: &slides.TextRun{Content: "Hello ", Style: &slides.TextStyle{FontFamily: "Arial"}}},
+									{TextRun: &slides.TextRun{Content: "brand ", Style: &slides.TextStyle{FontFamily: "Roboto"}}},
+									{TextRun: &slides.TextRun{Content: "world\n", Style: &slides.TextStyle{FontFamily: "arial"}}},
+								},
+							},
+						},
+					},
+					{
+						ObjectId: "group-1",
+						ElementGroup: &slides.Group{
+							Children: []*slides.PageElement{
+								{
+									ObjectId: "table-1",
+									Table: &slides.Table{
+										TableRows: []*slides.TableRow{
+											{TableCells: []*slides.TableCell{
+												{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+													{TextRun: &slides.TextRun{Content: "cell\n", Style: &slides.TextStyle{FontFamily: "Arial"}}},
+												}}},
+											}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestReplaceFont_OnlyMatchingRuns(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return replaceFontTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	factory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	}
+	tools := NewTools(DefaultToolsConfig(), factory)
+
+	output, err := tools.ReplaceFont(context.Background(), &mockTokenSource{}, ReplaceFontInput{
+		PresentationID: "pres-1",
+		FromFontFamily: "Arial",
+		ToFontFamily:   "Inter",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []struct {
+		objectID string
+		start    int64
+		end      int64
+		cell     bool
+	}{
+		{"shape-1", 0, 6, false},
+		{"shape-1", 12, 18, false},
+		{"table-1", 0, 5, true},
+	}
+
+	if len(capturedRequests) != len(expected) {
+		t.Fatalf("expected %d requests, got %d", len(expected), len(capturedRequests))
+	}
+	for i, exp := range expected {
+		req := capturedRequests[i].UpdateTextStyle
+		if req.ObjectId != exp.objectID {
+			t.Errorf("request %d: expected object '%s', got '%s'", i, exp.objectID, req.ObjectId)
+		}
+		if *req.TextRange.StartIndex != exp.start || *req.TextRange.EndIndex != exp.end {
+			t.Errorf("request %d: expected range [%d,%d), got [%d,%d)", i, exp.start, exp.end, *req.TextRange.StartIndex, *req.TextRange.EndIndex)
+		}
+		if req.Style.FontFamily != "Inter" || req.Fields != "fontFamily" {
+			t.Errorf("request %d: expected fontFamily=Inter, got %s (%s)", i, req.Style.FontFamily, req.Fields)
+		}
+		if (req.CellLocation != nil) != exp.cell {
+			t.Errorf("request %d: unexpected cell location %+v", i, req.CellLocation)
+		}
+	}
+
+	if output.RunsUpdated != 3 {
+		t.Errorf("expected 3 runs updated, got %d", output.RunsUpdated)
+	}
+	if len(output.AffectedObjects) != 2 || output.AffectedObjects[0] != "shape-1" || output.AffectedObjects[1] != "table-1[0,0]" {
+		t.Errorf("unexpected affected objects: %v", output.AffectedObjects)
+	}
+}
+
+func TestFindFontRuns_UTF16Offsets(t *testing.T) {
+	// Without API indices, offsets are counted in UTF-16 units: "Café " is 6 bytes but 5 units,
+	// "🎉 " is 5 bytes but 3 units.
+	textContent := &slides.TextContent{
+		TextElements: []*slides.TextElement{
+			{TextRun: &slides.TextRun{Content: "Café ", Style: &slides.TextStyle{FontFamily: "Arial"}}},
+			{TextRun: &slides.TextRun{Content: "🎉 ", Style: &slides.TextStyle{FontFamily: "Roboto"}}},
+			{TextRun: &slides.TextRun{Content: "fin\n", Style: &slides.TextStyle{FontFamily: "Arial"}}},
+		},
+	}
+
+	ranges := findFontRuns(textContent, "Arial", "Inter")
+
+	expected := [][2]int64{{0, 5}, {8, 12}}
+	if len(ranges) != len(expected) {
+		t.Fatalf("expected %d ranges, got %v", len(expected), ranges)
+	}
+	for i, exp := range expected {
+		if ranges[i] != exp {
+			t.Errorf("range %d: expected %v, got %v", i, exp, ranges[i])
+		}
+	}
+}
+
+func TestReplaceFont_AllFonts(t *testing.T) {
+	var capturedRequests []*slides.Request
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return replaceFontTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	factory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	}
+	tools := NewTools(DefaultToolsConfig(), factory)
+
+	output, err := tools.ReplaceFont(context.Background(), &mockTokenSource{}, ReplaceFontInput{
+		PresentationID: "pres-1",
+		ToFontFamily:   "Roboto",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Runs already in Roboto are skipped
+	if output.RunsUpdated != 3 || len(capturedRequests) != 3 {
+		t.Errorf("expected 3 runs updated, got %d (%d requests)", output.RunsUpdated, len(capturedRequests))
+	}
+}
+
+func TestReplaceFont_NoMatches(t *testing.T) {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return replaceFontTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			t.Fatal("BatchUpdate should not be called without matches")
+			return nil, nil
+		},
+	}
+
+	factory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	}
+	tools := NewTools(DefaultToolsConfig(), factory)
+
+	output, err := tools.ReplaceFont(context.Background(), &mockTokenSource{}, ReplaceFontInput{
+		PresentationID: "pres-1",
+		FromFontFamily: "Comic Sans MS",
+		ToFontFamily:   "Inter",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.RunsUpdated != 0 || len(output.AffectedObjects) != 0 {
+		t.Errorf("expected no updates, got %+v", output)
+	}
+}
+
+func TestReplaceFont_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       ReplaceFontInput
+		getErr      error
+		batchErr    error
+		expectedErr error
+	}{
+		{"missing presentation ID", ReplaceFontInput{ToFontFamily: "Inter"}, nil, nil, ErrInvalidPresentationID},
+		{"missing target font", ReplaceFontInput{PresentationID: "pres-1"}, nil, nil, ErrInvalidFontFamily},
+		{"presentation not found", ReplaceFontInput{PresentationID: "pres-1", ToFontFamily: "Inter"}, errors.New("googleapi: Error 404: not found"), nil, ErrPresentationNotFound},
+		{"batch update failure", ReplaceFontInput{PresentationID: "pres-1", ToFontFamily: "Inter"}, nil, errors.New("boom"), ErrReplaceFontFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return replaceFontTestPresentation(), nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					return nil, tt.batchErr
+				},
+			}
+			factory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			}
+			tools := NewTools(DefaultToolsConfig(), factory)
+
+			_, err := tools.ReplaceFont(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -69,6 +69,9 @@ func (t *Tools) ReplaceImage(ctx context.Context, tokenSource oauth2.TokenSource
 	if mimeType == "" {
 		return nil, fmt.Errorf("%w: unable to detect image format", ErrInvalidImageData)
 	}
+	if err := t.checkImageFormatAllowed(mimeType); err != nil {
+		return nil, err
+	}
 
 	// Create services
 	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
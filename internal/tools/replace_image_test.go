@@ -7,7 +7,6 @@ import (
 	"io"
 	"strings"
 	"testing"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/drive/v3"
@@ -29,12 +28,8 @@ func TestReplaceImage(t *testing.T) {
 	}
 	validPNGBase64 := base64.StdEncoding.EncodeToString(validPNGData)
 
-	// Override time function for deterministic object IDs
-	originalTimeNow := imageTimeNowFunc
-	defer func() { imageTimeNowFunc = originalTimeNow }()
-	imageTimeNowFunc = func() time.Time {
-		return time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
-	}
+	// Fixed ID suffix for deterministic object IDs
+	stubObjectIDSuffix(t, "1705320000000000000")
 
 	tests := []struct {
 		name              string
@@ -0,0 +1,317 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for scale_font_sizes tool.
+var (
+	ErrInvalidScaleFactor    = errors.New("invalid scale factor")
+	ErrInvalidFontSizeBounds = errors.New("invalid font size bounds")
+	ErrScaleFontSizesFailed  = errors.New("failed to scale font sizes")
+)
+
+// Font size bounds applied when min_size/max_size are not given, matching what the Slides editor accepts.
+const (
+	defaultMinScaledFontSize = 1.0   // Points
+	defaultMaxScaledFontSize = 400.0 // Points
+)
+
+// ScaleFontSizesInput represents the input for the scale_font_sizes tool.
+type ScaleFontSizesInput struct {
+	PresentationID string  `json:"presentation_id"`
+	Factor         float64 `json:"factor"`              // Required, > 0 (e.g. 0.9 shrinks by 10%)
+	MinSize        float64 `json:"min_size,omitempty"`  // Points, default 1
+	MaxSize        float64 `json:"max_size,omitempty"`  // Points, default 400
+	Scope          string  `json:"scope,omitempty"`     // "all" | "slide" | "object" - Default: "all"
+	SlideID        string  `json:"slide_id,omitempty"`  // Required when scope is "slide"
+	ObjectID       string  `json:"object_id,omitempty"` // Required when scope is "object"
+}
+
+// FontSizeChange describes one text run whose font size was scaled.
+type FontSizeChange struct {
+	SlideIndex int     `json:"slide_index"` // 1-based
+	ObjectID   string  `json:"object_id"`   // Table cells use "tableId[row,col]"
+	StartIndex int     `json:"start_index"`
+	EndIndex   int     `json:"end_index"`
+	FromSize   float64 `json:"from_size"` // Points
+	ToSize     float64 `json:"to_size"`   // Points
+	Clamped    bool    `json:"clamped,omitempty"`
+}
+
+// ScaleFontSizesOutput represents the output of the scale_font_sizes tool.
+type ScaleFontSizesOutput struct {
+	PresentationID string           `json:"presentation_id"`
+	Factor         float64          `json:"factor"`
+	MinSize        float64          `json:"min_size"`
+	MaxSize        float64          `json:"max_size"`
+	ScaledCount    int              `json:"scaled_count"`
+	SkippedRuns    int              `json:"skipped_runs"` // Runs without an explicit size (inherited from the placeholder)
+	Changes        []FontSizeChange `json:"changes"`
+}
+
+// fontSizeTarget holds a change with everything needed to build its request.
+type fontSizeTarget struct {
+	change       FontSizeChange
+	objectID     string
+	cellLocation *slides.TableCellLocation
+}
+
+// ScaleFontSizes multiplies the explicit font size of every text run in scope by a factor,
+// clamping the result to [min_size, max_size]. Runs that inherit their size are left alone.
+func (t *Tools) ScaleFontSizes(ctx context.Context, tokenSource oauth2.TokenSource, input ScaleFontSizesInput) (*ScaleFontSizesOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.Factor <= 0 || math.IsNaN(input.Factor) || math.IsInf(input.Factor, 0) {
+		return nil, fmt.Errorf("%w: factor must be greater than 0", ErrInvalidScaleFactor)
+	}
+	minSize, maxSize, err := fontSizeBounds(input.MinSize, input.MaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := strings.ToLower(strings.TrimSpace(input.Scope))
+	if scope == "" {
+		scope = "all"
+	}
+	if scope != "all" && scope != "slide" && scope != "object" {
+		return nil, fmt.Errorf("%w: scope must be 'all', 'slide', or 'object'", ErrInvalidScope)
+	}
+	if scope == "slide" && input.SlideID == "" {
+		return nil, fmt.Errorf("%w: slide_id is required when scope is 'slide'", ErrInvalidScope)
+	}
+	if scope == "object" && input.ObjectID == "" {
+		return nil, fmt.Errorf("%w: object_id is required when scope is 'object'", ErrInvalidScope)
+	}
+
+	t.config.Logger.Info("scaling font sizes",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Float64("factor", input.Factor),
+		slog.String("scope", scope),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation for the current sizes
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// Validate scope targets exist
+	switch scope {
+	case "slide":
+		if _, _, err := findSlide(presentation, 0, input.SlideID); err != nil {
+			return nil, err
+		}
+	case "object":
+		if findSlideContainingObject(presentation.Slides, input.ObjectID) == nil {
+			return nil, fmt.Errorf("%w: object '%s' not found", ErrObjectNotFound, input.ObjectID)
+		}
+	}
+
+	output := &ScaleFontSizesOutput{
+		PresentationID: input.PresentationID,
+		Factor:         input.Factor,
+		MinSize:        minSize,
+		MaxSize:        maxSize,
+		Changes:        []FontSizeChange{},
+	}
+
+	var targets []fontSizeTarget
+	for slideIdx, slide := range presentation.Slides {
+		if slide == nil {
+			continue
+		}
+		if scope == "slide" && slide.ObjectId != input.SlideID {
+			continue
+		}
+		filterObjectID := ""
+		if scope == "object" {
+			filterObjectID = input.ObjectID
+		}
+		slideTargets, skipped := findFontSizeTargets(slide.PageElements, input.Factor, minSize, maxSize, filterObjectID)
+		for _, target := range slideTargets {
+			target.change.SlideIndex = slideIdx + 1
+			targets = append(targets, target)
+			output.Changes = append(output.Changes, target.change)
+		}
+		output.SkippedRuns += skipped
+	}
+	output.ScaledCount = len(output.Changes)
+
+	if len(targets) > 0 {
+		_, err = slidesService.BatchUpdate(ctx, input.PresentationID, buildFontSizeRequests(targets))
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, ErrPresentationNotFound
+			}
+			if isForbiddenError(err) {
+				return nil, ErrAccessDenied
+			}
+			return nil, fmt.Errorf("%w: %v", ErrScaleFontSizesFailed, err)
+		}
+	}
+
+	t.config.Logger.Info("font sizes scaled successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("scaled_count", output.ScaledCount),
+		slog.Int("skipped_runs", output.SkippedRuns),
+	)
+
+	return output, nil
+}
+
+// fontSizeBounds fills in the default bounds and checks 0 < min <= max.
+func fontSizeBounds(minSize, maxSize float64) (float64, float64, error) {
+	if minSize == 0 {
+		minSize = defaultMinScaledFontSize
+	}
+	if maxSize == 0 {
+		maxSize = defaultMaxScaledFontSize
+	}
+	for _, size := range []float64{minSize, maxSize} {
+		if size < 0 || math.IsNaN(size) || math.IsInf(size, 0) {
+			return 0, 0, fmt.Errorf("%w: min_size and max_size must be positive numbers of points", ErrInvalidFontSizeBounds)
+		}
+	}
+	if minSize > maxSize {
+		return 0, 0, fmt.Errorf("%w: min_size %.1f is greater than max_size %.1f", ErrInvalidFontSizeBounds, minSize, maxSize)
+	}
+	return minSize, maxSize, nil
+}
+
+// scaleFontSize multiplies size by factor, rounds to 0.1pt and clamps it to [minSize, maxSize].
+// The second result reports whether clamping changed the value.
+func scaleFontSize(size, factor, minSize, maxSize float64) (float64, bool) {
+	scaled := math.Round(size*factor*10) / 10
+	clamped := math.Min(math.Max(scaled, minSize), maxSize)
+	return clamped, clamped != scaled
+}
+
+// findFontSizeTargets scales the explicit font size of each text run in shapes and table cells
+// (recursing into groups) and returns the runs whose size changes, plus the number of runs
+// skipped for having no explicit size. When filterObjectID is set, only that object is scanned.
+func findFontSizeTargets(elements []*slides.PageElement, factor, minSize, maxSize float64, filterObjectID string) ([]fontSizeTarget, int) {
+	var targets []fontSizeTarget
+	skipped := 0
+
+	scan := func(text *slides.TextContent, objectID, reportedID string, cellLocation *slides.TableCellLocation) {
+		if text == nil {
+			return
+		}
+		for _, textElement := range text.TextElements {
+			if textElement == nil || textElement.TextRun == nil || textElement.EndIndex <= textElement.StartIndex {
+				continue
+			}
+			style := textElement.TextRun.Style
+			if style == nil || style.FontSize == nil || style.FontSize.Magnitude <= 0 {
+				skipped++
+				continue
+			}
+			from := convertToPoints(style.FontSize)
+			to, clamped := scaleFontSize(from, factor, minSize, maxSize)
+			if to == from {
+				continue
+			}
+			targets = append(targets, fontSizeTarget{
+				change: FontSizeChange{
+					ObjectID:   reportedID,
+					StartIndex: int(textElement.StartIndex),
+					EndIndex:   int(textElement.EndIndex),
+					FromSize:   from,
+					ToSize:     to,
+					Clamped:    clamped,
+				},
+				objectID:     objectID,
+				cellLocation: cellLocation,
+			})
+		}
+	}
+
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+
+		if element.ElementGroup != nil {
+			groupTargets, groupSkipped := findFontSizeTargets(element.ElementGroup.Children, factor, minSize, maxSize, filterObjectID)
+			targets = append(targets, groupTargets...)
+			skipped += groupSkipped
+			continue
+		}
+
+		if filterObjectID != "" && element.ObjectId != filterObjectID {
+			continue
+		}
+
+		if element.Shape != nil {
+			scan(element.Shape.Text, element.ObjectId, element.ObjectId, nil)
+		}
+
+		if element.Table != nil {
+			for rowIdx, row := range element.Table.TableRows {
+				if row == nil {
+					continue
+				}
+				for colIdx, cell := range row.TableCells {
+					if cell == nil {
+						continue
+					}
+					scan(cell.Text, element.ObjectId, fmt.Sprintf("%s[%d,%d]", element.ObjectId, rowIdx, colIdx), &slides.TableCellLocation{
+						RowIndex:        int64(rowIdx),
+						ColumnIndex:     int64(colIdx),
+						ForceSendFields: []string{"RowIndex", "ColumnIndex"},
+					})
+				}
+			}
+		}
+	}
+
+	return targets, skipped
+}
+
+// buildFontSizeRequests creates one UpdateTextStyle(fontSize) request per scaled run.
+func buildFontSizeRequests(targets []fontSizeTarget) []*slides.Request {
+	requests := make([]*slides.Request, 0, len(targets))
+	for _, target := range targets {
+		startIdx := int64(target.change.StartIndex)
+		endIdx := int64(target.change.EndIndex)
+		requests = append(requests, &slides.Request{
+			UpdateTextStyle: &slides.UpdateTextStyleRequest{
+				ObjectId:     target.objectID,
+				CellLocation: target.cellLocation,
+				Style: &slides.TextStyle{
+					FontSize: &slides.Dimension{Magnitude: target.change.ToSize, Unit: "PT"},
+				},
+				TextRange: &slides.Range{
+					Type:       "FIXED_RANGE",
+					StartIndex: &startIdx,
+					EndIndex:   &endIdx,
+				},
+				Fields: "fontSize",
+			},
+		})
+	}
+	return requests
+}
@@ -0,0 +1,212 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+// sizedRun builds a text run with an explicit font size, or an inherited one when size is 0.
+func sizedRun(start, end int64, size float64) *slides.TextElement {
+	style := &slides.TextStyle{}
+	if size > 0 {
+		style.FontSize = &slides.Dimension{Magnitude: size, Unit: "PT"}
+	}
+	return &slides.TextElement{StartIndex: start, EndIndex: end, TextRun: &slides.TextRun{Content: "text", Style: style}}
+}
+
+func scaleFontSizesTestPresentation() *slides.Presentation {
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					{ObjectId: "title", Shape: &slides.Shape{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+						{StartIndex: 0, EndIndex: 0, ParagraphMarker: &slides.ParagraphMarker{}},
+						sizedRun(0, 6, 36),
+						sizedRun(6, 10, 0), // Inherited size: skipped
+					}}}},
+					{ObjectId: "group-1", ElementGroup: &slides.Group{Children: []*slides.PageElement{
+						{ObjectId: "caption", Shape: &slides.Shape{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+							sizedRun(0, 4, 10),
+						}}}},
+					}}},
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					{ObjectId: "table-1", Table: &slides.Table{TableRows: []*slides.TableRow{{TableCells: []*slides.TableCell{
+						{Text: &slides.TextContent{TextElements: []*slides.TextElement{sizedRun(0, 3, 14)}}},
+					}}}}},
+				},
+			},
+		},
+	}
+}
+
+func TestScaleFontSizes(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(scaleFontSizesTestPresentation(), nil, nil, &batchCalls, &captured)
+
+	output, err := tools.ScaleFontSizes(context.Background(), nil, ScaleFontSizesInput{PresentationID: "pres-1", Factor: 0.5, MinSize: 6})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 {
+		t.Fatalf("expected a single batch update, got %d", batchCalls)
+	}
+	if output.ScaledCount != 3 || len(captured) != 3 || output.SkippedRuns != 1 {
+		t.Fatalf("expected 3 scaled runs and 1 skipped, got %d scaled, %d requests, %d skipped", output.ScaledCount, len(captured), output.SkippedRuns)
+	}
+	if output.MinSize != 6 || output.MaxSize != defaultMaxScaledFontSize {
+		t.Errorf("unexpected bounds: %v-%v", output.MinSize, output.MaxSize)
+	}
+
+	want := []struct {
+		objectID string
+		from, to float64
+		clamped  bool
+	}{
+		{"title", 36, 18, false},
+		{"caption", 10, 6, true}, // 5pt clamped to min_size
+		{"table-1[0,0]", 14, 7, false},
+	}
+	for i, w := range want {
+		change := output.Changes[i]
+		if change.ObjectID != w.objectID || change.FromSize != w.from || change.ToSize != w.to || change.Clamped != w.clamped {
+			t.Errorf("change %d = %+v, want %s %v->%v clamped=%v", i, change, w.objectID, w.from, w.to, w.clamped)
+		}
+		style := captured[i].UpdateTextStyle
+		if style.Fields != "fontSize" || style.Style.FontSize.Magnitude != w.to || style.Style.FontSize.Unit != "PT" {
+			t.Errorf("request %d: unexpected style update %+v", i, style)
+		}
+	}
+
+	if output.Changes[2].SlideIndex != 2 {
+		t.Errorf("expected table change on slide 2, got %d", output.Changes[2].SlideIndex)
+	}
+	cell := captured[2].UpdateTextStyle
+	if cell.ObjectId != "table-1" || cell.CellLocation == nil || cell.CellLocation.RowIndex != 0 || cell.CellLocation.ColumnIndex != 0 {
+		t.Errorf("expected a table cell request, got %+v", cell)
+	}
+	if *cell.TextRange.StartIndex != 0 || *cell.TextRange.EndIndex != 3 || cell.TextRange.Type != "FIXED_RANGE" {
+		t.Errorf("unexpected cell range %+v", cell.TextRange)
+	}
+}
+
+func TestScaleFontSizes_ScopeAndMaxClamp(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(scaleFontSizesTestPresentation(), nil, nil, &batchCalls, &captured)
+
+	output, err := tools.ScaleFontSizes(context.Background(), nil, ScaleFontSizesInput{
+		PresentationID: "pres-1",
+		Factor:         2,
+		MaxSize:        48,
+		Scope:          "slide",
+		SlideID:        "slide-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.ScaledCount != 2 {
+		t.Fatalf("expected only slide-1 runs to scale, got %+v", output.Changes)
+	}
+	if title := output.Changes[0]; title.ToSize != 48 || !title.Clamped {
+		t.Errorf("expected the 36pt title clamped to 48pt, got %+v", title)
+	}
+	if caption := output.Changes[1]; caption.ToSize != 20 || caption.Clamped {
+		t.Errorf("expected the caption doubled to 20pt, got %+v", caption)
+	}
+}
+
+func TestScaleFontSizes_NothingToScale(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(scaleFontSizesTestPresentation(), nil, nil, &batchCalls, &captured)
+
+	// Clamping every size back to itself changes nothing.
+	output, err := tools.ScaleFontSizes(context.Background(), nil, ScaleFontSizesInput{
+		PresentationID: "pres-1",
+		Factor:         1.5,
+		MaxSize:        10,
+		Scope:          "object",
+		ObjectID:       "caption",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.ScaledCount != 0 || batchCalls != 0 {
+		t.Errorf("expected no changes and no batch update, got %d changes, %d calls", output.ScaledCount, batchCalls)
+	}
+}
+
+func TestScaleFontSizes_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    ScaleFontSizesInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", ScaleFontSizesInput{Factor: 1.2}, nil, nil, ErrInvalidPresentationID},
+		{"missing factor", ScaleFontSizesInput{PresentationID: "pres-1"}, nil, nil, ErrInvalidScaleFactor},
+		{"negative factor", ScaleFontSizesInput{PresentationID: "pres-1", Factor: -1}, nil, nil, ErrInvalidScaleFactor},
+		{"infinite factor", ScaleFontSizesInput{PresentationID: "pres-1", Factor: math.Inf(1)}, nil, nil, ErrInvalidScaleFactor},
+		{"negative min", ScaleFontSizesInput{PresentationID: "pres-1", Factor: 1.2, MinSize: -4}, nil, nil, ErrInvalidFontSizeBounds},
+		{"min above max", ScaleFontSizesInput{PresentationID: "pres-1", Factor: 1.2, MinSize: 30, MaxSize: 20}, nil, nil, ErrInvalidFontSizeBounds},
+		{"invalid scope", ScaleFontSizesInput{PresentationID: "pres-1", Factor: 1.2, Scope: "page"}, nil, nil, ErrInvalidScope},
+		{"slide scope without slide", ScaleFontSizesInput{PresentationID: "pres-1", Factor: 1.2, Scope: "slide"}, nil, nil, ErrInvalidScope},
+		{"unknown slide", ScaleFontSizesInput{PresentationID: "pres-1", Factor: 1.2, Scope: "slide", SlideID: "nope"}, nil, nil, ErrSlideNotFound},
+		{"unknown object", ScaleFontSizesInput{PresentationID: "pres-1", Factor: 1.2, Scope: "object", ObjectID: "nope"}, nil, nil, ErrObjectNotFound},
+		{"presentation not found", ScaleFontSizesInput{PresentationID: "pres-1", Factor: 1.2}, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"batch update failure", ScaleFontSizesInput{PresentationID: "pres-1", Factor: 1.2}, nil, errors.New("boom"), ErrScaleFontSizesFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newBatchCaptureTestTools(scaleFontSizesTestPresentation(), tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.ScaleFontSizes(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestScaleFontSize(t *testing.T) {
+	tests := []struct {
+		name        string
+		size        float64
+		factor      float64
+		wantSize    float64
+		wantClamped bool
+	}{
+		{"grow", 12, 1.5, 18, false},
+		{"shrink rounds to 0.1pt", 11, 0.9, 9.9, false},
+		{"rounding", 13, 1.15, 15, false},
+		{"clamped to min", 8, 0.25, 6, true},
+		{"clamped to max", 60, 2, 72, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, clamped := scaleFontSize(tt.size, tt.factor, 6, 72)
+			if got != tt.wantSize || clamped != tt.wantClamped {
+				t.Errorf("scaleFontSize(%v, %v) = (%v, %v), want (%v, %v)", tt.size, tt.factor, got, clamped, tt.wantSize, tt.wantClamped)
+			}
+		})
+	}
+}
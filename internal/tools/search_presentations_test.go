@@ -24,6 +24,11 @@ type mockDriveService struct {
 	CreateReplyFunc    func(ctx context.Context, fileID, commentID string, reply *drive.Reply) (*drive.Reply, error)
 	UpdateCommentFunc  func(ctx context.Context, fileID, commentID string, comment *drive.Comment) (*drive.Comment, error)
 	DeleteCommentFunc  func(ctx context.Context, fileID, commentID string) error
+	TrashFileFunc      func(ctx context.Context, fileID string) error
+	DeleteFileFunc     func(ctx context.Context, fileID string) error
+	GetFileFunc        func(ctx context.Context, fileID string, fields googleapi.Field) (*drive.File, error)
+	GetAboutFunc       func(ctx context.Context) (*drive.About, error)
+	GetFileETagFunc    func(ctx context.Context, fileID string) (string, error)
 }
 
 func (m *mockDriveService) ListFiles(ctx context.Context, query string, pageSize int64, fields googleapi.Field) (*drive.FileList, error) {
@@ -103,6 +108,41 @@ func (m *mockDriveService) DeleteComment(ctx context.Context, fileID, commentID
 	return errors.New("not implemented")
 }
 
+func (m *mockDriveService) TrashFile(ctx context.Context, fileID string) error {
+	if m.TrashFileFunc != nil {
+		return m.TrashFileFunc(ctx, fileID)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *mockDriveService) DeleteFile(ctx context.Context, fileID string) error {
+	if m.DeleteFileFunc != nil {
+		return m.DeleteFileFunc(ctx, fileID)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *mockDriveService) GetFile(ctx context.Context, fileID string, fields googleapi.Field) (*drive.File, error) {
+	if m.GetFileFunc != nil {
+		return m.GetFileFunc(ctx, fileID, fields)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockDriveService) GetAbout(ctx context.Context) (*drive.About, error) {
+	if m.GetAboutFunc != nil {
+		return m.GetAboutFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockDriveService) GetFileETag(ctx context.Context, fileID string) (string, error) {
+	if m.GetFileETagFunc != nil {
+		return m.GetFileETagFunc(ctx, fileID)
+	}
+	return "", errors.New("not implemented")
+}
+
 func TestSearchPresentations_Success(t *testing.T) {
 	mockService := &mockDriveService{
 		ListFilesFunc: func(ctx context.Context, query string, pageSize int64, fields googleapi.Field) (*drive.FileList, error) {
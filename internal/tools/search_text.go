@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/slides/v1"
@@ -21,7 +23,9 @@ var (
 type SearchTextInput struct {
 	PresentationID string `json:"presentation_id"`
 	Query          string `json:"query"`
-	CaseSensitive  bool   `json:"case_sensitive,omitempty"` // Default: false
+	CaseSensitive  bool   `json:"case_sensitive,omitempty"`  // Default: false
+	IncludeMasters bool   `json:"include_masters,omitempty"` // Also search master pages
+	IncludeLayouts bool   `json:"include_layouts,omitempty"` // Also search layout pages
 }
 
 // SearchTextOutput represents the output of the search_text tool.
@@ -35,9 +39,10 @@ type SearchTextOutput struct {
 
 // SearchTextResult represents a search result grouped by slide.
 type SearchTextResult struct {
-	SlideIndex int           `json:"slide_index"` // 1-based
-	SlideID    string        `json:"slide_id"`
-	Matches    []TextMatch   `json:"matches"`
+	SlideIndex int         `json:"slide_index"`         // 1-based; 0 for masters and layouts
+	SlideID    string      `json:"slide_id"`            // Page object ID
+	PageType   string      `json:"page_type,omitempty"` // "MASTER" or "LAYOUT"; empty for slides
+	Matches    []TextMatch `json:"matches"`
 }
 
 // TextMatch represents a single text match within an object.
@@ -62,6 +67,8 @@ func (t *Tools) SearchText(ctx context.Context, tokenSource oauth2.TokenSource,
 		slog.String("presentation_id", input.PresentationID),
 		slog.String("query", input.Query),
 		slog.Bool("case_sensitive", input.CaseSensitive),
+		slog.Bool("include_masters", input.IncludeMasters),
+		slog.Bool("include_layouts", input.IncludeLayouts),
 	)
 
 	// Create Slides service
@@ -86,12 +93,13 @@ func (t *Tools) SearchText(ctx context.Context, tokenSource oauth2.TokenSource,
 	var results []SearchTextResult
 	totalMatches := 0
 
-	for slideIdx, slide := range presentation.Slides {
-		slideMatches := searchInSlide(slide, input.Query, input.CaseSensitive)
+	for _, page := range traversalPages(presentation, input.IncludeMasters, input.IncludeLayouts) {
+		slideMatches := searchInSlide(page.Page, input.Query, input.CaseSensitive)
 		if len(slideMatches) > 0 {
 			results = append(results, SearchTextResult{
-				SlideIndex: slideIdx + 1, // 1-based
-				SlideID:    slide.ObjectId,
+				SlideIndex: page.slideIndex(),
+				SlideID:    page.Page.ObjectId,
+				PageType:   page.nonSlideType(),
 				Matches:    slideMatches,
 			})
 			totalMatches += len(slideMatches)
@@ -220,37 +228,83 @@ type matchInfo struct {
 func findMatchesInText(text, query string, caseSensitive bool) []matchInfo {
 	var matches []matchInfo
 
-	if text == "" || query == "" {
-		return matches
+	// Overlapping, so "aa" is found twice in "aaa"
+	for _, span := range findQuerySpans(text, query, caseSensitive, true) {
+		matches = append(matches, matchInfo{
+			startIndex: utf16Index(text, span.start),
+			context:    extractContext(text, span.start, span.end-span.start, 50),
+		})
 	}
 
-	searchText := text
-	searchQuery := query
-	if !caseSensitive {
-		searchText = strings.ToLower(text)
-		searchQuery = strings.ToLower(query)
+	return matches
+}
+
+// textSpan is a [start, end) byte range of a string.
+type textSpan struct {
+	start int
+	end   int
+}
+
+// findQuerySpans returns the byte range in text of each occurrence of query. Case-insensitive
+// matching compares rune by rune with simple case folding, so the ranges always refer to text
+// itself even where lowercasing would change its length (the Kelvin sign, "İ"). Overlapping
+// matches resume one rune after the previous start, otherwise after its end.
+func findQuerySpans(text, query string, caseSensitive, overlapping bool) []textSpan {
+	var spans []textSpan
+
+	if text == "" || query == "" {
+		return spans
 	}
 
-	// Find all occurrences
-	startPos := 0
-	for {
-		idx := strings.Index(searchText[startPos:], searchQuery)
-		if idx == -1 {
-			break
+	for pos := 0; pos < len(text); {
+		end, ok := matchQueryAt(text, pos, query, caseSensitive)
+		if ok {
+			spans = append(spans, textSpan{start: pos, end: end})
+			if !overlapping {
+				pos = end
+				continue
+			}
 		}
+		_, size := utf8.DecodeRuneInString(text[pos:])
+		pos += size
+	}
 
-		actualIdx := startPos + idx
-		context := extractContext(text, actualIdx, len(query), 50)
+	return spans
+}
 
-		matches = append(matches, matchInfo{
-			startIndex: actualIdx,
-			context:    context,
-		})
+// matchQueryAt reports whether query occurs in text at byte offset pos, and where it ends.
+func matchQueryAt(text string, pos int, query string, caseSensitive bool) (int, bool) {
+	if caseSensitive {
+		if strings.HasPrefix(text[pos:], query) {
+			return pos + len(query), true
+		}
+		return 0, false
+	}
 
-		startPos = actualIdx + 1 // Move past this match to find overlapping matches
+	for _, queryRune := range query {
+		if pos >= len(text) {
+			return 0, false
+		}
+		textRune, size := utf8.DecodeRuneInString(text[pos:])
+		if !equalFoldRune(textRune, queryRune) {
+			return 0, false
+		}
+		pos += size
 	}
+	return pos, true
+}
 
-	return matches
+// equalFoldRune reports whether a and b are equal under simple Unicode case folding.
+func equalFoldRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
 }
 
 // extractContext extracts surrounding text (contextChars before and after) around a match.
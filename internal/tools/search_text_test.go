@@ -726,6 +726,41 @@ func TestFindMatchesInText(t *testing.T) {
 	}
 }
 
+func TestFindQuerySpans(t *testing.T) {
+	tests := []struct {
+		name          string
+		text          string
+		query         string
+		caseSensitive bool
+		overlapping   bool
+		want          []textSpan
+	}{
+		{"overlapping", "aaa", "aa", true, true, []textSpan{{0, 2}, {1, 3}}},
+		{"non-overlapping", "aaa", "aa", true, false, []textSpan{{0, 2}}},
+		{"case-insensitive", "Go go GO", "go", false, false, []textSpan{{0, 2}, {3, 5}, {6, 8}}},
+		{"case-sensitive", "Go go GO", "go", true, false, []textSpan{{3, 5}}},
+		{"kelvin sign folds to k", "5\u212A", "5k", false, false, []textSpan{{0, 4}}},
+		{"kelvin sign is not k when case-sensitive", "5\u212A", "5k", true, false, nil},
+		{"offsets after dotted capital I", "İİ abc", "ABC", false, false, []textSpan{{5, 8}}},
+		{"query longer than text", "ab", "abc", false, false, nil},
+		{"empty query", "abc", "", false, false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spans := findQuerySpans(tt.text, tt.query, tt.caseSensitive, tt.overlapping)
+			if len(spans) != len(tt.want) {
+				t.Fatalf("got %v, want %v", spans, tt.want)
+			}
+			for i := range spans {
+				if spans[i] != tt.want[i] {
+					t.Errorf("span %d = %v, want %v", i, spans[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
 func TestExtractContext(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -0,0 +1,188 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+// scopedTokenSource returns a token carrying a scope string, like a fresh token response.
+type scopedTokenSource struct {
+	scope string
+	err   error
+}
+
+func (s *scopedTokenSource) Token() (*oauth2.Token, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	token := &oauth2.Token{AccessToken: "test-token"}
+	return token.WithExtra(map[string]any{"scope": s.scope}), nil
+}
+
+func newSelfTestTools(slidesService *mockSlidesService, driveService *mockDriveService) *Tools {
+	return NewToolsWithDrive(DefaultToolsConfig(),
+		func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) { return slidesService, nil },
+		func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) { return driveService, nil },
+	)
+}
+
+func TestSelfTest_ReadOnly(t *testing.T) {
+	slidesService := &mockSlidesService{
+		CreatePresentationFunc: func(ctx context.Context, presentation *slides.Presentation) (*slides.Presentation, error) {
+			t.Error("read-only self-test must not create presentations")
+			return nil, nil
+		},
+	}
+	driveService := &mockDriveService{
+		GetAboutFunc: func(ctx context.Context) (*drive.About, error) {
+			return &drive.About{User: &drive.User{EmailAddress: "user@example.com", DisplayName: "Test User"}}, nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			t.Error("read-only self-test must not delete files")
+			return nil
+		},
+	}
+
+	tools := newSelfTestTools(slidesService, driveService)
+	output, err := tools.SelfTest(context.Background(), &scopedTokenSource{
+		scope: "https://www.googleapis.com/auth/presentations https://www.googleapis.com/auth/drive",
+	}, SelfTestInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !output.Success {
+		t.Error("expected success")
+	}
+	if output.Email != "user@example.com" || output.DisplayName != "Test User" {
+		t.Errorf("unexpected identity %q / %q", output.Email, output.DisplayName)
+	}
+	wantScopes := []string{"https://www.googleapis.com/auth/presentations", "https://www.googleapis.com/auth/drive"}
+	if len(output.Scopes) != len(wantScopes) {
+		t.Fatalf("expected scopes %v, got %v", wantScopes, output.Scopes)
+	}
+	for i, scope := range wantScopes {
+		if output.Scopes[i] != scope {
+			t.Errorf("scope %d = %q, want %q", i, output.Scopes[i], scope)
+		}
+	}
+	if output.WriteCheck != nil {
+		t.Errorf("expected no write check, got %+v", output.WriteCheck)
+	}
+}
+
+func TestSelfTest_CreateAndDelete(t *testing.T) {
+	var deletedID string
+	slidesService := &mockSlidesService{
+		CreatePresentationFunc: func(ctx context.Context, presentation *slides.Presentation) (*slides.Presentation, error) {
+			if presentation.Title != selfTestPresentationTitle {
+				t.Errorf("expected title %q, got %q", selfTestPresentationTitle, presentation.Title)
+			}
+			return &slides.Presentation{PresentationId: "throwaway-id"}, nil
+		},
+	}
+	driveService := &mockDriveService{
+		GetAboutFunc: func(ctx context.Context) (*drive.About, error) {
+			return &drive.About{User: &drive.User{EmailAddress: "user@example.com"}}, nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			deletedID = fileID
+			return nil
+		},
+	}
+
+	tools := newSelfTestTools(slidesService, driveService)
+	output, err := tools.SelfTest(context.Background(), &mockTokenSource{}, SelfTestInput{CreateAndDelete: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if deletedID != "throwaway-id" {
+		t.Errorf("expected throwaway presentation to be deleted, got %q", deletedID)
+	}
+	if output.WriteCheck == nil || !output.WriteCheck.Created || !output.WriteCheck.Deleted || output.WriteCheck.PresentationID != "throwaway-id" {
+		t.Errorf("unexpected write check %+v", output.WriteCheck)
+	}
+	if len(output.Scopes) != 0 {
+		t.Errorf("expected no scopes for a token without scope info, got %v", output.Scopes)
+	}
+}
+
+func TestSelfTest_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		tokenSource oauth2.TokenSource
+		input       SelfTestInput
+		aboutErr    error
+		createErr   error
+		deleteErr   error
+		wantErr     error
+	}{
+		{
+			name:        "token cannot be obtained",
+			tokenSource: &scopedTokenSource{err: errors.New("refresh token revoked")},
+			wantErr:     ErrSelfTestFailed,
+		},
+		{
+			name:        "drive access denied",
+			tokenSource: &mockTokenSource{},
+			aboutErr:    errors.New("googleapi: Error 403: insufficient permissions"),
+			wantErr:     ErrAccessDenied,
+		},
+		{
+			name:        "drive read fails",
+			tokenSource: &mockTokenSource{},
+			aboutErr:    errors.New("backend error"),
+			wantErr:     ErrDriveAPIError,
+		},
+		{
+			name:        "create fails",
+			tokenSource: &mockTokenSource{},
+			input:       SelfTestInput{CreateAndDelete: true},
+			createErr:   errors.New("backend error"),
+			wantErr:     ErrSelfTestFailed,
+		},
+		{
+			name:        "delete fails",
+			tokenSource: &mockTokenSource{},
+			input:       SelfTestInput{CreateAndDelete: true},
+			deleteErr:   errors.New("backend error"),
+			wantErr:     ErrSelfTestFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slidesService := &mockSlidesService{
+				CreatePresentationFunc: func(ctx context.Context, presentation *slides.Presentation) (*slides.Presentation, error) {
+					if tt.createErr != nil {
+						return nil, tt.createErr
+					}
+					return &slides.Presentation{PresentationId: "throwaway-id"}, nil
+				},
+			}
+			driveService := &mockDriveService{
+				GetAboutFunc: func(ctx context.Context) (*drive.About, error) {
+					if tt.aboutErr != nil {
+						return nil, tt.aboutErr
+					}
+					return &drive.About{User: &drive.User{EmailAddress: "user@example.com"}}, nil
+				},
+				DeleteFileFunc: func(ctx context.Context, fileID string) error {
+					return tt.deleteErr
+				},
+			}
+
+			tools := newSelfTestTools(slidesService, driveService)
+			_, err := tools.SelfTest(context.Background(), tt.tokenSource, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
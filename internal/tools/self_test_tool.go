@@ -0,0 +1,132 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for self_test tool.
+var (
+	ErrSelfTestFailed = errors.New("self-test failed")
+)
+
+// selfTestPresentationTitle is the title of the throwaway presentation used by the write check.
+const selfTestPresentationTitle = "google-slides-mcp self-test (safe to delete)"
+
+// SelfTestInput represents the input for the self_test tool.
+type SelfTestInput struct {
+	// CreateAndDelete also creates and permanently deletes a throwaway presentation,
+	// verifying write access. Off by default because it modifies the user's Drive.
+	CreateAndDelete bool `json:"create_and_delete,omitempty"`
+}
+
+// SelfTestOutput represents the output of the self_test tool.
+type SelfTestOutput struct {
+	Success     bool                `json:"success"`
+	Email       string              `json:"email"`
+	DisplayName string              `json:"display_name,omitempty"`
+	Scopes      []string            `json:"scopes,omitempty"` // Granted scopes, when the token reports them
+	WriteCheck  *SelfTestWriteCheck `json:"write_check,omitempty"`
+}
+
+// SelfTestWriteCheck reports the create and delete round trip.
+type SelfTestWriteCheck struct {
+	PresentationID string `json:"presentation_id"`
+	Created        bool   `json:"created"`
+	Deleted        bool   `json:"deleted"`
+}
+
+// SelfTest verifies that the OAuth token works by reading the authenticated user's identity,
+// and optionally by creating and deleting a throwaway presentation.
+func (t *Tools) SelfTest(ctx context.Context, tokenSource oauth2.TokenSource, input SelfTestInput) (*SelfTestOutput, error) {
+	t.config.Logger.Info("running self-test",
+		slog.Bool("create_and_delete", input.CreateAndDelete),
+	)
+
+	token, err := tokenSource.Token()
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to obtain token: %v", ErrSelfTestFailed, err)
+	}
+
+	// Create Drive service
+	driveService, err := t.driveServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+	}
+
+	about, err := driveService.GetAbout(ctx)
+	if err != nil {
+		if isForbiddenError(err) {
+			return nil, fmt.Errorf("%w: token lacks Drive access", ErrAccessDenied)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrDriveAPIError, err)
+	}
+
+	output := &SelfTestOutput{
+		Success: true,
+		Scopes:  grantedScopes(token),
+	}
+	if about != nil && about.User != nil {
+		output.Email = about.User.EmailAddress
+		output.DisplayName = about.User.DisplayName
+	}
+
+	if input.CreateAndDelete {
+		check, err := t.selfTestWriteCheck(ctx, tokenSource, driveService)
+		if err != nil {
+			return nil, err
+		}
+		output.WriteCheck = check
+	}
+
+	t.config.Logger.Info("self-test passed",
+		slog.String("email", output.Email),
+		slog.Int("scope_count", len(output.Scopes)),
+	)
+
+	return output, nil
+}
+
+// selfTestWriteCheck creates a throwaway presentation and permanently deletes it.
+func (t *Tools) selfTestWriteCheck(ctx context.Context, tokenSource oauth2.TokenSource, driveService DriveService) (*SelfTestWriteCheck, error) {
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	presentation, err := slidesService.CreatePresentation(ctx, &slides.Presentation{Title: selfTestPresentationTitle})
+	if err != nil {
+		if isForbiddenError(err) {
+			return nil, fmt.Errorf("%w: token cannot create presentations", ErrAccessDenied)
+		}
+		return nil, fmt.Errorf("%w: failed to create presentation: %v", ErrSelfTestFailed, err)
+	}
+
+	check := &SelfTestWriteCheck{
+		PresentationID: presentation.PresentationId,
+		Created:        true,
+	}
+
+	if err := driveService.DeleteFile(ctx, presentation.PresentationId); err != nil {
+		return nil, fmt.Errorf("%w: created presentation %s but could not delete it: %v", ErrSelfTestFailed, presentation.PresentationId, err)
+	}
+	check.Deleted = true
+
+	return check, nil
+}
+
+// grantedScopes returns the scopes reported in the token response, if any.
+// Tokens restored from storage usually carry no scope information.
+func grantedScopes(token *oauth2.Token) []string {
+	if token == nil {
+		return nil
+	}
+	scope, _ := token.Extra("scope").(string)
+	return strings.Fields(scope)
+}
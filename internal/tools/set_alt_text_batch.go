@@ -0,0 +1,152 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for set_alt_text_batch tool.
+var (
+	ErrInvalidAltTextItems = errors.New("invalid alt text items")
+	ErrSetAltTextFailed    = errors.New("failed to set alt text")
+)
+
+// AltTextItem is the alt text to set on one object. A nil field keeps the current value and an
+// empty string clears it.
+type AltTextItem struct {
+	ObjectID    string  `json:"object_id"`
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// SetAltTextBatchInput represents the input for the set_alt_text_batch tool.
+type SetAltTextBatchInput struct {
+	PresentationID string        `json:"presentation_id"`
+	Items          []AltTextItem `json:"items"`
+}
+
+// AltTextResult reports one object's alt text before and after the update.
+type AltTextResult struct {
+	ObjectID            string `json:"object_id"`
+	SlideID             string `json:"slide_id"`
+	PreviousTitle       string `json:"previous_title,omitempty"`
+	PreviousDescription string `json:"previous_description,omitempty"`
+	Title               string `json:"title"`
+	Description         string `json:"description"`
+}
+
+// SetAltTextBatchOutput represents the output of the set_alt_text_batch tool.
+type SetAltTextBatchOutput struct {
+	PresentationID string          `json:"presentation_id"`
+	Results        []AltTextResult `json:"results"` // In input order
+	UpdatedCount   int             `json:"updated_count"`
+}
+
+// SetAltTextBatch sets the alt text title and description of several page elements in a single
+// batch update, for accessibility passes. Every object must exist; nothing is written otherwise.
+func (t *Tools) SetAltTextBatch(ctx context.Context, tokenSource oauth2.TokenSource, input SetAltTextBatchInput) (*SetAltTextBatchOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if len(input.Items) == 0 {
+		return nil, fmt.Errorf("%w: at least one item is required", ErrInvalidAltTextItems)
+	}
+	seen := make(map[string]bool, len(input.Items))
+	for i, item := range input.Items {
+		if item.ObjectID == "" {
+			return nil, fmt.Errorf("%w: item %d: object_id is required", ErrInvalidAltTextItems, i+1)
+		}
+		if seen[item.ObjectID] {
+			return nil, fmt.Errorf("%w: item %d: object '%s' is listed more than once", ErrInvalidAltTextItems, i+1, item.ObjectID)
+		}
+		seen[item.ObjectID] = true
+		if item.Title == nil && item.Description == nil {
+			return nil, fmt.Errorf("%w: item %d: title or description is required", ErrInvalidAltTextItems, i+1)
+		}
+	}
+
+	t.config.Logger.Info("setting alt text",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("item_count", len(input.Items)),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to verify the objects and read their current alt text
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	output := &SetAltTextBatchOutput{
+		PresentationID: input.PresentationID,
+		Results:        make([]AltTextResult, 0, len(input.Items)),
+	}
+	requests := make([]*slides.Request, 0, len(input.Items))
+	for _, item := range input.Items {
+		slide := findSlideContainingObject(presentation.Slides, item.ObjectID)
+		if slide == nil {
+			return nil, fmt.Errorf("%w: object '%s' not found in presentation", ErrObjectNotFound, item.ObjectID)
+		}
+		element := findElementByID(slide.PageElements, item.ObjectID)
+
+		result := AltTextResult{
+			ObjectID:            item.ObjectID,
+			SlideID:             slide.ObjectId,
+			PreviousTitle:       element.Title,
+			PreviousDescription: element.Description,
+			Title:               element.Title,
+			Description:         element.Description,
+		}
+		request := &slides.UpdatePageElementAltTextRequest{ObjectId: item.ObjectID}
+		if item.Title != nil {
+			request.Title = *item.Title
+			request.ForceSendFields = append(request.ForceSendFields, "Title")
+			result.Title = *item.Title
+		}
+		if item.Description != nil {
+			request.Description = *item.Description
+			request.ForceSendFields = append(request.ForceSendFields, "Description")
+			result.Description = *item.Description
+		}
+
+		requests = append(requests, &slides.Request{UpdatePageElementAltText: request})
+		output.Results = append(output.Results, result)
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSetAltTextFailed, err)
+	}
+	output.UpdatedCount = len(output.Results)
+
+	t.config.Logger.Info("alt text set successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("updated_count", output.UpdatedCount),
+	)
+
+	return output, nil
+}
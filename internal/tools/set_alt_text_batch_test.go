@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func newSetAltTextTestTools(getErr, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	chart := treeTestElement("chart-image", 0, 0, 300, 200)
+	chart.Title = "Old chart title"
+	chart.Description = "Old chart description"
+
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1", PageElements: []*slides.PageElement{chart}},
+			{ObjectId: "slide-2", PageElements: []*slides.PageElement{
+				{ObjectId: "group-1", ElementGroup: &slides.Group{Children: []*slides.PageElement{
+					treeTestElement("logo", 0, 0, 50, 50),
+				}}},
+			}},
+		},
+	}
+
+	return newBatchCaptureTestTools(presentation, getErr, batchErr, batchCalls, captured)
+}
+
+func TestSetAltTextBatch_MultipleObjects(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newSetAltTextTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.SetAltTextBatch(context.Background(), nil, SetAltTextBatchInput{
+		PresentationID: "pres-1",
+		Items: []AltTextItem{
+			{ObjectID: "chart-image", Description: stringPtr("Revenue by quarter, rising from 2M to 5M")},
+			{ObjectID: "logo", Title: stringPtr("Company logo"), Description: stringPtr("")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 || len(captured) != 2 {
+		t.Fatalf("expected both updates in one batch, got %d calls and %d requests", batchCalls, len(captured))
+	}
+
+	chart := captured[0].UpdatePageElementAltText
+	if chart == nil || chart.ObjectId != "chart-image" || chart.Description != "Revenue by quarter, rising from 2M to 5M" {
+		t.Fatalf("unexpected chart request: %+v", chart)
+	}
+	if chart.Title != "" || len(chart.ForceSendFields) != 1 || chart.ForceSendFields[0] != "Description" {
+		t.Errorf("expected only the description to be sent for the chart, got %+v", chart)
+	}
+
+	logo := captured[1].UpdatePageElementAltText
+	if logo == nil || logo.ObjectId != "logo" || logo.Title != "Company logo" || logo.Description != "" {
+		t.Fatalf("unexpected logo request: %+v", logo)
+	}
+	if len(logo.ForceSendFields) != 2 {
+		t.Errorf("expected the empty description to be force-sent to clear it, got %v", logo.ForceSendFields)
+	}
+
+	if output.UpdatedCount != 2 || len(output.Results) != 2 {
+		t.Fatalf("unexpected output: %+v", output)
+	}
+	first := output.Results[0]
+	if first.SlideID != "slide-1" || first.PreviousTitle != "Old chart title" || first.Title != "Old chart title" {
+		t.Errorf("expected the chart title to be kept, got %+v", first)
+	}
+	if first.PreviousDescription != "Old chart description" || first.Description != "Revenue by quarter, rising from 2M to 5M" {
+		t.Errorf("unexpected chart description result: %+v", first)
+	}
+	if second := output.Results[1]; second.SlideID != "slide-2" || second.Title != "Company logo" || second.Description != "" {
+		t.Errorf("unexpected logo result: %+v", second)
+	}
+}
+
+func TestSetAltTextBatch_Errors(t *testing.T) {
+	title := stringPtr("Title")
+	valid := SetAltTextBatchInput{PresentationID: "pres-1", Items: []AltTextItem{{ObjectID: "chart-image", Title: title}}}
+
+	tests := []struct {
+		name     string
+		input    SetAltTextBatchInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", SetAltTextBatchInput{Items: valid.Items}, nil, nil, ErrInvalidPresentationID},
+		{"no items", SetAltTextBatchInput{PresentationID: "pres-1"}, nil, nil, ErrInvalidAltTextItems},
+		{"missing object ID", SetAltTextBatchInput{PresentationID: "pres-1", Items: []AltTextItem{{Title: title}}}, nil, nil, ErrInvalidAltTextItems},
+		{"nothing to set", SetAltTextBatchInput{PresentationID: "pres-1", Items: []AltTextItem{{ObjectID: "chart-image"}}}, nil, nil, ErrInvalidAltTextItems},
+		{"repeated object", SetAltTextBatchInput{PresentationID: "pres-1", Items: []AltTextItem{{ObjectID: "logo", Title: title}, {ObjectID: "logo", Title: title}}}, nil, nil, ErrInvalidAltTextItems},
+		{"unknown object", SetAltTextBatchInput{PresentationID: "pres-1", Items: []AltTextItem{{ObjectID: "logo", Title: title}, {ObjectID: "nope", Title: title}}}, nil, nil, ErrObjectNotFound},
+		{"presentation not found", valid, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", valid, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", valid, nil, errors.New("boom"), ErrSetAltTextFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newSetAltTextTestTools(tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.SetAltTextBatch(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", batchCalls)
+			}
+		})
+	}
+}
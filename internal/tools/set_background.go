@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"net/url"
 	"strings"
 
 	"golang.org/x/oauth2"
@@ -15,11 +17,21 @@ import (
 
 // Sentinel errors for set_background tool.
 var (
-	ErrSetBackgroundFailed    = errors.New("failed to set background")
-	ErrInvalidBackgroundType  = errors.New("invalid background type")
-	ErrMissingBackgroundColor = errors.New("color is required for solid background")
-	ErrMissingGradientColors  = errors.New("start_color and end_color are required for gradient background")
-	ErrInvalidGradientAngle   = errors.New("gradient angle must be between 0 and 360")
+	ErrSetBackgroundFailed       = errors.New("failed to set background")
+	ErrInvalidBackgroundType     = errors.New("invalid background type")
+	ErrMissingBackgroundColor    = errors.New("color is required for solid background")
+	ErrMissingGradientColors     = errors.New("start_color and end_color are required for gradient background")
+	ErrInvalidGradientAngle      = errors.New("gradient angle must be between 0 and 360")
+	ErrInvalidGradientResolution = errors.New("invalid gradient resolution")
+	ErrIrrelevantBackgroundField = errors.New("field does not apply to background type")
+)
+
+// Gradient image resolution bounds, in pixels along the longer edge.
+// Images are PNG-encoded without compression, so larger values grow the upload quickly.
+const (
+	defaultGradientResolution = 256
+	minGradientResolution     = 2
+	maxGradientResolution     = 1024
 )
 
 // SetBackgroundInput represents the input for the set_background tool.
@@ -31,15 +43,22 @@ type SetBackgroundInput struct {
 	BackgroundType string `json:"background_type"`           // Required: "solid", "image", or "gradient"
 
 	// For solid background
-	Color string `json:"color,omitempty"` // Hex color (e.g., "#FF0000")
+	Color string `json:"color,omitempty"` // Hex color (e.g., "#FF0000") or theme color name (e.g., "ACCENT1")
 
-	// For image background
-	ImageBase64 string `json:"image_base64,omitempty"` // Base64 encoded image data
+	// For image background (exactly one image source is required)
+	ImageBase64      string `json:"image_base64,omitempty"`        // Base64 encoded image data, uploaded to Drive
+	ImageDriveFileID string `json:"image_drive_file_id,omitempty"` // Existing Drive image, reused without upload
+	ImageURL         string `json:"image_url,omitempty"`           // Publicly accessible image URL
+	MakePublic       bool   `json:"make_public,omitempty"`         // Share image_drive_file_id via link before use
 
 	// For gradient background
 	StartColor string   `json:"start_color,omitempty"` // Hex color for gradient start
 	EndColor   string   `json:"end_color,omitempty"`   // Hex color for gradient end
 	Angle      *float64 `json:"angle,omitempty"`       // Degrees (0-360), default 0 (left to right)
+
+	// Pixels along the longer edge of the generated gradient image (default 256, max 1024).
+	// The shorter edge follows the presentation's page aspect ratio.
+	GradientResolution int `json:"gradient_resolution,omitempty"`
 }
 
 // SetBackgroundOutput represents the output of the set_background tool.
@@ -47,6 +66,7 @@ type SetBackgroundOutput struct {
 	Success       bool     `json:"success"`
 	Message       string   `json:"message"`
 	AffectedSlides []string `json:"affected_slides"` // Slide IDs that were modified
+	Summary        string   `json:"summary"`         // Human-readable description of the action taken
 }
 
 // SetBackground sets the background for one or all slides.
@@ -73,32 +93,8 @@ func (t *Tools) SetBackground(ctx context.Context, tokenSource oauth2.TokenSourc
 		return nil, fmt.Errorf("%w: slide_index or slide_id is required when scope is 'slide'", ErrInvalidSlideReference)
 	}
 
-	// Validate background type-specific parameters
-	switch bgType {
-	case "solid":
-		if input.Color == "" {
-			return nil, ErrMissingBackgroundColor
-		}
-		if parseHexColor(input.Color) == nil {
-			return nil, fmt.Errorf("%w: invalid color format '%s'", ErrMissingBackgroundColor, input.Color)
-		}
-	case "image":
-		if input.ImageBase64 == "" {
-			return nil, fmt.Errorf("%w: image_base64 is required for image background", ErrInvalidImageData)
-		}
-	case "gradient":
-		if input.StartColor == "" || input.EndColor == "" {
-			return nil, ErrMissingGradientColors
-		}
-		if parseHexColor(input.StartColor) == nil {
-			return nil, fmt.Errorf("%w: invalid start_color format '%s'", ErrMissingGradientColors, input.StartColor)
-		}
-		if parseHexColor(input.EndColor) == nil {
-			return nil, fmt.Errorf("%w: invalid end_color format '%s'", ErrMissingGradientColors, input.EndColor)
-		}
-		if input.Angle != nil && (*input.Angle < 0 || *input.Angle > 360) {
-			return nil, ErrInvalidGradientAngle
-		}
+	if err := t.validateBackgroundSpec(bgType, input); err != nil {
+		return nil, err
 	}
 
 	t.config.Logger.Info("setting background",
@@ -140,56 +136,168 @@ func (t *Tools) SetBackground(ctx context.Context, tokenSource oauth2.TokenSourc
 		targetSlideIDs = []string{slideID}
 	}
 
-	// Build background property based on type
-	var pageBackgroundFill *slides.PageBackgroundFill
-	var driveFileID string
+	pageBackgroundFill, uploadedFileID, err := t.buildPageBackgroundFill(ctx, tokenSource, bgType, input, presentation.PageSize)
+	if err != nil {
+		return nil, err
+	}
 
-	switch bgType {
-	case "solid":
-		rgb := parseHexColor(input.Color)
-		pageBackgroundFill = &slides.PageBackgroundFill{
-			SolidFill: &slides.SolidFill{
-				Color: &slides.OpaqueColor{
-					RgbColor: rgb,
+	// Build update requests for each target slide
+	var requests []*slides.Request
+	for _, slideID := range targetSlideIDs {
+		requests = append(requests, &slides.Request{
+			UpdatePageProperties: &slides.UpdatePagePropertiesRequest{
+				ObjectId: slideID,
+				PageProperties: &slides.PageProperties{
+					PageBackgroundFill: pageBackgroundFill,
 				},
+				Fields: "pageBackgroundFill",
 			},
+		})
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if uploadedFileID != "" {
+			t.cleanupBackgroundUploads(ctx, tokenSource, []string{uploadedFileID})
 		}
-	case "image":
-		// Decode and upload image
-		imageData, err := base64.StdEncoding.DecodeString(input.ImageBase64)
-		if err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrInvalidImageData, err)
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
 		}
-
-		mimeType := detectImageMimeType(imageData)
-		if mimeType == "" {
-			return nil, fmt.Errorf("%w: unable to detect image format", ErrInvalidImageData)
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
 		}
+		return nil, fmt.Errorf("%w: %v", ErrSetBackgroundFailed, err)
+	}
 
-		// Create Drive service to upload image
-		driveService, err := t.driveServiceFactory(ctx, tokenSource)
-		if err != nil {
-			return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+	// Build success message
+	var message string
+	switch bgType {
+	case "solid":
+		message = fmt.Sprintf("Solid background (%s) applied successfully", input.Color)
+	case "image":
+		message = "Image background applied successfully"
+	case "gradient":
+		message = fmt.Sprintf("Gradient background (%s to %s) applied successfully", input.StartColor, input.EndColor)
+	}
+
+	if scope == "all" {
+		message += fmt.Sprintf(" to all %d slides", len(targetSlideIDs))
+	} else {
+		message += " to slide"
+	}
+
+	output := &SetBackgroundOutput{
+		Success:        true,
+		Message:        message,
+		AffectedSlides: targetSlideIDs,
+		Summary:        buildBackgroundSummary(bgType, input, len(targetSlideIDs)),
+	}
+
+	t.config.Logger.Info("background set successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("background_type", bgType),
+		slog.Int("slides_affected", len(targetSlideIDs)),
+	)
+
+	return output, nil
+}
+
+// validateBackgroundSpec checks the fields required by a normalized background type and
+// flags fields the type ignores (an error with StrictInputs, a warning otherwise).
+func (t *Tools) validateBackgroundSpec(bgType string, input SetBackgroundInput) error {
+	// Flag fields that the chosen background type ignores
+	if irrelevant := irrelevantBackgroundFields(bgType, input); len(irrelevant) > 0 {
+		if t.config.StrictInputs {
+			return fmt.Errorf("%w: %s not used for '%s' background", ErrIrrelevantBackgroundField, strings.Join(irrelevant, ", "), bgType)
 		}
+		t.config.Logger.Warn("ignoring fields not used by background type",
+			slog.String("background_type", bgType),
+			slog.String("fields", strings.Join(irrelevant, ",")),
+		)
+	}
 
-		// Upload image to Drive
-		fileName := generateBackgroundFileName()
-		uploadedFile, err := driveService.UploadFile(ctx, fileName, mimeType, bytes.NewReader(imageData))
-		if err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrImageUploadFailed, err)
+	// Validate background type-specific parameters
+	switch bgType {
+	case "solid":
+		if input.Color == "" {
+			return ErrMissingBackgroundColor
+		}
+		if parseColor(input.Color) == nil {
+			return fmt.Errorf("%w: '%s' is neither a hex color nor a theme color (%s)", ErrMissingBackgroundColor, input.Color, strings.Join(themeColorTypes, ", "))
+		}
+	case "image":
+		sources := 0
+		for _, source := range []string{input.ImageBase64, input.ImageDriveFileID, input.ImageURL} {
+			if source != "" {
+				sources++
+			}
+		}
+		if sources != 1 {
+			return fmt.Errorf("%w: exactly one of image_base64, image_drive_file_id, or image_url is required for image background", ErrInvalidImageData)
+		}
+		if input.ImageURL != "" && !strings.HasPrefix(input.ImageURL, "http://") && !strings.HasPrefix(input.ImageURL, "https://") {
+			return fmt.Errorf("%w: image_url must be an http or https URL", ErrInvalidImageData)
+		}
+	case "gradient":
+		if input.StartColor == "" || input.EndColor == "" {
+			return ErrMissingGradientColors
+		}
+		if parseHexColor(input.StartColor) == nil {
+			return fmt.Errorf("%w: invalid start_color format '%s'", ErrMissingGradientColors, input.StartColor)
 		}
-		driveFileID = uploadedFile.Id
+		if parseHexColor(input.EndColor) == nil {
+			return fmt.Errorf("%w: invalid end_color format '%s'", ErrMissingGradientColors, input.EndColor)
+		}
+		if input.Angle != nil && (*input.Angle < 0 || *input.Angle > 360) {
+			return ErrInvalidGradientAngle
+		}
+		if input.GradientResolution != 0 && (input.GradientResolution < minGradientResolution || input.GradientResolution > maxGradientResolution) {
+			return fmt.Errorf("%w: gradient_resolution must be between %d and %d, got %d",
+				ErrInvalidGradientResolution, minGradientResolution, maxGradientResolution, input.GradientResolution)
+		}
+	}
 
-		// Make the file publicly accessible so Slides can read it
-		err = driveService.MakeFilePublic(ctx, driveFileID)
-		if err != nil {
-			t.config.Logger.Warn("failed to make background image public",
-				slog.String("file_id", driveFileID),
-				slog.String("error", err.Error()),
-			)
+	return nil
+}
+
+// buildPageBackgroundFill resolves a validated background spec into a page fill. Image and
+// gradient backgrounds are uploaded to Drive first, so this may make Drive API calls; the
+// ID of a file uploaded here is returned so it can be cleaned up if the update fails.
+func (t *Tools) buildPageBackgroundFill(ctx context.Context, tokenSource oauth2.TokenSource, bgType string, input SetBackgroundInput, pageSize *slides.Size) (*slides.PageBackgroundFill, string, error) {
+	var pageBackgroundFill *slides.PageBackgroundFill
+	var uploadedFileID string
+
+	switch bgType {
+	case "solid":
+		pageBackgroundFill = buildSolidBackgroundFill(input.Color)
+	case "image":
+		var imageURL string
+		switch {
+		case input.ImageURL != "":
+			imageURL = input.ImageURL
+		case input.ImageDriveFileID != "":
+			if input.MakePublic {
+				driveService, err := t.driveServiceFactory(ctx, tokenSource)
+				if err != nil {
+					return nil, "", fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+				}
+				if err := driveService.MakeFilePublic(ctx, input.ImageDriveFileID); err != nil {
+					t.config.Logger.Warn("failed to make background image public",
+						slog.String("file_id", input.ImageDriveFileID),
+						slog.String("error", err.Error()),
+					)
+				}
+			}
+			imageURL = driveImageContentURL(input.ImageDriveFileID)
+		default:
+			var err error
+			imageURL, uploadedFileID, err = t.uploadBackgroundImage(ctx, tokenSource, input.ImageBase64)
+			if err != nil {
+				return nil, "", err
+			}
 		}
 
-		imageURL := fmt.Sprintf("https://drive.google.com/uc?id=%s&export=download", driveFileID)
 		pageBackgroundFill = &slides.PageBackgroundFill{
 			StretchedPictureFill: &slides.StretchedPictureFill{
 				ContentUrl: imageURL,
@@ -216,23 +324,25 @@ func (t *Tools) SetBackground(ctx context.Context, tokenSource oauth2.TokenSourc
 		// StretchedPictureFill. Let's implement that approach.
 
 		// Generate gradient image
-		gradientImageData, err := generateGradientImage(startRgb, endRgb, angle)
+		width, height := gradientDimensions(input.GradientResolution, pageSize)
+		gradientImageData, err := generateGradientImage(startRgb, endRgb, angle, width, height)
 		if err != nil {
-			return nil, fmt.Errorf("%w: failed to generate gradient image: %v", ErrSetBackgroundFailed, err)
+			return nil, "", fmt.Errorf("%w: failed to generate gradient image: %v", ErrSetBackgroundFailed, err)
 		}
 
 		// Upload gradient image to Drive
 		driveService, err := t.driveServiceFactory(ctx, tokenSource)
 		if err != nil {
-			return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+			return nil, "", fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
 		}
 
 		fileName := generateBackgroundFileName()
 		uploadedFile, err := driveService.UploadFile(ctx, fileName, "image/png", bytes.NewReader(gradientImageData))
 		if err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrImageUploadFailed, err)
+			return nil, "", fmt.Errorf("%w: %v", ErrImageUploadFailed, err)
 		}
-		driveFileID = uploadedFile.Id
+		driveFileID := uploadedFile.Id
+		uploadedFileID = driveFileID
 
 		// Make the file publicly accessible
 		err = driveService.MakeFilePublic(ctx, driveFileID)
@@ -243,7 +353,7 @@ func (t *Tools) SetBackground(ctx context.Context, tokenSource oauth2.TokenSourc
 			)
 		}
 
-		imageURL := fmt.Sprintf("https://drive.google.com/uc?id=%s&export=download", driveFileID)
+		imageURL := driveImageContentURL(driveFileID)
 		pageBackgroundFill = &slides.PageBackgroundFill{
 			StretchedPictureFill: &slides.StretchedPictureFill{
 				ContentUrl: imageURL,
@@ -251,81 +361,136 @@ func (t *Tools) SetBackground(ctx context.Context, tokenSource oauth2.TokenSourc
 		}
 	}
 
-	// Build update requests for each target slide
-	var requests []*slides.Request
-	for _, slideID := range targetSlideIDs {
-		requests = append(requests, &slides.Request{
-			UpdatePageProperties: &slides.UpdatePagePropertiesRequest{
-				ObjectId: slideID,
-				PageProperties: &slides.PageProperties{
-					PageBackgroundFill: pageBackgroundFill,
-				},
-				Fields: "pageBackgroundFill",
-			},
-		})
+	return pageBackgroundFill, uploadedFileID, nil
+}
+
+// cleanupBackgroundUploads deletes background images uploaded for an update that failed.
+func (t *Tools) cleanupBackgroundUploads(ctx context.Context, tokenSource oauth2.TokenSource, fileIDs []string) {
+	if len(fileIDs) == 0 {
+		return
 	}
 
-	// Execute batch update
-	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	driveService, err := t.driveServiceFactory(ctx, tokenSource)
 	if err != nil {
-		if isNotFoundError(err) {
-			return nil, ErrPresentationNotFound
-		}
-		if isForbiddenError(err) {
-			return nil, ErrAccessDenied
-		}
-		return nil, fmt.Errorf("%w: %v", ErrSetBackgroundFailed, err)
+		t.config.Logger.Warn("failed to create drive service to delete orphaned uploads",
+			slog.String("error", err.Error()),
+		)
+		return
 	}
+	t.deleteOrphanedUploads(ctx, driveService, fileIDs)
+}
 
-	// Build success message
-	var message string
+// buildSolidBackgroundFill builds the page fill for a validated solid background color. Theme
+// colors are referenced rather than resolved, so the background follows later theme changes.
+func buildSolidBackgroundFill(color string) *slides.PageBackgroundFill {
+	return &slides.PageBackgroundFill{
+		SolidFill: &slides.SolidFill{
+			Color: parseColor(color),
+		},
+	}
+}
+
+// buildBackgroundSummary describes the applied background, e.g.
+// "Applied solid #FF0000 background to 3 slides".
+func buildBackgroundSummary(bgType string, input SetBackgroundInput, slideCount int) string {
+	var description string
 	switch bgType {
 	case "solid":
-		message = fmt.Sprintf("Solid background (%s) applied successfully", input.Color)
-	case "image":
-		message = "Image background applied successfully"
+		description = "solid " + strings.ToUpper(input.Color)
 	case "gradient":
-		message = fmt.Sprintf("Gradient background (%s to %s) applied successfully", input.StartColor, input.EndColor)
+		description = fmt.Sprintf("gradient %s to %s", strings.ToUpper(input.StartColor), strings.ToUpper(input.EndColor))
+	default:
+		description = bgType
 	}
 
-	if scope == "all" {
-		message += fmt.Sprintf(" to all %d slides", len(targetSlideIDs))
-	} else {
-		message += " to slide"
+	unit := "slides"
+	if slideCount == 1 {
+		unit = "slide"
 	}
+	return fmt.Sprintf("Applied %s background to %d %s", description, slideCount, unit)
+}
 
-	output := &SetBackgroundOutput{
-		Success:        true,
-		Message:        message,
-		AffectedSlides: targetSlideIDs,
+// uploadBackgroundImage decodes a base64 image, uploads it to Drive and returns its content URL
+// and file ID.
+func (t *Tools) uploadBackgroundImage(ctx context.Context, tokenSource oauth2.TokenSource, imageBase64 string) (string, string, error) {
+	imageData, err := base64.StdEncoding.DecodeString(imageBase64)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %v", ErrInvalidImageData, err)
 	}
 
-	t.config.Logger.Info("background set successfully",
-		slog.String("presentation_id", input.PresentationID),
-		slog.String("background_type", bgType),
-		slog.Int("slides_affected", len(targetSlideIDs)),
-	)
+	mimeType := detectImageMimeType(imageData)
+	if mimeType == "" {
+		return "", "", fmt.Errorf("%w: unable to detect image format", ErrInvalidImageData)
+	}
+	if err := t.checkImageFormatAllowed(mimeType); err != nil {
+		return "", "", err
+	}
 
-	return output, nil
+	// Create Drive service to upload image
+	driveService, err := t.driveServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+	}
+
+	// Upload image to Drive
+	fileName := generateBackgroundFileName()
+	uploadedFile, err := driveService.UploadFile(ctx, fileName, mimeType, bytes.NewReader(imageData))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %v", ErrImageUploadFailed, err)
+	}
+
+	// Make the file publicly accessible so Slides can read it
+	err = driveService.MakeFilePublic(ctx, uploadedFile.Id)
+	if err != nil {
+		t.config.Logger.Warn("failed to make background image public",
+			slog.String("file_id", uploadedFile.Id),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return driveImageContentURL(uploadedFile.Id), uploadedFile.Id, nil
 }
 
-// backgroundTimeNowFunc allows overriding the time function for tests.
-var backgroundTimeNowFunc = imageTimeNowFunc
+// driveImageContentURL builds the download URL Slides uses to fetch a Drive-hosted image.
+// The file ID may come straight from the caller, so it is query-escaped.
+func driveImageContentURL(fileID string) string {
+	return "https://drive.google.com/uc?id=" + url.QueryEscape(fileID) + "&export=download"
+}
 
 // generateBackgroundFileName generates a unique file name for the uploaded background image.
 func generateBackgroundFileName() string {
-	return fmt.Sprintf("slides_background_%d.png", backgroundTimeNowFunc().UnixNano())
+	return newObjectID("slides_background") + ".png"
 }
 
-// generateGradientImage creates a PNG image with a linear gradient.
-// The angle is in degrees (0 = left to right, 90 = top to bottom).
-func generateGradientImage(startColor, endColor *slides.RgbColor, angle float64) ([]byte, error) {
-	// For simplicity, we generate a small gradient image (100x100 pixels)
-	// that will be stretched to fill the slide background.
-	// This approach works because the API stretches the image to fit.
+// gradientDimensions returns the gradient image size for a resolution (longer edge,
+// 0 for the default), matching the page aspect ratio when the page size is known.
+func gradientDimensions(resolution int, pageSize *slides.Size) (int, int) {
+	if resolution <= 0 {
+		resolution = defaultGradientResolution
+	}
 
-	width := 100
-	height := 100
+	if pageSize == nil || pageSize.Width == nil || pageSize.Height == nil ||
+		pageSize.Width.Magnitude <= 0 || pageSize.Height.Magnitude <= 0 {
+		return resolution, resolution
+	}
+
+	pageWidth := pageSize.Width.Magnitude
+	pageHeight := pageSize.Height.Magnitude
+	if pageWidth >= pageHeight {
+		height := int(math.Round(float64(resolution) * pageHeight / pageWidth))
+		return resolution, max(height, minGradientResolution)
+	}
+	width := int(math.Round(float64(resolution) * pageWidth / pageHeight))
+	return max(width, minGradientResolution), resolution
+}
+
+// generateGradientImage creates a width x height PNG image with a linear gradient.
+// The angle is in degrees (0 = left to right, 90 = top to bottom).
+// The image is stretched by the API to fill the slide background.
+func generateGradientImage(startColor, endColor *slides.RgbColor, angle float64, width, height int) ([]byte, error) {
+	if width < minGradientResolution || height < minGradientResolution {
+		return nil, fmt.Errorf("%w: %dx%d is too small", ErrInvalidGradientResolution, width, height)
+	}
 
 	// Convert RgbColor (0-1 range) to 0-255 range
 	startR := uint8(startColor.Red * 255)
@@ -523,7 +688,7 @@ var crc32Table = [256]uint32{
 	0x88085AE6, 0xFF0F6A70, 0x66063BCA, 0x11010B5C, 0x8F659EFF, 0xF862AE69, 0x616BFFD3, 0x166CCF45,
 	0xA00AE278, 0xD70DD2EE, 0x4E048354, 0x3903B3C2, 0xA7672661, 0xD06016F7, 0x4969474D, 0x3E6E77DB,
 	0xAED16A4A, 0xD9D65ADC, 0x40DF0B66, 0x37D83BF0, 0xA9BCAE53, 0xDEBB9EC5, 0x47B2CF7F, 0x30B5FFE9,
-	0xBDBDF21C, 0xCABAC28A, 0x53B39330, 0x24B4A3A6, 0xBAD03605, 0xCDD706B3, 0x54DE5729, 0x23D967BF,
+	0xBDBDF21C, 0xCABAC28A, 0x53B39330, 0x24B4A3A6, 0xBAD03605, 0xCDD70693, 0x54DE5729, 0x23D967BF,
 	0xB3667A2E, 0xC4614AB8, 0x5D681B02, 0x2A6F2B94, 0xB40BBE37, 0xC30C8EA1, 0x5A05DF1B, 0x2D02EF8D,
 }
 
@@ -607,3 +772,30 @@ func adler32(data []byte) uint32 {
 
 	return (b << 16) | a
 }
+
+// irrelevantBackgroundFields returns the JSON names of set fields that bgType does not use.
+func irrelevantBackgroundFields(bgType string, input SetBackgroundInput) []string {
+	setFields := []struct {
+		name    string
+		set     bool
+		bgTypes string
+	}{
+		{"color", input.Color != "", "solid"},
+		{"image_base64", input.ImageBase64 != "", "image"},
+		{"image_drive_file_id", input.ImageDriveFileID != "", "image"},
+		{"image_url", input.ImageURL != "", "image"},
+		{"make_public", input.MakePublic, "image"},
+		{"start_color", input.StartColor != "", "gradient"},
+		{"end_color", input.EndColor != "", "gradient"},
+		{"angle", input.Angle != nil, "gradient"},
+		{"gradient_resolution", input.GradientResolution != 0, "gradient"},
+	}
+
+	var irrelevant []string
+	for _, field := range setFields {
+		if field.set && field.bgTypes != bgType {
+			irrelevant = append(irrelevant, field.name)
+		}
+	}
+	return irrelevant
+}
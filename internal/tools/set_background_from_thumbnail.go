@@ -0,0 +1,125 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/oauth2"
+)
+
+// Sentinel errors for set_background_from_slide_thumbnail tool.
+var (
+	ErrThumbnailFetchFailed = errors.New("failed to fetch slide thumbnail")
+)
+
+// SetBackgroundFromSlideThumbnailInput represents the input for the
+// set_background_from_slide_thumbnail tool.
+type SetBackgroundFromSlideThumbnailInput struct {
+	PresentationID   string `json:"presentation_id"`
+	SourceSlideIndex int    `json:"source_slide_index,omitempty"` // 1-based slide to render
+	SourceSlideID    string `json:"source_slide_id,omitempty"`    // Alternative to source_slide_index
+	TargetSlideIndex int    `json:"target_slide_index,omitempty"` // 1-based slide receiving the background
+	TargetSlideID    string `json:"target_slide_id,omitempty"`    // Alternative to target_slide_index
+}
+
+// SetBackgroundFromSlideThumbnailOutput represents the output of the
+// set_background_from_slide_thumbnail tool.
+type SetBackgroundFromSlideThumbnailOutput struct {
+	SourceSlideID string `json:"source_slide_id"`
+	TargetSlideID string `json:"target_slide_id"`
+}
+
+// SetBackgroundFromSlideThumbnail renders one slide as a thumbnail and uses it as the
+// background image of another slide. The thumbnail is uploaded to Drive like any other
+// image background, since Slides thumbnail URLs expire after a short time.
+func (t *Tools) SetBackgroundFromSlideThumbnail(ctx context.Context, tokenSource oauth2.TokenSource, input SetBackgroundFromSlideThumbnailInput) (*SetBackgroundFromSlideThumbnailOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.SourceSlideIndex == 0 && input.SourceSlideID == "" {
+		return nil, fmt.Errorf("%w: source_slide_index or source_slide_id is required", ErrInvalidSlideReference)
+	}
+	if input.TargetSlideIndex == 0 && input.TargetSlideID == "" {
+		return nil, fmt.Errorf("%w: target_slide_index or target_slide_id is required", ErrInvalidSlideReference)
+	}
+
+	t.config.Logger.Info("setting background from slide thumbnail",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("source_slide_index", input.SourceSlideIndex),
+		slog.String("source_slide_id", input.SourceSlideID),
+		slog.Int("target_slide_index", input.TargetSlideIndex),
+		slog.String("target_slide_id", input.TargetSlideID),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation to resolve the source slide
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	sourceSlideID, _, err := findSlide(presentation, input.SourceSlideIndex, input.SourceSlideID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Render the source slide
+	thumbnail, err := slidesService.GetThumbnail(ctx, input.PresentationID, sourceSlideID)
+	if err != nil {
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrThumbnailFetchFailed, err)
+	}
+	if thumbnail == nil {
+		return nil, fmt.Errorf("%w: empty thumbnail response", ErrThumbnailFetchFailed)
+	}
+
+	thumbnailData, err := fetchThumbnailImage(ctx, thumbnail.ContentUrl)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrThumbnailFetchFailed, err)
+	}
+
+	// Upload and apply through the regular image background path
+	backgroundOutput, err := t.SetBackground(ctx, tokenSource, SetBackgroundInput{
+		PresentationID: input.PresentationID,
+		Scope:          "slide",
+		SlideIndex:     input.TargetSlideIndex,
+		SlideID:        input.TargetSlideID,
+		BackgroundType: "image",
+		ImageBase64:    base64.StdEncoding.EncodeToString(thumbnailData),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SetBackgroundFromSlideThumbnailOutput{
+		SourceSlideID: sourceSlideID,
+	}
+	if len(backgroundOutput.AffectedSlides) > 0 {
+		output.TargetSlideID = backgroundOutput.AffectedSlides[0]
+	}
+
+	t.config.Logger.Info("background set from slide thumbnail successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("source_slide_id", output.SourceSlideID),
+		slog.String("target_slide_id", output.TargetSlideID),
+	)
+
+	return output, nil
+}
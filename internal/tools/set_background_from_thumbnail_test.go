@@ -0,0 +1,165 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestSetBackgroundFromSlideThumbnail(t *testing.T) {
+	thumbnailServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(testPNGBytes)
+	}))
+	defer thumbnailServer.Close()
+
+	var thumbnailPage string
+	var updateRequests []*slides.Request
+	slidesService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				Slides: []*slides.Page{
+					{ObjectId: "chart-slide"},
+					{ObjectId: "target-slide"},
+				},
+			}, nil
+		},
+		GetThumbnailFunc: func(ctx context.Context, presentationID, pageObjectID string) (*slides.Thumbnail, error) {
+			thumbnailPage = pageObjectID
+			return &slides.Thumbnail{ContentUrl: thumbnailServer.URL + "/thumb.png"}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			updateRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	var uploaded []byte
+	driveService := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			if mimeType != "image/png" {
+				t.Errorf("expected image/png upload, got %s", mimeType)
+			}
+			uploaded, _ = io.ReadAll(content)
+			return &drive.File{Id: "uploaded-thumb"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(),
+		func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) { return slidesService, nil },
+		func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) { return driveService, nil },
+	)
+
+	output, err := tools.SetBackgroundFromSlideThumbnail(context.Background(), &mockTokenSource{}, SetBackgroundFromSlideThumbnailInput{
+		PresentationID:   "pres-1",
+		SourceSlideIndex: 1,
+		TargetSlideID:    "target-slide",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if thumbnailPage != "chart-slide" {
+		t.Errorf("expected thumbnail of 'chart-slide', got %q", thumbnailPage)
+	}
+	if !bytes.Equal(uploaded, testPNGBytes) {
+		t.Error("expected the fetched thumbnail bytes to be uploaded unchanged")
+	}
+
+	if len(updateRequests) != 1 || updateRequests[0].UpdatePageProperties == nil {
+		t.Fatalf("expected a single UpdatePageProperties request, got %+v", updateRequests)
+	}
+	update := updateRequests[0].UpdatePageProperties
+	if update.ObjectId != "target-slide" {
+		t.Errorf("expected background on 'target-slide', got %q", update.ObjectId)
+	}
+	fill := update.PageProperties.PageBackgroundFill
+	if fill == nil || fill.StretchedPictureFill == nil {
+		t.Fatalf("expected a stretched picture fill, got %+v", fill)
+	}
+	if fill.StretchedPictureFill.ContentUrl != driveImageContentURL("uploaded-thumb") {
+		t.Errorf("expected background URL of the uploaded thumbnail, got %q", fill.StretchedPictureFill.ContentUrl)
+	}
+
+	if output.SourceSlideID != "chart-slide" || output.TargetSlideID != "target-slide" {
+		t.Errorf("unexpected output %+v", output)
+	}
+}
+
+func TestSetBackgroundFromSlideThumbnail_Errors(t *testing.T) {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides:         []*slides.Page{{ObjectId: "slide-1"}, {ObjectId: "slide-2"}},
+	}
+
+	tests := []struct {
+		name         string
+		input        SetBackgroundFromSlideThumbnailInput
+		thumbnailErr error
+		wantErr      error
+	}{
+		{
+			name:    "missing presentation ID",
+			input:   SetBackgroundFromSlideThumbnailInput{SourceSlideIndex: 1, TargetSlideIndex: 2},
+			wantErr: ErrInvalidPresentationID,
+		},
+		{
+			name:    "missing source slide",
+			input:   SetBackgroundFromSlideThumbnailInput{PresentationID: "pres-1", TargetSlideIndex: 2},
+			wantErr: ErrInvalidSlideReference,
+		},
+		{
+			name:    "missing target slide",
+			input:   SetBackgroundFromSlideThumbnailInput{PresentationID: "pres-1", SourceSlideIndex: 1},
+			wantErr: ErrInvalidSlideReference,
+		},
+		{
+			name:    "source slide out of range",
+			input:   SetBackgroundFromSlideThumbnailInput{PresentationID: "pres-1", SourceSlideIndex: 5, TargetSlideIndex: 2},
+			wantErr: ErrSlideNotFound,
+		},
+		{
+			name:         "thumbnail request fails",
+			input:        SetBackgroundFromSlideThumbnailInput{PresentationID: "pres-1", SourceSlideIndex: 1, TargetSlideIndex: 2},
+			thumbnailErr: errors.New("backend error"),
+			wantErr:      ErrThumbnailFetchFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slidesService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return presentation, nil
+				},
+				GetThumbnailFunc: func(ctx context.Context, presentationID, pageObjectID string) (*slides.Thumbnail, error) {
+					return nil, tt.thumbnailErr
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					t.Error("background must not be updated on error")
+					return nil, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return slidesService, nil
+			})
+
+			_, err := tools.SetBackgroundFromSlideThumbnail(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -3,10 +3,11 @@ package tools
 import (
 	"context"
 	"encoding/base64"
+	"encoding/binary"
 	"errors"
+	"hash/crc32"
 	"io"
 	"testing"
-	"time"
 
 	"golang.org/x/oauth2"
 	"google.golang.org/api/drive/v3"
@@ -136,6 +137,10 @@ func TestSetBackground_SolidColor_AllSlides(t *testing.T) {
 		t.Errorf("expected 3 affected slides, got %d", len(output.AffectedSlides))
 	}
 
+	if output.Summary != "Applied solid #00FF00 background to 3 slides" {
+		t.Errorf("unexpected summary: %s", output.Summary)
+	}
+
 	// Verify 3 update requests were created
 	if len(capturedRequests) != 3 {
 		t.Fatalf("expected 3 requests, got %d", len(capturedRequests))
@@ -196,12 +201,8 @@ func TestSetBackground_Image_SingleSlide(t *testing.T) {
 		return mockDrive, nil
 	}
 
-	// Set fixed time for deterministic file names
-	originalTimeFunc := backgroundTimeNowFunc
-	backgroundTimeNowFunc = func() time.Time {
-		return time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
-	}
-	defer func() { backgroundTimeNowFunc = originalTimeFunc }()
+	// Fixed ID suffix for deterministic file names
+	stubObjectIDSuffix(t, "1705312800000000000")
 
 	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, driveFactory)
 	tokenSource := &mockTokenSource{}
@@ -542,6 +543,64 @@ func TestSetBackground_MissingSlideReference(t *testing.T) {
 	}
 }
 
+func TestSetBackground_SolidThemeColor(t *testing.T) {
+	var capturedRequests []*slides.Request
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "test-presentation",
+				Slides:         []*slides.Page{{ObjectId: "slide-1"}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	})
+
+	output, err := tools.SetBackground(context.Background(), &mockTokenSource{}, SetBackgroundInput{
+		PresentationID: "test-presentation",
+		Scope:          "slide",
+		SlideIndex:     1,
+		BackgroundType: "solid",
+		Color:          "accent1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Summary != "Applied solid ACCENT1 background to 1 slide" {
+		t.Errorf("unexpected summary: %s", output.Summary)
+	}
+
+	if len(capturedRequests) != 1 || capturedRequests[0].UpdatePageProperties == nil {
+		t.Fatalf("expected 1 UpdatePageProperties request, got %+v", capturedRequests)
+	}
+	color := capturedRequests[0].UpdatePageProperties.PageProperties.PageBackgroundFill.SolidFill.Color
+	if color.ThemeColor != "ACCENT1" || color.RgbColor != nil {
+		t.Errorf("expected a ThemeColor ACCENT1 fill, got %+v", color)
+	}
+}
+
+func TestSetBackground_UnknownThemeColor(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	_, err := tools.SetBackground(context.Background(), &mockTokenSource{}, SetBackgroundInput{
+		PresentationID: "test-presentation",
+		Scope:          "slide",
+		SlideIndex:     1,
+		BackgroundType: "solid",
+		Color:          "ACCENT7",
+	})
+	if !errors.Is(err, ErrMissingBackgroundColor) {
+		t.Errorf("expected ErrMissingBackgroundColor, got %v", err)
+	}
+}
+
 func TestSetBackground_MissingSolidColor(t *testing.T) {
 	tools := NewTools(DefaultToolsConfig(), nil)
 	tokenSource := &mockTokenSource{}
@@ -605,6 +664,183 @@ func TestSetBackground_MissingImageData(t *testing.T) {
 	}
 }
 
+func TestSetBackground_Image_DriveFileID(t *testing.T) {
+	var capturedRequests []*slides.Request
+	var publicFileID string
+	uploadCalled := false
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "test-presentation",
+				Slides: []*slides.Page{
+					{ObjectId: "slide-1"},
+				},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			uploadCalled = true
+			return &drive.File{Id: "unexpected"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			publicFileID = fileID
+			return nil
+		},
+	}
+
+	slidesFactory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	}
+	driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return mockDrive, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, driveFactory)
+
+	_, err := tools.SetBackground(context.Background(), &mockTokenSource{}, SetBackgroundInput{
+		PresentationID:   "test-presentation",
+		Scope:            "slide",
+		SlideIndex:       1,
+		BackgroundType:   "image",
+		ImageDriveFileID: "existing-drive-image",
+		MakePublic:       true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if uploadCalled {
+		t.Error("expected no upload when image_drive_file_id is provided")
+	}
+	if publicFileID != "existing-drive-image" {
+		t.Errorf("expected MakeFilePublic to be called with 'existing-drive-image', got '%s'", publicFileID)
+	}
+
+	if len(capturedRequests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(capturedRequests))
+	}
+	bgFill := capturedRequests[0].UpdatePageProperties.PageProperties.PageBackgroundFill
+	if bgFill == nil || bgFill.StretchedPictureFill == nil {
+		t.Fatal("expected StretchedPictureFill background")
+	}
+	expectedURL := "https://drive.google.com/uc?id=existing-drive-image&export=download"
+	if bgFill.StretchedPictureFill.ContentUrl != expectedURL {
+		t.Errorf("expected ContentUrl '%s', got '%s'", expectedURL, bgFill.StretchedPictureFill.ContentUrl)
+	}
+}
+
+func TestSetBackground_Image_URL(t *testing.T) {
+	var capturedRequests []*slides.Request
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: "test-presentation",
+				Slides: []*slides.Page{
+					{ObjectId: "slide-1"},
+				},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	slidesFactory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	}
+	driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		t.Fatal("drive service should not be created for image_url")
+		return nil, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, driveFactory)
+
+	_, err := tools.SetBackground(context.Background(), &mockTokenSource{}, SetBackgroundInput{
+		PresentationID: "test-presentation",
+		Scope:          "all",
+		BackgroundType: "image",
+		ImageURL:       "https://example.com/bg.png",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bgFill := capturedRequests[0].UpdatePageProperties.PageProperties.PageBackgroundFill
+	if bgFill.StretchedPictureFill.ContentUrl != "https://example.com/bg.png" {
+		t.Errorf("expected ContentUrl 'https://example.com/bg.png', got '%s'", bgFill.StretchedPictureFill.ContentUrl)
+	}
+}
+
+func TestDriveImageContentURL_EscapesFileID(t *testing.T) {
+	tests := []struct {
+		fileID string
+		want   string
+	}{
+		{"1AbC-d_E", "https://drive.google.com/uc?id=1AbC-d_E&export=download"},
+		{"abc&export=view#frag", "https://drive.google.com/uc?id=abc%26export%3Dview%23frag&export=download"},
+		{"a b/c?d", "https://drive.google.com/uc?id=a+b%2Fc%3Fd&export=download"},
+	}
+
+	for _, tt := range tests {
+		if got := driveImageContentURL(tt.fileID); got != tt.want {
+			t.Errorf("driveImageContentURL(%q) = %q, want %q", tt.fileID, got, tt.want)
+		}
+	}
+}
+
+func TestSetBackground_ImageSourceValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input SetBackgroundInput
+	}{
+		{
+			name: "base64 and drive file ID",
+			input: SetBackgroundInput{
+				ImageBase64:      "aGVsbG8=",
+				ImageDriveFileID: "file-1",
+			},
+		},
+		{
+			name: "drive file ID and URL",
+			input: SetBackgroundInput{
+				ImageDriveFileID: "file-1",
+				ImageURL:         "https://example.com/bg.png",
+			},
+		},
+		{
+			name: "non-http URL",
+			input: SetBackgroundInput{
+				ImageURL: "ftp://example.com/bg.png",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := NewTools(DefaultToolsConfig(), nil)
+
+			input := tt.input
+			input.PresentationID = "test-presentation"
+			input.Scope = "all"
+			input.BackgroundType = "image"
+
+			_, err := tools.SetBackground(context.Background(), &mockTokenSource{}, input)
+			if !errors.Is(err, ErrInvalidImageData) {
+				t.Errorf("expected ErrInvalidImageData, got %v", err)
+			}
+		})
+	}
+}
+
 func TestSetBackground_MissingGradientColors(t *testing.T) {
 	tools := NewTools(DefaultToolsConfig(), nil)
 	tokenSource := &mockTokenSource{}
@@ -956,6 +1192,64 @@ func TestSetBackground_BatchUpdateFailed(t *testing.T) {
 	}
 }
 
+func TestSetBackground_BatchUpdateFailed_DeletesUpload(t *testing.T) {
+	for _, bgType := range []string{"image", "gradient"} {
+		t.Run(bgType, func(t *testing.T) {
+			mockSlides := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return &slides.Presentation{
+						PresentationId: "test-presentation",
+						Slides:         []*slides.Page{{ObjectId: "slide-1"}},
+					}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					return nil, errors.New("batch update failed")
+				},
+			}
+
+			var deletedIDs []string
+			mockDrive := &mockDriveService{
+				UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+					return &drive.File{Id: "uploaded-bg"}, nil
+				},
+				MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+					return nil
+				},
+				DeleteFileFunc: func(ctx context.Context, fileID string) error {
+					deletedIDs = append(deletedIDs, fileID)
+					return nil
+				},
+			}
+
+			tools := NewToolsWithDrive(DefaultToolsConfig(),
+				func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) { return mockSlides, nil },
+				func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) { return mockDrive, nil },
+			)
+
+			input := SetBackgroundInput{
+				PresentationID: "test-presentation",
+				Scope:          "slide",
+				SlideIndex:     1,
+				BackgroundType: bgType,
+			}
+			if bgType == "image" {
+				input.ImageBase64 = base64.StdEncoding.EncodeToString(testPNGBytes)
+			} else {
+				input.StartColor = "#FF0000"
+				input.EndColor = "#0000FF"
+			}
+
+			_, err := tools.SetBackground(context.Background(), &mockTokenSource{}, input)
+			if !errors.Is(err, ErrSetBackgroundFailed) {
+				t.Fatalf("expected ErrSetBackgroundFailed, got %v", err)
+			}
+			if len(deletedIDs) != 1 || deletedIDs[0] != "uploaded-bg" {
+				t.Errorf("expected uploaded file to be deleted, got %v", deletedIDs)
+			}
+		})
+	}
+}
+
 func TestSetBackground_MakePublicFailed_StillSucceeds(t *testing.T) {
 	mockSlides := &mockSlidesService{
 		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
@@ -1109,7 +1403,7 @@ func TestGenerateGradientImage(t *testing.T) {
 	endRgb := &slides.RgbColor{Red: 0.0, Green: 0.0, Blue: 1.0}   // Blue
 
 	// Test horizontal gradient (0 degrees)
-	imageData, err := generateGradientImage(startRgb, endRgb, 0)
+	imageData, err := generateGradientImage(startRgb, endRgb, 0, 100, 100)
 	if err != nil {
 		t.Fatalf("unexpected error generating gradient: %v", err)
 	}
@@ -1134,7 +1428,7 @@ func TestGenerateGradientImage_DifferentAngles(t *testing.T) {
 	angles := []float64{0, 45, 90, 135, 180, 225, 270, 315, 360}
 
 	for _, angle := range angles {
-		imageData, err := generateGradientImage(startRgb, endRgb, angle)
+		imageData, err := generateGradientImage(startRgb, endRgb, angle, 100, 100)
 		if err != nil {
 			t.Errorf("unexpected error for angle %.0f: %v", angle, err)
 			continue
@@ -1146,6 +1440,93 @@ func TestGenerateGradientImage_DifferentAngles(t *testing.T) {
 	}
 }
 
+func TestGenerateGradientImage_IHDRDimensions(t *testing.T) {
+	startRgb := &slides.RgbColor{Red: 1.0}
+	endRgb := &slides.RgbColor{Blue: 1.0}
+
+	sizes := []struct{ width, height int }{
+		{256, 144},
+		{64, 64},
+		{2, 1024},
+	}
+
+	for _, size := range sizes {
+		imageData, err := generateGradientImage(startRgb, endRgb, 90, size.width, size.height)
+		if err != nil {
+			t.Fatalf("unexpected error for %dx%d: %v", size.width, size.height, err)
+		}
+
+		// IHDR data follows the 8-byte signature, 4-byte length and 4-byte chunk type
+		if string(imageData[12:16]) != "IHDR" {
+			t.Fatalf("expected IHDR chunk, got %q", imageData[12:16])
+		}
+		width := int(binary.BigEndian.Uint32(imageData[16:20]))
+		height := int(binary.BigEndian.Uint32(imageData[20:24]))
+		if width != size.width || height != size.height {
+			t.Errorf("expected IHDR %dx%d, got %dx%d", size.width, size.height, width, height)
+		}
+	}
+}
+
+func TestGenerateGradientImage_TooSmall(t *testing.T) {
+	_, err := generateGradientImage(&slides.RgbColor{}, &slides.RgbColor{}, 0, 1, 100)
+	if !errors.Is(err, ErrInvalidGradientResolution) {
+		t.Errorf("expected ErrInvalidGradientResolution, got %v", err)
+	}
+}
+
+func TestGradientDimensions(t *testing.T) {
+	widescreen := &slides.Size{
+		Width:  &slides.Dimension{Magnitude: 9144000, Unit: "EMU"},
+		Height: &slides.Dimension{Magnitude: 5143500, Unit: "EMU"},
+	}
+	portrait := &slides.Size{
+		Width:  &slides.Dimension{Magnitude: 5143500, Unit: "EMU"},
+		Height: &slides.Dimension{Magnitude: 9144000, Unit: "EMU"},
+	}
+
+	tests := []struct {
+		name           string
+		resolution     int
+		pageSize       *slides.Size
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{"default without page size", 0, nil, defaultGradientResolution, defaultGradientResolution},
+		{"widescreen default", 0, widescreen, 256, 144},
+		{"widescreen custom", 1024, widescreen, 1024, 576},
+		{"portrait", 512, portrait, 288, 512},
+		{"tiny keeps minimum", 2, widescreen, 2, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height := gradientDimensions(tt.resolution, tt.pageSize)
+			if width != tt.expectedWidth || height != tt.expectedHeight {
+				t.Errorf("expected %dx%d, got %dx%d", tt.expectedWidth, tt.expectedHeight, width, height)
+			}
+		})
+	}
+}
+
+func TestSetBackground_InvalidGradientResolution(t *testing.T) {
+	tools := NewTools(DefaultToolsConfig(), nil)
+
+	for _, resolution := range []int{-1, 1, maxGradientResolution + 1} {
+		_, err := tools.SetBackground(context.Background(), &mockTokenSource{}, SetBackgroundInput{
+			PresentationID:     "test-presentation",
+			Scope:              "all",
+			BackgroundType:     "gradient",
+			StartColor:         "#000000",
+			EndColor:           "#FFFFFF",
+			GradientResolution: resolution,
+		})
+		if !errors.Is(err, ErrInvalidGradientResolution) {
+			t.Errorf("resolution %d: expected ErrInvalidGradientResolution, got %v", resolution, err)
+		}
+	}
+}
+
 func TestEncodePNG(t *testing.T) {
 	// Create a simple 2x2 red image
 	pixels := []byte{
@@ -1173,6 +1554,18 @@ func TestEncodePNG(t *testing.T) {
 	}
 }
 
+func TestCRC32PNG(t *testing.T) {
+	// Every table entry must match the standard CRC-32 table, or some chunks fail to decode
+	for i := range crc32Table {
+		if got, want := crc32PNG([]byte{byte(i)}), crc32.ChecksumIEEE([]byte{byte(i)}); got != want {
+			t.Errorf("crc32PNG(%#x) = %#08x, want %#08x", i, got, want)
+		}
+	}
+	if got := crc32PNG([]byte("IEND")); got != 0xAE426082 {
+		t.Errorf("crc32PNG(IEND) = %#08x, want 0xae426082", got)
+	}
+}
+
 func TestAdler32(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1206,12 +1599,48 @@ func TestAdler32(t *testing.T) {
 	}
 }
 
-func TestGenerateBackgroundFileName(t *testing.T) {
-	originalTimeFunc := backgroundTimeNowFunc
-	backgroundTimeNowFunc = func() time.Time {
-		return time.Date(2024, 1, 15, 10, 30, 0, 123456789, time.UTC)
+func TestBuildBackgroundSummary(t *testing.T) {
+	tests := []struct {
+		name       string
+		bgType     string
+		input      SetBackgroundInput
+		slideCount int
+		expected   string
+	}{
+		{
+			name:       "solid single slide",
+			bgType:     "solid",
+			input:      SetBackgroundInput{Color: "#ff0000"},
+			slideCount: 1,
+			expected:   "Applied solid #FF0000 background to 1 slide",
+		},
+		{
+			name:       "image multiple slides",
+			bgType:     "image",
+			slideCount: 4,
+			expected:   "Applied image background to 4 slides",
+		},
+		{
+			name:       "gradient",
+			bgType:     "gradient",
+			input:      SetBackgroundInput{StartColor: "#000000", EndColor: "#FFFFFF"},
+			slideCount: 2,
+			expected:   "Applied gradient #000000 to #FFFFFF background to 2 slides",
+		},
 	}
-	defer func() { backgroundTimeNowFunc = originalTimeFunc }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildBackgroundSummary(tt.bgType, tt.input, tt.slideCount)
+			if got != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGenerateBackgroundFileName(t *testing.T) {
+	stubObjectIDSuffix(t, "1705314600123456789")
 
 	fileName := generateBackgroundFileName()
 	if fileName == "" {
@@ -1222,3 +1651,177 @@ func TestGenerateBackgroundFileName(t *testing.T) {
 		t.Errorf("expected file name to contain 'slides_background_', got: %s", fileName)
 	}
 }
+
+func TestSetBackground_StrictInputs(t *testing.T) {
+	angle := 45.0
+
+	tests := []struct {
+		name    string
+		strict  bool
+		input   SetBackgroundInput
+		wantErr error
+	}{
+		{
+			name:   "strict rejects angle on solid",
+			strict: true,
+			input: SetBackgroundInput{
+				BackgroundType: "solid",
+				Color:          "#FF0000",
+				Angle:          &angle,
+			},
+			wantErr: ErrIrrelevantBackgroundField,
+		},
+		{
+			name:   "strict rejects color on gradient",
+			strict: true,
+			input: SetBackgroundInput{
+				BackgroundType: "gradient",
+				Color:          "#FF0000",
+				StartColor:     "#000000",
+				EndColor:       "#FFFFFF",
+			},
+			wantErr: ErrIrrelevantBackgroundField,
+		},
+		{
+			name:   "strict rejects gradient colors on image",
+			strict: true,
+			input: SetBackgroundInput{
+				BackgroundType: "image",
+				ImageURL:       "https://example.com/bg.png",
+				StartColor:     "#000000",
+			},
+			wantErr: ErrIrrelevantBackgroundField,
+		},
+		{
+			name:   "strict accepts consistent gradient",
+			strict: true,
+			input: SetBackgroundInput{
+				BackgroundType: "gradient",
+				StartColor:     "#000000",
+				EndColor:       "#FFFFFF",
+				Angle:          &angle,
+			},
+		},
+		{
+			name:   "lenient ignores angle on solid",
+			strict: false,
+			input: SetBackgroundInput{
+				BackgroundType: "solid",
+				Color:          "#FF0000",
+				Angle:          &angle,
+			},
+		},
+		{
+			name:   "lenient ignores color on gradient",
+			strict: false,
+			input: SetBackgroundInput{
+				BackgroundType: "gradient",
+				Color:          "#FF0000",
+				StartColor:     "#000000",
+				EndColor:       "#FFFFFF",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSlides := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return &slides.Presentation{
+						PresentationId: "test-presentation",
+						Slides:         []*slides.Page{{ObjectId: "slide-1"}},
+					}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			mockDrive := &mockDriveService{
+				UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+					return &drive.File{Id: "uploaded-file"}, nil
+				},
+				MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+					return nil
+				},
+			}
+
+			config := DefaultToolsConfig()
+			config.StrictInputs = tt.strict
+			tools := NewToolsWithDrive(config, func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockSlides, nil
+			}, func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+				return mockDrive, nil
+			})
+
+			input := tt.input
+			input.PresentationID = "test-presentation"
+			input.Scope = "all"
+
+			_, err := tools.SetBackground(context.Background(), &mockTokenSource{}, input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestIrrelevantBackgroundFields(t *testing.T) {
+	angle := 90.0
+	input := SetBackgroundInput{
+		Color:              "#FF0000",
+		ImageURL:           "https://example.com/bg.png",
+		Angle:              &angle,
+		GradientResolution: 128,
+	}
+
+	got := irrelevantBackgroundFields("gradient", input)
+	want := []string{"color", "image_url"}
+	if len(got) != len(want) {
+		t.Fatalf("irrelevantBackgroundFields() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("field %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSetBackground_DisallowedImageFormat(t *testing.T) {
+	gifBytes := []byte{0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x01, 0x00, 0x01, 0x00}
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+		},
+	}
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			t.Error("disallowed image should not be uploaded")
+			return &drive.File{Id: "file-1"}, nil
+		},
+	}
+
+	config := DefaultToolsConfig()
+	config.AllowedImageFormats = []string{"png", "jpeg"}
+	tools := NewToolsWithDrive(config, func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	}, func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return mockDrive, nil
+	})
+
+	_, err := tools.SetBackground(context.Background(), &mockTokenSource{}, SetBackgroundInput{
+		PresentationID: "test-presentation",
+		Scope:          "all",
+		BackgroundType: "image",
+		ImageBase64:    base64.StdEncoding.EncodeToString(gifBytes),
+	})
+	if !errors.Is(err, ErrDisallowedImageFormat) {
+		t.Errorf("expected ErrDisallowedImageFormat, got %v", err)
+	}
+}
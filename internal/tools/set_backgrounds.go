@@ -0,0 +1,238 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for set_backgrounds tool.
+var (
+	ErrNoBackgrounds = errors.New("no backgrounds provided")
+)
+
+// BackgroundSpec describes one slide's background for the set_backgrounds tool.
+// Fields mirror SetBackgroundInput and follow the same rules.
+type BackgroundSpec struct {
+	BackgroundType string `json:"background_type"` // Required: "solid", "image", or "gradient"
+
+	// For solid background
+	Color string `json:"color,omitempty"`
+
+	// For image background (exactly one image source is required)
+	ImageBase64      string `json:"image_base64,omitempty"`
+	ImageDriveFileID string `json:"image_drive_file_id,omitempty"`
+	ImageURL         string `json:"image_url,omitempty"`
+	MakePublic       bool   `json:"make_public,omitempty"`
+
+	// For gradient background
+	StartColor         string   `json:"start_color,omitempty"`
+	EndColor           string   `json:"end_color,omitempty"`
+	Angle              *float64 `json:"angle,omitempty"`
+	GradientResolution int      `json:"gradient_resolution,omitempty"`
+}
+
+// SetBackgroundsInput represents the input for the set_backgrounds tool.
+type SetBackgroundsInput struct {
+	PresentationID string                    `json:"presentation_id"`
+	Backgrounds    map[string]BackgroundSpec `json:"backgrounds"` // Slide ID -> background
+}
+
+// SetBackgroundsResult describes the background applied to one slide.
+type SetBackgroundsResult struct {
+	SlideID        string `json:"slide_id"`
+	BackgroundType string `json:"background_type"`
+	Summary        string `json:"summary"`
+}
+
+// SetBackgroundsOutput represents the output of the set_backgrounds tool.
+type SetBackgroundsOutput struct {
+	Success bool                   `json:"success"`
+	Results []SetBackgroundsResult `json:"results"` // One entry per slide, ordered by slide ID
+	Uploads int                    `json:"uploads"` // Images uploaded to Drive for gradient/image backgrounds
+}
+
+// SetBackgrounds applies a different background to each listed slide in a single batch update.
+// Identical image and gradient specs share one Drive upload.
+func (t *Tools) SetBackgrounds(ctx context.Context, tokenSource oauth2.TokenSource, input SetBackgroundsInput) (*SetBackgroundsOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+
+	if len(input.Backgrounds) == 0 {
+		return nil, ErrNoBackgrounds
+	}
+
+	// Iterate slides in a stable order so requests and results are deterministic
+	slideIDs := make([]string, 0, len(input.Backgrounds))
+	for slideID := range input.Backgrounds {
+		slideIDs = append(slideIDs, slideID)
+	}
+	sort.Strings(slideIDs)
+
+	bgTypes := make(map[string]string, len(slideIDs))
+	for _, slideID := range slideIDs {
+		if strings.TrimSpace(slideID) == "" {
+			return nil, fmt.Errorf("%w: background map keys must be slide IDs", ErrInvalidSlideReference)
+		}
+
+		spec := input.Backgrounds[slideID]
+		bgType := strings.ToLower(strings.TrimSpace(spec.BackgroundType))
+		if bgType != "solid" && bgType != "image" && bgType != "gradient" {
+			return nil, fmt.Errorf("%w: slide '%s': background_type must be 'solid', 'image', or 'gradient', got '%s'", ErrInvalidBackgroundType, slideID, spec.BackgroundType)
+		}
+		if err := t.validateBackgroundSpec(bgType, spec.toSetBackgroundInput(input.PresentationID)); err != nil {
+			return nil, fmt.Errorf("slide '%s': %w", slideID, err)
+		}
+		bgTypes[slideID] = bgType
+	}
+
+	t.config.Logger.Info("setting backgrounds",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slides", len(slideIDs)),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// Check every slide exists before uploading anything
+	for _, slideID := range slideIDs {
+		if _, _, err := findSlide(presentation, 0, slideID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Resolve fills, reusing uploads for identical image/gradient specs: one step per slide plus
+	// the final update
+	progress := ProgressFromContext(ctx)
+	totalSteps := len(slideIDs) + 1
+	fills := make(map[string]*slides.PageBackgroundFill)
+	var uploadedFileIDs []string
+	var requests []*slides.Request
+	results := make([]SetBackgroundsResult, 0, len(slideIDs))
+	for i, slideID := range slideIDs {
+		bgType := bgTypes[slideID]
+		bgInput := input.Backgrounds[slideID].toSetBackgroundInput(input.PresentationID)
+
+		key := backgroundSpecKey(bgType, bgInput)
+		fill, ok := fills[key]
+		if !ok {
+			var uploadedFileID string
+			fill, uploadedFileID, err = t.buildPageBackgroundFill(ctx, tokenSource, bgType, bgInput, presentation.PageSize)
+			if err != nil {
+				t.cleanupBackgroundUploads(ctx, tokenSource, uploadedFileIDs)
+				return nil, err
+			}
+			fills[key] = fill
+			if uploadedFileID != "" {
+				uploadedFileIDs = append(uploadedFileIDs, uploadedFileID)
+			}
+		}
+
+		requests = append(requests, &slides.Request{
+			UpdatePageProperties: &slides.UpdatePagePropertiesRequest{
+				ObjectId: slideID,
+				PageProperties: &slides.PageProperties{
+					PageBackgroundFill: fill,
+				},
+				Fields: "pageBackgroundFill",
+			},
+		})
+		results = append(results, SetBackgroundsResult{
+			SlideID:        slideID,
+			BackgroundType: bgType,
+			Summary:        buildBackgroundSummary(bgType, bgInput, 1),
+		})
+
+		progress.Report(i+1, totalSteps, fmt.Sprintf("Prepared background %d of %d", i+1, len(slideIDs)))
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		t.cleanupBackgroundUploads(ctx, tokenSource, uploadedFileIDs)
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSetBackgroundFailed, err)
+	}
+	progress.Report(totalSteps, totalSteps, "Applied backgrounds")
+
+	output := &SetBackgroundsOutput{
+		Success: true,
+		Results: results,
+		Uploads: len(uploadedFileIDs),
+	}
+
+	t.config.Logger.Info("backgrounds set successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slides_affected", len(results)),
+		slog.Int("uploads", output.Uploads),
+	)
+
+	return output, nil
+}
+
+// toSetBackgroundInput converts a spec to the single-slide input so validation and
+// fill building are shared with set_background.
+func (s BackgroundSpec) toSetBackgroundInput(presentationID string) SetBackgroundInput {
+	return SetBackgroundInput{
+		PresentationID:     presentationID,
+		Scope:              "slide",
+		BackgroundType:     s.BackgroundType,
+		Color:              s.Color,
+		ImageBase64:        s.ImageBase64,
+		ImageDriveFileID:   s.ImageDriveFileID,
+		ImageURL:           s.ImageURL,
+		MakePublic:         s.MakePublic,
+		StartColor:         s.StartColor,
+		EndColor:           s.EndColor,
+		Angle:              s.Angle,
+		GradientResolution: s.GradientResolution,
+	}
+}
+
+// backgroundSpecKey identifies specs that resolve to the same fill.
+func backgroundSpecKey(bgType string, input SetBackgroundInput) string {
+	angle := 0.0
+	if input.Angle != nil {
+		angle = *input.Angle
+	}
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%t|%s|%s|%g|%d",
+		bgType,
+		strings.ToUpper(input.Color),
+		input.ImageBase64,
+		input.ImageDriveFileID,
+		input.ImageURL,
+		input.MakePublic,
+		strings.ToUpper(input.StartColor),
+		strings.ToUpper(input.EndColor),
+		angle,
+		input.GradientResolution,
+	)
+}
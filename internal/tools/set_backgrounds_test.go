@@ -0,0 +1,260 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+func TestSetBackgrounds_TwoSlidesOneBatch(t *testing.T) {
+	var batchCalls int
+	var capturedRequests []*slides.Request
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				Slides: []*slides.Page{
+					{ObjectId: "slide-1"},
+					{ObjectId: "slide-2"},
+					{ObjectId: "slide-3"},
+				},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			batchCalls++
+			capturedRequests = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	var uploadedMimeTypes []string
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			uploadedMimeTypes = append(uploadedMimeTypes, mimeType)
+			return &drive.File{Id: "gradient-file"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+	}
+
+	slidesFactory := func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	}
+	driveFactory := func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) {
+		return mockDrive, nil
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(), slidesFactory, driveFactory)
+
+	output, err := tools.SetBackgrounds(context.Background(), &mockTokenSource{}, SetBackgroundsInput{
+		PresentationID: "test-presentation",
+		Backgrounds: map[string]BackgroundSpec{
+			"slide-3": {BackgroundType: "gradient", StartColor: "#000000", EndColor: "#FFFFFF"},
+			"slide-1": {BackgroundType: "solid", Color: "#ff0000"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 {
+		t.Errorf("expected 1 batch update, got %d", batchCalls)
+	}
+	if len(uploadedMimeTypes) != 1 || uploadedMimeTypes[0] != "image/png" {
+		t.Errorf("expected one PNG upload, got %v", uploadedMimeTypes)
+	}
+	if output.Uploads != 1 {
+		t.Errorf("expected uploads 1, got %d", output.Uploads)
+	}
+
+	if len(capturedRequests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(capturedRequests))
+	}
+
+	solid := capturedRequests[0].UpdatePageProperties
+	if solid == nil || solid.ObjectId != "slide-1" {
+		t.Fatalf("expected first request to update slide-1, got %+v", solid)
+	}
+	rgb := solid.PageProperties.PageBackgroundFill.SolidFill.Color.RgbColor
+	if !rgbEqual(rgb, &slides.RgbColor{Red: 1}) {
+		t.Errorf("expected red solid fill, got %+v", rgb)
+	}
+
+	gradient := capturedRequests[1].UpdatePageProperties
+	if gradient == nil || gradient.ObjectId != "slide-3" {
+		t.Fatalf("expected second request to update slide-3, got %+v", gradient)
+	}
+	picture := gradient.PageProperties.PageBackgroundFill.StretchedPictureFill
+	if picture == nil || !strings.Contains(picture.ContentUrl, "gradient-file") {
+		t.Errorf("expected stretched picture fill from uploaded gradient, got %+v", picture)
+	}
+
+	if len(output.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(output.Results))
+	}
+	if output.Results[0].SlideID != "slide-1" || output.Results[0].BackgroundType != "solid" {
+		t.Errorf("unexpected first result: %+v", output.Results[0])
+	}
+	if output.Results[0].Summary != "Applied solid #FF0000 background to 1 slide" {
+		t.Errorf("unexpected summary: %q", output.Results[0].Summary)
+	}
+	if output.Results[1].SlideID != "slide-3" || output.Results[1].BackgroundType != "gradient" {
+		t.Errorf("unexpected second result: %+v", output.Results[1])
+	}
+}
+
+func TestSetBackgrounds_ReportsProgress(t *testing.T) {
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				PresentationId: presentationID,
+				Slides:         []*slides.Page{{ObjectId: "slide-1"}, {ObjectId: "slide-2"}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockSlides, nil
+	})
+
+	recorder := &progressRecorder{}
+	ctx := WithProgress(context.Background(), recorder)
+	_, err := tools.SetBackgrounds(ctx, &mockTokenSource{}, SetBackgroundsInput{
+		PresentationID: "test-presentation",
+		Backgrounds: map[string]BackgroundSpec{
+			"slide-1": {BackgroundType: "solid", Color: "#112233"},
+			"slide-2": {BackgroundType: "solid", Color: "#445566"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// One step per slide plus the final update
+	wantCompleted := []int{1, 2, 3}
+	if len(recorder.completed) != len(wantCompleted) {
+		t.Fatalf("expected %d progress reports, got %d", len(wantCompleted), len(recorder.completed))
+	}
+	for i, want := range wantCompleted {
+		if recorder.completed[i] != want || recorder.totals[i] != 3 {
+			t.Errorf("report %d = %d/%d, want %d/3", i, recorder.completed[i], recorder.totals[i], want)
+		}
+	}
+}
+
+func TestSetBackgrounds_SharesIdenticalGradientUpload(t *testing.T) {
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return &slides.Presentation{
+				Slides: []*slides.Page{{ObjectId: "slide-1"}, {ObjectId: "slide-2"}},
+			}, nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	uploads := 0
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			uploads++
+			return &drive.File{Id: "gradient-file"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+	}
+
+	tools := NewToolsWithDrive(DefaultToolsConfig(),
+		func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) { return mockSlides, nil },
+		func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) { return mockDrive, nil },
+	)
+
+	spec := BackgroundSpec{BackgroundType: "gradient", StartColor: "#112233", EndColor: "#445566"}
+	output, err := tools.SetBackgrounds(context.Background(), &mockTokenSource{}, SetBackgroundsInput{
+		PresentationID: "test-presentation",
+		Backgrounds:    map[string]BackgroundSpec{"slide-1": spec, "slide-2": spec},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if uploads != 1 || output.Uploads != 1 {
+		t.Errorf("expected a single shared upload, got %d calls and output %d", uploads, output.Uploads)
+	}
+}
+
+func TestSetBackgrounds_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       SetBackgroundsInput
+		expectedErr error
+	}{
+		{
+			name:        "missing presentation ID",
+			input:       SetBackgroundsInput{Backgrounds: map[string]BackgroundSpec{"slide-1": {BackgroundType: "solid", Color: "#FF0000"}}},
+			expectedErr: ErrInvalidPresentationID,
+		},
+		{
+			name:        "empty map",
+			input:       SetBackgroundsInput{PresentationID: "p"},
+			expectedErr: ErrNoBackgrounds,
+		},
+		{
+			name:        "invalid type",
+			input:       SetBackgroundsInput{PresentationID: "p", Backgrounds: map[string]BackgroundSpec{"slide-1": {BackgroundType: "pattern"}}},
+			expectedErr: ErrInvalidBackgroundType,
+		},
+		{
+			name:        "missing solid color",
+			input:       SetBackgroundsInput{PresentationID: "p", Backgrounds: map[string]BackgroundSpec{"slide-1": {BackgroundType: "solid"}}},
+			expectedErr: ErrMissingBackgroundColor,
+		},
+		{
+			name:        "missing gradient colors",
+			input:       SetBackgroundsInput{PresentationID: "p", Backgrounds: map[string]BackgroundSpec{"slide-1": {BackgroundType: "gradient", StartColor: "#000000"}}},
+			expectedErr: ErrMissingGradientColors,
+		},
+		{
+			name:        "unknown slide",
+			input:       SetBackgroundsInput{PresentationID: "p", Backgrounds: map[string]BackgroundSpec{"missing": {BackgroundType: "solid", Color: "#FF0000"}}},
+			expectedErr: ErrSlideNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batchCalled := false
+			mockSlides := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					return &slides.Presentation{Slides: []*slides.Page{{ObjectId: "slide-1"}}}, nil
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					batchCalled = true
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockSlides, nil
+			})
+
+			_, err := tools.SetBackgrounds(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+			if batchCalled {
+				t.Error("expected no batch update on error")
+			}
+		})
+	}
+}
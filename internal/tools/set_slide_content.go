@@ -0,0 +1,258 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for set_slide_content tool.
+var (
+	ErrSetSlideContentFailed = errors.New("failed to set slide content")
+	ErrNoSlideContent        = errors.New("no slide content provided")
+	ErrReplaceNotConfirmed   = errors.New("replacement not confirmed: set confirm to true")
+)
+
+// setSlideContentBulletPreset is the bullet preset applied to the bullets.
+const setSlideContentBulletPreset = "BULLET_DISC_CIRCLE_SQUARE"
+
+// Geometry in points of the text boxes created when the slide has no title or body placeholder.
+const (
+	slideContentMargin      = 36.0
+	slideContentTitleHeight = 60.0
+	slideContentBodyTop     = slideContentMargin + slideContentTitleHeight + 12
+)
+
+// SlideContentImage is one image to place on the slide.
+type SlideContentImage struct {
+	ImageBase64 string          `json:"image_base64"`       // Base64 encoded image data
+	Position    *PositionInput  `json:"position,omitempty"` // Position in points (default: 0, 0)
+	Size        *ImageSizeInput `json:"size,omitempty"`     // Size in points (optional)
+}
+
+// SetSlideContentInput represents the input for the set_slide_content tool.
+type SetSlideContentInput struct {
+	PresentationID string              `json:"presentation_id"`
+	SlideIndex     int                 `json:"slide_index,omitempty"` // 1-based index
+	SlideID        string              `json:"slide_id,omitempty"`    // Alternative to slide_index
+	Title          string              `json:"title,omitempty"`
+	Bullets        []string            `json:"bullets,omitempty"` // One bulleted paragraph each
+	Images         []SlideContentImage `json:"images,omitempty"`
+	Confirm        bool                `json:"confirm"` // Must be true to proceed
+}
+
+// SetSlideContentOutput represents the output of the set_slide_content tool.
+type SetSlideContentOutput struct {
+	SlideID          string   `json:"slide_id"`
+	SlideIndex       int      `json:"slide_index"` // 1-based
+	DeletedObjectIDs []string `json:"deleted_object_ids"`
+	TitleObjectID    string   `json:"title_object_id,omitempty"` // Placeholder or created text box holding the title
+	BodyObjectID     string   `json:"body_object_id,omitempty"`  // Placeholder or created text box holding the bullets
+	ImageObjectIDs   []string `json:"image_object_ids,omitempty"`
+}
+
+// SetSlideContent regenerates a slide in place: every top-level element that is not a placeholder
+// is deleted, the title and body placeholders are rewritten with the title and bullets, and the
+// images are added, all in a single batch update. Placeholders are kept so the slide still follows
+// its layout; when the layout has no title or body placeholder a text box is created instead.
+func (t *Tools) SetSlideContent(ctx context.Context, tokenSource oauth2.TokenSource, input SetSlideContentInput) (*SetSlideContentOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.SlideIndex == 0 && input.SlideID == "" {
+		return nil, ErrInvalidSlideReference
+	}
+	if input.Title == "" && len(input.Bullets) == 0 && len(input.Images) == 0 {
+		return nil, fmt.Errorf("%w: provide a title, bullets or images", ErrNoSlideContent)
+	}
+	for i, bullet := range input.Bullets {
+		if strings.TrimSpace(bullet) == "" {
+			return nil, fmt.Errorf("%w: bullet %d is empty", ErrInvalidText, i+1)
+		}
+	}
+
+	// Decode every image before touching the presentation
+	imageData := make([][]byte, len(input.Images))
+	mimeTypes := make([]string, len(input.Images))
+	for i, image := range input.Images {
+		if image.Size != nil && ((image.Size.Width != nil && *image.Size.Width <= 0) ||
+			(image.Size.Height != nil && *image.Size.Height <= 0)) {
+			return nil, fmt.Errorf("%w: image %d", ErrInvalidImageSize, i+1)
+		}
+		if image.Position != nil && (image.Position.X < 0 || image.Position.Y < 0) {
+			return nil, fmt.Errorf("%w: image %d", ErrInvalidImagePosition, i+1)
+		}
+		data, err := base64.StdEncoding.DecodeString(image.ImageBase64)
+		if err != nil || len(data) == 0 {
+			return nil, fmt.Errorf("%w: image %d", ErrInvalidImageData, i+1)
+		}
+		mimeType := detectImageMimeType(data)
+		if mimeType == "" {
+			return nil, fmt.Errorf("%w: unable to detect the format of image %d", ErrInvalidImageData, i+1)
+		}
+		if err := t.checkImageFormatAllowed(mimeType); err != nil {
+			return nil, err
+		}
+		imageData[i] = data
+		mimeTypes[i] = mimeType
+	}
+
+	if !input.Confirm {
+		return nil, ErrReplaceNotConfirmed
+	}
+
+	t.config.Logger.Info("setting slide content",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+		slog.Bool("has_title", input.Title != ""),
+		slog.Int("bullets", len(input.Bullets)),
+		slog.Int("images", len(input.Images)),
+	)
+
+	// Create services; Drive is only needed to upload images
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	var driveService DriveService
+	if len(input.Images) > 0 {
+		driveService, err = t.driveServiceFactory(ctx, tokenSource)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to create drive service: %v", ErrDriveAPIError, err)
+		}
+	}
+
+	// Get the presentation to find the slide and its current elements
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	slideID, slideIndex, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+	if err != nil {
+		return nil, err
+	}
+	slide := presentation.Slides[slideIndex-1]
+	pageWidth, pageHeight := pageSizeInPoints(presentation.PageSize)
+
+	output := &SetSlideContentOutput{
+		SlideID:          slideID,
+		SlideIndex:       slideIndex,
+		DeletedObjectIDs: []string{},
+	}
+
+	// Deletions go first so later requests never touch removed elements
+	var requests []*slides.Request
+	for _, element := range slide.PageElements {
+		if element == nil || (element.Shape != nil && element.Shape.Placeholder != nil) {
+			continue
+		}
+		requests = append(requests, &slides.Request{
+			DeleteObject: &slides.DeleteObjectRequest{ObjectId: element.ObjectId},
+		})
+		output.DeletedObjectIDs = append(output.DeletedObjectIDs, element.ObjectId)
+	}
+
+	// Title: rewrite the title placeholder, clearing it when no title is given
+	title := normalizeLineBreaks(input.Title, false)
+	if placeholder := findPlaceholderByType(slide.PageElements, "TITLE"); placeholder != nil {
+		requests = append(requests, buildReplaceShapeTextRequests(placeholder, title)...)
+		if title != "" {
+			output.TitleObjectID = placeholder.ObjectId
+		}
+	} else if title != "" {
+		output.TitleObjectID = newObjectID("slide_title")
+		requests = append(requests, buildTextBoxRequests(output.TitleObjectID, slideID, AddTextBoxInput{
+			Text:     title,
+			Position: &PositionInput{X: slideContentMargin, Y: slideContentMargin},
+			Size:     &SizeInput{Width: pageWidth - 2*slideContentMargin, Height: slideContentTitleHeight},
+		})...)
+	}
+
+	// Bullets: one paragraph per bullet in the body placeholder, clearing it when there are none
+	paragraphs := make([]string, len(input.Bullets))
+	for i, bullet := range input.Bullets {
+		paragraphs[i] = normalizeLineBreaks(bullet, true)
+	}
+	body := strings.Join(paragraphs, "\n")
+	if placeholder := findPlaceholderByType(slide.PageElements, "BODY"); placeholder != nil {
+		requests = append(requests, buildReplaceShapeTextRequests(placeholder, body)...)
+		if body != "" {
+			output.BodyObjectID = placeholder.ObjectId
+		}
+	} else if body != "" {
+		output.BodyObjectID = newObjectID("slide_body")
+		requests = append(requests, buildTextBoxRequests(output.BodyObjectID, slideID, AddTextBoxInput{
+			Text:     body,
+			Position: &PositionInput{X: slideContentMargin, Y: slideContentBodyTop},
+			Size:     &SizeInput{Width: pageWidth - 2*slideContentMargin, Height: pageHeight - slideContentBodyTop - slideContentMargin},
+		})...)
+	}
+	if output.BodyObjectID != "" {
+		requests = append(requests, &slides.Request{
+			CreateParagraphBullets: &slides.CreateParagraphBulletsRequest{
+				ObjectId:     output.BodyObjectID,
+				TextRange:    &slides.Range{Type: "ALL"},
+				BulletPreset: setSlideContentBulletPreset,
+			},
+		})
+	}
+
+	// Images: upload each one, then place it
+	baseObjectID := generateImageObjectID()
+	baseFileName := generateImageFileName()
+	uploadedFileIDs := make([]string, 0, len(input.Images))
+	for i, image := range input.Images {
+		driveFileID, err := t.uploadSlideImage(ctx, driveService, fmt.Sprintf("%s_%d", baseFileName, i), mimeTypes[i], imageData[i])
+		if err != nil {
+			t.deleteOrphanedUploads(ctx, driveService, uploadedFileIDs)
+			return nil, err
+		}
+		uploadedFileIDs = append(uploadedFileIDs, driveFileID)
+
+		objectID := fmt.Sprintf("%s_%d", baseObjectID, i)
+		requests = append(requests, buildImageRequests(objectID, slideID, driveFileID, AddImageInput{
+			Position: image.Position,
+			Size:     image.Size,
+		})...)
+		output.ImageObjectIDs = append(output.ImageObjectIDs, objectID)
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		// None of the images made it onto the slide, so don't leave the uploads behind
+		t.deleteOrphanedUploads(ctx, driveService, uploadedFileIDs)
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSetSlideContentFailed, err)
+	}
+
+	t.config.Logger.Info("slide content set successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("slide_id", slideID),
+		slog.Int("deleted", len(output.DeletedObjectIDs)),
+		slog.Int("images", len(output.ImageObjectIDs)),
+	)
+
+	return output, nil
+}
@@ -0,0 +1,229 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+type slideContentTestCapture struct {
+	requests   []*slides.Request
+	batchCalls int
+	uploads    int
+	deleted    []string
+}
+
+func newSetSlideContentTestTools(getErr, batchErr error, capture *slideContentTestCapture) *Tools {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{ObjectId: "slide-1", PageElements: []*slides.PageElement{
+				{ObjectId: "title-1", Shape: &slides.Shape{
+					Placeholder: &slides.Placeholder{Type: "TITLE"},
+					Text:        newParagraphStyleTestText(nil, "Old title"),
+				}},
+				{ObjectId: "body-1", Shape: &slides.Shape{Placeholder: &slides.Placeholder{Type: "BODY"}}},
+				{ObjectId: "old-box", Shape: &slides.Shape{ShapeType: "TEXT_BOX"}},
+				{ObjectId: "old-image", Image: &slides.Image{}},
+				{ObjectId: "old-group", ElementGroup: &slides.Group{Children: []*slides.PageElement{
+					{ObjectId: "old-child", Shape: &slides.Shape{ShapeType: "RECTANGLE"}},
+				}}},
+			}},
+			{ObjectId: "slide-2", PageElements: []*slides.PageElement{
+				{ObjectId: "blank-box", Shape: &slides.Shape{ShapeType: "TEXT_BOX"}},
+			}},
+		},
+	}
+
+	mockSlides := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return presentation, getErr
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			capture.batchCalls++
+			capture.requests = requests
+			return &slides.BatchUpdatePresentationResponse{}, batchErr
+		},
+	}
+	mockDrive := &mockDriveService{
+		UploadFileFunc: func(ctx context.Context, name, mimeType string, content io.Reader) (*drive.File, error) {
+			capture.uploads++
+			return &drive.File{Id: "image-file"}, nil
+		},
+		MakeFilePublicFunc: func(ctx context.Context, fileID string) error {
+			return nil
+		},
+		DeleteFileFunc: func(ctx context.Context, fileID string) error {
+			capture.deleted = append(capture.deleted, fileID)
+			return nil
+		},
+	}
+
+	return NewToolsWithDrive(DefaultToolsConfig(),
+		func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) { return mockSlides, nil },
+		func(ctx context.Context, ts oauth2.TokenSource) (DriveService, error) { return mockDrive, nil },
+	)
+}
+
+func TestSetSlideContent(t *testing.T) {
+	stubObjectIDSuffix(t, "1705312800000000000")
+	capture := &slideContentTestCapture{}
+	tools := newSetSlideContentTestTools(nil, nil, capture)
+
+	output, err := tools.SetSlideContent(context.Background(), &mockTokenSource{}, SetSlideContentInput{
+		PresentationID: "pres-1",
+		SlideIndex:     1,
+		Title:          "New title",
+		Bullets:        []string{"First", "Second"},
+		Images:         []SlideContentImage{{ImageBase64: base64.StdEncoding.EncodeToString(testPNGBytes), Position: &PositionInput{X: 400, Y: 200}}},
+		Confirm:        true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if capture.batchCalls != 1 || capture.uploads != 1 {
+		t.Fatalf("expected one upload and one batch update, got %d and %d", capture.uploads, capture.batchCalls)
+	}
+
+	var deleted []string
+	var deletedTitle bool
+	inserted := map[string]string{}
+	var bulleted, image string
+	for _, request := range capture.requests {
+		switch {
+		case request.DeleteObject != nil:
+			if len(inserted) > 0 || image != "" {
+				t.Errorf("expected deletions before new content, got delete of %s later", request.DeleteObject.ObjectId)
+			}
+			deleted = append(deleted, request.DeleteObject.ObjectId)
+		case request.DeleteText != nil:
+			deletedTitle = request.DeleteText.ObjectId == "title-1"
+		case request.InsertText != nil:
+			inserted[request.InsertText.ObjectId] = request.InsertText.Text
+		case request.CreateParagraphBullets != nil:
+			bulleted = request.CreateParagraphBullets.ObjectId
+		case request.CreateImage != nil:
+			image = request.CreateImage.ObjectId
+		}
+	}
+
+	if len(deleted) != 3 || deleted[0] != "old-box" || deleted[1] != "old-image" || deleted[2] != "old-group" {
+		t.Errorf("expected the three non-placeholder elements deleted, got %v", deleted)
+	}
+	if !deletedTitle || inserted["title-1"] != "New title" {
+		t.Errorf("expected the old title replaced, got delete=%v insert=%q", deletedTitle, inserted["title-1"])
+	}
+	if inserted["body-1"] != "First\nSecond" || bulleted != "body-1" {
+		t.Errorf("expected bulleted paragraphs in the body placeholder, got %q bulleted on %q", inserted["body-1"], bulleted)
+	}
+	if image == "" || image != output.ImageObjectIDs[0] {
+		t.Errorf("expected the image to be created, got %q and %v", image, output.ImageObjectIDs)
+	}
+
+	if output.SlideID != "slide-1" || output.TitleObjectID != "title-1" || output.BodyObjectID != "body-1" || len(output.DeletedObjectIDs) != 3 {
+		t.Errorf("unexpected output: %+v", output)
+	}
+}
+
+func TestSetSlideContent_WithoutPlaceholders(t *testing.T) {
+	stubObjectIDSuffix(t, "1705312800000000000")
+	capture := &slideContentTestCapture{}
+	tools := newSetSlideContentTestTools(nil, nil, capture)
+
+	output, err := tools.SetSlideContent(context.Background(), &mockTokenSource{}, SetSlideContentInput{
+		PresentationID: "pres-1",
+		SlideID:        "slide-2",
+		Title:          "Title",
+		Bullets:        []string{"Only point"},
+		Confirm:        true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if capture.uploads != 0 {
+		t.Errorf("expected no uploads, got %d", capture.uploads)
+	}
+	if capture.requests[0].DeleteObject == nil || capture.requests[0].DeleteObject.ObjectId != "blank-box" {
+		t.Errorf("expected the old text box deleted first, got %+v", capture.requests[0])
+	}
+
+	created := map[string]bool{}
+	for _, request := range capture.requests {
+		if request.CreateShape != nil {
+			created[request.CreateShape.ObjectId] = true
+		}
+	}
+	if output.TitleObjectID == "" || output.BodyObjectID == "" || !created[output.TitleObjectID] || !created[output.BodyObjectID] {
+		t.Errorf("expected title and body text boxes to be created, got %+v and %v", output, created)
+	}
+	last := capture.requests[len(capture.requests)-1].CreateParagraphBullets
+	if last == nil || last.ObjectId != output.BodyObjectID {
+		t.Errorf("expected the body text box to be bulleted, got %+v", capture.requests[len(capture.requests)-1])
+	}
+}
+
+func TestSetSlideContent_Errors(t *testing.T) {
+	valid := SetSlideContentInput{
+		PresentationID: "pres-1",
+		SlideIndex:     1,
+		Title:          "Title",
+		Images:         []SlideContentImage{{ImageBase64: base64.StdEncoding.EncodeToString(testPNGBytes)}},
+		Confirm:        true,
+	}
+	with := func(modify func(*SetSlideContentInput)) SetSlideContentInput {
+		input := valid
+		modify(&input)
+		return input
+	}
+	negative := -1.0
+
+	tests := []struct {
+		name        string
+		input       SetSlideContentInput
+		getErr      error
+		batchErr    error
+		wantErr     error
+		wantDeleted bool
+	}{
+		{"missing presentation", with(func(i *SetSlideContentInput) { i.PresentationID = "" }), nil, nil, ErrInvalidPresentationID, false},
+		{"missing slide", with(func(i *SetSlideContentInput) { i.SlideIndex = 0 }), nil, nil, ErrInvalidSlideReference, false},
+		{"no content", with(func(i *SetSlideContentInput) { i.Title = ""; i.Images = nil }), nil, nil, ErrNoSlideContent, false},
+		{"empty bullet", with(func(i *SetSlideContentInput) { i.Bullets = []string{"ok", " "} }), nil, nil, ErrInvalidText, false},
+		{"bad image data", with(func(i *SetSlideContentInput) { i.Images = []SlideContentImage{{ImageBase64: "!!"}} }), nil, nil, ErrInvalidImageData, false},
+		{"bad image size", with(func(i *SetSlideContentInput) {
+			i.Images = []SlideContentImage{{ImageBase64: valid.Images[0].ImageBase64, Size: &ImageSizeInput{Width: &negative}}}
+		}), nil, nil, ErrInvalidImageSize, false},
+		{"not confirmed", with(func(i *SetSlideContentInput) { i.Confirm = false }), nil, nil, ErrReplaceNotConfirmed, false},
+		{"slide not found", with(func(i *SetSlideContentInput) { i.SlideIndex = 5 }), nil, nil, ErrSlideNotFound, false},
+		{"presentation not found", valid, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound, false},
+		{"access denied", valid, &googleapi.Error{Code: 403}, nil, ErrAccessDenied, false},
+		{"batch update failure", valid, nil, errors.New("boom"), ErrSetSlideContentFailed, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			capture := &slideContentTestCapture{}
+			tools := newSetSlideContentTestTools(tt.getErr, tt.batchErr, capture)
+
+			_, err := tools.SetSlideContent(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.batchErr == nil && capture.batchCalls != 0 {
+				t.Errorf("expected no batch update, got %d", capture.batchCalls)
+			}
+			if tt.wantDeleted != (len(capture.deleted) == 1) {
+				t.Errorf("expected orphan cleanup %v, got %v", tt.wantDeleted, capture.deleted)
+			}
+		})
+	}
+}
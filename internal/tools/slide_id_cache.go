@@ -0,0 +1,209 @@
+package tools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// SlideIDCache caches the slide index -> object ID map of presentations for a session.
+// Entries are scoped to the caller's access token, so one user's cached slides are never
+// served to another, and keyed by presentation ID and revision within that scope. They are
+// replaced when a newer revision is fetched and dropped whenever a batch update is sent for
+// the presentation.
+type SlideIDCache struct {
+	mu      sync.RWMutex
+	entries map[slideIDCacheKey]slideIDCacheEntry
+}
+
+// slideIDCacheKey identifies the cached slide IDs of one presentation for one caller.
+type slideIDCacheKey struct {
+	scope          string
+	presentationID string
+}
+
+// slideIDCacheEntry holds the slide IDs of one presentation revision.
+type slideIDCacheEntry struct {
+	revisionID string
+	slideIDs   []string
+}
+
+// NewSlideIDCache creates an empty slide ID cache.
+func NewSlideIDCache() *SlideIDCache {
+	return &SlideIDCache{
+		entries: make(map[slideIDCacheKey]slideIDCacheEntry),
+	}
+}
+
+// Get returns the cached revision and slide IDs (in slide order) for a presentation in scope.
+func (c *SlideIDCache) Get(scope, presentationID string) (string, []string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[slideIDCacheKey{scope: scope, presentationID: presentationID}]
+	if !ok {
+		return "", nil, false
+	}
+	return entry.revisionID, entry.slideIDs, true
+}
+
+// Store records the slide IDs for a presentation revision in scope, replacing any other revision.
+func (c *SlideIDCache) Store(scope, presentationID, revisionID string, slideIDs []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := make([]string, len(slideIDs))
+	copy(ids, slideIDs)
+	c.entries[slideIDCacheKey{scope: scope, presentationID: presentationID}] = slideIDCacheEntry{
+		revisionID: revisionID,
+		slideIDs:   ids,
+	}
+}
+
+// Invalidate removes the cached slide IDs for a presentation in every scope.
+func (c *SlideIDCache) Invalidate(presentationID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.presentationID == presentationID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Len returns the number of cached entries across all scopes.
+func (c *SlideIDCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.entries)
+}
+
+// observe stores the slide IDs of a presentation fetched by the caller in scope.
+func (c *SlideIDCache) observe(scope string, presentation *slides.Presentation) {
+	if presentation == nil || presentation.PresentationId == "" {
+		return
+	}
+
+	// Keep the existing entry when the revision has not changed
+	if revisionID, _, ok := c.Get(scope, presentation.PresentationId); ok && revisionID == presentation.RevisionId {
+		return
+	}
+
+	slideIDs := make([]string, 0, len(presentation.Slides))
+	for _, slide := range presentation.Slides {
+		if slide == nil {
+			continue
+		}
+		slideIDs = append(slideIDs, slide.ObjectId)
+	}
+	c.Store(scope, presentation.PresentationId, presentation.RevisionId, slideIDs)
+}
+
+// slideIDCacheScope identifies the caller behind a token source by a hash of its access token.
+// It reports false when the caller cannot be identified, in which case nothing is cached.
+func slideIDCacheScope(tokenSource oauth2.TokenSource) (string, bool) {
+	if tokenSource == nil {
+		return "", false
+	}
+	token, err := tokenSource.Token()
+	if err != nil || token == nil || token.AccessToken == "" {
+		return "", false
+	}
+	sum := sha256.Sum256([]byte(token.AccessToken))
+	return hex.EncodeToString(sum[:]), true
+}
+
+// slideIDCachingService keeps a SlideIDCache in sync with the calls made through it.
+type slideIDCachingService struct {
+	SlidesService
+	cache  *SlideIDCache
+	scope  string
+	scoped bool // False when the caller is unknown: the cache is only invalidated, never read or filled
+}
+
+// cachedSlideIDs returns the caller's cached slide IDs for a presentation.
+func (s *slideIDCachingService) cachedSlideIDs(presentationID string) ([]string, bool) {
+	if !s.scoped {
+		return nil, false
+	}
+	_, slideIDs, ok := s.cache.Get(s.scope, presentationID)
+	return slideIDs, ok
+}
+
+// GetPresentation fetches a presentation and records its slide IDs.
+func (s *slideIDCachingService) GetPresentation(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+	presentation, err := s.SlidesService.GetPresentation(ctx, presentationID)
+	if err != nil {
+		return nil, err
+	}
+	if s.scoped {
+		s.cache.observe(s.scope, presentation)
+	}
+	return presentation, nil
+}
+
+// BatchUpdate invalidates the cached slide IDs before applying a mutation.
+func (s *slideIDCachingService) BatchUpdate(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+	s.cache.Invalidate(presentationID)
+	return s.SlidesService.BatchUpdate(ctx, presentationID, requests)
+}
+
+// newSlideIDCachingFactory wraps a factory so every service it creates uses the cache,
+// scoped to the caller of the token source.
+func newSlideIDCachingFactory(factory SlidesServiceFactory, cache *SlideIDCache) SlidesServiceFactory {
+	return func(ctx context.Context, tokenSource oauth2.TokenSource) (SlidesService, error) {
+		service, err := factory(ctx, tokenSource)
+		if err != nil {
+			return nil, err
+		}
+		scope, scoped := slideIDCacheScope(tokenSource)
+		return &slideIDCachingService{SlidesService: service, cache: cache, scope: scope, scoped: scoped}, nil
+	}
+}
+
+// resolveSlideID returns the object ID and 1-based index of a slide, using the
+// caller's session cache when it holds the presentation and fetching it otherwise.
+func (t *Tools) resolveSlideID(ctx context.Context, slidesService SlidesService, presentationID string, slideIndex int, slideID string) (string, int, error) {
+	if cached, ok := slidesService.(*slideIDCachingService); ok {
+		if slideIDs, ok := cached.cachedSlideIDs(presentationID); ok {
+			return findSlideInIDs(slideIDs, slideIndex, slideID)
+		}
+	}
+
+	presentation, err := slidesService.GetPresentation(ctx, presentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return "", 0, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return "", 0, ErrAccessDenied
+		}
+		return "", 0, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	return findSlide(presentation, slideIndex, slideID)
+}
+
+// findSlideInIDs mirrors findSlide against an ordered list of slide IDs.
+func findSlideInIDs(slideIDs []string, slideIndex int, slideID string) (string, int, error) {
+	if slideID != "" {
+		for i, id := range slideIDs {
+			if id == slideID {
+				return slideID, i + 1, nil
+			}
+		}
+		return "", 0, fmt.Errorf("%w: slide_id '%s' not found", ErrSlideNotFound, slideID)
+	}
+
+	if slideIndex < 1 || slideIndex > len(slideIDs) {
+		return "", 0, fmt.Errorf("%w: slide index %d out of range (1-%d)", ErrSlideNotFound, slideIndex, len(slideIDs))
+	}
+	return slideIDs[slideIndex-1], slideIndex, nil
+}
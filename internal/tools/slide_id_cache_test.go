@@ -0,0 +1,297 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func newSlideIDCacheTestPresentation(revisionID string, slideIDs ...string) *slides.Presentation {
+	presentation := &slides.Presentation{
+		PresentationId: "test-presentation",
+		RevisionId:     revisionID,
+	}
+	for _, id := range slideIDs {
+		presentation.Slides = append(presentation.Slides, &slides.Page{ObjectId: id})
+	}
+	return presentation
+}
+
+func TestSlideIDCache_ReusedUntilMutation(t *testing.T) {
+	ctx := context.Background()
+	cache := NewSlideIDCache()
+
+	getCalls := 0
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			getCalls++
+			return newSlideIDCacheTestPresentation("rev-1", "slide-1", "slide-2"), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+
+	config := DefaultToolsConfig()
+	config.SlideIDCache = cache
+	tools := NewTools(config, func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	service, err := tools.slidesServiceFactory(ctx, &mockTokenSource{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// First resolution fetches the presentation
+	slideID, index, err := tools.resolveSlideID(ctx, service, "test-presentation", 2, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if slideID != "slide-2" || index != 2 {
+		t.Errorf("resolved (%s, %d), want (slide-2, 2)", slideID, index)
+	}
+
+	// Subsequent resolutions reuse the cached map
+	if _, _, err := tools.resolveSlideID(ctx, service, "test-presentation", 1, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, index, err := tools.resolveSlideID(ctx, service, "test-presentation", 0, "slide-2"); err != nil || index != 2 {
+		t.Fatalf("resolve by ID = (%d, %v), want (2, nil)", index, err)
+	}
+	if getCalls != 1 {
+		t.Errorf("expected 1 GetPresentation call before mutation, got %d", getCalls)
+	}
+
+	// A mutation invalidates the entry
+	if _, err := service.BatchUpdate(ctx, "test-presentation", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cache.Len() != 0 {
+		t.Error("expected cache entry to be invalidated after batch update")
+	}
+
+	if _, _, err := tools.resolveSlideID(ctx, service, "test-presentation", 1, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if getCalls != 2 {
+		t.Errorf("expected 2 GetPresentation calls after mutation, got %d", getCalls)
+	}
+}
+
+func TestSlideIDCache_RevisionChangeReplacesEntry(t *testing.T) {
+	cache := NewSlideIDCache()
+
+	cache.observe("scope", newSlideIDCacheTestPresentation("rev-1", "slide-1"))
+	cache.observe("scope", newSlideIDCacheTestPresentation("rev-2", "slide-1", "slide-new"))
+
+	revisionID, slideIDs, ok := cache.Get("scope", "test-presentation")
+	if !ok {
+		t.Fatal("expected cache entry")
+	}
+	if revisionID != "rev-2" {
+		t.Errorf("revision = %q, want rev-2", revisionID)
+	}
+	if len(slideIDs) != 2 || slideIDs[1] != "slide-new" {
+		t.Errorf("slideIDs = %v, want [slide-1 slide-new]", slideIDs)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", cache.Len())
+	}
+}
+
+func TestSlideIDCache_SlideLookupToolsUseCache(t *testing.T) {
+	tests := []struct {
+		name      string
+		call      func(ctx context.Context, tools *Tools) error
+		pageObjID func(request *slides.Request) string
+	}{
+		{
+			name: "add_text_box",
+			call: func(ctx context.Context, tools *Tools) error {
+				_, err := tools.AddTextBox(ctx, &mockTokenSource{}, AddTextBoxInput{
+					PresentationID: "test-presentation",
+					SlideIndex:     2,
+					Text:           "Hello",
+					Size:           &SizeInput{Width: 100, Height: 50},
+				})
+				return err
+			},
+			pageObjID: func(request *slides.Request) string { return request.CreateShape.ElementProperties.PageObjectId },
+		},
+		{
+			name: "create_shape",
+			call: func(ctx context.Context, tools *Tools) error {
+				_, err := tools.CreateShape(ctx, &mockTokenSource{}, CreateShapeInput{
+					PresentationID: "test-presentation",
+					SlideIndex:     2,
+					ShapeType:      "RECTANGLE",
+					Size:           &SizeInput{Width: 100, Height: 50},
+				})
+				return err
+			},
+			pageObjID: func(request *slides.Request) string { return request.CreateShape.ElementProperties.PageObjectId },
+		},
+		{
+			name: "create_line",
+			call: func(ctx context.Context, tools *Tools) error {
+				_, err := tools.CreateLine(ctx, &mockTokenSource{}, CreateLineInput{
+					PresentationID: "test-presentation",
+					SlideIndex:     2,
+					StartPoint:     &Point{X: 0, Y: 0},
+					EndPoint:       &Point{X: 100, Y: 50},
+				})
+				return err
+			},
+			pageObjID: func(request *slides.Request) string { return request.CreateLine.ElementProperties.PageObjectId },
+		},
+		{
+			name: "create_table",
+			call: func(ctx context.Context, tools *Tools) error {
+				_, err := tools.CreateTable(ctx, &mockTokenSource{}, CreateTableInput{
+					PresentationID: "test-presentation",
+					SlideIndex:     2,
+					Rows:           2,
+					Columns:        2,
+				})
+				return err
+			},
+			pageObjID: func(request *slides.Request) string { return request.CreateTable.ElementProperties.PageObjectId },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cache := NewSlideIDCache()
+			scope, _ := slideIDCacheScope(&mockTokenSource{})
+			cache.Store(scope, "test-presentation", "rev-1", []string{"slide-1", "slide-2"})
+
+			var batchSlideID string
+			mockService := &mockSlidesService{
+				GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+					t.Error("GetPresentation should not be called when the slide ID is cached")
+					return nil, errors.New("unexpected call")
+				},
+				BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+					batchSlideID = tt.pageObjID(requests[0])
+					return &slides.BatchUpdatePresentationResponse{}, nil
+				},
+			}
+
+			config := DefaultToolsConfig()
+			config.SlideIDCache = cache
+			tools := NewTools(config, func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				return mockService, nil
+			})
+
+			if err := tt.call(ctx, tools); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if batchSlideID != "slide-2" {
+				t.Errorf("element created on %q, want slide-2", batchSlideID)
+			}
+			if _, _, ok := cache.Get(scope, "test-presentation"); ok {
+				t.Errorf("expected cache entry to be invalidated by %s", tt.name)
+			}
+		})
+	}
+}
+
+func TestSlideIDCache_ScopedToCaller(t *testing.T) {
+	ctx := context.Background()
+	cache := NewSlideIDCache()
+
+	getCalls := 0
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			getCalls++
+			return newSlideIDCacheTestPresentation("rev-1", "slide-1"), nil
+		},
+	}
+
+	config := DefaultToolsConfig()
+	config.SlideIDCache = cache
+	tools := NewTools(config, func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	resolve := func(tokenSource oauth2.TokenSource) {
+		t.Helper()
+		service, err := tools.slidesServiceFactory(ctx, tokenSource)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, _, err := tools.resolveSlideID(ctx, service, "test-presentation", 1, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Another user's cached entry is never used
+	resolve(&mockTokenSource{})
+	resolve(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "other-user"}))
+	if getCalls != 2 {
+		t.Errorf("expected each caller to fetch the presentation, got %d calls", getCalls)
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Len() = %d, want one entry per caller", cache.Len())
+	}
+
+	// A caller that cannot be identified neither reads nor fills the cache
+	resolve(nil)
+	resolve(nil)
+	if getCalls != 4 || cache.Len() != 2 {
+		t.Errorf("expected uncached fetches without a token, got %d calls and %d entries", getCalls, cache.Len())
+	}
+
+	// A mutation invalidates the presentation for every caller
+	service, _ := tools.slidesServiceFactory(ctx, nil)
+	mockService.BatchUpdateFunc = func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+		return &slides.BatchUpdatePresentationResponse{}, nil
+	}
+	if _, err := service.BatchUpdate(ctx, "test-presentation", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("expected every entry invalidated, got %d", cache.Len())
+	}
+}
+
+func TestFindSlideInIDs(t *testing.T) {
+	slideIDs := []string{"a", "b", "c"}
+
+	tests := []struct {
+		name       string
+		slideIndex int
+		slideID    string
+		wantID     string
+		wantIndex  int
+		wantErr    error
+	}{
+		{name: "by index", slideIndex: 3, wantID: "c", wantIndex: 3},
+		{name: "by ID", slideID: "b", wantID: "b", wantIndex: 2},
+		{name: "index out of range", slideIndex: 4, wantErr: ErrSlideNotFound},
+		{name: "unknown ID", slideID: "z", wantErr: ErrSlideNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, index, err := findSlideInIDs(slideIDs, tt.slideIndex, tt.slideID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id != tt.wantID || index != tt.wantIndex {
+				t.Errorf("got (%s, %d), want (%s, %d)", id, index, tt.wantID, tt.wantIndex)
+			}
+		})
+	}
+}
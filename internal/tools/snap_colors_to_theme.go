@@ -0,0 +1,308 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for snap_colors_to_theme tool.
+var (
+	ErrInvalidSnapThreshold = errors.New("invalid snap threshold")
+	ErrSnapColorsFailed     = errors.New("failed to snap colors to theme")
+)
+
+// Snap thresholds are Euclidean distances between colors on the 0-255 RGB scale.
+const (
+	DefaultSnapThreshold = 30.0
+	maxSnapThreshold     = 441.7 // Distance from black to white, rounded up
+)
+
+// snapThemeColorTypes are the theme colors a color can snap to. The hyperlink colors are left
+// out so regular text and fills never turn into link colors.
+var snapThemeColorTypes = themeColorTypes[:10]
+
+// SnapColorsToThemeInput represents the input for the snap_colors_to_theme tool.
+type SnapColorsToThemeInput struct {
+	PresentationID string  `json:"presentation_id"`
+	Threshold      float64 `json:"threshold,omitempty"` // Max RGB distance (0-255 scale) to snap, default 30
+}
+
+// ColorSnap describes one explicit color replaced by a theme color reference.
+type ColorSnap struct {
+	SlideIndex int     `json:"slide_index"` // 1-based
+	ObjectID   string  `json:"object_id"`
+	Target     string  `json:"target"`     // "text", "fill" or "outline"
+	FromColor  string  `json:"from_color"` // Original hex color
+	ThemeColor string  `json:"theme_color"`
+	Distance   float64 `json:"distance"` // RGB distance to the theme color, 0-255 scale
+}
+
+// SnapColorsToThemeOutput represents the output of the snap_colors_to_theme tool.
+type SnapColorsToThemeOutput struct {
+	PresentationID  string      `json:"presentation_id"`
+	Threshold       float64     `json:"threshold"`
+	SnappedCount    int         `json:"snapped_count"`
+	Snaps           []ColorSnap `json:"snaps"`
+	AffectedObjects []string    `json:"affected_objects"`
+}
+
+// SnapColorsToTheme rewrites explicit RGB text, fill and outline colors on slides that lie within
+// the threshold of a theme color into references to the nearest theme color, so they follow the
+// theme from then on. Each slide is compared with its own master's color scheme.
+func (t *Tools) SnapColorsToTheme(ctx context.Context, tokenSource oauth2.TokenSource, input SnapColorsToThemeInput) (*SnapColorsToThemeOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	threshold := input.Threshold
+	if threshold == 0 {
+		threshold = DefaultSnapThreshold
+	}
+	if math.IsNaN(threshold) || threshold < 0 || threshold > maxSnapThreshold {
+		return nil, fmt.Errorf("%w: threshold must be between 0 and %.1f", ErrInvalidSnapThreshold, maxSnapThreshold)
+	}
+
+	t.config.Logger.Info("snapping colors to theme",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Float64("threshold", threshold),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	mastersByID := make(map[string]*slides.Page)
+	for _, master := range presentation.Masters {
+		mastersByID[master.ObjectId] = master
+	}
+	var defaultMaster *slides.Page
+	if len(presentation.Masters) > 0 {
+		defaultMaster = presentation.Masters[0]
+	}
+
+	output := &SnapColorsToThemeOutput{
+		PresentationID:  input.PresentationID,
+		Threshold:       threshold,
+		Snaps:           []ColorSnap{},
+		AffectedObjects: []string{},
+	}
+
+	var requests []*slides.Request
+	schemeFound := false
+	for i, slide := range presentation.Slides {
+		master := defaultMaster
+		if slide.SlideProperties != nil && mastersByID[slide.SlideProperties.MasterObjectId] != nil {
+			master = mastersByID[slide.SlideProperties.MasterObjectId]
+		}
+		themeColors := themeColorsByType(master)
+		if len(themeColors) == 0 {
+			continue
+		}
+		schemeFound = true
+
+		slideRequests, snaps := buildSnapColorRequests(slide.PageElements, themeColors, threshold)
+		for j := range snaps {
+			snaps[j].SlideIndex = i + 1
+		}
+		requests = append(requests, slideRequests...)
+		output.Snaps = append(output.Snaps, snaps...)
+	}
+	if !schemeFound && len(presentation.Slides) > 0 {
+		return nil, ErrNoColorScheme
+	}
+
+	output.SnappedCount = len(output.Snaps)
+	seen := make(map[string]bool)
+	for _, snap := range output.Snaps {
+		if !seen[snap.ObjectID] {
+			seen[snap.ObjectID] = true
+			output.AffectedObjects = append(output.AffectedObjects, snap.ObjectID)
+		}
+	}
+
+	if len(requests) == 0 {
+		t.config.Logger.Info("no colors to snap",
+			slog.String("presentation_id", input.PresentationID),
+		)
+		return output, nil
+	}
+
+	// Execute batch update
+	_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSnapColorsFailed, err)
+	}
+
+	t.config.Logger.Info("colors snapped to theme successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("snapped_count", output.SnappedCount),
+		slog.Int("affected_objects", len(output.AffectedObjects)),
+	)
+
+	return output, nil
+}
+
+// nearestThemeColor returns the theme color type closest to color and its distance on the
+// 0-255 scale. Ties go to the earlier type in snapThemeColorTypes.
+func nearestThemeColor(color *slides.RgbColor, themeColors map[string]*slides.RgbColor) (string, float64) {
+	bestType := ""
+	bestDistance := math.Inf(1)
+	for _, colorType := range snapThemeColorTypes {
+		themeColor := themeColors[colorType]
+		if themeColor == nil {
+			continue
+		}
+		if distance := rgbDistance(color, themeColor); distance < bestDistance {
+			bestType, bestDistance = colorType, distance
+		}
+	}
+	return bestType, bestDistance
+}
+
+// rgbDistance is the Euclidean distance between two colors on the 0-255 RGB scale.
+func rgbDistance(a, b *slides.RgbColor) float64 {
+	dr := (a.Red - b.Red) * 255
+	dg := (a.Green - b.Green) * 255
+	db := (a.Blue - b.Blue) * 255
+	return math.Sqrt(dr*dr + dg*dg + db*db)
+}
+
+// buildSnapColorRequests snaps explicit colors on shapes, including shapes in groups: text runs
+// get an UpdateTextStyle for their foreground color, and shapes an UpdateShapeProperties for
+// their solid fill and outline colors. Colors already referencing the theme are left alone.
+func buildSnapColorRequests(elements []*slides.PageElement, themeColors map[string]*slides.RgbColor, threshold float64) ([]*slides.Request, []ColorSnap) {
+	var requests []*slides.Request
+	var snaps []ColorSnap
+
+	snap := func(objectID, target string, color *slides.RgbColor) string {
+		if color == nil {
+			return ""
+		}
+		themeType, distance := nearestThemeColor(color, themeColors)
+		if themeType == "" || distance > threshold {
+			return ""
+		}
+		snaps = append(snaps, ColorSnap{
+			ObjectID:   objectID,
+			Target:     target,
+			FromColor:  rgbHex(color),
+			ThemeColor: themeType,
+			Distance:   math.Round(distance*10) / 10,
+		})
+		return themeType
+	}
+
+	var visit func(elements []*slides.PageElement)
+	visit = func(elements []*slides.PageElement) {
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+			if element.ElementGroup != nil {
+				visit(element.ElementGroup.Children)
+				continue
+			}
+			if element.Shape == nil {
+				continue
+			}
+
+			if element.Shape.Text != nil {
+				for _, textElement := range element.Shape.Text.TextElements {
+					run := textElement.TextRun
+					if run == nil || run.Style == nil || textElement.EndIndex <= textElement.StartIndex {
+						continue
+					}
+					foreground := run.Style.ForegroundColor
+					if foreground == nil || foreground.OpaqueColor == nil {
+						continue
+					}
+					themeType := snap(element.ObjectId, "text", foreground.OpaqueColor.RgbColor)
+					if themeType == "" {
+						continue
+					}
+
+					start := textElement.StartIndex
+					end := textElement.EndIndex
+					requests = append(requests, &slides.Request{
+						UpdateTextStyle: &slides.UpdateTextStyleRequest{
+							ObjectId: element.ObjectId,
+							TextRange: &slides.Range{
+								Type:       "FIXED_RANGE",
+								StartIndex: &start,
+								EndIndex:   &end,
+							},
+							Style: &slides.TextStyle{
+								ForegroundColor: &slides.OptionalColor{OpaqueColor: &slides.OpaqueColor{ThemeColor: themeType}},
+							},
+							Fields: "foregroundColor",
+						},
+					})
+				}
+			}
+
+			props := element.Shape.ShapeProperties
+			if props == nil {
+				continue
+			}
+			shapeProps := &slides.ShapeProperties{}
+			var fields []string
+			if fill := props.ShapeBackgroundFill; fill != nil && fill.SolidFill != nil && fill.SolidFill.Color != nil {
+				if themeType := snap(element.ObjectId, "fill", fill.SolidFill.Color.RgbColor); themeType != "" {
+					shapeProps.ShapeBackgroundFill = &slides.ShapeBackgroundFill{
+						SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{ThemeColor: themeType}},
+					}
+					fields = append(fields, "shapeBackgroundFill.solidFill.color")
+				}
+			}
+			if outline := props.Outline; outline != nil && outline.OutlineFill != nil && outline.OutlineFill.SolidFill != nil && outline.OutlineFill.SolidFill.Color != nil {
+				if themeType := snap(element.ObjectId, "outline", outline.OutlineFill.SolidFill.Color.RgbColor); themeType != "" {
+					shapeProps.Outline = &slides.Outline{
+						OutlineFill: &slides.OutlineFill{
+							SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{ThemeColor: themeType}},
+						},
+					}
+					fields = append(fields, "outline.outlineFill.solidFill.color")
+				}
+			}
+			if len(fields) > 0 {
+				requests = append(requests, &slides.Request{
+					UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
+						ObjectId:        element.ObjectId,
+						ShapeProperties: shapeProps,
+						Fields:          strings.Join(fields, ","),
+					},
+				})
+			}
+		}
+	}
+
+	visit(elements)
+	return requests, snaps
+}
@@ -0,0 +1,214 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+// rgb255 builds an RgbColor from 0-255 channel values.
+func rgb255(r, g, b float64) *slides.RgbColor {
+	return &slides.RgbColor{Red: r / 255, Green: g / 255, Blue: b / 255}
+}
+
+func snapColorsTestPresentation() *slides.Presentation {
+	solidFill := func(color *slides.RgbColor) *slides.ShapeBackgroundFill {
+		return &slides.ShapeBackgroundFill{SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{RgbColor: color}}}
+	}
+	textRun := func(start, end int64, color *slides.RgbColor) *slides.TextElement {
+		return &slides.TextElement{
+			StartIndex: start,
+			EndIndex:   end,
+			TextRun: &slides.TextRun{
+				Content: "text",
+				Style:   &slides.TextStyle{ForegroundColor: &slides.OptionalColor{OpaqueColor: &slides.OpaqueColor{RgbColor: color}}},
+			},
+		}
+	}
+
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Masters: []*slides.Page{{
+			ObjectId: "master-1",
+			PageProperties: &slides.PageProperties{ColorScheme: &slides.ColorScheme{Colors: []*slides.ThemeColorPair{
+				{Type: "DARK1", Color: rgb255(0, 0, 0)},
+				{Type: "LIGHT1", Color: rgb255(255, 255, 255)},
+				{Type: "ACCENT1", Color: rgb255(66, 133, 244)},
+				{Type: "ACCENT2", Color: rgb255(219, 68, 55)},
+				{Type: "HYPERLINK", Color: rgb255(17, 85, 204)},
+			}}},
+		}},
+		Slides: []*slides.Page{{
+			ObjectId:        "slide-1",
+			SlideProperties: &slides.SlideProperties{MasterObjectId: "master-1"},
+			PageElements: []*slides.PageElement{
+				// Near ACCENT1: snapped
+				{ObjectId: "near-fill", Shape: &slides.Shape{ShapeProperties: &slides.ShapeProperties{
+					ShapeBackgroundFill: solidFill(rgb255(70, 130, 240)),
+				}}},
+				// Far from every theme color: left alone
+				{ObjectId: "far-fill", Shape: &slides.Shape{ShapeProperties: &slides.ShapeProperties{
+					ShapeBackgroundFill: solidFill(rgb255(120, 200, 40)),
+				}}},
+				// Already a theme reference: left alone
+				{ObjectId: "theme-fill", Shape: &slides.Shape{ShapeProperties: &slides.ShapeProperties{
+					ShapeBackgroundFill: &slides.ShapeBackgroundFill{SolidFill: &slides.SolidFill{Color: &slides.OpaqueColor{ThemeColor: "ACCENT2"}}},
+				}}},
+				{ObjectId: "group-1", ElementGroup: &slides.Group{Children: []*slides.PageElement{
+					{ObjectId: "text-1", Shape: &slides.Shape{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+						textRun(0, 5, rgb255(215, 70, 60)),  // Near ACCENT2
+						textRun(5, 10, rgb255(20, 85, 200)), // Nearest is HYPERLINK, which is never a target
+					}}}},
+				}}},
+			},
+		}},
+	}
+}
+
+func TestSnapColorsToTheme(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(snapColorsTestPresentation(), nil, nil, &batchCalls, &captured)
+
+	output, err := tools.SnapColorsToTheme(context.Background(), nil, SnapColorsToThemeInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 {
+		t.Fatalf("expected a single batch update, got %d", batchCalls)
+	}
+	if output.Threshold != DefaultSnapThreshold {
+		t.Errorf("expected default threshold, got %v", output.Threshold)
+	}
+	if output.SnappedCount != 2 || len(captured) != 2 {
+		t.Fatalf("expected 2 snaps and 2 requests, got %d snaps, %d requests: %+v", output.SnappedCount, len(captured), output.Snaps)
+	}
+
+	fill := captured[0].UpdateShapeProperties
+	if fill == nil || fill.ObjectId != "near-fill" || fill.Fields != "shapeBackgroundFill.solidFill.color" {
+		t.Fatalf("expected fill snap on near-fill, got %+v", captured[0])
+	}
+	if got := fill.ShapeProperties.ShapeBackgroundFill.SolidFill.Color; got.ThemeColor != "ACCENT1" || got.RgbColor != nil {
+		t.Errorf("expected ACCENT1 reference, got %+v", got)
+	}
+
+	text := captured[1].UpdateTextStyle
+	if text == nil || text.ObjectId != "text-1" || *text.TextRange.StartIndex != 0 || *text.TextRange.EndIndex != 5 {
+		t.Fatalf("expected text snap on text-1 [0,5), got %+v", captured[1])
+	}
+	if got := text.Style.ForegroundColor.OpaqueColor.ThemeColor; got != "ACCENT2" {
+		t.Errorf("expected ACCENT2 reference, got %s", got)
+	}
+
+	if output.Snaps[0].FromColor != "#4682F0" || output.Snaps[0].Target != "fill" || output.Snaps[0].SlideIndex != 1 {
+		t.Errorf("unexpected snap report: %+v", output.Snaps[0])
+	}
+	if len(output.AffectedObjects) != 2 {
+		t.Errorf("expected 2 affected objects, got %v", output.AffectedObjects)
+	}
+}
+
+func TestSnapColorsToTheme_LargeThresholdSnapsFarColor(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(snapColorsTestPresentation(), nil, nil, &batchCalls, &captured)
+
+	output, err := tools.SnapColorsToTheme(context.Background(), nil, SnapColorsToThemeInput{PresentationID: "pres-1", Threshold: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	snapped := map[string]string{}
+	for _, snap := range output.Snaps {
+		snapped[snap.ObjectID] = snap.ThemeColor
+	}
+	if snapped["far-fill"] == "" {
+		t.Errorf("expected far-fill to snap with a large threshold, got %+v", output.Snaps)
+	}
+	if _, ok := snapped["theme-fill"]; ok {
+		t.Error("theme references must never be rewritten")
+	}
+}
+
+func TestSnapColorsToTheme_NothingToSnap(t *testing.T) {
+	presentation := snapColorsTestPresentation()
+	presentation.Slides[0].PageElements = presentation.Slides[0].PageElements[1:3]
+
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newBatchCaptureTestTools(presentation, nil, nil, &batchCalls, &captured)
+
+	output, err := tools.SnapColorsToTheme(context.Background(), nil, SnapColorsToThemeInput{PresentationID: "pres-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.SnappedCount != 0 || batchCalls != 0 {
+		t.Errorf("expected no snaps and no batch update, got %d snaps, %d calls", output.SnappedCount, batchCalls)
+	}
+}
+
+func TestSnapColorsToTheme_Errors(t *testing.T) {
+	noScheme := &slides.Presentation{
+		Masters: []*slides.Page{{ObjectId: "master-1"}},
+		Slides:  []*slides.Page{{ObjectId: "slide-1"}},
+	}
+
+	tests := []struct {
+		name         string
+		presentation *slides.Presentation
+		input        SnapColorsToThemeInput
+		getErr       error
+		batchErr     error
+		wantErr      error
+	}{
+		{"missing presentation", nil, SnapColorsToThemeInput{}, nil, nil, ErrInvalidPresentationID},
+		{"negative threshold", nil, SnapColorsToThemeInput{PresentationID: "pres-1", Threshold: -1}, nil, nil, ErrInvalidSnapThreshold},
+		{"threshold too large", nil, SnapColorsToThemeInput{PresentationID: "pres-1", Threshold: 500}, nil, nil, ErrInvalidSnapThreshold},
+		{"NaN threshold", nil, SnapColorsToThemeInput{PresentationID: "pres-1", Threshold: math.NaN()}, nil, nil, ErrInvalidSnapThreshold},
+		{"no color scheme", noScheme, SnapColorsToThemeInput{PresentationID: "pres-1"}, nil, nil, ErrNoColorScheme},
+		{"presentation not found", nil, SnapColorsToThemeInput{PresentationID: "pres-1"}, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"batch update failure", nil, SnapColorsToThemeInput{PresentationID: "pres-1"}, nil, errors.New("boom"), ErrSnapColorsFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			presentation := tt.presentation
+			if presentation == nil {
+				presentation = snapColorsTestPresentation()
+			}
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newBatchCaptureTestTools(presentation, tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.SnapColorsToTheme(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRgbDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *slides.RgbColor
+		want float64
+	}{
+		{"identical", rgb255(10, 20, 30), rgb255(10, 20, 30), 0},
+		{"one channel", rgb255(0, 0, 0), rgb255(30, 0, 0), 30},
+		{"3-4-5", rgb255(0, 0, 0), rgb255(0, 30, 40), 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rgbDistance(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("rgbDistance = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
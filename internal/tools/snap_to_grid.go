@@ -0,0 +1,244 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for snap_to_grid tool.
+var (
+	ErrInvalidGridStep  = errors.New("invalid grid step")
+	ErrSnapToGridFailed = errors.New("failed to snap objects to grid")
+)
+
+// SnapToGridInput represents the input for the snap_to_grid tool.
+// Targets are either explicit ObjectIDs or every top-level element of one slide.
+type SnapToGridInput struct {
+	PresentationID string   `json:"presentation_id"`
+	ObjectIDs      []string `json:"object_ids,omitempty"`  // Explicit targets; cannot be combined with a slide
+	SlideIndex     int      `json:"slide_index,omitempty"` // 1-based; snaps every top-level element on the slide
+	SlideID        string   `json:"slide_id,omitempty"`    // Alternative to slide_index
+	GridStep       float64  `json:"grid_step"`             // Grid spacing in points, must be > 0
+	SnapSize       bool     `json:"snap_size,omitempty"`   // Also round width and height to the grid
+}
+
+// SnappedObject describes one element's geometry after snapping.
+type SnappedObject struct {
+	ObjectID string    `json:"object_id"`
+	Position *Position `json:"position"`       // Points
+	Size     *Size     `json:"size,omitempty"` // Points; only with snap_size
+	Changed  bool      `json:"changed"`        // False when the element was already on the grid
+}
+
+// SnapToGridOutput represents the output of the snap_to_grid tool.
+type SnapToGridOutput struct {
+	PresentationID string          `json:"presentation_id"`
+	GridStep       float64         `json:"grid_step"`
+	Objects        []SnappedObject `json:"objects"`
+	ChangedCount   int             `json:"changed_count"`
+}
+
+// SnapToGrid rounds each target's position, and optionally its displayed size, to the nearest
+// multiple of the grid step. Sizes are changed through the transform's scale so rotation and
+// flips are kept; a size never snaps below one grid step. Elements already on the grid and
+// elements without a transform are left alone.
+func (t *Tools) SnapToGrid(ctx context.Context, tokenSource oauth2.TokenSource, input SnapToGridInput) (*SnapToGridOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if !(input.GridStep > 0) || math.IsInf(input.GridStep, 1) {
+		return nil, fmt.Errorf("%w: grid_step must be greater than 0", ErrInvalidGridStep)
+	}
+	hasSlide := input.SlideIndex != 0 || input.SlideID != ""
+	if len(input.ObjectIDs) == 0 && !hasSlide {
+		return nil, fmt.Errorf("%w: provide object_ids or slide_index/slide_id", ErrInvalidObjectID)
+	}
+	if len(input.ObjectIDs) > 0 && hasSlide {
+		return nil, fmt.Errorf("%w: object_ids cannot be combined with a slide", ErrInvalidObjectID)
+	}
+	for _, objectID := range input.ObjectIDs {
+		if objectID == "" {
+			return nil, fmt.Errorf("%w: object_ids cannot contain empty IDs", ErrInvalidObjectID)
+		}
+	}
+
+	t.config.Logger.Info("snapping objects to grid",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("object_count", len(input.ObjectIDs)),
+		slog.Int("slide_index", input.SlideIndex),
+		slog.String("slide_id", input.SlideID),
+		slog.Float64("grid_step", input.GridStep),
+		slog.Bool("snap_size", input.SnapSize),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation for the current transforms
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	var targets []*slides.PageElement
+	if hasSlide {
+		_, slideIndex, err := findSlide(presentation, input.SlideIndex, input.SlideID)
+		if err != nil {
+			return nil, err
+		}
+		for _, element := range presentation.Slides[slideIndex-1].PageElements {
+			if element != nil {
+				targets = append(targets, element)
+			}
+		}
+	} else {
+		for _, objectID := range input.ObjectIDs {
+			element := findElementByIDRecursively(presentation.Slides, objectID)
+			if element == nil {
+				return nil, fmt.Errorf("%w: object '%s' not found", ErrObjectNotFound, objectID)
+			}
+			targets = append(targets, element)
+		}
+	}
+
+	output := &SnapToGridOutput{
+		PresentationID: input.PresentationID,
+		GridStep:       input.GridStep,
+		Objects:        []SnappedObject{},
+	}
+	var requests []*slides.Request
+	for _, element := range targets {
+		if element.Transform == nil {
+			continue
+		}
+
+		transform, changed := snapTransformToGrid(element, input.GridStep, input.SnapSize)
+		snapped := SnappedObject{
+			ObjectID: element.ObjectId,
+			Position: &Position{
+				X: emuToPoints(transform.TranslateX),
+				Y: emuToPoints(transform.TranslateY),
+			},
+			Changed: changed,
+		}
+		if input.SnapSize {
+			snapped.Size = displayedSizeWithTransform(element, transform)
+		}
+		output.Objects = append(output.Objects, snapped)
+
+		if !changed {
+			continue
+		}
+		output.ChangedCount++
+		requests = append(requests, &slides.Request{
+			UpdatePageElementTransform: &slides.UpdatePageElementTransformRequest{
+				ObjectId:  element.ObjectId,
+				Transform: transform,
+				ApplyMode: "ABSOLUTE",
+			},
+		})
+	}
+
+	// Execute batch update only when something moved
+	if len(requests) > 0 {
+		_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, ErrPresentationNotFound
+			}
+			if isForbiddenError(err) {
+				return nil, ErrAccessDenied
+			}
+			return nil, fmt.Errorf("%w: %v", ErrSnapToGridFailed, err)
+		}
+	}
+
+	t.config.Logger.Info("objects snapped to grid",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("object_count", len(output.Objects)),
+		slog.Int("changed_count", output.ChangedCount),
+	)
+
+	return output, nil
+}
+
+// snapTransformToGrid returns a copy of the element's transform with its translation, and with
+// snapSize its displayed size, rounded to multiples of step (points). The second result reports
+// whether anything moved by at least one EMU.
+func snapTransformToGrid(element *slides.PageElement, step float64, snapSize bool) (*slides.AffineTransform, bool) {
+	current := element.Transform
+	snapped := &slides.AffineTransform{
+		ScaleX:     current.ScaleX,
+		ScaleY:     current.ScaleY,
+		ShearX:     current.ShearX,
+		ShearY:     current.ShearY,
+		TranslateX: pointsToEMU(snapToStep(emuToPoints(current.TranslateX), step)),
+		TranslateY: pointsToEMU(snapToStep(emuToPoints(current.TranslateY), step)),
+		Unit:       "EMU",
+	}
+
+	if snapSize {
+		if width, height, ok := displayedSizeInPoints(element); ok {
+			ratioX, ratioY := 1.0, 1.0
+			if width > 0 {
+				ratioX = math.Max(snapToStep(width, step), step) / width
+			}
+			if height > 0 {
+				ratioY = math.Max(snapToStep(height, step), step) / height
+			}
+			scaleTransformColumns(snapped, ratioX, ratioY)
+		}
+	}
+
+	changed := math.Abs(snapped.TranslateX-current.TranslateX) >= 1 ||
+		math.Abs(snapped.TranslateY-current.TranslateY) >= 1 ||
+		math.Abs(snapped.ScaleX-current.ScaleX) > 1e-9 ||
+		math.Abs(snapped.ScaleY-current.ScaleY) > 1e-9 ||
+		math.Abs(snapped.ShearX-current.ShearX) > 1e-9 ||
+		math.Abs(snapped.ShearY-current.ShearY) > 1e-9
+	return snapped, changed
+}
+
+// scaleTransformColumns multiplies the displayed width of transform by ratioX and its height by
+// ratioY, in place. Scaling a column of the matrix resizes along that axis and keeps rotation
+// and flips.
+func scaleTransformColumns(transform *slides.AffineTransform, ratioX, ratioY float64) {
+	transform.ScaleX *= ratioX
+	transform.ShearY *= ratioX
+	transform.ScaleY *= ratioY
+	transform.ShearX *= ratioY
+}
+
+// displayedSizeWithTransform returns the element's displayed size under transform, rounded to two
+// decimals, or nil when the element has no size (groups and some lines).
+func displayedSizeWithTransform(element *slides.PageElement, transform *slides.AffineTransform) *Size {
+	width, height, ok := displayedSizeInPoints(&slides.PageElement{Size: element.Size, Transform: transform})
+	if !ok {
+		return nil
+	}
+	return &Size{
+		Width:  math.Round(width*100) / 100,
+		Height: math.Round(height*100) / 100,
+	}
+}
+
+// snapToStep rounds value to the nearest multiple of step.
+func snapToStep(value, step float64) float64 {
+	return math.Round(value/step) * step
+}
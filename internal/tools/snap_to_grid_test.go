@@ -0,0 +1,196 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+)
+
+func newSnapToGridTestTools(getErr, batchErr error, batchCalls *int, captured *[]*slides.Request) *Tools {
+	presentation := &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					treeTestElement("off-grid", 13, 27, 95, 42),
+					treeTestElement("on-grid", 20, 40, 100, 50),
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					treeTestElement("other", 4, 6, 10, 10),
+				},
+			},
+		},
+	}
+
+	return newBatchCaptureTestTools(presentation, getErr, batchErr, batchCalls, captured)
+}
+
+func TestSnapToGrid_Slide(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newSnapToGridTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.SnapToGrid(context.Background(), nil, SnapToGridInput{
+		PresentationID: "pres-1",
+		SlideIndex:     1,
+		GridStep:       10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if batchCalls != 1 || len(captured) != 1 {
+		t.Fatalf("expected one request for the off-grid element, got %d calls and %d requests", batchCalls, len(captured))
+	}
+	update := captured[0].UpdatePageElementTransform
+	if update.ObjectId != "off-grid" || update.ApplyMode != "ABSOLUTE" {
+		t.Errorf("unexpected request: %+v", update)
+	}
+	if update.Transform.TranslateX != pointsToEMU(10) || update.Transform.TranslateY != pointsToEMU(30) {
+		t.Errorf("expected position (10, 30), got (%v, %v) pt", emuToPoints(update.Transform.TranslateX), emuToPoints(update.Transform.TranslateY))
+	}
+	if update.Transform.ScaleX != 1 || update.Transform.ScaleY != 1 {
+		t.Errorf("expected the scale to be kept without snap_size, got %+v", update.Transform)
+	}
+
+	if output.ChangedCount != 1 || len(output.Objects) != 2 {
+		t.Fatalf("unexpected output: %+v", output)
+	}
+	if output.Objects[1].ObjectID != "on-grid" || output.Objects[1].Changed || output.Objects[1].Size != nil {
+		t.Errorf("expected on-grid to be reported unchanged, got %+v", output.Objects[1])
+	}
+}
+
+func TestSnapToGrid_SnapSize(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newSnapToGridTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.SnapToGrid(context.Background(), nil, SnapToGridInput{
+		PresentationID: "pres-1",
+		ObjectIDs:      []string{"off-grid"},
+		GridStep:       10,
+		SnapSize:       true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	transform := captured[0].UpdatePageElementTransform.Transform
+	if math.Abs(transform.ScaleX-100.0/95) > 1e-9 || math.Abs(transform.ScaleY-40.0/42) > 1e-9 {
+		t.Errorf("expected scales snapping the size to 100x40, got %v x %v", transform.ScaleX, transform.ScaleY)
+	}
+	got := output.Objects[0]
+	if got.Size == nil || got.Size.Width != 100 || got.Size.Height != 40 {
+		t.Errorf("expected a 100x40 size, got %+v", got.Size)
+	}
+	if got.Position.X != 10 || got.Position.Y != 30 {
+		t.Errorf("expected position (10, 30), got %+v", got.Position)
+	}
+}
+
+func TestSnapToGrid_AlreadyAligned(t *testing.T) {
+	var batchCalls int
+	var captured []*slides.Request
+	tools := newSnapToGridTestTools(nil, nil, &batchCalls, &captured)
+
+	output, err := tools.SnapToGrid(context.Background(), nil, SnapToGridInput{
+		PresentationID: "pres-1",
+		ObjectIDs:      []string{"on-grid"},
+		GridStep:       10,
+		SnapSize:       true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if batchCalls != 0 || output.ChangedCount != 0 {
+		t.Errorf("expected no batch update, got %d calls and output %+v", batchCalls, output)
+	}
+}
+
+func TestSnapToGrid_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    SnapToGridInput
+		getErr   error
+		batchErr error
+		wantErr  error
+	}{
+		{"missing presentation", SnapToGridInput{SlideIndex: 1, GridStep: 10}, nil, nil, ErrInvalidPresentationID},
+		{"zero grid step", SnapToGridInput{PresentationID: "pres-1", SlideIndex: 1}, nil, nil, ErrInvalidGridStep},
+		{"negative grid step", SnapToGridInput{PresentationID: "pres-1", SlideIndex: 1, GridStep: -5}, nil, nil, ErrInvalidGridStep},
+		{"no targets", SnapToGridInput{PresentationID: "pres-1", GridStep: 10}, nil, nil, ErrInvalidObjectID},
+		{"objects and slide", SnapToGridInput{PresentationID: "pres-1", ObjectIDs: []string{"off-grid"}, SlideIndex: 1, GridStep: 10}, nil, nil, ErrInvalidObjectID},
+		{"empty object ID", SnapToGridInput{PresentationID: "pres-1", ObjectIDs: []string{""}, GridStep: 10}, nil, nil, ErrInvalidObjectID},
+		{"unknown object", SnapToGridInput{PresentationID: "pres-1", ObjectIDs: []string{"nope"}, GridStep: 10}, nil, nil, ErrObjectNotFound},
+		{"unknown slide", SnapToGridInput{PresentationID: "pres-1", SlideIndex: 9, GridStep: 10}, nil, nil, ErrSlideNotFound},
+		{"presentation not found", SnapToGridInput{PresentationID: "pres-1", SlideIndex: 1, GridStep: 10}, &googleapi.Error{Code: 404}, nil, ErrPresentationNotFound},
+		{"access denied", SnapToGridInput{PresentationID: "pres-1", SlideIndex: 1, GridStep: 10}, &googleapi.Error{Code: 403}, nil, ErrAccessDenied},
+		{"batch update failure", SnapToGridInput{PresentationID: "pres-1", SlideIndex: 1, GridStep: 10}, nil, errors.New("boom"), ErrSnapToGridFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batchCalls int
+			var captured []*slides.Request
+			tools := newSnapToGridTestTools(tt.getErr, tt.batchErr, &batchCalls, &captured)
+
+			_, err := tools.SnapToGrid(context.Background(), nil, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSnapToStep(t *testing.T) {
+	tests := []struct {
+		value, step, want float64
+	}{
+		{13, 10, 10},
+		{15, 10, 20},
+		{27, 10, 30},
+		{-4, 10, 0},
+		{-6, 10, -10},
+		{7.3, 2.5, 7.5},
+		{0, 8, 0},
+	}
+
+	for _, tt := range tests {
+		if got := snapToStep(tt.value, tt.step); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("snapToStep(%v, %v) = %v, want %v", tt.value, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestSnapTransformToGrid_KeepsFlipAndMinimumSize(t *testing.T) {
+	element := treeTestElement("flipped", 0, 0, 4, 20)
+	element.Transform.ScaleX = -1
+
+	transform, changed := snapTransformToGrid(element, 10, true)
+	if !changed {
+		t.Fatal("expected the width to change")
+	}
+	// The 4pt width snaps up to one grid step and keeps its horizontal flip
+	if math.Abs(transform.ScaleX+2.5) > 1e-9 || transform.ScaleY != 1 {
+		t.Errorf("expected scale (-2.5, 1), got (%v, %v)", transform.ScaleX, transform.ScaleY)
+	}
+}
+
+func TestScaleTransformColumns(t *testing.T) {
+	// A 90° rotation keeps its orientation: only the columns' lengths change
+	transform := &slides.AffineTransform{ScaleX: 0, ShearX: -1, ShearY: 1, ScaleY: 0, TranslateX: 5}
+	scaleTransformColumns(transform, 2, 3)
+
+	if transform.ScaleX != 0 || transform.ShearX != -3 || transform.ShearY != 2 || transform.ScaleY != 0 || transform.TranslateX != 5 {
+		t.Errorf("expected columns scaled by (2, 3) and the translation kept, got %+v", transform)
+	}
+}
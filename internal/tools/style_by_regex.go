@@ -0,0 +1,303 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Sentinel errors for style_by_regex tool.
+var (
+	ErrInvalidPattern     = errors.New("invalid pattern")
+	ErrInvalidMatchMode   = errors.New("invalid match mode")
+	ErrStyleByRegexFailed = errors.New("failed to style matching text")
+)
+
+// Match modes for the style_by_regex tool.
+const (
+	regexMatchSubstring = "substring" // Style only the matched characters
+	regexMatchRun       = "run"       // Style every text run containing a match
+)
+
+// StyleByRegexInput represents the input for the style_by_regex tool.
+type StyleByRegexInput struct {
+	PresentationID string              `json:"presentation_id"`
+	Pattern        string              `json:"pattern"`         // Go regular expression (RE2 syntax)
+	Match          string              `json:"match,omitempty"` // "substring" (default) or "run"
+	Style          *StyleTextStyleSpec `json:"style"`
+}
+
+// StyleByRegexOutput represents the output of the style_by_regex tool.
+type StyleByRegexOutput struct {
+	PresentationID string              `json:"presentation_id"`
+	Pattern        string              `json:"pattern"`
+	Match          string              `json:"match"`
+	AppliedStyles  []string            `json:"applied_styles"`
+	MatchCount     int                 `json:"match_count"`
+	Matches        []StyleByRegexMatch `json:"matches"`
+}
+
+// StyleByRegexMatch describes a single styled character range.
+type StyleByRegexMatch struct {
+	SlideIndex int    `json:"slide_index"` // 1-based
+	SlideID    string `json:"slide_id"`
+	ObjectID   string `json:"object_id"` // Table cells use "tableId[row,col]"
+	StartIndex int    `json:"start_index"`
+	EndIndex   int    `json:"end_index"`
+	Text       string `json:"text"` // Styled text
+}
+
+// regexStyleTarget holds a match with everything needed to build its request.
+type regexStyleTarget struct {
+	match        StyleByRegexMatch
+	objectID     string
+	cellLocation *slides.TableCellLocation
+}
+
+// StyleByRegex applies a text style to every match of a regular expression across all slides.
+func (t *Tools) StyleByRegex(ctx context.Context, tokenSource oauth2.TokenSource, input StyleByRegexInput) (*StyleByRegexOutput, error) {
+	// Validate input
+	if input.PresentationID == "" {
+		return nil, fmt.Errorf("%w: presentation_id is required", ErrInvalidPresentationID)
+	}
+	if input.Pattern == "" {
+		return nil, fmt.Errorf("%w: pattern is required", ErrInvalidPattern)
+	}
+	re, err := regexp.Compile(input.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+
+	mode := strings.ToLower(strings.TrimSpace(input.Match))
+	if mode == "" {
+		mode = regexMatchSubstring
+	}
+	if mode != regexMatchSubstring && mode != regexMatchRun {
+		return nil, fmt.Errorf("%w: match must be '%s' or '%s', got '%s'", ErrInvalidMatchMode, regexMatchSubstring, regexMatchRun, input.Match)
+	}
+
+	if input.Style == nil {
+		return nil, fmt.Errorf("%w: style is required", ErrNoStyleProvided)
+	}
+	// The object ID is filled in per match; only the style and fields are reused here
+	styleRequest, appliedStyles := buildStyleTextRequest(StyleTextInput{Style: input.Style})
+	if styleRequest == nil {
+		return nil, ErrNoStyleProvided
+	}
+
+	t.config.Logger.Info("styling text by pattern",
+		slog.String("presentation_id", input.PresentationID),
+		slog.String("pattern", input.Pattern),
+		slog.String("match", mode),
+	)
+
+	// Create Slides service
+	slidesService, err := t.slidesServiceFactory(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create slides service: %v", ErrSlidesAPIError, err)
+	}
+
+	// Get the presentation
+	presentation, err := slidesService.GetPresentation(ctx, input.PresentationID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrPresentationNotFound
+		}
+		if isForbiddenError(err) {
+			return nil, ErrAccessDenied
+		}
+		return nil, fmt.Errorf("%w: %v", ErrSlidesAPIError, err)
+	}
+
+	// Collect match ranges
+	var targets []regexStyleTarget
+	for slideIdx, slide := range presentation.Slides {
+		if slide == nil {
+			continue
+		}
+		for _, target := range findRegexStyleTargets(slide.PageElements, re, mode == regexMatchRun) {
+			target.match.SlideIndex = slideIdx + 1
+			target.match.SlideID = slide.ObjectId
+			targets = append(targets, target)
+		}
+	}
+
+	matches := make([]StyleByRegexMatch, 0, len(targets))
+	for _, target := range targets {
+		matches = append(matches, target.match)
+	}
+
+	if len(targets) > 0 {
+		requests := buildRegexStyleRequests(targets, styleRequest.UpdateTextStyle)
+
+		_, err = slidesService.BatchUpdate(ctx, input.PresentationID, requests)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, ErrPresentationNotFound
+			}
+			if isForbiddenError(err) {
+				return nil, ErrAccessDenied
+			}
+			return nil, fmt.Errorf("%w: %v", ErrStyleByRegexFailed, err)
+		}
+	}
+
+	output := &StyleByRegexOutput{
+		PresentationID: input.PresentationID,
+		Pattern:        input.Pattern,
+		Match:          mode,
+		AppliedStyles:  appliedStyles,
+		MatchCount:     len(matches),
+		Matches:        matches,
+	}
+
+	t.config.Logger.Info("text styled by pattern successfully",
+		slog.String("presentation_id", input.PresentationID),
+		slog.Int("match_count", output.MatchCount),
+	)
+
+	return output, nil
+}
+
+// findRegexStyleTargets scans elements (recursing into groups and tables) for pattern matches.
+func findRegexStyleTargets(elements []*slides.PageElement, re *regexp.Regexp, wholeRun bool) []regexStyleTarget {
+	var targets []regexStyleTarget
+
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+
+		if element.ElementGroup != nil {
+			targets = append(targets, findRegexStyleTargets(element.ElementGroup.Children, re, wholeRun)...)
+			continue
+		}
+
+		if element.Shape != nil && element.Shape.Text != nil {
+			for _, match := range findRegexMatchRanges(element.Shape.Text, re, wholeRun) {
+				match.ObjectID = element.ObjectId
+				targets = append(targets, regexStyleTarget{match: match, objectID: element.ObjectId})
+			}
+		}
+
+		if element.Table != nil {
+			for rowIdx, row := range element.Table.TableRows {
+				if row == nil {
+					continue
+				}
+				for colIdx, cell := range row.TableCells {
+					if cell == nil || cell.Text == nil {
+						continue
+					}
+					for _, match := range findRegexMatchRanges(cell.Text, re, wholeRun) {
+						match.ObjectID = fmt.Sprintf("%s[%d,%d]", element.ObjectId, rowIdx, colIdx)
+						targets = append(targets, regexStyleTarget{
+							match:    match,
+							objectID: element.ObjectId,
+							cellLocation: &slides.TableCellLocation{
+								RowIndex:        int64(rowIdx),
+								ColumnIndex:     int64(colIdx),
+								ForceSendFields: []string{"RowIndex", "ColumnIndex"},
+							},
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return targets
+}
+
+// findRegexMatchRanges returns the ranges to style in a text body. In substring mode each
+// non-empty match is returned and may span runs; in run mode each text run containing a
+// match is returned once, without its trailing newline.
+func findRegexMatchRanges(textContent *slides.TextContent, re *regexp.Regexp, wholeRun bool) []StyleByRegexMatch {
+	var ranges []StyleByRegexMatch
+
+	if !wholeRun {
+		text := rawTextFromTextContent(textContent)
+		for _, loc := range re.FindAllStringIndex(text, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			ranges = append(ranges, StyleByRegexMatch{
+				StartIndex: utf16Index(text, loc[0]),
+				EndIndex:   utf16Index(text, loc[1]),
+				Text:       text[loc[0]:loc[1]],
+			})
+		}
+		return ranges
+	}
+
+	offset := 0
+	for _, textElement := range textContent.TextElements {
+		if textElement == nil {
+			continue
+		}
+		var content string
+		switch {
+		case textElement.TextRun != nil:
+			content = textElement.TextRun.Content
+		case textElement.AutoText != nil:
+			content = textElement.AutoText.Content
+		default:
+			continue
+		}
+
+		start := offset
+		offset += utf16Len(content)
+
+		runText := strings.TrimSuffix(content, "\n")
+		if runText == "" || !hasNonEmptyMatch(re, runText) {
+			continue
+		}
+		ranges = append(ranges, StyleByRegexMatch{
+			StartIndex: start,
+			EndIndex:   start + utf16Len(runText),
+			Text:       runText,
+		})
+	}
+	return ranges
+}
+
+// hasNonEmptyMatch reports whether the pattern matches at least one character of text,
+// so patterns like `\d*` do not select every run.
+func hasNonEmptyMatch(re *regexp.Regexp, text string) bool {
+	for _, loc := range re.FindAllStringIndex(text, -1) {
+		if loc[0] != loc[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// buildRegexStyleRequests creates one UpdateTextStyle request per matched range, sharing the
+// style and field mask of the template request.
+func buildRegexStyleRequests(targets []regexStyleTarget, template *slides.UpdateTextStyleRequest) []*slides.Request {
+	requests := make([]*slides.Request, 0, len(targets))
+	for _, target := range targets {
+		startIdx := int64(target.match.StartIndex)
+		endIdx := int64(target.match.EndIndex)
+		requests = append(requests, &slides.Request{
+			UpdateTextStyle: &slides.UpdateTextStyleRequest{
+				ObjectId:     target.objectID,
+				CellLocation: target.cellLocation,
+				Style:        template.Style,
+				TextRange: &slides.Range{
+					Type:       "FIXED_RANGE",
+					StartIndex: &startIdx,
+					EndIndex:   &endIdx,
+				},
+				Fields: template.Fields,
+			},
+		})
+	}
+	return requests
+}
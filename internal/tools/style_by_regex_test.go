@@ -0,0 +1,254 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/slides/v1"
+)
+
+func styleByRegexTestPresentation() *slides.Presentation {
+	return &slides.Presentation{
+		PresentationId: "pres-1",
+		Slides: []*slides.Page{
+			{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "kpi-box",
+						Shape: &slides.Shape{
+							Text: &slides.TextContent{
+								TextElements: []*slides.TextElement{
+									{TextRun: &slides.TextRun{Content: "Revenue: 120 "}},
+									{TextRun: &slides.TextRun{Content: "units\n"}},
+									{TextRun: &slides.TextRun{Content: "Churn -4\n"}},
+								},
+							},
+						},
+					},
+					{
+						ObjectId: "group-1",
+						ElementGroup: &slides.Group{
+							Children: []*slides.PageElement{
+								{
+									ObjectId: "no-numbers",
+									Shape: &slides.Shape{
+										Text: &slides.TextContent{
+											TextElements: []*slides.TextElement{
+												{TextRun: &slides.TextRun{Content: "Summary\n"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				ObjectId: "slide-2",
+				PageElements: []*slides.PageElement{
+					{
+						ObjectId: "table-1",
+						Table: &slides.Table{
+							TableRows: []*slides.TableRow{
+								{
+									TableCells: []*slides.TableCell{
+										{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+											{TextRun: &slides.TextRun{Content: "Q1\n"}},
+										}}},
+										{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+											{TextRun: &slides.TextRun{Content: "n/a\n"}},
+										}}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newStyleByRegexTestTools(captured *[]*slides.Request) *Tools {
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			return styleByRegexTestPresentation(), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			*captured = requests
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	return NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+}
+
+func TestStyleByRegex_ColorsNumbersRed(t *testing.T) {
+	var captured []*slides.Request
+	tools := newStyleByRegexTestTools(&captured)
+
+	output, err := tools.StyleByRegex(context.Background(), &mockTokenSource{}, StyleByRegexInput{
+		PresentationID: "pres-1",
+		Pattern:        `-?\d+`,
+		Style:          &StyleTextStyleSpec{ForegroundColor: "#FF0000"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []StyleByRegexMatch{
+		{SlideIndex: 1, SlideID: "slide-1", ObjectID: "kpi-box", StartIndex: 9, EndIndex: 12, Text: "120"},
+		{SlideIndex: 1, SlideID: "slide-1", ObjectID: "kpi-box", StartIndex: 25, EndIndex: 27, Text: "-4"},
+		{SlideIndex: 2, SlideID: "slide-2", ObjectID: "table-1[0,0]", StartIndex: 1, EndIndex: 2, Text: "1"},
+	}
+	if output.MatchCount != len(want) {
+		t.Fatalf("expected %d matches, got %d: %+v", len(want), output.MatchCount, output.Matches)
+	}
+	for i, w := range want {
+		if output.Matches[i] != w {
+			t.Errorf("match %d = %+v, want %+v", i, output.Matches[i], w)
+		}
+	}
+
+	if len(captured) != len(want) {
+		t.Fatalf("expected %d requests, got %d", len(want), len(captured))
+	}
+	red := parseHexColor("#FF0000")
+	for i, req := range captured {
+		update := req.UpdateTextStyle
+		if update == nil {
+			t.Fatalf("request %d is not UpdateTextStyle", i)
+		}
+		if update.Fields != "foregroundColor" {
+			t.Errorf("request %d fields = %q, want foregroundColor", i, update.Fields)
+		}
+		if !rgbEqual(update.Style.ForegroundColor.OpaqueColor.RgbColor, red) {
+			t.Errorf("request %d color = %+v, want red", i, update.Style.ForegroundColor.OpaqueColor.RgbColor)
+		}
+		if update.TextRange.Type != "FIXED_RANGE" ||
+			*update.TextRange.StartIndex != int64(want[i].StartIndex) ||
+			*update.TextRange.EndIndex != int64(want[i].EndIndex) {
+			t.Errorf("request %d range = %s [%d, %d), want FIXED_RANGE [%d, %d)", i, update.TextRange.Type,
+				*update.TextRange.StartIndex, *update.TextRange.EndIndex, want[i].StartIndex, want[i].EndIndex)
+		}
+	}
+
+	cell := captured[2].UpdateTextStyle
+	if cell.ObjectId != "table-1" || cell.CellLocation == nil || cell.CellLocation.RowIndex != 0 || cell.CellLocation.ColumnIndex != 0 {
+		t.Errorf("expected table cell [0,0] of table-1, got %s %+v", cell.ObjectId, cell.CellLocation)
+	}
+}
+
+func TestStyleByRegex_WholeRun(t *testing.T) {
+	var captured []*slides.Request
+	tools := newStyleByRegexTestTools(&captured)
+
+	output, err := tools.StyleByRegex(context.Background(), &mockTokenSource{}, StyleByRegexInput{
+		PresentationID: "pres-1",
+		Pattern:        `\d+`,
+		Match:          "RUN",
+		Style:          &StyleTextStyleSpec{ForegroundColor: "#FF0000"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []StyleByRegexMatch{
+		{SlideIndex: 1, SlideID: "slide-1", ObjectID: "kpi-box", StartIndex: 0, EndIndex: 13, Text: "Revenue: 120 "},
+		{SlideIndex: 1, SlideID: "slide-1", ObjectID: "kpi-box", StartIndex: 19, EndIndex: 27, Text: "Churn -4"},
+		{SlideIndex: 2, SlideID: "slide-2", ObjectID: "table-1[0,0]", StartIndex: 0, EndIndex: 2, Text: "Q1"},
+	}
+	if output.Match != regexMatchRun {
+		t.Errorf("expected match mode %q, got %q", regexMatchRun, output.Match)
+	}
+	if output.MatchCount != len(want) {
+		t.Fatalf("expected %d matches, got %d: %+v", len(want), output.MatchCount, output.Matches)
+	}
+	for i, w := range want {
+		if output.Matches[i] != w {
+			t.Errorf("match %d = %+v, want %+v", i, output.Matches[i], w)
+		}
+	}
+	if len(captured) != len(want) {
+		t.Errorf("expected %d requests, got %d", len(want), len(captured))
+	}
+}
+
+func TestStyleByRegex_NoMatchesSkipsBatchUpdate(t *testing.T) {
+	var captured []*slides.Request
+	tools := newStyleByRegexTestTools(&captured)
+
+	// `[€£]*` only produces empty matches here, which must not select anything
+	for _, mode := range []string{regexMatchSubstring, regexMatchRun} {
+		output, err := tools.StyleByRegex(context.Background(), &mockTokenSource{}, StyleByRegexInput{
+			PresentationID: "pres-1",
+			Pattern:        `[€£]*`,
+			Match:          mode,
+			Style:          &StyleTextStyleSpec{Bold: boolPtr(true)},
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", mode, err)
+		}
+		if output.MatchCount != 0 || len(output.Matches) != 0 {
+			t.Errorf("%s: expected no matches, got %+v", mode, output.Matches)
+		}
+	}
+	if captured != nil {
+		t.Errorf("expected no batch update, got %d requests", len(captured))
+	}
+}
+
+func TestStyleByRegex_ValidationErrors(t *testing.T) {
+	red := &StyleTextStyleSpec{ForegroundColor: "#FF0000"}
+
+	tests := []struct {
+		name    string
+		input   StyleByRegexInput
+		wantErr error
+	}{
+		{name: "missing presentation ID", input: StyleByRegexInput{Pattern: `\d+`, Style: red}, wantErr: ErrInvalidPresentationID},
+		{name: "missing pattern", input: StyleByRegexInput{PresentationID: "pres-1", Style: red}, wantErr: ErrInvalidPattern},
+		{name: "pattern does not compile", input: StyleByRegexInput{PresentationID: "pres-1", Pattern: `(\d+`, Style: red}, wantErr: ErrInvalidPattern},
+		{name: "unknown match mode", input: StyleByRegexInput{PresentationID: "pres-1", Pattern: `\d+`, Match: "word", Style: red}, wantErr: ErrInvalidMatchMode},
+		{name: "missing style", input: StyleByRegexInput{PresentationID: "pres-1", Pattern: `\d+`}, wantErr: ErrNoStyleProvided},
+		{name: "empty style", input: StyleByRegexInput{PresentationID: "pres-1", Pattern: `\d+`, Style: &StyleTextStyleSpec{}}, wantErr: ErrNoStyleProvided},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+				t.Fatal("service must not be created for invalid input")
+				return nil, nil
+			})
+
+			_, err := tools.StyleByRegex(context.Background(), &mockTokenSource{}, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFindRegexMatchRanges_UTF16Indices(t *testing.T) {
+	textContent := &slides.TextContent{TextElements: []*slides.TextElement{
+		{TextRun: &slides.TextRun{Content: "🚀 Launch café "}},
+		{TextRun: &slides.TextRun{Content: "in 2024\n"}},
+	}}
+	re := regexp.MustCompile(`\d+`)
+
+	substring := findRegexMatchRanges(textContent, re, false)
+	if len(substring) != 1 || substring[0].StartIndex != 18 || substring[0].EndIndex != 22 {
+		t.Errorf("substring matches = %+v, want 2024 at 18-22", substring)
+	}
+
+	wholeRun := findRegexMatchRanges(textContent, re, true)
+	if len(wholeRun) != 1 || wholeRun[0].StartIndex != 15 || wholeRun[0].EndIndex != 22 {
+		t.Errorf("run matches = %+v, want 'in 2024' at 15-22", wholeRun)
+	}
+}
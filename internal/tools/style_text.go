@@ -21,8 +21,8 @@ var (
 type StyleTextInput struct {
 	PresentationID string              `json:"presentation_id"`
 	ObjectID       string              `json:"object_id"`
-	StartIndex     *int                `json:"start_index,omitempty"` // Optional, whole text if omitted
-	EndIndex       *int                `json:"end_index,omitempty"`   // Optional, whole text if omitted
+	StartIndex     *int                `json:"start_index,omitempty"` // Optional, in UTF-16 code units; whole text if omitted
+	EndIndex       *int                `json:"end_index,omitempty"`   // Optional, in UTF-16 code units; whole text if omitted
 	Style          *StyleTextStyleSpec `json:"style"`
 }
 
@@ -0,0 +1,49 @@
+package tools
+
+import "unicode/utf8"
+
+// The Slides API indexes text in UTF-16 code units, while Go strings are indexed in bytes.
+// The two only agree for ASCII: "é" is 2 bytes but 1 unit, and an emoji such as "😀" is
+// 4 bytes but 2 units (a surrogate pair). Any FIXED_RANGE built from Go string offsets must
+// be converted with these helpers first.
+
+// utf16Len returns the length of s in UTF-16 code units.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16RuneLen(r)
+	}
+	return n
+}
+
+// utf16Index converts a byte offset into s to the matching UTF-16 index. Offsets past the
+// end of s are clamped to its length.
+func utf16Index(s string, byteOffset int) int {
+	if byteOffset > len(s) {
+		byteOffset = len(s)
+	}
+	return utf16Len(s[:byteOffset])
+}
+
+// utf16RuneLen returns how many UTF-16 code units encode r. Runes outside the Basic
+// Multilingual Plane need a surrogate pair; invalid bytes decode to U+FFFD, a single unit.
+func utf16RuneLen(r rune) int {
+	if r > 0xFFFF && r <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
+
+// utf16ToByteOffset converts a UTF-16 index into s to the matching byte offset, so API indices
+// can be used to slice Go strings. An index inside a surrogate pair rounds up to the end of
+// that rune, and indices past the end of s are clamped to len(s).
+func utf16ToByteOffset(s string, index int) int {
+	units := 0
+	for offset, r := range s {
+		if units >= index {
+			return offset
+		}
+		units += utf16RuneLen(r)
+	}
+	return len(s)
+}
@@ -0,0 +1,74 @@
+package tools
+
+import "testing"
+
+func TestUTF16Len(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{"empty", "", 0},
+		{"ascii", "Hello", 5},
+		{"accented", "café", 4},
+		{"emoji", "😀", 2},
+		{"mixed", "Go 🚀 café\n", 11},
+		{"invalid byte", "a\xffb", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := utf16Len(tt.text); got != tt.want {
+				t.Errorf("utf16Len(%q) = %d, want %d", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUTF16Index(t *testing.T) {
+	text := "🚀 Launch café"
+	tests := []struct {
+		name       string
+		byteOffset int
+		want       int
+	}{
+		{"start", 0, 0},
+		{"after emoji", 4, 2},
+		{"word after emoji", 5, 3},
+		{"end", len(text), 14},
+		{"past end", len(text) + 10, 14},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := utf16Index(text, tt.byteOffset); got != tt.want {
+				t.Errorf("utf16Index(%d) = %d, want %d", tt.byteOffset, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUTF16ToByteOffset(t *testing.T) {
+	text := "a😀é!"
+	tests := []struct {
+		name  string
+		index int
+		want  int
+	}{
+		{"start", 0, 0},
+		{"before emoji", 1, 1},
+		{"inside surrogate pair", 2, 5},
+		{"after emoji", 3, 5},
+		{"after accent", 4, 7},
+		{"end", 5, 8},
+		{"past end", 9, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := utf16ToByteOffset(text, tt.index); got != tt.want {
+				t.Errorf("utf16ToByteOffset(%d) = %d, want %d", tt.index, got, tt.want)
+			}
+		})
+	}
+}
@@ -81,6 +81,11 @@ type DriveService interface {
 	CreateReply(ctx context.Context, fileID, commentID string, reply *drive.Reply) (*drive.Reply, error)
 	UpdateComment(ctx context.Context, fileID, commentID string, comment *drive.Comment) (*drive.Comment, error)
 	DeleteComment(ctx context.Context, fileID, commentID string) error
+	TrashFile(ctx context.Context, fileID string) error
+	DeleteFile(ctx context.Context, fileID string) error
+	GetFile(ctx context.Context, fileID string, fields googleapi.Field) (*drive.File, error)
+	GetAbout(ctx context.Context) (*drive.About, error)
+	GetFileETag(ctx context.Context, fileID string) (string, error)
 }
 
 // DriveServiceFactory creates a Drive service from a token source.
@@ -224,6 +229,57 @@ func (s *realDriveService) DeleteComment(ctx context.Context, fileID, commentID
 		Do()
 }
 
+// TrashFile moves a file to the trash.
+func (s *realDriveService) TrashFile(ctx context.Context, fileID string) error {
+	_, err := s.service.Files.Update(fileID, &drive.File{Trashed: true}).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	return err
+}
+
+// DeleteFile permanently deletes a file, bypassing the trash.
+func (s *realDriveService) DeleteFile(ctx context.Context, fileID string) error {
+	return s.service.Files.Delete(fileID).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+}
+
+// GetFile returns a file's metadata, limited to fields when set.
+func (s *realDriveService) GetFile(ctx context.Context, fileID string, fields googleapi.Field) (*drive.File, error) {
+	call := s.service.Files.Get(fileID).
+		SupportsAllDrives(true).
+		Context(ctx)
+
+	if fields != "" {
+		call = call.Fields(fields)
+	}
+
+	return call.Do()
+}
+
+// GetAbout returns information about the authenticated user.
+func (s *realDriveService) GetAbout(ctx context.Context) (*drive.About, error) {
+	return s.service.About.Get().
+		Fields("user(displayName,emailAddress)").
+		Context(ctx).
+		Do()
+}
+
+// GetFileETag returns the ETag header Drive sends with a file's metadata.
+func (s *realDriveService) GetFileETag(ctx context.Context, fileID string) (string, error) {
+	file, err := s.service.Files.Get(fileID).
+		Fields("id").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", err
+	}
+	return file.Header.Get("ETag"), nil
+}
+
 // NewRealDriveServiceFactory returns a factory that creates real Drive services.
 func NewRealDriveServiceFactory() DriveServiceFactory {
 	return func(ctx context.Context, tokenSource oauth2.TokenSource) (DriveService, error) {
@@ -305,13 +361,37 @@ func NewRealTranslateServiceFactory() TranslateServiceFactory {
 
 // ToolsConfig holds configuration for the tools.
 type ToolsConfig struct {
-	Logger *slog.Logger
+	Logger       *slog.Logger
+	SlideIDCache *SlideIDCache // Optional session-scoped slide ID cache (nil disables caching)
+	StrictInputs bool          // Reject inputs with fields that do not apply to the chosen mode
+
+	// AllowedImageFormats restricts uploaded images to these formats, given as MIME types
+	// ("image/png") or short names ("png", "jpeg"). Empty allows every detected format.
+	AllowedImageFormats []string
+
+	// MaxBatchOperations caps the operations accepted by one batch_update call.
+	// Zero or negative uses DefaultMaxBatchOperations.
+	MaxBatchOperations int
+
+	// LinkColor is the hex color manage_hyperlinks uses for style_link when the call gives none.
+	// Empty uses DefaultLinkColor.
+	LinkColor string
+
+	// FeatureFlags lets operators disable individual tools by name. Nil enables every tool.
+	FeatureFlags FeatureFlags
 }
 
+// DefaultMaxBatchOperations is the batch_update operation limit when none is configured.
+const DefaultMaxBatchOperations = 500
+
+// DefaultLinkColor is the conventional hyperlink blue used by Google editors.
+const DefaultLinkColor = "#1155CC"
+
 // DefaultToolsConfig returns default configuration.
 func DefaultToolsConfig() ToolsConfig {
 	return ToolsConfig{
-		Logger: slog.Default(),
+		Logger:             slog.Default(),
+		MaxBatchOperations: DefaultMaxBatchOperations,
 	}
 }
 
@@ -340,6 +420,9 @@ func NewToolsWithAllServices(config ToolsConfig, slidesFactory SlidesServiceFact
 	if config.Logger == nil {
 		config.Logger = slog.Default()
 	}
+	if config.MaxBatchOperations <= 0 {
+		config.MaxBatchOperations = DefaultMaxBatchOperations
+	}
 	if slidesFactory == nil {
 		slidesFactory = NewRealSlidesServiceFactory()
 	}
@@ -349,6 +432,9 @@ func NewToolsWithAllServices(config ToolsConfig, slidesFactory SlidesServiceFact
 	if translateFactory == nil {
 		translateFactory = NewRealTranslateServiceFactory()
 	}
+	if config.SlideIDCache != nil {
+		slidesFactory = newSlideIDCachingFactory(slidesFactory, config.SlideIDCache)
+	}
 
 	return &Tools{
 		config:                  config,
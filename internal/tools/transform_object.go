@@ -22,8 +22,9 @@ type TransformObjectInput struct {
 	ObjectID            string         `json:"object_id"`
 	Position            *PositionInput `json:"position,omitempty"`
 	Size                *SizeInput     `json:"size,omitempty"`
-	Rotation            *float64       `json:"rotation,omitempty"` // Degrees 0-360
+	Rotation            *float64       `json:"rotation,omitempty"`             // Degrees 0-360
 	ScaleProportionally bool           `json:"scale_proportionally,omitempty"` // Default true
+	ReturnFinalState    bool           `json:"return_final_state,omitempty"`   // Re-fetch and report the resulting geometry
 }
 
 // TransformObjectOutput represents the output of the transform_object tool.
@@ -31,6 +32,8 @@ type TransformObjectOutput struct {
 	Position *Position `json:"position"`
 	Size     *Size     `json:"size"`
 	Rotation float64   `json:"rotation"`
+
+	FinalState *ObjectState `json:"final_state,omitempty"` // Only with return_final_state
 }
 
 // TransformObject moves, resizes, or rotates an object.
@@ -104,12 +107,12 @@ func (t *Tools) TransformObject(ctx context.Context, tokenSource oauth2.TokenSou
 	// The Slides API documentation says:
 	// "The size of the element is derived from the size of the bounding box of the transformed element."
 	// Actually, changing size usually implies changing the Scale factors in the transform, OR using UpdatePageElementTransformRequest with ABSOLUTE mode.
-	
+
 	req := &slides.Request{
 		UpdatePageElementTransform: &slides.UpdatePageElementTransformRequest{
-			ObjectId:     input.ObjectID,
-			Transform:    newTransform,
-			ApplyMode:    "ABSOLUTE",
+			ObjectId:  input.ObjectID,
+			Transform: newTransform,
+			ApplyMode: "ABSOLUTE",
 		},
 	}
 
@@ -131,6 +134,10 @@ func (t *Tools) TransformObject(ctx context.Context, tokenSource oauth2.TokenSou
 		Rotation: newRotation,
 	}
 
+	if input.ReturnFinalState {
+		output.FinalState = t.readBackObjectState(ctx, slidesService, input.PresentationID, input.ObjectID)
+	}
+
 	return output, nil
 }
 
@@ -161,7 +168,7 @@ func findElementByIDRecursively(slidesList []*slides.Page, objectID string) *sli
 // UpdatePageElementTransformRequest documentation:
 // "Updates the transform of a page element. Updating the transform of a group will change the absolute transform of the page elements in that group..."
 // It doesn't explicitly accept a "Size". Size is a property of the element.
-// 
+//
 // Wait, CreateShape allows setting Size. But UpdatePageElementTransform is for the matrix.
 // Does resizing an element change its Size property or its Transform scale?
 // Usually, for Shapes/Images, Size is the un-transformed size, and Transform puts it on the page.
@@ -169,13 +176,13 @@ func findElementByIDRecursively(slidesList []*slides.Page, objectID string) *sli
 //
 // Actually, creating a request with `UpdatePageElementTransform` is the standard way to move/resize/rotate.
 // If we change size, we effectively calculate new ScaleX/ScaleY factors based on the ratio: NewSize / OriginalSize (assuming we keep original element size constant and just scale it).
-// OR we can't change the base Size of an existing element easily? 
+// OR we can't change the base Size of an existing element easily?
 // No, the `Size` field in PageElement is output only for some types?
 // Let's assume we treat the `Size` in the API response as the visual size *after* transform?
 // API Docs: "Size of the page element. This property is read-only." -> So we MUST use Transform to resize.
 //
 // So: VisualWidth = BaseWidth * ScaleX (roughly, with rotation mixing it up).
-// 
+//
 // Strategy:
 // 1. Decompose current transform to get current Translation, Scale, Rotation/Shear.
 // 2. Apply requested changes.
@@ -193,15 +200,15 @@ func calculateNewTransform(current *slides.AffineTransform, currentSize *slides.
 	// Sx = sqrt(ScaleX^2 + ShearY^2)
 	// Sy = sqrt(ScaleY^2 + ShearX^2)
 	// Rotation = atan2(ShearY, ScaleX)
-	
+
 	sx := math.Sqrt(current.ScaleX*current.ScaleX + current.ShearY*current.ShearY)
 	sy := math.Sqrt(current.ScaleY*current.ScaleY + current.ShearX*current.ShearX)
-	
+
 	// Rotation in radians
 	currentAngle := math.Atan2(current.ShearY, current.ScaleX)
-	
+
 	// 2. Apply updates
-	
+
 	// Position
 	if input.Position != nil {
 		tx = pointsToEMU(input.Position.X)
@@ -222,14 +229,14 @@ func calculateNewTransform(current *slides.AffineTransform, currentSize *slides.
 	// VisualHeight = BaseHeight * sy
 	// If input provides new Width/Height (in points), we need BaseWidth/BaseHeight to calc new sx/sy.
 	// Since Size is read-only, we assume currentSize represents BaseSize?
-	// API Docs: "The size of the page element." 
-	// Actually, `Size` returned by API is usually the bounding box size *without* rotation? 
+	// API Docs: "The size of the page element."
+	// Actually, `Size` returned by API is usually the bounding box size *without* rotation?
 	// Or is it the base size?
 	// Let's assume `Size` + `Transform` determines visual appearance.
 	// So `Size` provided in `element` is the base size $W_{base}, H_{base}$.
 	// We want new visual size $W_{new}, H_{new}$.
 	// New $s_x = W_{new} / W_{base}$.
-	
+
 	if input.Size != nil {
 		if currentSize == nil || currentSize.Width == nil || currentSize.Height == nil {
 			// Fallback: If we can't get base size, we can't resize accurately unless we assume current scale is 1?
@@ -237,15 +244,8 @@ func calculateNewTransform(current *slides.AffineTransform, currentSize *slides.
 			return nil, nil, 0, errors.New("cannot resize object with unknown base size")
 		}
 
-		baseWidth := currentSize.Width.Magnitude // In EMU? Size struct has Unit.
-		if currentSize.Width.Unit == "PT" {
-			baseWidth = pointsToEMU(baseWidth)
-		}
-		
-		baseHeight := currentSize.Height.Magnitude
-		if currentSize.Height.Unit == "PT" {
-			baseHeight = pointsToEMU(baseHeight)
-		}
+		baseWidth := convertToEMU(currentSize.Width)
+		baseHeight := convertToEMU(currentSize.Height)
 
 		originalSx := sx
 		originalSy := sy
@@ -253,7 +253,7 @@ func calculateNewTransform(current *slides.AffineTransform, currentSize *slides.
 		if input.Size.Width > 0 {
 			targetWidthEMU := pointsToEMU(input.Size.Width)
 			sx = targetWidthEMU / baseWidth
-			
+
 			if input.Size.Height <= 0 && input.ScaleProportionally {
 				if originalSx != 0 {
 					sy = originalSy * (sx / originalSx)
@@ -265,12 +265,12 @@ func calculateNewTransform(current *slides.AffineTransform, currentSize *slides.
 
 		if input.Size.Height > 0 {
 			targetHeightEMU := pointsToEMU(input.Size.Height)
-			
+
 			// If Width was also set, we just set Height independently
 			// If Width NOT set, we calculate sy, and maybe sx.
 			if input.Size.Width <= 0 {
 				sy = targetHeightEMU / baseHeight
-				
+
 				if input.ScaleProportionally {
 					if originalSy != 0 {
 						sx = originalSx * (sy / originalSy)
@@ -288,13 +288,13 @@ func calculateNewTransform(current *slides.AffineTransform, currentSize *slides.
 	// 3. Recompose transform matrix
 	// [ sx*cos(a)   -sy*sin(a)   tx ]
 	// [ sx*sin(a)    sy*cos(a)   ty ]
-	
+
 	cosA := math.Cos(newAngle)
 	sinA := math.Sin(newAngle)
 
 	newTransform := &slides.AffineTransform{
 		ScaleX:     sx * cosA,
-		ShearY:     sx * sinA, // Google's ShearY corresponds to (1,0) mapping to (ScaleX, ShearY)
+		ShearY:     sx * sinA,  // Google's ShearY corresponds to (1,0) mapping to (ScaleX, ShearY)
 		ShearX:     -sy * sinA, // Google's ShearX corresponds to (0,1) mapping to (ShearX, ScaleY)
 		ScaleY:     sy * cosA,
 		TranslateX: tx,
@@ -305,12 +305,10 @@ func calculateNewTransform(current *slides.AffineTransform, currentSize *slides.
 	// Calculated visual size for output
 	var visualWidth, visualHeight float64
 	if currentSize != nil {
-		// Use magnitude directly as we are multiplying by scale factor
-		bw := currentSize.Width.Magnitude
-		bh := currentSize.Height.Magnitude
-		if currentSize.Width.Unit == "PT" { bw = pointsToEMU(bw) }
-		if currentSize.Height.Unit == "PT" { bh = pointsToEMU(bh) }
-		
+		// Use the base size in EMU as we are multiplying by scale factor
+		bw := convertToEMU(currentSize.Width)
+		bh := convertToEMU(currentSize.Height)
+
 		visualWidth = bw * sx
 		visualHeight = bh * sy
 	}
@@ -245,3 +245,116 @@ func TestTransformObject(t *testing.T) {
 func float64PtrTransform(v float64) *float64 {
 	return &v
 }
+
+func TestTransformObject_ReturnFinalState(t *testing.T) {
+	ctx := context.Background()
+
+	// The second fetch reflects what the API actually stored after the update
+	elementAt := func(x, y float64) *slides.Presentation {
+		return &slides.Presentation{
+			Slides: []*slides.Page{{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{{
+					ObjectId: "shape-1",
+					Size: &slides.Size{
+						Width:  &slides.Dimension{Magnitude: pointsToEMU(100), Unit: "EMU"},
+						Height: &slides.Dimension{Magnitude: pointsToEMU(50), Unit: "EMU"},
+					},
+					Transform: &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: pointsToEMU(x), TranslateY: pointsToEMU(y), Unit: "EMU"},
+				}},
+			}},
+		}
+	}
+
+	for _, returnFinalState := range []bool{false, true} {
+		getCalls := 0
+		mockService := &mockSlidesService{
+			GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+				getCalls++
+				if getCalls == 1 {
+					return elementAt(10, 20), nil
+				}
+				return elementAt(50, 61), nil
+			},
+			BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+				return &slides.BatchUpdatePresentationResponse{}, nil
+			},
+		}
+		tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+			return mockService, nil
+		})
+
+		output, err := tools.TransformObject(ctx, nil, TransformObjectInput{
+			PresentationID:   "pres",
+			ObjectID:         "shape-1",
+			Position:         &PositionInput{X: 50, Y: 60},
+			ReturnFinalState: returnFinalState,
+		})
+		require.NoError(t, err)
+
+		if !returnFinalState {
+			assert.Equal(t, 1, getCalls, "no read-back expected by default")
+			assert.Nil(t, output.FinalState)
+			continue
+		}
+
+		assert.Equal(t, 2, getCalls)
+		require.NotNil(t, output.FinalState)
+		require.NotNil(t, output.FinalState.Position)
+		assert.Equal(t, 50.0, output.FinalState.Position.X)
+		assert.Equal(t, 61.0, output.FinalState.Position.Y)
+		assert.Equal(t, 100.0, output.FinalState.Size.Width)
+	}
+}
+
+func TestTransformObject_ReturnFinalStateAfterResize(t *testing.T) {
+	ctx := context.Background()
+
+	// The API keeps the intrinsic 100x50 size and stores the resize as scale factors
+	elementWithScale := func(scaleX, scaleY float64) *slides.Presentation {
+		return &slides.Presentation{
+			Slides: []*slides.Page{{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{{
+					ObjectId: "shape-1",
+					Size: &slides.Size{
+						Width:  &slides.Dimension{Magnitude: pointsToEMU(100), Unit: "EMU"},
+						Height: &slides.Dimension{Magnitude: pointsToEMU(50), Unit: "EMU"},
+					},
+					Transform: &slides.AffineTransform{ScaleX: scaleX, ScaleY: scaleY, TranslateX: pointsToEMU(10), TranslateY: pointsToEMU(20), Unit: "EMU"},
+				}},
+			}},
+		}
+	}
+
+	getCalls := 0
+	mockService := &mockSlidesService{
+		GetPresentationFunc: func(ctx context.Context, presentationID string) (*slides.Presentation, error) {
+			getCalls++
+			if getCalls == 1 {
+				return elementWithScale(1, 1), nil
+			}
+			return elementWithScale(2, 1.5), nil
+		},
+		BatchUpdateFunc: func(ctx context.Context, presentationID string, requests []*slides.Request) (*slides.BatchUpdatePresentationResponse, error) {
+			return &slides.BatchUpdatePresentationResponse{}, nil
+		},
+	}
+	tools := NewTools(DefaultToolsConfig(), func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+		return mockService, nil
+	})
+
+	output, err := tools.TransformObject(ctx, nil, TransformObjectInput{
+		PresentationID:   "pres",
+		ObjectID:         "shape-1",
+		Size:             &SizeInput{Width: 200, Height: 75},
+		ReturnFinalState: true,
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, output.FinalState)
+	require.NotNil(t, output.FinalState.Size)
+	assert.InDelta(t, 200.0, output.FinalState.Size.Width, 0.01)
+	assert.InDelta(t, 75.0, output.FinalState.Size.Height, 0.01)
+	assert.Equal(t, 10.0, output.FinalState.Position.X)
+}
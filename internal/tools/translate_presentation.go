@@ -132,6 +132,11 @@ func (t *Tools) TranslatePresentation(ctx context.Context, tokenSource oauth2.To
 		return nil, fmt.Errorf("%w: no text found in the specified scope", ErrNoTextToTranslate)
 	}
 
+	// Three steps: collect the text, translate it, write it back
+	progress := ProgressFromContext(ctx)
+	const totalSteps = 3
+	progress.Report(1, totalSteps, fmt.Sprintf("Collected %d text elements", len(textElements)))
+
 	// Extract all texts for batch translation
 	texts := make([]string, len(textElements))
 	for i, elem := range textElements {
@@ -147,6 +152,7 @@ func (t *Tools) TranslatePresentation(ctx context.Context, tokenSource oauth2.To
 	if len(translatedTexts) != len(texts) {
 		return nil, fmt.Errorf("%w: translation count mismatch", ErrTranslateFailed)
 	}
+	progress.Report(2, totalSteps, fmt.Sprintf("Translated %d text elements", len(texts)))
 
 	// Build batch update requests to replace text
 	requests := make([]*slides.Request, 0, len(textElements)*2)
@@ -205,6 +211,7 @@ func (t *Tools) TranslatePresentation(ctx context.Context, tokenSource oauth2.To
 		}
 		return nil, fmt.Errorf("%w: %v", ErrTranslateFailed, err)
 	}
+	progress.Report(totalSteps, totalSteps, "Applied translations")
 
 	// Build affected slides list
 	affectedSlides := make([]int, 0, len(affectedSlidesMap))
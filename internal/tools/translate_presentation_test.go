@@ -841,6 +841,53 @@ func TestTranslatePresentation(t *testing.T) {
 	}
 }
 
+func TestTranslatePresentation_ReportsProgress(t *testing.T) {
+	slidesMock := &mockSlidesServiceForTranslate{
+		presentation: &slides.Presentation{
+			PresentationId: "pres-123",
+			Slides: []*slides.Page{{
+				ObjectId: "slide-1",
+				PageElements: []*slides.PageElement{{
+					ObjectId: "shape-1",
+					Shape: &slides.Shape{
+						ShapeType: "TEXT_BOX",
+						Text: &slides.TextContent{TextElements: []*slides.TextElement{
+							{TextRun: &slides.TextRun{Content: "Hello World"}},
+						}},
+					},
+				}},
+			}},
+		},
+	}
+	tools := NewToolsWithAllServices(
+		DefaultToolsConfig(),
+		func(ctx context.Context, ts oauth2.TokenSource) (SlidesService, error) {
+			return slidesMock, nil
+		},
+		nil,
+		func(ctx context.Context, ts oauth2.TokenSource) (TranslateService, error) {
+			return &mockTranslateService{}, nil
+		},
+	)
+
+	recorder := &progressRecorder{}
+	ctx := WithProgress(context.Background(), recorder)
+	if _, err := tools.TranslatePresentation(ctx, nil, TranslatePresentationInput{PresentationID: "pres-123", TargetLanguage: "fr"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Collect, translate, write back
+	wantCompleted := []int{1, 2, 3}
+	if len(recorder.completed) != len(wantCompleted) {
+		t.Fatalf("expected %d progress reports, got %d", len(wantCompleted), len(recorder.completed))
+	}
+	for i, want := range wantCompleted {
+		if recorder.completed[i] != want || recorder.totals[i] != 3 {
+			t.Errorf("report %d = %d/%d, want %d/3", i, recorder.completed[i], recorder.totals[i], want)
+		}
+	}
+}
+
 func TestCollectTextElements(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -0,0 +1,71 @@
+package tools
+
+import "google.golang.org/api/slides/v1"
+
+// Page geometry in the Slides API is expressed in EMU (English Metric Units), while tool
+// inputs and outputs use points. All conversions go through these constants.
+const (
+	emuPerPoint   = 12700.0
+	emuPerInch    = 914400.0
+	emuPerCM      = 360000.0
+	pointsPerInch = emuPerInch / emuPerPoint // 72
+)
+
+// pointsToEMU converts points to EMU.
+func pointsToEMU(points float64) float64 {
+	return points * emuPerPoint
+}
+
+// emuToPoints converts EMU to points.
+func emuToPoints(emu float64) float64 {
+	return emu / emuPerPoint
+}
+
+// inchesToEMU converts inches to EMU.
+func inchesToEMU(inches float64) float64 {
+	return inches * emuPerInch
+}
+
+// emuToInches converts EMU to inches.
+func emuToInches(emu float64) float64 {
+	return emu / emuPerInch
+}
+
+// cmToEMU converts centimeters to EMU.
+func cmToEMU(cm float64) float64 {
+	return cm * emuPerCM
+}
+
+// emuToCM converts EMU to centimeters.
+func emuToCM(emu float64) float64 {
+	return emu / emuPerCM
+}
+
+// convertToPoints converts a Dimension to points.
+// Magnitudes without a known unit are taken as points.
+func convertToPoints(dim *slides.Dimension) float64 {
+	if dim == nil {
+		return 0
+	}
+
+	switch dim.Unit {
+	case "PT":
+		return dim.Magnitude
+	case "EMU":
+		return emuToPoints(dim.Magnitude)
+	default:
+		return dim.Magnitude
+	}
+}
+
+// convertToEMU converts a Dimension to EMU.
+// Magnitudes without a known unit are taken as EMU, the unit the API reports geometry in.
+func convertToEMU(dim *slides.Dimension) float64 {
+	if dim == nil {
+		return 0
+	}
+	if dim.Unit == "PT" {
+		return pointsToEMU(dim.Magnitude)
+	}
+	return dim.Magnitude
+}
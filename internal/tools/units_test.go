@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"math"
+	"testing"
+
+	"google.golang.org/api/slides/v1"
+)
+
+func TestUnitConstants(t *testing.T) {
+	if emuPerPoint != 12700 || emuPerInch != 914400 || emuPerCM != 360000 {
+		t.Errorf("unexpected EMU constants: %v per point, %v per inch, %v per cm", emuPerPoint, emuPerInch, emuPerCM)
+	}
+	if pointsPerInch != 72 {
+		t.Errorf("expected 72 points per inch, got %v", pointsPerInch)
+	}
+}
+
+func TestUnitConversions(t *testing.T) {
+	// One standard 10 inch slide width in each unit
+	tests := []struct {
+		name    string
+		toEMU   func(float64) float64
+		fromEMU func(float64) float64
+		value   float64
+	}{
+		{"points", pointsToEMU, emuToPoints, 720},
+		{"inches", inchesToEMU, emuToInches, 10},
+		{"centimeters", cmToEMU, emuToCM, 25.4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.toEMU(tt.value); math.Abs(got-9144000) > 1e-6 {
+				t.Errorf("%v %s = %v EMU, want 9144000", tt.value, tt.name, got)
+			}
+			if got := tt.fromEMU(9144000); math.Abs(got-tt.value) > 1e-9 {
+				t.Errorf("9144000 EMU = %v %s, want %v", got, tt.name, tt.value)
+			}
+		})
+	}
+}
+
+func TestUnitConversions_RoundTrip(t *testing.T) {
+	values := []float64{0, 1, 0.5, 12.75, 72, 720, 1234.5678, -36}
+	conversions := []struct {
+		name    string
+		toEMU   func(float64) float64
+		fromEMU func(float64) float64
+	}{
+		{"points", pointsToEMU, emuToPoints},
+		{"inches", inchesToEMU, emuToInches},
+		{"centimeters", cmToEMU, emuToCM},
+	}
+
+	for _, conversion := range conversions {
+		t.Run(conversion.name, func(t *testing.T) {
+			for _, value := range values {
+				if got := conversion.fromEMU(conversion.toEMU(value)); math.Abs(got-value) > 1e-9 {
+					t.Errorf("round trip of %v = %v", value, got)
+				}
+				if got := conversion.toEMU(conversion.fromEMU(value * 1000)); math.Abs(got-value*1000) > 1e-6 {
+					t.Errorf("round trip of %v EMU = %v", value*1000, got)
+				}
+			}
+		})
+	}
+
+	// Crossing units through EMU: 1 inch = 72 points = 2.54 cm
+	if got := emuToPoints(inchesToEMU(1)); got != pointsPerInch {
+		t.Errorf("1 inch = %v points, want 72", got)
+	}
+	if got := emuToCM(inchesToEMU(1)); math.Abs(got-2.54) > 1e-12 {
+		t.Errorf("1 inch = %v cm, want 2.54", got)
+	}
+	if got := emuToInches(cmToEMU(2.54)); math.Abs(got-1) > 1e-12 {
+		t.Errorf("2.54 cm = %v inches, want 1", got)
+	}
+}
+
+func TestConvertDimensions(t *testing.T) {
+	tests := []struct {
+		name       string
+		dim        *slides.Dimension
+		wantPoints float64
+		wantEMU    float64
+	}{
+		{"nil", nil, 0, 0},
+		{"points", &slides.Dimension{Magnitude: 10, Unit: "PT"}, 10, 127000},
+		{"emu", &slides.Dimension{Magnitude: 127000, Unit: "EMU"}, 10, 127000},
+		{"zero emu", &slides.Dimension{Unit: "EMU"}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertToPoints(tt.dim); got != tt.wantPoints {
+				t.Errorf("convertToPoints() = %v, want %v", got, tt.wantPoints)
+			}
+			if got := convertToEMU(tt.dim); got != tt.wantEMU {
+				t.Errorf("convertToEMU() = %v, want %v", got, tt.wantEMU)
+			}
+			if tt.dim != nil {
+				if got := convertToEMU(&slides.Dimension{Magnitude: convertToPoints(tt.dim), Unit: "PT"}); got != tt.wantEMU {
+					t.Errorf("PT round trip = %v EMU, want %v", got, tt.wantEMU)
+				}
+			}
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package transport
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"sync"
+
+	"github.com/smorand/google-slides-mcp/internal/tools"
 )
 
 const (
@@ -96,6 +99,12 @@ type ServerInfo struct {
 type ToolCallParams struct {
 	Name      string          `json:"name"`
 	Arguments json.RawMessage `json:"arguments,omitempty"`
+	Meta      *ToolCallMeta   `json:"_meta,omitempty"`
+}
+
+// ToolCallMeta carries MCP request metadata for a tool call.
+type ToolCallMeta struct {
+	ProgressToken any `json:"progressToken,omitempty"` // Set by clients that want progress notifications
 }
 
 // ToolCallResult represents the result of a tool call.
@@ -113,6 +122,8 @@ type ContentBlock struct {
 // MCPHandler handles MCP protocol requests.
 type MCPHandler struct {
 	logger      *slog.Logger
+	toolGate    ToolGate
+	dispatcher  ToolDispatcher
 	initialized bool
 	mu          sync.RWMutex
 }
@@ -127,6 +138,20 @@ func NewMCPHandler(logger *slog.Logger) *MCPHandler {
 	}
 }
 
+// SetToolGate sets the gate that can refuse tool calls before dispatch. Nil allows every tool.
+func (h *MCPHandler) SetToolGate(gate ToolGate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.toolGate = gate
+}
+
+// SetToolDispatcher sets the dispatcher that runs tool calls. Nil reports every tool as not found.
+func (h *MCPHandler) SetToolDispatcher(dispatcher ToolDispatcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.dispatcher = dispatcher
+}
+
 // HandleInitialize handles the MCP initialize request.
 func (h *MCPHandler) HandleInitialize(w http.ResponseWriter, r *http.Request) {
 	var req JSONRPCRequest
@@ -191,7 +216,7 @@ func (h *MCPHandler) HandleToolCall(w http.ResponseWriter, r *http.Request) {
 
 	switch req.Method {
 	case "tools/call":
-		h.handleToolsCall(w, req)
+		h.handleToolsCall(r.Context(), w, req)
 	case "tools/list":
 		h.handleToolsList(w, req)
 	default:
@@ -209,7 +234,7 @@ func (h *MCPHandler) handleToolsList(w http.ResponseWriter, req JSONRPCRequest)
 }
 
 // handleToolsCall handles a tool call request.
-func (h *MCPHandler) handleToolsCall(w http.ResponseWriter, req JSONRPCRequest) {
+func (h *MCPHandler) handleToolsCall(ctx context.Context, w http.ResponseWriter, req JSONRPCRequest) {
 	var params ToolCallParams
 	if err := json.Unmarshal(req.Params, &params); err != nil {
 		h.writeError(w, req.ID, ErrorCodeInvalidParams, "invalid params", err)
@@ -220,18 +245,56 @@ func (h *MCPHandler) handleToolsCall(w http.ResponseWriter, req JSONRPCRequest)
 		slog.String("tool", params.Name),
 	)
 
-	// For now, return an error for unknown tools. Tools will be added in future stories.
-	result := ToolCallResult{
-		Content: []ContentBlock{
-			{
-				Type: "text",
-				Text: fmt.Sprintf("Tool '%s' not found", params.Name),
+	// Refuse tools disabled by the operator before anything is dispatched
+	h.mu.RLock()
+	gate := h.toolGate
+	dispatcher := h.dispatcher
+	h.mu.RUnlock()
+	if gate != nil {
+		if err := gate.CheckToolEnabled(params.Name); err != nil {
+			h.logger.Warn("refused disabled tool",
+				slog.String("tool", params.Name),
+			)
+			h.writeResponse(w, req.ID, ToolCallResult{
+				Content: []ContentBlock{{Type: "text", Text: err.Error()}},
+				IsError: true,
+			})
+			return
+		}
+	}
+
+	if dispatcher == nil {
+		h.writeResponse(w, req.ID, ToolCallResult{
+			Content: []ContentBlock{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Tool '%s' not found", params.Name),
+				},
 			},
-		},
-		IsError: true,
+			IsError: true,
+		})
+		return
 	}
 
-	h.writeResponse(w, req.ID, result)
+	// Progress notifications share the response stream, so the final result goes through the notifier too
+	if params.Meta != nil && params.Meta.ProgressToken != nil {
+		notifier := NewProgressNotifier(w, params.Meta.ProgressToken, h.logger)
+		ctx = tools.WithProgress(ctx, notifier)
+		w = notifier
+	}
+
+	text, err := dispatcher.CallTool(ctx, params.Name, params.Arguments)
+	if err != nil {
+		h.writeResponse(w, req.ID, ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: err.Error()}},
+			IsError: true,
+		})
+		return
+	}
+
+	h.writeResponse(w, req.ID, ToolCallResult{
+		Content: []ContentBlock{{Type: "text", Text: text}},
+	})
 }
 
 // parseRequest reads and parses a JSON-RPC request from the HTTP body.
@@ -2,6 +2,7 @@ package transport
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"log/slog"
@@ -9,6 +10,9 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"github.com/smorand/google-slides-mcp/internal/tools"
+	"golang.org/x/oauth2"
 )
 
 func TestMCPInitialize(t *testing.T) {
@@ -224,6 +228,52 @@ func TestToolsCall(t *testing.T) {
 	}
 }
 
+func TestToolsCallDisabledTool(t *testing.T) {
+	var factoryCalls int
+	config := tools.DefaultToolsConfig()
+	config.FeatureFlags = tools.FeatureFlags{"delete_presentation": false}
+	gate := tools.NewTools(config, func(ctx context.Context, ts oauth2.TokenSource) (tools.SlidesService, error) {
+		factoryCalls++
+		return nil, nil
+	})
+
+	h := NewMCPHandler(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	h.SetToolGate(gate)
+	h.mu.Lock()
+	h.initialized = true
+	h.mu.Unlock()
+
+	req := JSONRPCRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "tools/call",
+		Params:  json.RawMessage(`{"name": "delete_presentation", "arguments": {"presentation_id": "abc"}}`),
+	}
+	body, _ := json.Marshal(req)
+
+	httpReq := httptest.NewRequest(http.MethodPost, "/mcp", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.HandleToolCall(w, httpReq)
+
+	var resp struct {
+		Result ToolCallResult `json:"result"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if !resp.Result.IsError {
+		t.Error("expected isError to be true for a disabled tool")
+	}
+	if len(resp.Result.Content) != 1 || !strings.Contains(resp.Result.Content[0].Text, tools.ErrFeatureDisabled.Error()) {
+		t.Errorf("expected feature-disabled message, got %+v", resp.Result.Content)
+	}
+	if factoryCalls != 0 {
+		t.Errorf("expected no API service to be created, got %d", factoryCalls)
+	}
+}
+
 func TestUnknownMethod(t *testing.T) {
 	h := NewMCPHandler(slog.New(slog.NewTextHandler(io.Discard, nil)))
 
@@ -434,3 +484,107 @@ func TestToolsCallInvalidParams(t *testing.T) {
 		t.Errorf("error code = %d, want %d", resp.Error.Code, ErrorCodeInvalidParams)
 	}
 }
+
+// fakeToolDispatcher reports progress through the call context, then returns its result.
+type fakeToolDispatcher struct {
+	reports int
+	text    string
+	err     error
+}
+
+func (d *fakeToolDispatcher) CallTool(ctx context.Context, name string, arguments json.RawMessage) (string, error) {
+	progress := tools.ProgressFromContext(ctx)
+	for i := 1; i <= d.reports; i++ {
+		progress.Report(i, d.reports, "working")
+	}
+	return d.text, d.err
+}
+
+func TestToolsCallDispatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		params          string
+		dispatcher      *fakeToolDispatcher
+		wantContentType string
+		wantEvents      int // Progress notifications ahead of the result
+		wantIsError     bool
+		wantText        string
+	}{
+		{
+			name:            "progress token streams events",
+			params:          `{"name": "add_image_grid", "arguments": {}, "_meta": {"progressToken": "tok-1"}}`,
+			dispatcher:      &fakeToolDispatcher{reports: 2, text: "done"},
+			wantContentType: "text/event-stream",
+			wantEvents:      2,
+			wantText:        "done",
+		},
+		{
+			name:            "no progress token stays plain JSON",
+			params:          `{"name": "add_image_grid", "arguments": {}}`,
+			dispatcher:      &fakeToolDispatcher{reports: 2, text: "done"},
+			wantContentType: "application/json",
+			wantText:        "done",
+		},
+		{
+			name:            "dispatch error",
+			params:          `{"name": "add_image_grid", "arguments": {}, "_meta": {"progressToken": 3}}`,
+			dispatcher:      &fakeToolDispatcher{err: tools.ErrInvalidPresentationID},
+			wantContentType: "application/json",
+			wantIsError:     true,
+			wantText:        tools.ErrInvalidPresentationID.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewMCPHandler(slog.New(slog.NewTextHandler(io.Discard, nil)))
+			h.SetToolDispatcher(tt.dispatcher)
+			h.mu.Lock()
+			h.initialized = true
+			h.mu.Unlock()
+
+			req := JSONRPCRequest{
+				JSONRPC: "2.0",
+				ID:      1,
+				Method:  "tools/call",
+				Params:  json.RawMessage(tt.params),
+			}
+			body, _ := json.Marshal(req)
+
+			httpReq := httptest.NewRequest(http.MethodPost, "/mcp", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			h.HandleToolCall(w, httpReq)
+
+			if got := w.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Fatalf("Content-Type = %q, want %q", got, tt.wantContentType)
+			}
+			final := w.Body.String()
+			if tt.wantContentType == "text/event-stream" {
+				events := readSSEEvents(t, final)
+				if len(events) != tt.wantEvents+1 {
+					t.Fatalf("expected %d events, got %d", tt.wantEvents+1, len(events))
+				}
+				for _, event := range events[:tt.wantEvents] {
+					if !strings.Contains(event, ProgressNotificationMethod) {
+						t.Errorf("expected a progress notification, got %s", event)
+					}
+				}
+				final = events[len(events)-1]
+			}
+
+			var resp struct {
+				Result ToolCallResult `json:"result"`
+			}
+			if err := json.Unmarshal([]byte(final), &resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Result.IsError != tt.wantIsError {
+				t.Errorf("isError = %v, want %v", resp.Result.IsError, tt.wantIsError)
+			}
+			if len(resp.Result.Content) != 1 || resp.Result.Content[0].Text != tt.wantText {
+				t.Errorf("expected text %q, got %+v", tt.wantText, resp.Result.Content)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+)
+
+// ProgressNotificationMethod is the MCP method used for progress notifications.
+const ProgressNotificationMethod = "notifications/progress"
+
+// JSONRPCNotification represents a JSON-RPC 2.0 notification (a request without an ID).
+type JSONRPCNotification struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+// ProgressParams represents the params of an MCP progress notification.
+type ProgressParams struct {
+	ProgressToken any    `json:"progressToken"`
+	Progress      int    `json:"progress"`
+	Total         int    `json:"total,omitempty"`
+	Message       string `json:"message,omitempty"`
+}
+
+// ProgressNotifier streams MCP progress notifications for a tool call ahead of its result.
+// The first report switches the response to a server-sent event stream (text/event-stream):
+// each notification, and then the final JSON-RPC response, is sent as one "data:" event.
+// Without any report the response stays a plain JSON body. It satisfies tools.Progress and
+// wraps the response writer so the status line is sent only once.
+type ProgressNotifier struct {
+	w           http.ResponseWriter
+	token       any
+	logger      *slog.Logger
+	mu          sync.Mutex
+	wroteHeader bool
+	streaming   bool // Set once the response has become an event stream
+}
+
+// NewProgressNotifier creates a notifier that writes progress for token to w.
+func NewProgressNotifier(w http.ResponseWriter, token any, logger *slog.Logger) *ProgressNotifier {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ProgressNotifier{
+		w:      w,
+		token:  token,
+		logger: logger,
+	}
+}
+
+// Report sends a notifications/progress event and flushes it to the client. Reports after a
+// plain JSON response has started are dropped, since they can no longer be framed as events.
+func (n *ProgressNotifier) Report(completed, total int, message string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.streaming {
+		if n.wroteHeader {
+			n.logger.Warn("dropping progress notification after the response started")
+			return
+		}
+		n.w.Header().Set("Content-Type", "text/event-stream")
+		n.w.Header().Set("Cache-Control", "no-cache")
+		n.writeHeaderLocked(http.StatusOK)
+		n.streaming = true
+	}
+
+	notification := JSONRPCNotification{
+		JSONRPC: JSONRPCVersion,
+		Method:  ProgressNotificationMethod,
+		Params: ProgressParams{
+			ProgressToken: n.token,
+			Progress:      completed,
+			Total:         total,
+			Message:       message,
+		},
+	}
+	data, err := json.Marshal(notification)
+	if err != nil {
+		n.logger.Error("failed to encode progress notification", slog.Any("error", err))
+		return
+	}
+	if err := n.writeEventLocked(data); err != nil {
+		n.logger.Error("failed to write progress notification", slog.Any("error", err))
+		return
+	}
+
+	if flusher, ok := n.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Header returns the underlying response headers. Once the event stream has started, it
+// returns a detached copy so the final response cannot rewrite the stream's headers.
+func (n *ProgressNotifier) Header() http.Header {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.streaming {
+		return n.w.Header().Clone()
+	}
+	return n.w.Header()
+}
+
+// WriteHeader sends the status line unless a progress notification already did.
+func (n *ProgressNotifier) WriteHeader(status int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.wroteHeader {
+		n.writeHeaderLocked(status)
+	}
+}
+
+// Write writes to the underlying response. Once the response is an event stream, each call
+// is sent as one event, so callers must write a whole JSON message at a time.
+func (n *ProgressNotifier) Write(b []byte) (int, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.streaming {
+		n.wroteHeader = true
+		return n.w.Write(b)
+	}
+	if err := n.writeEventLocked(bytes.TrimRight(b, "\n")); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// Flush flushes the underlying response if it supports flushing.
+func (n *ProgressNotifier) Flush() {
+	if flusher, ok := n.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// writeHeaderLocked sends the status line. The caller must hold n.mu.
+func (n *ProgressNotifier) writeHeaderLocked(status int) {
+	n.w.WriteHeader(status)
+	n.wroteHeader = true
+}
+
+// writeEventLocked writes data as one server-sent event. The caller must hold n.mu.
+func (n *ProgressNotifier) writeEventLocked(data []byte) error {
+	_, err := fmt.Fprintf(n.w, "data: %s\n\n", data)
+	return err
+}
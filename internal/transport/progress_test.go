@@ -0,0 +1,122 @@
+package transport
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/smorand/google-slides-mcp/internal/tools"
+)
+
+// Compile-time check that the notifier can be handed to tools.WithProgress.
+var _ tools.Progress = (*ProgressNotifier)(nil)
+
+// readSSEEvents returns the data payload of each server-sent event in body.
+func readSSEEvents(t *testing.T, body string) []string {
+	t.Helper()
+	var events []string
+	for _, frame := range strings.Split(strings.TrimSuffix(body, "\n\n"), "\n\n") {
+		data, ok := strings.CutPrefix(frame, "data: ")
+		if !ok || strings.Contains(data, "\n") {
+			t.Fatalf("malformed event %q in %q", frame, body)
+		}
+		events = append(events, data)
+	}
+	return events
+}
+
+func TestProgressNotifier_StreamsNotificationsBeforeResult(t *testing.T) {
+	h := NewMCPHandler(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	w := httptest.NewRecorder()
+
+	notifier := NewProgressNotifier(w, "tok-1", nil)
+	notifier.Report(1, 3, "Uploaded image 1 of 2")
+	notifier.Report(2, 3, "Uploaded image 2 of 2")
+	h.writeResponse(notifier, 7, map[string]any{"ok": true})
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Content-Type"); got != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want text/event-stream", got)
+	}
+	if got := w.Header().Get("Transfer-Encoding"); got != "" {
+		t.Errorf("expected net/http to manage Transfer-Encoding, got %q", got)
+	}
+
+	events := readSSEEvents(t, w.Body.String())
+	if len(events) != 3 {
+		t.Fatalf("expected 2 notifications and the result, got %d events", len(events))
+	}
+	for i, want := range []int{1, 2} {
+		var notification struct {
+			Method string         `json:"method"`
+			Params ProgressParams `json:"params"`
+		}
+		if err := json.Unmarshal([]byte(events[i]), &notification); err != nil {
+			t.Fatalf("failed to decode notification %d: %v", i, err)
+		}
+		if notification.Method != ProgressNotificationMethod {
+			t.Errorf("notification %d method = %q", i, notification.Method)
+		}
+		if notification.Params.ProgressToken != "tok-1" || notification.Params.Progress != want || notification.Params.Total != 3 {
+			t.Errorf("notification %d params = %+v", i, notification.Params)
+		}
+	}
+
+	var resp JSONRPCResponse
+	if err := json.Unmarshal([]byte(events[2]), &resp); err != nil {
+		t.Fatalf("failed to decode final response: %v", err)
+	}
+	if resp.ID != float64(7) || resp.Result == nil {
+		t.Errorf("unexpected final response: %+v", resp)
+	}
+}
+
+func TestProgressNotifier_WithoutReportsWritesPlainJSON(t *testing.T) {
+	h := NewMCPHandler(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	w := httptest.NewRecorder()
+
+	notifier := NewProgressNotifier(w, "tok-1", nil)
+	h.writeResponse(notifier, 1, map[string]any{"ok": true})
+	notifier.Report(1, 1, "too late")
+
+	if got := w.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+	var resp JSONRPCResponse
+	decoder := json.NewDecoder(w.Body)
+	if err := decoder.Decode(&resp); err != nil || resp.Result == nil {
+		t.Fatalf("expected a plain JSON response, got %+v (%v)", resp, err)
+	}
+	if decoder.More() {
+		t.Error("expected a late report to be dropped")
+	}
+}
+
+func TestProgressNotifier_WithoutReportsPassesStatusThrough(t *testing.T) {
+	w := httptest.NewRecorder()
+	notifier := NewProgressNotifier(w, 1, nil)
+
+	notifier.WriteHeader(http.StatusBadRequest)
+	notifier.WriteHeader(http.StatusOK)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestToolCallParams_ProgressToken(t *testing.T) {
+	var params ToolCallParams
+	raw := `{"name": "add_image_grid", "_meta": {"progressToken": "abc"}}`
+	if err := json.Unmarshal([]byte(raw), &params); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if params.Meta == nil || params.Meta.ProgressToken != "abc" {
+		t.Errorf("expected progress token abc, got %+v", params.Meta)
+	}
+}
@@ -58,6 +58,17 @@ type RateLimitMiddleware interface {
 	Middleware(next http.HandlerFunc) http.HandlerFunc
 }
 
+// ToolGate decides whether a tool may be dispatched, for example from per-tool feature flags.
+type ToolGate interface {
+	CheckToolEnabled(name string) error
+}
+
+// ToolDispatcher runs a tool call and returns its text result. When the client sent a progress
+// token, ctx carries the call's progress notifier (see tools.ProgressFromContext).
+type ToolDispatcher interface {
+	CallTool(ctx context.Context, name string, arguments json.RawMessage) (string, error)
+}
+
 // Server represents the HTTP streamable MCP server.
 type Server struct {
 	config              ServerConfig
@@ -157,6 +168,16 @@ func (s *Server) SetRateLimitMiddleware(middleware RateLimitMiddleware) {
 	s.rateLimitMiddleware = middleware
 }
 
+// SetToolGate sets the gate consulted before each tool call is dispatched.
+func (s *Server) SetToolGate(gate ToolGate) {
+	s.handler.SetToolGate(gate)
+}
+
+// SetToolDispatcher sets the dispatcher that runs tool calls.
+func (s *Server) SetToolDispatcher(dispatcher ToolDispatcher) {
+	s.handler.SetToolDispatcher(dispatcher)
+}
+
 // handleAuth handles the /auth endpoint.
 func (s *Server) handleAuth(w http.ResponseWriter, r *http.Request) {
 	if s.authHandler == nil {